@@ -0,0 +1,507 @@
+// itstasty-admin contains maintenance commands that operate directly on the database.
+// The database is configured with the same environment variables as the server
+// (DB_DRIVER, DB_URL, DB_NAME, DB_USER, DB_PW, SQLITE_PATH)
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"itsTasty/pkg/api/adapters/dishRepo"
+	"itsTasty/pkg/api/domain"
+	"itsTasty/pkg/api/menuImporter"
+	"itsTasty/pkg/api/nameNormalizer"
+	"itsTasty/pkg/api/transferService"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+const (
+	envVarDBDriver   = "DB_DRIVER"
+	envVarDBURL      = "DB_URL"
+	envVarDBName     = "DB_NAME"
+	envVarDBUser     = "DB_USER"
+	envVarDBPW       = "DB_PW"
+	envVarDBSSLMode  = "DB_SSLMODE"
+	envVarSQLitePath = "SQLITE_PATH"
+	//envVarDishNameRules is the default for the -rules flag
+	envVarDishNameRules = "DISH_NAME_RULES"
+)
+
+const usage = `usage: itstasty-admin <command> [flags]
+
+commands:
+  export           write all data to a snapshot file
+  import           add all data from a snapshot file that is not yet present
+  import-menu      add historical menus from a CSV or iCalendar file
+  normalize-names  preview or apply the dish name normalization rules to existing dishes
+  merge-locations  preview or apply merging one location into another
+  dedupe-dishes    fold a duplicate dish into its canonical dish
+
+Run "itstasty-admin <command> -h" for the flags of a command.
+`
+
+func setEnvErr(name string) error {
+	return fmt.Errorf("environment variable %v not set", name)
+}
+
+// adminRepo is the data access required by all commands. It is implemented by all storage backends
+type adminRepo interface {
+	transferService.Repo
+	domain.LocationRepo
+}
+
+// openRepo connects to the database configured via the environment and applies all migrations
+// found in migrationsDir
+func openRepo(migrationsDir string) (adminRepo, error) {
+	switch driver := strings.ToLower(os.Getenv(envVarDBDriver)); driver {
+	case "", "postgres":
+		env := make(map[string]string)
+		for _, v := range []string{envVarDBURL, envVarDBName, envVarDBUser, envVarDBPW} {
+			if env[v] = os.Getenv(v); env[v] == "" {
+				return nil, setEnvErr(v)
+			}
+		}
+		options := url.Values{}
+		if sslMode := os.Getenv(envVarDBSSLMode); sslMode != "" {
+			options.Set("sslmode", sslMode)
+		}
+		db, err := dishRepo.ConnectToPostgresDB(context.Background(), env[envVarDBUser], env[envVarDBPW],
+			env[envVarDBURL], env[envVarDBName], options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to db : %v", err)
+		}
+		return dishRepo.NewPostgresRepo(db, &migrate.FileMigrationSource{Dir: filepath.Join(migrationsDir, "postgres")})
+	case "sqlite":
+		path := os.Getenv(envVarSQLitePath)
+		if path == "" {
+			return nil, setEnvErr(envVarSQLitePath)
+		}
+		db, err := dishRepo.OpenSQLiteDB(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open sqlite db : %v", err)
+		}
+		return dishRepo.NewSQLiteRepo(db, &migrate.FileMigrationSource{Dir: filepath.Join(migrationsDir, "sqlite")})
+	default:
+		return nil, fmt.Errorf("unsupported value %v for %v", driver, envVarDBDriver)
+	}
+}
+
+// loadNormalizer uses the rules from path or nameNormalizer.DefaultConfig if path is empty
+func loadNormalizer(path string) (*nameNormalizer.Normalizer, error) {
+	cfg := nameNormalizer.DefaultConfig()
+	if path != "" {
+		var err error
+		if cfg, err = nameNormalizer.LoadConfig(path); err != nil {
+			return nil, err
+		}
+	}
+	return nameNormalizer.NewNormalizer(cfg)
+}
+
+func runExport(args []string) (err error) {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	out := flags.String("out", "-", "output file, \"-\" for stdout")
+	format := flags.String("format", string(transferService.FormatJSON), "snapshot format, json or ndjson")
+	migrationsDir := flags.String("migrations", "/migrations", "directory containing the db migrations")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	parsedFormat, err := transferService.ParseFormat(*format)
+	if err != nil {
+		return err
+	}
+
+	r, err := openRepo(*migrationsDir)
+	if err != nil {
+		return fmt.Errorf("failed to open repo : %v", err)
+	}
+	defer func() {
+		err = errors.Join(err, r.Close())
+	}()
+
+	snapshot, err := transferService.NewDefaultTransferService(r).Export(context.Background())
+	if err != nil {
+		return fmt.Errorf("export failed : %v", err)
+	}
+
+	var w io.Writer = os.Stdout
+	if *out != "-" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return fmt.Errorf("failed to create output file : %v", err)
+		}
+		defer func() {
+			err = errors.Join(err, f.Close())
+		}()
+		w = f
+	}
+	if err := transferService.Write(w, snapshot, parsedFormat); err != nil {
+		return err
+	}
+
+	log.Printf("Exported %v locations, %v dishes, %v merged dishes, %v ratings and %v streaks",
+		len(snapshot.Locations), len(snapshot.Dishes), len(snapshot.MergedDishes), len(snapshot.Ratings),
+		len(snapshot.Streaks))
+	return nil
+}
+
+func runImport(args []string) (err error) {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	in := flags.String("in", "-", "input file, \"-\" for stdin")
+	format := flags.String("format", string(transferService.FormatJSON), "snapshot format, json or ndjson")
+	anonymize := flags.Bool("anonymize-emails", false, "replace user emails with pseudonyms")
+	anonymizationKeyFile := flags.String("anonymization-key-file", "",
+		"file with the secret key for the pseudonyms, a random key for this import if empty")
+	migrationsDir := flags.String("migrations", "/migrations", "directory containing the db migrations")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	parsedFormat, err := transferService.ParseFormat(*format)
+	if err != nil {
+		return err
+	}
+
+	var rd io.Reader = os.Stdin
+	if *in != "-" {
+		f, err := os.Open(*in)
+		if err != nil {
+			return fmt.Errorf("failed to open input file : %v", err)
+		}
+		defer f.Close()
+		rd = f
+	}
+	snapshot, err := transferService.Read(rd, parsedFormat)
+	if err != nil {
+		return fmt.Errorf("failed to read snapshot : %v", err)
+	}
+
+	opts := transferService.ImportOptions{AnonymizeEmails: *anonymize}
+	if *anonymizationKeyFile != "" {
+		opts.AnonymizationKey, err = os.ReadFile(*anonymizationKeyFile)
+		if err != nil {
+			return fmt.Errorf("failed to read anonymization key : %v", err)
+		}
+	}
+
+	r, err := openRepo(*migrationsDir)
+	if err != nil {
+		return fmt.Errorf("failed to open repo : %v", err)
+	}
+	defer func() {
+		err = errors.Join(err, r.Close())
+	}()
+
+	report, err := transferService.NewDefaultTransferService(r).Import(context.Background(), snapshot, opts)
+	if report != nil {
+		log.Printf("Dishes: %v created, %v skipped", report.CreatedDishes, report.SkippedDishes)
+		log.Printf("Merged dishes: %v created, %v skipped", report.CreatedMergedDishes, report.SkippedMergedDishes)
+		log.Printf("Ratings: %v created, %v skipped", report.CreatedRatings, report.SkippedRatings)
+		log.Printf("Streaks: %v created, %v skipped", report.CreatedStreaks, report.SkippedStreaks)
+		if *anonymize {
+			log.Printf("Anonymized %v user emails", report.AnonymizedUserEmails)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("import failed : %v", err)
+	}
+	return nil
+}
+
+func runImportMenu(args []string) (err error) {
+	flags := flag.NewFlagSet("import-menu", flag.ExitOnError)
+	in := flags.String("in", "-", "input file, \"-\" for stdin")
+	format := flags.String("format", string(menuImporter.FormatCSV), "file format, csv or ical")
+	location := flags.String("location", "", "location for rows/events that do not specify one")
+	verbose := flags.Bool("v", false, "print the result of every row")
+	rules := flags.String("rules", os.Getenv(envVarDishNameRules), "dish name rules file, built-in rules if empty")
+	migrationsDir := flags.String("migrations", "/migrations", "directory containing the db migrations")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	parsedFormat, err := menuImporter.ParseFormat(*format)
+	if err != nil {
+		return err
+	}
+	normalizer, err := loadNormalizer(*rules)
+	if err != nil {
+		return fmt.Errorf("failed to load dish name rules : %v", err)
+	}
+
+	var rd io.Reader = os.Stdin
+	if *in != "-" {
+		f, err := os.Open(*in)
+		if err != nil {
+			return fmt.Errorf("failed to open input file : %v", err)
+		}
+		defer f.Close()
+		rd = f
+	}
+	entries, rowErrors, err := menuImporter.Parse(rd, parsedFormat, *location)
+	if err != nil {
+		return fmt.Errorf("failed to parse file : %v", err)
+	}
+
+	r, err := openRepo(*migrationsDir)
+	if err != nil {
+		return fmt.Errorf("failed to open repo : %v", err)
+	}
+	defer func() {
+		err = errors.Join(err, r.Close())
+	}()
+
+	report, err := menuImporter.NewImporter(r, r, normalizer).Import(context.Background(), entries, rowErrors)
+	if report != nil {
+		for _, v := range report.Rows {
+			if *verbose || v.Status == menuImporter.RowSkipped {
+				log.Printf("Row %v: %v %v", v.Row, v.Status, v.Reason)
+			}
+		}
+		log.Printf("%v created, %v updated, %v skipped", report.Created, report.Updated, report.Skipped)
+	}
+	if err != nil {
+		return fmt.Errorf("import failed : %v", err)
+	}
+	return nil
+}
+
+func runNormalizeNames(args []string) (err error) {
+	flags := flag.NewFlagSet("normalize-names", flag.ExitOnError)
+	location := flags.String("location", "", "only consider dishes of this location")
+	rename := flags.Bool("rename", false, "rename dishes to their normalized name")
+	merge := flags.Bool("merge", false, "combine dishes whose normalized names collide in a merged dish")
+	rules := flags.String("rules", os.Getenv(envVarDishNameRules), "dish name rules file, built-in rules if empty")
+	migrationsDir := flags.String("migrations", "/migrations", "directory containing the db migrations")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	normalizer, err := loadNormalizer(*rules)
+	if err != nil {
+		return fmt.Errorf("failed to load dish name rules : %v", err)
+	}
+	var optionalLocation *string
+	if *location != "" {
+		optionalLocation = location
+	}
+
+	r, err := openRepo(*migrationsDir)
+	if err != nil {
+		return fmt.Errorf("failed to open repo : %v", err)
+	}
+	defer func() {
+		err = errors.Join(err, r.Close())
+	}()
+
+	service := nameNormalizer.NewDefaultNormalizationService(r, normalizer)
+	var changes []nameNormalizer.Change
+	//without -rename and -merge, only the tags are stored. Thus, stick to a preview to not surprise the user
+	if *rename || *merge {
+		changes, err = service.Apply(context.Background(), optionalLocation,
+			nameNormalizer.ApplyOptions{Rename: *rename, Merge: *merge})
+	} else {
+		changes, err = service.Preview(context.Background(), optionalLocation)
+	}
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, v := range changes {
+		status := "preview"
+		if v.Applied {
+			status = "applied"
+		} else if v.Error != "" {
+			status = "failed: " + v.Error
+			failed += 1
+		}
+		target := ""
+		if v.Action == nameNormalizer.ActionMerge {
+			target = fmt.Sprintf(" (with dish %v)", v.TargetDishID)
+		}
+		log.Printf("Dish %v at %v: %v %q -> %q%v tags %v [%v]", v.DishID, v.Location, v.Action, v.Name,
+			v.NormalizedName, target, v.Tags, status)
+	}
+	log.Printf("%v changes, %v failed", len(changes), failed)
+	return nil
+}
+
+// locationIDByName returns the id of the location with the given name or alias
+func locationIDByName(ctx context.Context, r adminRepo, name string) (int64, error) {
+	resolved, err := r.ResolveLocationName(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	locations, err := r.GetAllLocations(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for id, v := range locations {
+		if v.Name == resolved {
+			return id, nil
+		}
+	}
+	return 0, fmt.Errorf("location %v not found", name)
+}
+
+func runMergeLocations(args []string) (err error) {
+	flags := flag.NewFlagSet("merge-locations", flag.ExitOnError)
+	source := flags.String("source", "", "location that is merged into the target location and deleted afterwards")
+	target := flags.String("target", "", "location that is kept")
+	apply := flags.Bool("apply", false, "apply the merge instead of only printing the report")
+	migrationsDir := flags.String("migrations", "/migrations", "directory containing the db migrations")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *source == "" || *target == "" {
+		return errors.New("-source and -target are required")
+	}
+
+	r, err := openRepo(*migrationsDir)
+	if err != nil {
+		return fmt.Errorf("failed to open repo : %v", err)
+	}
+	defer func() {
+		err = errors.Join(err, r.Close())
+	}()
+
+	ctx := context.Background()
+	sourceID, err := locationIDByName(ctx, r, *source)
+	if err != nil {
+		return err
+	}
+	targetID, err := locationIDByName(ctx, r, *target)
+	if err != nil {
+		return err
+	}
+
+	report, err := r.MergeLocations(ctx, sourceID, targetID, !*apply)
+	if err != nil {
+		return err
+	}
+
+	status := "applied"
+	if report.DryRun {
+		status = "preview"
+	}
+	for _, v := range report.MovedDishes {
+		log.Printf("Dish %v: move to %v [%v]", v, report.TargetLocation, status)
+	}
+	for _, v := range report.CombinedDishes {
+		log.Printf("Dish %v: combine %q with dish %v [%v]", v.SourceDishID, v.Name, v.TargetDishID, status)
+	}
+	for _, v := range report.MovedMergedDishes {
+		log.Printf("Merged dish %v: move to %v [%v]", v, report.TargetLocation, status)
+	}
+	for _, v := range report.CombinedMergedDishes {
+		log.Printf("Merged dish %v: combine with merged dish %v [%v]", v.SourceMergedDishID, v.TargetMergedDishID,
+			status)
+	}
+	for _, v := range report.MembershipChanges {
+		log.Printf("Dish %v: add to merged dish %v [%v]", v.DishID, v.MergedDishID, status)
+	}
+	for _, v := range report.DishFamilyChanges.LeavingDishes {
+		log.Printf("Dish %v: remove from dish family %v [%v]", v.DishID, v.DishFamilyID, status)
+	}
+	for _, v := range report.DishFamilyChanges.JoiningDishes {
+		log.Printf("Dish %v: add to dish family %v [%v]", v.DishID, v.DishFamilyID, status)
+	}
+	for _, v := range report.DishFamilyChanges.DissolvedFamilies {
+		log.Printf("Dish family %v: dissolve, less than two dishes remain [%v]", v, status)
+	}
+	log.Printf("Merging %v into %v: %v dishes moved, %v combined, %v merged dishes moved, %v combined [%v]",
+		report.SourceLocation, report.TargetLocation, len(report.MovedDishes), len(report.CombinedDishes),
+		len(report.MovedMergedDishes), len(report.CombinedMergedDishes), status)
+	return nil
+}
+
+func runDedupeDishes(args []string) (err error) {
+	flags := flag.NewFlagSet("dedupe-dishes", flag.ExitOnError)
+	canonicalID := flags.Int64("canonical", 0, "id of the dish that is kept")
+	duplicateID := flags.Int64("duplicate", 0, "id of the dish that is folded into the canonical dish and deleted")
+	performedBy := flags.String("by", os.Getenv("USER"), "who performs the dedupe, stored in the audit log")
+	apply := flags.Bool("apply", false, "apply the dedupe instead of only printing both dishes")
+	migrationsDir := flags.String("migrations", "/migrations", "directory containing the db migrations")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *canonicalID == 0 || *duplicateID == 0 {
+		return errors.New("-canonical and -duplicate are required")
+	}
+
+	r, err := openRepo(*migrationsDir)
+	if err != nil {
+		return fmt.Errorf("failed to open repo : %v", err)
+	}
+	defer func() {
+		err = errors.Join(err, r.Close())
+	}()
+
+	ctx := context.Background()
+	if !*apply {
+		for _, id := range []int64{*canonicalID, *duplicateID} {
+			dish, err := r.GetDishByID(ctx, id)
+			if err != nil {
+				return err
+			}
+			log.Printf("Dish %v at %v: %q served %v times [preview]", id, dish.ServedAt, dish.Name,
+				len(dish.Occurrences()))
+		}
+		log.Printf("Pass -apply to fold dish %v into dish %v", *duplicateID, *canonicalID)
+		return nil
+	}
+
+	dedupe, err := r.DedupeDish(ctx, *canonicalID, *duplicateID, *performedBy)
+	if err != nil {
+		return err
+	}
+	log.Printf("Folded dish %v %q into dish %v: %v occurrences moved, %v dropped, %v ratings moved, %v dropped",
+		dedupe.DuplicateDishID, dedupe.DuplicateDishName, dedupe.CanonicalDishID, dedupe.MovedOccurrences,
+		dedupe.DroppedOccurrences, dedupe.MovedRatings, dedupe.DroppedRatings)
+	switch {
+	case dedupe.DishFamilyID == nil:
+	case dedupe.CanonicalJoinedDishFamily:
+		log.Printf("Dish %v took the place of dish %v in dish family %v", dedupe.CanonicalDishID,
+			dedupe.DuplicateDishID, *dedupe.DishFamilyID)
+	case dedupe.DishFamilyDissolved:
+		log.Printf("Dissolved dish family %v, less than two dishes remain", *dedupe.DishFamilyID)
+	default:
+		log.Printf("Removed dish %v from dish family %v", dedupe.DuplicateDishID, *dedupe.DishFamilyID)
+	}
+	return nil
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "export":
+		err = runExport(os.Args[2:])
+	case "import":
+		err = runImport(os.Args[2:])
+	case "import-menu":
+		err = runImportMenu(os.Args[2:])
+	case "normalize-names":
+		err = runNormalizeNames(os.Args[2:])
+	case "merge-locations":
+		err = runMergeLocations(os.Args[2:])
+	case "dedupe-dishes":
+		err = runDedupeDishes(os.Args[2:])
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatalf("%v : %v", os.Args[1], err)
+	}
+}
@@ -0,0 +1,761 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"itsTasty/pkg/api/adapters/notifier"
+	"itsTasty/pkg/httpSecurity"
+	"itsTasty/pkg/logging"
+	"itsTasty/pkg/rateLimit"
+	"itsTasty/pkg/telemetry"
+	"log/slog"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	//envVarConfigFile is the path of an optional YAML or TOML config file. The --config flag takes precedence.
+	//Env vars take precedence over the values in the file
+	envVarConfigFile = "CONFIG_FILE"
+
+	//envVarListen is the address of the http server
+	envVarListen = "LISTEN"
+	//envVarTLSCertFile and envVarTLSKeyFile enable TLS if both are set. In dev mode, they default to the
+	//self-signed cert created by scripts/gen-local-certs.sh
+	envVarTLSCertFile = "TLS_CERT_FILE"
+	envVarTLSKeyFile  = "TLS_KEY_FILE"
+	//envVarTLSReloadInterval is the interval in which the cert and key files are checked for changes
+	envVarTLSReloadInterval = "TLS_RELOAD_INTERVAL"
+
+	//Timeouts and limits of the http server
+	//see https://pkg.go.dev/net/http#Server for their meaning
+	envVarHTTPReadHeaderTimeout = "HTTP_READ_HEADER_TIMEOUT"
+	envVarHTTPReadTimeout       = "HTTP_READ_TIMEOUT"
+	envVarHTTPWriteTimeout      = "HTTP_WRITE_TIMEOUT"
+	envVarHTTPIdleTimeout       = "HTTP_IDLE_TIMEOUT"
+	envVarHTTPMaxHeaderBytes    = "HTTP_MAX_HEADER_BYTES"
+	//envVarHTTPMaxBodyBytes limits the request bodies of the user and bot api
+	envVarHTTPMaxBodyBytes = "HTTP_MAX_BODY_BYTES"
+	//envVarHTTPContentSecurityPolicy overrides httpSecurity.DefaultContentSecurityPolicy
+	envVarHTTPContentSecurityPolicy = "HTTP_CONTENT_SECURITY_POLICY"
+	//envVarHTTPHSTSMaxAge is the max-age of the Strict-Transport-Security header. Zero disables the header
+	envVarHTTPHSTSMaxAge = "HTTP_HSTS_MAX_AGE"
+
+	//envVarDBDriver selects the storage backend. One of dbDriverPostgres (default), dbDriverSQLite or dbDriverMemory.
+	//With dbDriverMemory, the DB_* variables are not required and all data is lost on shutdown
+	envVarDBDriver = "DB_DRIVER"
+	//envVarSQLitePath is the path of the database file. Only required with dbDriverSQLite
+	envVarSQLitePath = "SQLITE_PATH"
+
+	envVarDBURL  = "DB_URL"
+	envVarDBName = "DB_NAME"
+	envVarDBUser = "DB_USER"
+	envVarDBPW   = "DB_PW"
+	//envVarDBSSLMode is the postgres sslmode, see https://www.postgresql.org/docs/current/libpq-ssl.html
+	envVarDBSSLMode = "DB_SSLMODE"
+
+	envOIDCSecret      = "OIDC_SECRET"
+	envOIDCCallbackURL = "OIDC_CALLBACK_URL"
+	envOIDCProviderURL = "OIDC_PROVIDER_URL"
+	envOIDCID          = "OIDC_ID"
+	//envOIDCBearerAudience is the audience that JWTs sent as bearer token to the user API must have.
+	//If empty, JWTs are rejected
+	envOIDCBearerAudience = "OIDC_BEARER_AUDIENCE"
+	//envOIDCRefreshIntervalMinutes is deprecated in favor of envOIDCRefreshInterval but still honored
+	envOIDCRefreshIntervalMinutes = "OIDC_REFRESH_INTERVAL_MINUTES"
+
+	envVacationServerURL    = "VACATION_SERVER_URL"
+	envVacationServerApiKey = "VACATION_SERVER_API_KEY"
+	envPublicHolidayRegion  = "PUBLIC_HOLIDAY_REGION"
+	//envVarVacationMaxAge is the maximum time since the last successful request to the vacation server before the
+	//app is reported as not ready.
+	//see https://pkg.go.dev/time#ParseDuration for input format
+	envVarVacationMaxAge = "VACATION_MAX_AGE"
+
+	envBotAPIToken = "BOT_API_TOKEN"
+
+	//envVarDishNameRules is the path of a JSON file with the dish name normalization rules.
+	//If not set, nameNormalizer.DefaultConfig is used
+	envVarDishNameRules = "DISH_NAME_RULES"
+
+	//envURLAfterLogin sets the default url after login if no login target is given to the authenticator
+	envURLAfterLogin  = "URL_AFTER_LOGIN"
+	envURLAfterLogout = "URL_AFTER_LOGOUT"
+
+	//envVarDevMode if set to true, mock login backend is used and X-Site cookies are allowed.
+	//Make sure to also configure envVarDevCORS
+	envVarDevMode = "DEV_MODE"
+
+	//envVarDevCORS one URL that is allowed for CORS requests
+	envVarDevCORS = "DEV_CORS"
+
+	//envVarSessionLifetime is the maximum lifetime of the session cookie. Afterward the user
+	//has to log in again
+	//see https://pkg.go.dev/time#ParseDuration for input format
+	envVarSessionLifetime = "SESSION_LIFETIME"
+
+	//envVarRatingReminderTime is the time of day in the format HH:MM after which users with an ongoing
+	//rating streak get reminded to rate. If not set, no reminders are sent
+	envVarRatingReminderTime = "RATING_REMINDER_TIME"
+	//envVarRatingReminderNotifier selects how reminders are sent. One of "smtp", "webhook" or "fake"
+	envVarRatingReminderNotifier = "RATING_REMINDER_NOTIFIER"
+	//envVarRatingReminderWebhookURL receives the reminders if envVarRatingReminderNotifier is "webhook"
+	envVarRatingReminderWebhookURL = "RATING_REMINDER_WEBHOOK_URL"
+
+	//SMTP config, required if envVarRatingReminderNotifier is "smtp". User and password are optional
+	envVarSMTPHost = "SMTP_HOST"
+	envVarSMTPPort = "SMTP_PORT"
+	envVarSMTPUser = "SMTP_USER"
+	envVarSMTPPW   = "SMTP_PW"
+	envVarSMTPFrom = "SMTP_FROM"
+
+	//Job intervals
+	//see https://pkg.go.dev/time#ParseDuration for input format
+	envOIDCRefreshInterval          = "OIDC_REFRESH_INTERVAL"
+	envVarWebhookDeliveryInterval   = "WEBHOOK_DELIVERY_INTERVAL"
+	envVarStreakUpdateInterval      = "STREAK_UPDATE_INTERVAL"
+	envVarRatingReminderJobInterval = "RATING_REMINDER_INTERVAL"
+
+	//envVarRateLimitStore selects where the request counters of the rate limiter are kept. Either
+	//rateLimitStoreMemory (default), which is per replica, or rateLimitStorePostgres, which requires dbDriverPostgres
+	envVarRateLimitStore = "RATE_LIMIT_STORE"
+	//Rate limits per route class in the format <requests>/<window>, e.g. 100/1m. 0 disables the limit.
+	//The user api is limited per user and the bot api per api key
+	envVarRateLimitRead      = "RATE_LIMIT_READ"
+	envVarRateLimitWrite     = "RATE_LIMIT_WRITE"
+	envVarRateLimitExpensive = "RATE_LIMIT_EXPENSIVE"
+	envVarRateLimitBot       = "RATE_LIMIT_BOT"
+
+	//envVarTracesExporter selects where OpenTelemetry spans are sent. One of telemetry.ExporterNone (default),
+	//telemetry.ExporterConsole or telemetry.ExporterOTLP. The otlp exporter is configured via the standard
+	//OTEL_EXPORTER_OTLP_* env vars
+	envVarTracesExporter = "OTEL_TRACES_EXPORTER"
+
+	//envVarLogLevel is one of "debug", "info" (default), "warn" or "error"
+	envVarLogLevel = "LOG_LEVEL"
+	//envVarLogFormat is either logging.FormatText (default) or logging.FormatJSON
+	envVarLogFormat = "LOG_FORMAT"
+
+	//envFileSuffix may be appended to any env var to read its value from the file with the given path,
+	//e.g. DB_PW_FILE=/run/secrets/db_pw
+	envFileSuffix = "_FILE"
+)
+
+const (
+	dbDriverPostgres = "postgres"
+	dbDriverSQLite   = "sqlite"
+	dbDriverMemory   = "memory"
+)
+
+const (
+	rateLimitStoreMemory   = "memory"
+	rateLimitStorePostgres = "postgres"
+)
+
+// Route classes of the rate limiter
+const (
+	//rateLimitClassRead are cheap user api requests that do not change data
+	rateLimitClassRead = "read"
+	//rateLimitClassWrite are user api requests that change data
+	rateLimitClassWrite = "write"
+	//rateLimitClassExpensive are user api requests that scan many dishes, e.g. the merge candidates
+	rateLimitClassExpensive = "expensive"
+	//rateLimitClassBot are all bot api requests
+	rateLimitClassBot = "bot"
+)
+
+// config is the validated configuration used by the app, see settings for the source
+type config struct {
+	//DB config
+	dbDriver   string
+	dbURL      string
+	dbName     string
+	dbUser     string
+	dbPW       string
+	dbOptions  url.Values
+	sqlitePath string
+
+	//OIDC Config
+
+	oidcSecret           string
+	oidcCallbackURL      string
+	oidcProviderURL      string
+	oidcID               string
+	oidcBearerAudience   string
+	urlAfterLogin        string
+	urlAfterLogout       string
+	oidcRefreshIntervall time.Duration
+
+	//Adapters Config
+	vacationServerURL    string
+	vacationServerAPIKey string
+	publicHolidayRegion  string
+	vacationMaxAge       time.Duration
+
+	//Bot Auth Config
+
+	botAPIToken string
+
+	//dishNameRulesPath is empty if the default rules should be used
+	dishNameRulesPath string
+
+	// Session Config
+
+	sessionSecret string
+
+	//HTTP Config
+
+	listen string
+	//tlsCertFile and tlsKeyFile are either both set or both empty. If empty, the server does not use TLS
+	tlsCertFile       string
+	tlsKeyFile        string
+	tlsReloadInterval time.Duration
+
+	readHeaderTimeout time.Duration
+	readTimeout       time.Duration
+	writeTimeout      time.Duration
+	idleTimeout       time.Duration
+	maxHeaderBytes    int
+	maxBodyBytes      int64
+	securityHeaders   httpSecurity.HeadersConfig
+
+	//Config for local development
+
+	devMode bool
+	devCORS string
+
+	//sessionLifetime is the expiry time of the session cookie
+	sessionLifetime time.Duration
+
+	//Rating Reminder Config
+
+	//ratingReminderEnabled is true if envVarRatingReminderTime is set
+	ratingReminderEnabled bool
+	//ratingReminderAfter is the offset from midnight after which reminders are sent
+	ratingReminderAfter      time.Duration
+	ratingReminderNotifier   string
+	ratingReminderWebhookURL string
+	smtpConfig               notifier.SMTPConfig
+
+	//Job Config
+
+	webhookDeliveryInterval time.Duration
+	streakUpdateInterval    time.Duration
+	ratingReminderInterval  time.Duration
+
+	//Rate Limit Config
+
+	rateLimitStore string
+	//rateLimits maps the rateLimitClass* constants to their limit
+	rateLimits map[string]rateLimit.Limit
+
+	//Telemetry Config
+
+	tracesExporter string
+
+	//Logging Config
+
+	logLevel  slog.Level
+	logFormat string
+}
+
+// settings mirrors the structure of the config file. Each field can be overridden by the env var bound in
+// settingsEnvBindings. Use toConfig to validate them
+type settings struct {
+	Listen string       `mapstructure:"listen" yaml:"listen"`
+	TLS    tlsSettings  `mapstructure:"tls" yaml:"tls"`
+	HTTP   httpSettings `mapstructure:"http" yaml:"http"`
+
+	DB       dbSettings       `mapstructure:"db" yaml:"db"`
+	OIDC     oidcSettings     `mapstructure:"oidc" yaml:"oidc"`
+	Vacation vacationSettings `mapstructure:"vacation" yaml:"vacation"`
+
+	PublicHolidayRegion string        `mapstructure:"publicHolidayRegion" yaml:"publicHolidayRegion"`
+	BotAPIToken         string        `mapstructure:"botAPIToken" yaml:"botAPIToken"`
+	DishNameRules       string        `mapstructure:"dishNameRules" yaml:"dishNameRules"`
+	URLAfterLogin       string        `mapstructure:"urlAfterLogin" yaml:"urlAfterLogin"`
+	URLAfterLogout      string        `mapstructure:"urlAfterLogout" yaml:"urlAfterLogout"`
+	SessionLifetime     time.Duration `mapstructure:"sessionLifetime" yaml:"sessionLifetime"`
+
+	Dev            devSettings            `mapstructure:"dev" yaml:"dev"`
+	RatingReminder ratingReminderSettings `mapstructure:"ratingReminder" yaml:"ratingReminder"`
+	Jobs           jobSettings            `mapstructure:"jobs" yaml:"jobs"`
+	RateLimit      rateLimitSettings      `mapstructure:"rateLimit" yaml:"rateLimit"`
+	Telemetry      telemetrySettings      `mapstructure:"telemetry" yaml:"telemetry"`
+	Log            logSettings            `mapstructure:"log" yaml:"log"`
+}
+
+type tlsSettings struct {
+	CertFile       string        `mapstructure:"certFile" yaml:"certFile"`
+	KeyFile        string        `mapstructure:"keyFile" yaml:"keyFile"`
+	ReloadInterval time.Duration `mapstructure:"reloadInterval" yaml:"reloadInterval"`
+}
+
+type httpSettings struct {
+	ReadHeaderTimeout     time.Duration `mapstructure:"readHeaderTimeout" yaml:"readHeaderTimeout"`
+	ReadTimeout           time.Duration `mapstructure:"readTimeout" yaml:"readTimeout"`
+	WriteTimeout          time.Duration `mapstructure:"writeTimeout" yaml:"writeTimeout"`
+	IdleTimeout           time.Duration `mapstructure:"idleTimeout" yaml:"idleTimeout"`
+	MaxHeaderBytes        int           `mapstructure:"maxHeaderBytes" yaml:"maxHeaderBytes"`
+	MaxBodyBytes          int64         `mapstructure:"maxBodyBytes" yaml:"maxBodyBytes"`
+	ContentSecurityPolicy string        `mapstructure:"contentSecurityPolicy" yaml:"contentSecurityPolicy"`
+	HSTSMaxAge            time.Duration `mapstructure:"hstsMaxAge" yaml:"hstsMaxAge"`
+}
+
+type dbSettings struct {
+	Driver   string `mapstructure:"driver" yaml:"driver"`
+	URL      string `mapstructure:"url" yaml:"url"`
+	Name     string `mapstructure:"name" yaml:"name"`
+	User     string `mapstructure:"user" yaml:"user"`
+	Password string `mapstructure:"password" yaml:"password"`
+	SSLMode  string `mapstructure:"sslMode" yaml:"sslMode"`
+	//Options are additional parameters of the postgres connection string, e.g. sslrootcert
+	Options    map[string]string `mapstructure:"options" yaml:"options"`
+	SQLitePath string            `mapstructure:"sqlitePath" yaml:"sqlitePath"`
+}
+
+type oidcSettings struct {
+	ProviderURL  string `mapstructure:"providerURL" yaml:"providerURL"`
+	ClientID     string `mapstructure:"clientID" yaml:"clientID"`
+	ClientSecret string `mapstructure:"clientSecret" yaml:"clientSecret"`
+	CallbackURL  string `mapstructure:"callbackURL" yaml:"callbackURL"`
+	//BearerAudience is the audience of JWTs accepted by the user API. There is no default, as the ID tokens of the
+	//web client must not be usable as bearer tokens
+	BearerAudience string `mapstructure:"bearerAudience" yaml:"bearerAudience"`
+}
+
+type vacationSettings struct {
+	ServerURL string        `mapstructure:"serverURL" yaml:"serverURL"`
+	APIKey    string        `mapstructure:"apiKey" yaml:"apiKey"`
+	MaxAge    time.Duration `mapstructure:"maxAge" yaml:"maxAge"`
+}
+
+type devSettings struct {
+	Mode bool   `mapstructure:"mode" yaml:"mode"`
+	CORS string `mapstructure:"cors" yaml:"cors"`
+}
+
+type ratingReminderSettings struct {
+	//Time is the time of day in the format HH:MM. Reminders are disabled if empty
+	Time       string       `mapstructure:"time" yaml:"time"`
+	Notifier   string       `mapstructure:"notifier" yaml:"notifier"`
+	WebhookURL string       `mapstructure:"webhookURL" yaml:"webhookURL"`
+	SMTP       smtpSettings `mapstructure:"smtp" yaml:"smtp"`
+}
+
+type smtpSettings struct {
+	Host     string `mapstructure:"host" yaml:"host"`
+	Port     int    `mapstructure:"port" yaml:"port"`
+	User     string `mapstructure:"user" yaml:"user"`
+	Password string `mapstructure:"password" yaml:"password"`
+	From     string `mapstructure:"from" yaml:"from"`
+}
+
+type jobSettings struct {
+	OIDCRefreshInterval     time.Duration `mapstructure:"oidcRefreshInterval" yaml:"oidcRefreshInterval"`
+	WebhookDeliveryInterval time.Duration `mapstructure:"webhookDeliveryInterval" yaml:"webhookDeliveryInterval"`
+	StreakUpdateInterval    time.Duration `mapstructure:"streakUpdateInterval" yaml:"streakUpdateInterval"`
+	RatingReminderInterval  time.Duration `mapstructure:"ratingReminderInterval" yaml:"ratingReminderInterval"`
+}
+
+type rateLimitSettings struct {
+	Store     string `mapstructure:"store" yaml:"store"`
+	Read      string `mapstructure:"read" yaml:"read"`
+	Write     string `mapstructure:"write" yaml:"write"`
+	Expensive string `mapstructure:"expensive" yaml:"expensive"`
+	Bot       string `mapstructure:"bot" yaml:"bot"`
+}
+
+type telemetrySettings struct {
+	TracesExporter string `mapstructure:"tracesExporter" yaml:"tracesExporter"`
+}
+
+type logSettings struct {
+	Level  string `mapstructure:"level" yaml:"level"`
+	Format string `mapstructure:"format" yaml:"format"`
+}
+
+// settingsEnvBindings maps the settings keys to the env vars that override them
+var settingsEnvBindings = []struct {
+	key string
+	env string
+}{
+	{"listen", envVarListen},
+	{"tls.certFile", envVarTLSCertFile},
+	{"tls.keyFile", envVarTLSKeyFile},
+	{"tls.reloadInterval", envVarTLSReloadInterval},
+	{"http.readHeaderTimeout", envVarHTTPReadHeaderTimeout},
+	{"http.readTimeout", envVarHTTPReadTimeout},
+	{"http.writeTimeout", envVarHTTPWriteTimeout},
+	{"http.idleTimeout", envVarHTTPIdleTimeout},
+	{"http.maxHeaderBytes", envVarHTTPMaxHeaderBytes},
+	{"http.maxBodyBytes", envVarHTTPMaxBodyBytes},
+	{"http.contentSecurityPolicy", envVarHTTPContentSecurityPolicy},
+	{"http.hstsMaxAge", envVarHTTPHSTSMaxAge},
+	{"db.driver", envVarDBDriver},
+	{"db.url", envVarDBURL},
+	{"db.name", envVarDBName},
+	{"db.user", envVarDBUser},
+	{"db.password", envVarDBPW},
+	{"db.sslMode", envVarDBSSLMode},
+	{"db.sqlitePath", envVarSQLitePath},
+	{"oidc.providerURL", envOIDCProviderURL},
+	{"oidc.clientID", envOIDCID},
+	{"oidc.clientSecret", envOIDCSecret},
+	{"oidc.callbackURL", envOIDCCallbackURL},
+	{"oidc.bearerAudience", envOIDCBearerAudience},
+	{"vacation.serverURL", envVacationServerURL},
+	{"vacation.apiKey", envVacationServerApiKey},
+	{"vacation.maxAge", envVarVacationMaxAge},
+	{"publicHolidayRegion", envPublicHolidayRegion},
+	{"botAPIToken", envBotAPIToken},
+	{"dishNameRules", envVarDishNameRules},
+	{"urlAfterLogin", envURLAfterLogin},
+	{"urlAfterLogout", envURLAfterLogout},
+	{"sessionLifetime", envVarSessionLifetime},
+	{"dev.mode", envVarDevMode},
+	{"dev.cors", envVarDevCORS},
+	{"ratingReminder.time", envVarRatingReminderTime},
+	{"ratingReminder.notifier", envVarRatingReminderNotifier},
+	{"ratingReminder.webhookURL", envVarRatingReminderWebhookURL},
+	{"ratingReminder.smtp.host", envVarSMTPHost},
+	{"ratingReminder.smtp.port", envVarSMTPPort},
+	{"ratingReminder.smtp.user", envVarSMTPUser},
+	{"ratingReminder.smtp.password", envVarSMTPPW},
+	{"ratingReminder.smtp.from", envVarSMTPFrom},
+	{"jobs.oidcRefreshInterval", envOIDCRefreshInterval},
+	{"jobs.webhookDeliveryInterval", envVarWebhookDeliveryInterval},
+	{"jobs.streakUpdateInterval", envVarStreakUpdateInterval},
+	{"jobs.ratingReminderInterval", envVarRatingReminderJobInterval},
+	{"rateLimit.store", envVarRateLimitStore},
+	{"rateLimit.read", envVarRateLimitRead},
+	{"rateLimit.write", envVarRateLimitWrite},
+	{"rateLimit.expensive", envVarRateLimitExpensive},
+	{"rateLimit.bot", envVarRateLimitBot},
+	{"telemetry.tracesExporter", envVarTracesExporter},
+	{"log.level", envVarLogLevel},
+	{"log.format", envVarLogFormat},
+}
+
+// settingKeyWithEnv formats key for error messages
+func settingKeyWithEnv(key string) string {
+	for _, v := range settingsEnvBindings {
+		if v.key == key {
+			return fmt.Sprintf("%v (env %v)", key, v.env)
+		}
+	}
+	return key
+}
+
+// loadSettings reads the config file at path, if path is not empty, and applies the env var overrides.
+// The result is not validated
+func loadSettings(path string) (*settings, error) {
+	v := viper.New()
+	v.SetDefault("listen", ":80")
+	v.SetDefault("tls.reloadInterval", time.Minute)
+	v.SetDefault("http.readHeaderTimeout", 10*time.Second)
+	v.SetDefault("http.readTimeout", 30*time.Second)
+	v.SetDefault("http.writeTimeout", time.Minute)
+	v.SetDefault("http.idleTimeout", 2*time.Minute)
+	v.SetDefault("http.maxHeaderBytes", 64<<10)
+	v.SetDefault("http.maxBodyBytes", 1<<20)
+	v.SetDefault("http.contentSecurityPolicy", httpSecurity.DefaultContentSecurityPolicy)
+	v.SetDefault("http.hstsMaxAge", 365*24*time.Hour)
+	v.SetDefault("db.driver", dbDriverPostgres)
+	v.SetDefault("db.sslMode", "disable")
+	v.SetDefault("vacation.maxAge", 3*time.Hour)
+	v.SetDefault("sessionLifetime", 7*24*time.Hour)
+	v.SetDefault("jobs.oidcRefreshInterval", 60*time.Minute)
+	v.SetDefault("jobs.webhookDeliveryInterval", 30*time.Second)
+	v.SetDefault("jobs.streakUpdateInterval", time.Hour)
+	v.SetDefault("jobs.ratingReminderInterval", 5*time.Minute)
+	v.SetDefault("rateLimit.store", rateLimitStoreMemory)
+	v.SetDefault("rateLimit.read", "300/1m")
+	v.SetDefault("rateLimit.write", "60/1m")
+	v.SetDefault("rateLimit.expensive", "10/1m")
+	v.SetDefault("rateLimit.bot", "600/1m")
+	v.SetDefault("telemetry.tracesExporter", telemetry.ExporterNone)
+	v.SetDefault("log.level", "info")
+	v.SetDefault("log.format", logging.FormatText)
+
+	if path != "" {
+		v.SetConfigFile(path)
+		if err := v.ReadInConfig(); err != nil {
+			return nil, fmt.Errorf("failed to read config file %v : %w", path, err)
+		}
+	}
+
+	for _, binding := range settingsEnvBindings {
+		if err := v.BindEnv(binding.key, binding.env); err != nil {
+			return nil, fmt.Errorf("failed to bind env var %v : %w", binding.env, err)
+		}
+		filePath := os.Getenv(binding.env + envFileSuffix)
+		if filePath == "" {
+			continue
+		}
+		if os.Getenv(binding.env) != "" {
+			return nil, fmt.Errorf("only one of %v and %v may be set", binding.env, binding.env+envFileSuffix)
+		}
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %v : %w", binding.env+envFileSuffix, err)
+		}
+		v.Set(binding.key, strings.TrimRight(string(content), "\r\n"))
+	}
+
+	if minutes := os.Getenv(envOIDCRefreshIntervalMinutes); minutes != "" && os.Getenv(envOIDCRefreshInterval) == "" {
+		parsed, err := strconv.ParseUint(minutes, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse value %v of %s to uint64 : %v", minutes, envOIDCRefreshIntervalMinutes, err)
+		}
+		v.Set("jobs.oidcRefreshInterval", time.Duration(parsed)*time.Minute)
+	}
+
+	s := settings{}
+	if err := v.Unmarshal(&s); err != nil {
+		return nil, fmt.Errorf("failed to decode config : %w", err)
+	}
+	return &s, nil
+}
+
+// redacted returns a copy of s with all secrets replaced by logging.Redacted
+func (s settings) redacted() settings {
+	redact := func(value *string) {
+		if *value != "" {
+			*value = logging.Redacted
+		}
+	}
+	redact(&s.DB.Password)
+	redact(&s.OIDC.ClientSecret)
+	redact(&s.Vacation.APIKey)
+	redact(&s.BotAPIToken)
+	redact(&s.RatingReminder.SMTP.Password)
+	//options are shared with the original settings and are thus copied before redacting, e.g. sslpassword
+	if s.DB.Options != nil {
+		options := make(map[string]string, len(s.DB.Options))
+		for k, v := range s.DB.Options {
+			if logging.IsSensitiveKey(k) {
+				v = logging.Redacted
+			}
+			options[k] = v
+		}
+		s.DB.Options = options
+	}
+	return s
+}
+
+// toConfig validates s and converts it to a config. All validation errors are returned at once
+func (s *settings) toConfig() (*config, error) {
+	errs := make([]error, 0)
+	required := func(key, value string) string {
+		if value == "" {
+			errs = append(errs, fmt.Errorf("%v is required", settingKeyWithEnv(key)))
+		}
+		return value
+	}
+	positive := func(key string, value time.Duration) time.Duration {
+		if value <= 0 {
+			errs = append(errs, fmt.Errorf("%v must be a positive duration but got %v", settingKeyWithEnv(key), value))
+		}
+		return value
+	}
+	oneOf := func(key, value string, allowed ...string) string {
+		value = strings.ToLower(value)
+		for _, v := range allowed {
+			if value == v {
+				return value
+			}
+		}
+		errs = append(errs, fmt.Errorf("%v must be one of %v but got \"%v\"", settingKeyWithEnv(key),
+			strings.Join(allowed, ", "), value))
+		return value
+	}
+
+	cfg := config{}
+
+	logLevel, err := logging.ParseLevel(s.Log.Level)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("%v : %v", settingKeyWithEnv("log.level"), err))
+	}
+	cfg.logLevel = logLevel
+	cfg.logFormat = oneOf("log.format", s.Log.Format, logging.FormatText, logging.FormatJSON)
+
+	cfg.devMode = s.Dev.Mode
+	cfg.devCORS = s.Dev.CORS
+
+	cfg.listen = required("listen", s.Listen)
+	cfg.tlsCertFile, cfg.tlsKeyFile = s.TLS.CertFile, s.TLS.KeyFile
+	if cfg.devMode && cfg.tlsCertFile == "" && cfg.tlsKeyFile == "" {
+		//SameSite=None cookies require TLS
+		cfg.tlsCertFile = "./selfSignedTLS/server.crt"
+		cfg.tlsKeyFile = "./selfSignedTLS/server.key"
+	}
+	if (cfg.tlsCertFile == "") != (cfg.tlsKeyFile == "") {
+		errs = append(errs, fmt.Errorf("%v and %v must be set together", settingKeyWithEnv("tls.certFile"),
+			settingKeyWithEnv("tls.keyFile")))
+	}
+	cfg.tlsReloadInterval = positive("tls.reloadInterval", s.TLS.ReloadInterval)
+
+	cfg.readHeaderTimeout = positive("http.readHeaderTimeout", s.HTTP.ReadHeaderTimeout)
+	cfg.readTimeout = positive("http.readTimeout", s.HTTP.ReadTimeout)
+	cfg.writeTimeout = positive("http.writeTimeout", s.HTTP.WriteTimeout)
+	cfg.idleTimeout = positive("http.idleTimeout", s.HTTP.IdleTimeout)
+	cfg.maxHeaderBytes = s.HTTP.MaxHeaderBytes
+	if cfg.maxHeaderBytes <= 0 {
+		errs = append(errs, fmt.Errorf("%v must be positive but got %v", settingKeyWithEnv("http.maxHeaderBytes"),
+			cfg.maxHeaderBytes))
+	}
+	cfg.maxBodyBytes = s.HTTP.MaxBodyBytes
+	if cfg.maxBodyBytes <= 0 {
+		errs = append(errs, fmt.Errorf("%v must be positive but got %v", settingKeyWithEnv("http.maxBodyBytes"),
+			cfg.maxBodyBytes))
+	}
+	cfg.securityHeaders = httpSecurity.HeadersConfig{
+		ContentSecurityPolicy: s.HTTP.ContentSecurityPolicy,
+		HSTSMaxAge:            s.HTTP.HSTSMaxAge,
+	}
+	if cfg.devMode {
+		//browsers would remember to only use https for localhost
+		cfg.securityHeaders.HSTSMaxAge = 0
+	}
+
+	cfg.dbDriver = oneOf("db.driver", s.DB.Driver, dbDriverPostgres, dbDriverSQLite, dbDriverMemory)
+	switch cfg.dbDriver {
+	case dbDriverPostgres:
+		cfg.dbURL = required("db.url", s.DB.URL)
+		cfg.dbName = required("db.name", s.DB.Name)
+		cfg.dbUser = required("db.user", s.DB.User)
+		cfg.dbPW = required("db.password", s.DB.Password)
+		cfg.dbOptions = url.Values{}
+		for k, v := range s.DB.Options {
+			cfg.dbOptions.Set(k, v)
+		}
+		cfg.dbOptions.Set("sslmode", oneOf("db.sslMode", s.DB.SSLMode,
+			"disable", "allow", "prefer", "require", "verify-ca", "verify-full"))
+	case dbDriverSQLite:
+		cfg.sqlitePath = required("db.sqlitePath", s.DB.SQLitePath)
+	}
+
+	if cfg.devMode {
+		cfg.oidcProviderURL, cfg.oidcID = s.OIDC.ProviderURL, s.OIDC.ClientID
+		cfg.oidcSecret, cfg.oidcCallbackURL = s.OIDC.ClientSecret, s.OIDC.CallbackURL
+	} else {
+		cfg.oidcProviderURL = required("oidc.providerURL", s.OIDC.ProviderURL)
+		cfg.oidcID = required("oidc.clientID", s.OIDC.ClientID)
+		cfg.oidcSecret = required("oidc.clientSecret", s.OIDC.ClientSecret)
+		cfg.oidcCallbackURL = required("oidc.callbackURL", s.OIDC.CallbackURL)
+	}
+	cfg.oidcBearerAudience = s.OIDC.BearerAudience
+	cfg.urlAfterLogin = required("urlAfterLogin", s.URLAfterLogin)
+	cfg.urlAfterLogout = required("urlAfterLogout", s.URLAfterLogout)
+	cfg.sessionLifetime = positive("sessionLifetime", s.SessionLifetime)
+
+	cfg.vacationServerURL = required("vacation.serverURL", s.Vacation.ServerURL)
+	cfg.vacationServerAPIKey = required("vacation.apiKey", s.Vacation.APIKey)
+	cfg.vacationMaxAge = positive("vacation.maxAge", s.Vacation.MaxAge)
+	cfg.publicHolidayRegion = required("publicHolidayRegion", s.PublicHolidayRegion)
+
+	cfg.botAPIToken = required("botAPIToken", s.BotAPIToken)
+	cfg.dishNameRulesPath = s.DishNameRules
+
+	cfg.oidcRefreshIntervall = positive("jobs.oidcRefreshInterval", s.Jobs.OIDCRefreshInterval)
+	cfg.webhookDeliveryInterval = positive("jobs.webhookDeliveryInterval", s.Jobs.WebhookDeliveryInterval)
+	cfg.streakUpdateInterval = positive("jobs.streakUpdateInterval", s.Jobs.StreakUpdateInterval)
+	cfg.ratingReminderInterval = positive("jobs.ratingReminderInterval", s.Jobs.RatingReminderInterval)
+
+	if reminderTime := s.RatingReminder.Time; reminderTime != "" {
+		parsed, err := time.Parse("15:04", reminderTime)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%v must be in the format HH:MM but got \"%v\"",
+				settingKeyWithEnv("ratingReminder.time"), reminderTime))
+		}
+		cfg.ratingReminderEnabled = true
+		cfg.ratingReminderAfter = time.Duration(parsed.Hour())*time.Hour + time.Duration(parsed.Minute())*time.Minute
+
+		cfg.ratingReminderNotifier = oneOf("ratingReminder.notifier", s.RatingReminder.Notifier, "smtp", "webhook", "fake")
+		switch cfg.ratingReminderNotifier {
+		case "smtp":
+			smtp := s.RatingReminder.SMTP
+			cfg.smtpConfig = notifier.SMTPConfig{
+				Host:      required("ratingReminder.smtp.host", smtp.Host),
+				Port:      smtp.Port,
+				Username:  smtp.User,
+				Password:  smtp.Password,
+				From:      required("ratingReminder.smtp.from", smtp.From),
+				RatingURL: cfg.urlAfterLogin,
+			}
+			if smtp.Port <= 0 || smtp.Port > 65535 {
+				errs = append(errs, fmt.Errorf("%v must be a valid port but got %v",
+					settingKeyWithEnv("ratingReminder.smtp.port"), smtp.Port))
+			}
+		case "webhook":
+			cfg.ratingReminderWebhookURL = required("ratingReminder.webhookURL", s.RatingReminder.WebhookURL)
+		}
+	}
+
+	cfg.rateLimitStore = oneOf("rateLimit.store", s.RateLimit.Store, rateLimitStoreMemory, rateLimitStorePostgres)
+	if cfg.rateLimitStore == rateLimitStorePostgres && cfg.dbDriver != dbDriverPostgres {
+		errs = append(errs, fmt.Errorf("%v \"%v\" requires %v \"%v\"", settingKeyWithEnv("rateLimit.store"),
+			rateLimitStorePostgres, settingKeyWithEnv("db.driver"), dbDriverPostgres))
+	}
+	cfg.rateLimits = make(map[string]rateLimit.Limit)
+	for _, v := range []struct{ class, value string }{
+		{rateLimitClassRead, s.RateLimit.Read},
+		{rateLimitClassWrite, s.RateLimit.Write},
+		{rateLimitClassExpensive, s.RateLimit.Expensive},
+		{rateLimitClassBot, s.RateLimit.Bot},
+	} {
+		limit, err := rateLimit.ParseLimit(v.value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%v : %v", settingKeyWithEnv("rateLimit."+v.class), err))
+		}
+		cfg.rateLimits[v.class] = limit
+	}
+
+	cfg.tracesExporter = oneOf("telemetry.tracesExporter", s.Telemetry.TracesExporter,
+		telemetry.ExporterNone, telemetry.ExporterConsole, telemetry.ExporterOTLP)
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// printEffectiveConfig writes the settings loaded from path and the env vars as YAML to w, with secrets redacted.
+// Returns the validation errors of the settings, if any
+func printEffectiveConfig(w io.Writer, path string) error {
+	s, err := loadSettings(path)
+	if err != nil {
+		return err
+	}
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(s.redacted()); err != nil {
+		return fmt.Errorf("failed to encode config : %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to encode config : %w", err)
+	}
+	_, err = s.toConfig()
+	return err
+}
+
+// parseConfig loads the settings from the config file at path, if path is not empty, and the env vars
+func parseConfig(path string) (*config, error) {
+	s, err := loadSettings(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := s.toConfig()
+	if err != nil {
+		return nil, fmt.Errorf("invalid config :\n%w", err)
+	}
+	return cfg, nil
+}
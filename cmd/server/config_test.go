@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"itsTasty/pkg/rateLimit"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// setRequiredEnv sets all env vars that are required in dev mode with the memory backend
+func setRequiredEnv(t *testing.T) {
+	t.Setenv(envVarDBDriver, dbDriverMemory)
+	t.Setenv(envVarDevMode, "true")
+	t.Setenv(envVacationServerURL, "https://vacation.test")
+	t.Setenv(envVacationServerApiKey, "vacation-secret")
+	t.Setenv(envPublicHolidayRegion, "Schleswig-Holstein")
+	t.Setenv(envBotAPIToken, "bot-secret")
+	t.Setenv(envURLAfterLogin, "https://localhost/welcome")
+	t.Setenv(envURLAfterLogout, "https://localhost/login")
+}
+
+func TestParseConfig_Env(t *testing.T) {
+	setRequiredEnv(t)
+	t.Setenv(envOIDCRefreshIntervalMinutes, "15")
+	t.Setenv(envVarStreakUpdateInterval, "2h")
+	t.Setenv(envOIDCBearerAudience, "itsTastyAPI")
+
+	cfg, err := parseConfig("")
+	require.NoError(t, err)
+	require.Equal(t, ":80", cfg.listen)
+	require.Equal(t, "bot-secret", cfg.botAPIToken)
+	require.Equal(t, 15*time.Minute, cfg.oidcRefreshIntervall)
+	require.Equal(t, 2*time.Hour, cfg.streakUpdateInterval)
+	require.Equal(t, "itsTastyAPI", cfg.oidcBearerAudience)
+	require.Equal(t, 30*time.Second, cfg.webhookDeliveryInterval)
+	require.Equal(t, int64(1<<20), cfg.maxBodyBytes)
+	require.Equal(t, 10*time.Second, cfg.readHeaderTimeout)
+	//dev mode defaults to the self-signed cert and does not send HSTS for localhost
+	require.Equal(t, "./selfSignedTLS/server.crt", cfg.tlsCertFile)
+	require.Zero(t, cfg.securityHeaders.HSTSMaxAge)
+	require.Equal(t, rateLimitStoreMemory, cfg.rateLimitStore)
+	require.Equal(t, rateLimit.Limit{Requests: 10, Window: time.Minute}, cfg.rateLimits[rateLimitClassExpensive])
+}
+
+func TestParseConfig_File(t *testing.T) {
+	setRequiredEnv(t)
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte(`
+listen: ":8443"
+tls:
+  certFile: /certs/tls.crt
+  keyFile: /certs/tls.key
+db:
+  driver: postgres
+  url: db:5432
+  name: itsTasty
+  user: itsTasty
+  sslMode: verify-full
+  options:
+    sslrootcert: /certs/ca.crt
+    sslpassword: key-secret
+oidc:
+  clientID: itsTasty
+botAPIToken: from-file
+`), 0600))
+	//env vars take precedence over the file, _FILE vars read the secret from a file
+	t.Setenv(envBotAPIToken, "from-env")
+	pwPath := filepath.Join(dir, "db_pw")
+	require.NoError(t, os.WriteFile(pwPath, []byte("db-secret\n"), 0600))
+	t.Setenv(envVarDBDriver, "")
+	t.Setenv(envVarDBPW+envFileSuffix, pwPath)
+
+	cfg, err := parseConfig(configPath)
+	require.NoError(t, err)
+	require.Equal(t, ":8443", cfg.listen)
+	require.Equal(t, "/certs/tls.crt", cfg.tlsCertFile)
+	require.Equal(t, dbDriverPostgres, cfg.dbDriver)
+	require.Equal(t, "db-secret", cfg.dbPW)
+	require.Equal(t, "verify-full", cfg.dbOptions.Get("sslmode"))
+	require.Equal(t, "/certs/ca.crt", cfg.dbOptions.Get("sslrootcert"))
+	require.Equal(t, "from-env", cfg.botAPIToken)
+	//the bearer audience does not default to the client id, otherwise ID tokens of the web client would be accepted
+	require.Empty(t, cfg.oidcBearerAudience)
+
+	out := &bytes.Buffer{}
+	require.NoError(t, printEffectiveConfig(out, configPath))
+	require.NotContains(t, out.String(), "db-secret")
+	require.NotContains(t, out.String(), "from-env")
+	require.NotContains(t, out.String(), "vacation-secret")
+	require.NotContains(t, out.String(), "key-secret")
+	require.Contains(t, out.String(), "sslMode: verify-full")
+	require.Contains(t, out.String(), "sslrootcert: /certs/ca.crt")
+}
+
+func TestParseConfig_Validation(t *testing.T) {
+	setRequiredEnv(t)
+	t.Setenv(envVarDBDriver, dbDriverPostgres)
+	t.Setenv(envVarDBURL, "db:5432")
+	t.Setenv(envBotAPIToken, "")
+	t.Setenv(envVarSessionLifetime, "-1h")
+	t.Setenv(envVarTLSCertFile, "/certs/tls.crt")
+	t.Setenv(envVarRatingReminderTime, "noon")
+	t.Setenv(envVarRatingReminderNotifier, "fake")
+	t.Setenv(envVarRateLimitWrite, "10 per minute")
+
+	//all errors are reported at once
+	_, err := parseConfig("")
+	require.Error(t, err)
+	for _, expected := range []string{
+		"db.name (env DB_NAME) is required",
+		"db.password (env DB_PW) is required",
+		"botAPIToken (env BOT_API_TOKEN) is required",
+		"sessionLifetime (env SESSION_LIFETIME) must be a positive duration",
+		"tls.certFile (env TLS_CERT_FILE) and tls.keyFile (env TLS_KEY_FILE) must be set together",
+		"ratingReminder.time (env RATING_REMINDER_TIME) must be in the format HH:MM",
+		"rateLimit.write (env RATE_LIMIT_WRITE) : limit \"10 per minute\" is not in the format",
+	} {
+		require.ErrorContains(t, err, expected)
+	}
+
+	//a secret must not be configured twice
+	t.Setenv(envBotAPIToken, "bot-secret")
+	t.Setenv(envBotAPIToken+envFileSuffix, "/run/secrets/bot")
+	_, err = parseConfig("")
+	require.ErrorContains(t, err, "only one of BOT_API_TOKEN and BOT_API_TOKEN_FILE may be set")
+
+	//the shared rate limit store needs postgres
+	setRequiredEnv(t)
+	t.Setenv(envBotAPIToken+envFileSuffix, "")
+	t.Setenv(envVarRateLimitStore, rateLimitStorePostgres)
+	_, err = parseConfig("")
+	require.ErrorContains(t, err, "rateLimit.store (env RATE_LIMIT_STORE) \"postgres\" requires db.driver (env DB_DRIVER) \"postgres\"")
+}
@@ -1,27 +1,51 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/deepmap/oapi-codegen/pkg/types"
 	migrate "github.com/rubenv/sql-migrate"
 	"github.com/stretchr/testify/require"
+	"io"
+	"itsTasty/pkg/api/accessTokenService"
 	"itsTasty/pkg/api/adapters/dishRepo"
+	"itsTasty/pkg/api/adapters/notifier"
 	"itsTasty/pkg/api/adapters/publicHoliday"
 	"itsTasty/pkg/api/adapters/vacation"
 	"itsTasty/pkg/api/domain"
+	"itsTasty/pkg/api/nameNormalizer"
 	"itsTasty/pkg/api/ports/botAPI"
 	"itsTasty/pkg/api/ports/userAPI"
+	"itsTasty/pkg/api/sessionService"
 	"itsTasty/pkg/api/statisticsService"
+	"itsTasty/pkg/api/webhookService"
+	"itsTasty/pkg/csrf"
+	"itsTasty/pkg/health"
+	"itsTasty/pkg/httpSecurity"
+	"itsTasty/pkg/logging"
+	"itsTasty/pkg/oidcAuth"
+	"itsTasty/pkg/rateLimit"
+	"itsTasty/pkg/telemetry"
 	"itsTasty/pkg/testutils"
+	"log/slog"
 	"net/http"
 	"net/http/cookiejar"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 type mockTimeSource struct {
@@ -964,183 +988,1632 @@ func TestRatingStreak(t *testing.T) {
 	require.Equal(t, 2, *longestStreaksResp.JSON200.LongestUserVotingStreakLength)
 }
 
-type testUser struct {
-	Email  string
-	client *userAPI.ClientWithResponses
-}
+func TestUserStatistics(t *testing.T) {
+	//Setup test env
 
-type testDish struct {
-	name     string
-	location string
-	id       int64
-}
+	app, ts, cleanup, _, err := setupTestEnv()
+	defer ts.Close()
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("Failed to cleanup test env : %v", err)
+		}
+	}()
+	require.NoError(t, err)
+
+	botApiClient, err := botAPI.NewClientWithResponses(ts.URL+"/botAPI/v1/", botAPI.WithHTTPClient(ts.Client()))
+	require.NoError(t, err)
+	user1, err := newUserClient("testUser1@test.mail", ts)
+	require.NoError(t, err)
+	user2, err := newUserClient("testUser2@test.mail", ts)
+	require.NoError(t, err)
+
+	testDishes, _ := setupTestDishes(t, botApiClient, user1, app)
+	dish1L1 := testDishes[0]
+	dish2L1 := testDishes[1]
 
-// setupTestDishes creates the 4 and a merged dish.
-// Returns dishes dish1L1, dish2L1, dish3L1, dish1L2 (in that order) and the id of the merged
-// dish "Merged Dish" that contains dish1L1 and dish2L1
-func setupTestDishes(t *testing.T, botApiClient botAPI.ClientWithResponsesInterface,
-	user *testUser, app *application) ([]*testDish, int64) {
-	//
-	//create test dishes
 	//
+	// RUN TEST
 
-	dish1L1 := testDish{
-		name:     "Test Dish 1",
-		location: "Test Location 1",
-	}
-	dish2L1 := testDish{
-		name:     "Test Dish 2",
-		location: "Test Location 1",
-	}
-	dish3L1 := testDish{
-		name:     "Test Dish 3",
-		location: "Test Location 1",
-	}
-	dish1L2 := testDish{
-		name:     "Test Dish 4",
-		location: "Test Location 2",
+	//no ratings yet -> statistics should be empty
+	statsResp, err := user1.client.GetUsersMeStatisticsWithResponse(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, statsResp.StatusCode())
+	require.Equal(t, 0, statsResp.JSON200.TotalRatings)
+	require.Empty(t, statsResp.JSON200.RatingsPerMonth)
+	require.Nil(t, statsResp.JSON200.AvgGivenRating)
+	require.Nil(t, statsResp.JSON200.FavouriteDish)
+	require.Nil(t, statsResp.JSON200.MostHatedDish)
+	require.Nil(t, statsResp.JSON200.CurrentStreakLength)
+	require.Nil(t, statsResp.JSON200.LongestStreakLength)
+
+	//user1 rates two dishes, user2 rates one dish
+	rate := func(user *testUser, dishID int64, rating userAPI.RateDishReqRating) {
+		postDishResp, err := user.client.PostDishesDishIDWithResponse(
+			context.Background(),
+			dishID,
+			userAPI.PostDishesDishIDJSONRequestBody{
+				Rating: rating,
+			})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, postDishResp.StatusCode())
 	}
+	rate(user1, dish1L1.id, userAPI.RateDishReqRatingN5)
+	rate(user1, dish2L1.id, userAPI.RateDishReqRatingN1)
+	rate(user2, dish1L1.id, userAPI.RateDishReqRatingN3)
+
+	statsResp, err = user1.client.GetUsersMeStatisticsWithResponse(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, statsResp.StatusCode())
+	require.Equal(t, 2, statsResp.JSON200.TotalRatings)
+	require.Len(t, statsResp.JSON200.RatingsPerMonth, 1)
+	require.Equal(t, 2, statsResp.JSON200.RatingsPerMonth[0].Count)
+	require.Equal(t, float32(3), *statsResp.JSON200.AvgGivenRating)
+	require.Equal(t, float32(3), *statsResp.JSON200.TeamAvgRating)
+	require.Equal(t, dish1L1.id, statsResp.JSON200.FavouriteDish.DishID)
+	require.Equal(t, dish1L1.name, statsResp.JSON200.FavouriteDish.DishName)
+	require.Equal(t, dish2L1.id, statsResp.JSON200.MostHatedDish.DishID)
+	require.Equal(t, 1, *statsResp.JSON200.CurrentStreakLength)
+	require.Equal(t, 1, *statsResp.JSON200.LongestStreakLength)
+	require.Equal(t, float32(100), statsResp.JSON200.Percentile)
+
+	statsResp, err = user2.client.GetUsersMeStatisticsWithResponse(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, statsResp.StatusCode())
+	require.Equal(t, 1, statsResp.JSON200.TotalRatings)
+	require.Equal(t, float32(0), statsResp.JSON200.Percentile)
+}
 
-	testDishes := []*testDish{&dish1L1, &dish2L1, &dish3L1, &dish1L2}
+func TestDishRatingTrend(t *testing.T) {
+	//Setup test env
 
-	for i, v := range testDishes {
-		resp, err := botApiClient.PostCreateOrUpdateDishWithResponse(
+	app, ts, cleanup, mockTime, err := setupTestEnv()
+	defer ts.Close()
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("Failed to cleanup test env : %v", err)
+		}
+	}()
+	require.NoError(t, err)
+
+	botApiClient, err := botAPI.NewClientWithResponses(ts.URL+"/botAPI/v1/", botAPI.WithHTTPClient(ts.Client()))
+	require.NoError(t, err)
+	user1, err := newUserClient("testUser1@test.mail", ts)
+	require.NoError(t, err)
+	user2, err := newUserClient("testUser2@test.mail", ts)
+	require.NoError(t, err)
+
+	//dish1L1 and dish2L1 are part of the merged dish
+	testDishes, mergedDishID := setupTestDishes(t, botApiClient, user1, app)
+	dish1L1 := testDishes[0]
+	dish2L1 := testDishes[1]
+	dish3L1 := testDishes[2]
+	day1 := domain.NewDayPrecisionTime(mockTime.Now())
+	day2 := day1.NextDay()
+
+	rate := func(user *testUser, dishID int64, rating userAPI.RateDishReqRating) {
+		postDishResp, err := user.client.PostDishesDishIDWithResponse(
 			context.Background(),
-			botAPI.PostCreateOrUpdateDishJSONRequestBody{
-				DishName: v.name,
-				ServedAt: v.location},
-			func(ctx context.Context, req *http.Request) error {
-				req.Header.Set("X-API-KEY", app.conf.botAPIToken)
-				return nil
+			dishID,
+			userAPI.PostDishesDishIDJSONRequestBody{
+				Rating: rating,
 			})
 		require.NoError(t, err)
-		require.Equal(t, resp.StatusCode(), http.StatusOK)
-		//for the first and the last test dish, a new location should be created
-		require.Equal(t, i == 0 || i == 3, resp.JSON200.CreatedNewLocation)
-		require.True(t, resp.JSON200.CreatedNewDish)
-
-		v.id = resp.JSON200.DishID
+		require.Equal(t, http.StatusOK, postDishResp.StatusCode())
 	}
 
 	//
-	// Create merged dish via user api
-	//
+	// RUN TEST
 
-	wantMergedDishName := "Merged Dish"
-	wantMergedDishIDsV1 := []int64{dish1L1.id, dish2L1.id}
+	//first serving: one rating for dish1L1
+	rate(user1, dish1L1.id, userAPI.RateDishReqRatingN5)
 
-	jsonResp, err := user.client.PostMergedDishesWithResponse(context.Background(),
-		userAPI.CreateMergedDishReq{
-			MergedDishes: wantMergedDishIDsV1,
-			Name:         wantMergedDishName,
-		},
-	)
+	//second serving: dish2L1 is served again and rated by both users
+	mockTime.CurrentTime = day2.Time
+	resp, err := botApiClient.PostCreateOrUpdateDishWithResponse(
+		context.Background(),
+		botAPI.PostCreateOrUpdateDishJSONRequestBody{
+			DishName: dish2L1.name,
+			ServedAt: dish2L1.location},
+		func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("X-API-KEY", app.conf.botAPIToken)
+			return nil
+		})
 	require.NoError(t, err)
-	require.Equal(t, http.StatusOK, jsonResp.StatusCode())
-	mergedDishID := jsonResp.JSON200.MergedDishID
+	require.Equal(t, http.StatusOK, resp.StatusCode())
+	rate(user1, dish2L1.id, userAPI.RateDishReqRatingN3)
+	rate(user2, dish2L1.id, userAPI.RateDishReqRatingN1)
 
-	return testDishes, mergedDishID
-}
+	//trend of dish in merged dish covers all dishes of the merged dish
+	trendResp, err := user1.client.GetDishesDishIDRatingTrendWithResponse(context.Background(), dish1L1.id)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, trendResp.StatusCode())
+	require.Equal(t, mergedDishID, *trendResp.JSON200.MergedDishID)
+	require.Len(t, trendResp.JSON200.Trend, 2)
 
-// newUserClient is a helper function that creates a client that is logged in as the given user
-func newUserClient(userEmail string, server *httptest.Server) (*testUser, error) {
-	certpool := x509.NewCertPool()
-	certpool.AddCert(server.Certificate())
+	require.Equal(t, day1.Format("2006-01-02"), trendResp.JSON200.Trend[0].Date.Format("2006-01-02"))
+	require.Equal(t, 1, trendResp.JSON200.Trend[0].RatingCount)
+	require.Equal(t, float32(5), *trendResp.JSON200.Trend[0].AvgRating)
+	require.Equal(t, map[string]int{"5": 1}, trendResp.JSON200.Trend[0].Ratings)
 
-	jar, err := cookiejar.New(nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create cookie jar : %v", err)
-	}
+	require.Equal(t, day2.Format("2006-01-02"), trendResp.JSON200.Trend[1].Date.Format("2006-01-02"))
+	require.Equal(t, 2, trendResp.JSON200.Trend[1].RatingCount)
+	require.Equal(t, float32(2), *trendResp.JSON200.Trend[1].AvgRating)
+	require.Equal(t, map[string]int{"3": 1, "1": 1}, trendResp.JSON200.Trend[1].Ratings)
 
-	c := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				RootCAs: certpool,
-			},
-		},
-		Jar: jar,
-	}
+	//dish without ratings has one serving without ratings
+	trendResp, err = user1.client.GetDishesDishIDRatingTrendWithResponse(context.Background(), dish3L1.id)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, trendResp.StatusCode())
+	require.Nil(t, trendResp.JSON200.MergedDishID)
+	require.Len(t, trendResp.JSON200.Trend, 1)
+	require.Equal(t, 0, trendResp.JSON200.Trend[0].RatingCount)
+	require.Nil(t, trendResp.JSON200.Trend[0].AvgRating)
 
-	apiClient, err := userAPI.NewClientWithResponses(server.URL+"/userAPI/v1", userAPI.WithHTTPClient(c))
-	if err != nil {
-		return nil, fmt.Errorf("failed to instantiate userAPI client : %v", err)
-	}
+	//unknown dish
+	trendResp, err = user1.client.GetDishesDishIDRatingTrendWithResponse(context.Background(), 4242)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, trendResp.StatusCode())
+}
 
-	loginURL, err := url.Parse(server.URL + "/authAPI/login")
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse login url : %v", err)
-	}
-	q := loginURL.Query()
-	q.Add("userEmail", userEmail)
-	q.Add("redirectTo", server.URL)
-	loginURL.RawQuery = q.Encode()
-	resp, err := c.Get(loginURL.String())
-	if err != nil {
-		return nil, fmt.Errorf("failed to perform login request : %v", err)
-	}
-	//this is a bit hacky. Our request gets redirected to the frontend during login.
-	//However, in the test environment we do not serve the frontend. Thus we expect a 404 here
+func TestBotMenu(t *testing.T) {
+	//Setup test env
 
-	if resp.StatusCode != http.StatusNotFound {
-		return nil, fmt.Errorf("login failed : %v", resp.StatusCode)
-	}
+	app, ts, cleanup, mockTime, err := setupTestEnv()
+	defer ts.Close()
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("Failed to cleanup test env : %v", err)
+		}
+	}()
+	require.NoError(t, err)
 
-	//To counteract the uncertainty from the hack 404 return code, we use the GetUsersMe endpoint
-	//to check that we are actually logged in
-	apiResp, err := apiClient.GetUsersMeWithResponse(context.Background())
-	if err != nil {
-		return nil, fmt.Errorf("failed to check if login was successful : %v", err)
-	}
-	if apiResp.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("login was not successful : %v", err)
-	}
-	if apiResp.JSON200.Email != userEmail {
-		return nil, fmt.Errorf("endpoint GetUsersMe returned user %v instead of expected user %v",
-			apiResp.JSON200.Email, userEmail)
-	}
+	botApiClient, err := botAPI.NewClientWithResponses(ts.URL+"/botAPI/v1/", botAPI.WithHTTPClient(ts.Client()))
+	require.NoError(t, err)
+	user1, err := newUserClient("testUser1@test.mail", ts)
+	require.NoError(t, err)
 
-	return &testUser{
-		Email:  userEmail,
-		client: apiClient,
-	}, nil
-}
+	//dish1L1 and dish2L1 are part of the merged dish
+	testDishes, mergedDishID := setupTestDishes(t, botApiClient, user1, app)
+	dish1L1 := testDishes[0]
+	dish3L1 := testDishes[2]
+	dish1L2 := testDishes[3]
+
+	postDishResp, err := user1.client.PostDishesDishIDWithResponse(
+		context.Background(),
+		dish1L1.id,
+		userAPI.PostDishesDishIDJSONRequestBody{
+			Rating: userAPI.RateDishReqRatingN4,
+		})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, postDishResp.StatusCode())
 
-// setupTestEnv prepares a new test environment. The caller must call the returned cleanup function
-// to terminate/free the resources allocated by this function. The caller must close ts once they are done with it
-func setupTestEnv() (app *application, ts *httptest.Server, cleanupFN func() error, mockTime *mockTimeSource, err error) {
-	//
-	//instantiate db backend
 	//
+	// RUN TEST
 
-	db, err := testutils.GlobalDockerPool.GetPostgresIntegrationTestDB()
-	if err != nil {
-		err = fmt.Errorf("getPostgresIntegrationTestDB failed : %v", err)
-		return
+	apiKeyEditor := func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("X-API-KEY", app.conf.botAPIToken)
+		return nil
 	}
+	today := types.Date{Time: domain.TruncateToDayPrecision(mockTime.Now())}
+
+	//all locations. Dishes of the merged dish should be listed only once
+	menuResp, err := botApiClient.GetMenuWithResponse(context.Background(), &botAPI.GetMenuParams{Date: today}, apiKeyEditor)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, menuResp.StatusCode())
+	require.Len(t, menuResp.JSON200.Locations, 2)
+
+	location1 := menuResp.JSON200.Locations[0]
+	require.Equal(t, dish1L1.location, location1.Location)
+	require.Len(t, location1.Dishes, 2)
+	require.Equal(t, "Merged Dish", location1.Dishes[0].Name)
+	require.Equal(t, mergedDishID, *location1.Dishes[0].MergedDishID)
+	require.Equal(t, 1, location1.Dishes[0].RatingCount)
+	require.Equal(t, float32(4), *location1.Dishes[0].AvgRating)
+	require.Nil(t, location1.Dishes[0].LastServed)
+	require.Equal(t, dish3L1.name, location1.Dishes[1].Name)
+	require.Equal(t, dish3L1.id, location1.Dishes[1].DishID)
+	require.Nil(t, location1.Dishes[1].AvgRating)
+
+	require.Contains(t, menuResp.JSON200.PlainText, "Merged Dish: 4.0/5 (1 rating), first serving")
+	require.Contains(t, menuResp.JSON200.Markdown, "- **Merged Dish**: 4.0/5 (1 rating), first serving")
+
+	//filter by location
+	menuResp, err = botApiClient.GetMenuWithResponse(context.Background(),
+		&botAPI.GetMenuParams{Date: today, Location: &dish1L2.location}, apiKeyEditor)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, menuResp.StatusCode())
+	require.Len(t, menuResp.JSON200.Locations, 1)
+	require.Equal(t, dish1L2.location, menuResp.JSON200.Locations[0].Location)
+	require.Len(t, menuResp.JSON200.Locations[0].Dishes, 1)
+	require.Equal(t, dish1L2.id, menuResp.JSON200.Locations[0].Dishes[0].DishID)
+
+	//serve dish again on next day. Previous serving should be reported
+	mockTime.CurrentTime = mockTime.CurrentTime.Add(24 * time.Hour)
+	resp, err := botApiClient.PostCreateOrUpdateDishWithResponse(
+		context.Background(),
+		botAPI.PostCreateOrUpdateDishJSONRequestBody{
+			DishName: dish3L1.name,
+			ServedAt: dish3L1.location},
+		apiKeyEditor)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode())
 
-	dockerCleanupFN := func() error {
-		if err := testutils.GlobalDockerPool.Cleanup(); err != nil {
-			return fmt.Errorf("failed to cleanup docker pool : %v", err)
+	tomorrow := types.Date{Time: domain.TruncateToDayPrecision(mockTime.Now())}
+	menuResp, err = botApiClient.GetMenuWithResponse(context.Background(), &botAPI.GetMenuParams{Date: tomorrow}, apiKeyEditor)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, menuResp.StatusCode())
+	require.Len(t, menuResp.JSON200.Locations, 1)
+	require.Len(t, menuResp.JSON200.Locations[0].Dishes, 1)
+	require.NotNil(t, menuResp.JSON200.Locations[0].Dishes[0].LastServed)
+	require.Equal(t, today.Format("2006-01-02"), menuResp.JSON200.Locations[0].Dishes[0].LastServed.Format("2006-01-02"))
+}
+
+func TestBotMenuImport(t *testing.T) {
+	//Setup test env
+
+	app, ts, cleanup, _, err := setupTestEnv()
+	defer ts.Close()
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("Failed to cleanup test env : %v", err)
 		}
+	}()
+	require.NoError(t, err)
+
+	botApiClient, err := botAPI.NewClientWithResponses(ts.URL+"/botAPI/v1/", botAPI.WithHTTPClient(ts.Client()))
+	require.NoError(t, err)
+	apiKeyEditor := func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("X-API-KEY", app.conf.botAPIToken)
 		return nil
 	}
+
+	//
+	// RUN TEST
+
+	location := "Mensa"
+	csvFile := "date,dish\n2023-03-01,VEGANISSIMO: Tofu\n2023-03-02,Tofu\ninvalid,Tofu\n"
+	importResp, err := botApiClient.PostMenuImportWithBodyWithResponse(context.Background(),
+		&botAPI.PostMenuImportParams{Format: botAPI.Csv, Location: &location}, "text/csv",
+		strings.NewReader(csvFile), apiKeyEditor)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, importResp.StatusCode())
+	require.Equal(t, 1, importResp.JSON200.Created)
+	require.Equal(t, 1, importResp.JSON200.Updated)
+	require.Equal(t, 1, importResp.JSON200.Skipped)
+	require.Len(t, importResp.JSON200.Rows, 3)
+	require.Equal(t, botAPI.Skipped, importResp.JSON200.Rows[2].Status)
+	dishID := *importResp.JSON200.Rows[0].DishID
+
+	dishResp, err := botApiClient.GetDishesDishIDWithResponse(context.Background(), dishID, apiKeyEditor)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, dishResp.StatusCode())
+	require.Equal(t, "Tofu", dishResp.JSON200.Name)
+	require.Equal(t, 2, dishResp.JSON200.OccurrenceCount)
+	require.Equal(t, []string{"vegan"}, dishResp.JSON200.Tags)
+
+	icalFile := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20230302\r\nSUMMARY:Tofu\r\n" +
+		"LOCATION:Mensa\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+	importResp, err = botApiClient.PostMenuImportWithBodyWithResponse(context.Background(),
+		&botAPI.PostMenuImportParams{Format: botAPI.Ical}, "text/calendar", strings.NewReader(icalFile), apiKeyEditor)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, importResp.StatusCode())
+	require.Equal(t, 1, importResp.JSON200.Skipped)
+
+	//unparsable file
+	importResp, err = botApiClient.PostMenuImportWithBodyWithResponse(context.Background(),
+		&botAPI.PostMenuImportParams{Format: botAPI.Ical}, "text/calendar", strings.NewReader(csvFile), apiKeyEditor)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, importResp.StatusCode())
+
+	//files may exceed the body limit of the other routes. MultiReader hides the size, thus the body is streamed
+	largeFile := "date,dish\ninvalid," + strings.Repeat("a", int(app.conf.maxBodyBytes)) + "\n"
+	importResp, err = botApiClient.PostMenuImportWithBodyWithResponse(context.Background(),
+		&botAPI.PostMenuImportParams{Format: botAPI.Csv, Location: &location}, "text/csv",
+		io.MultiReader(strings.NewReader(largeFile)), apiKeyEditor)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, importResp.StatusCode())
+	require.Equal(t, 1, importResp.JSON200.Skipped)
+
+	tooLargeFile := "date,dish\ninvalid," + strings.Repeat("a", botAPI.MaxMenuImportSize) + "\n"
+	importResp, err = botApiClient.PostMenuImportWithBodyWithResponse(context.Background(),
+		&botAPI.PostMenuImportParams{Format: botAPI.Csv, Location: &location}, "text/csv",
+		io.MultiReader(strings.NewReader(tooLargeFile)), apiKeyEditor)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusRequestEntityTooLarge, importResp.StatusCode())
+	importResp, err = botApiClient.PostMenuImportWithBodyWithResponse(context.Background(),
+		&botAPI.PostMenuImportParams{Format: botAPI.Csv, Location: &location}, "text/csv",
+		strings.NewReader(tooLargeFile), apiKeyEditor)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusRequestEntityTooLarge, importResp.StatusCode())
+}
+
+func TestBotNameNormalization(t *testing.T) {
+	//Setup test env
+
+	app, ts, cleanup, _, err := setupTestEnv()
+	defer ts.Close()
 	defer func() {
-		if err != nil {
-			if cleanupErr := dockerCleanupFN(); cleanupErr != nil {
-				err = errors.Join(err, fmt.Errorf("failed to cleanup docker resources : %v", err))
-			}
+		if err := cleanup(); err != nil {
+			t.Fatalf("Failed to cleanup test env : %v", err)
 		}
 	}()
+	require.NoError(t, err)
 
-	migrationSource := &migrate.FileMigrationSource{Dir: "../../migrations/postgres"}
-	repo, err := dishRepo.NewPostgresRepo(db, migrationSource)
-	if err != nil {
-		err = fmt.Errorf("NewPostgresRepo failed : %v", err)
-		return
-
+	botApiClient, err := botAPI.NewClientWithResponses(ts.URL+"/botAPI/v1/", botAPI.WithHTTPClient(ts.Client()))
+	require.NoError(t, err)
+	apiKeyEditor := func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("X-API-KEY", app.conf.botAPIToken)
+		return nil
 	}
 
-	mockTime = NewMockTimeSourceToday()
+	//
+	// RUN TEST
+	//
+
+	//dishes stored before the rules existed, bypassing the normalization in the bot api
+	location := "Mensa"
+	_, _, _, legacyPastaID, err := app.dishRepo.GetOrCreateDish(context.Background(), "BEGRENZTES ANGEBOT: Pasta", location)
+	require.NoError(t, err)
+	_, _, _, legacyTofuID, err := app.dishRepo.GetOrCreateDish(context.Background(), "VEGANISSIMO: Tofu", location)
+	require.NoError(t, err)
+	createResp, err := botApiClient.PostCreateOrUpdateDishWithResponse(context.Background(),
+		botAPI.PostCreateOrUpdateDishJSONRequestBody{DishName: `"YOUR FAVORITES" Pasta`, ServedAt: location}, apiKeyEditor)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, createResp.StatusCode())
+	require.True(t, createResp.JSON200.CreatedNewDish)
+	pastaID := createResp.JSON200.DishID
+
+	previewResp, err := botApiClient.GetNameNormalizationPreviewWithResponse(context.Background(),
+		&botAPI.GetNameNormalizationPreviewParams{ServedAt: &location}, apiKeyEditor)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, previewResp.StatusCode())
+	require.Len(t, previewResp.JSON200.Changes, 2)
+	require.Equal(t, legacyPastaID, previewResp.JSON200.Changes[0].DishID)
+	require.Equal(t, botAPI.Merge, previewResp.JSON200.Changes[0].Action)
+	require.Equal(t, pastaID, *previewResp.JSON200.Changes[0].TargetDishID)
+	require.Equal(t, legacyTofuID, previewResp.JSON200.Changes[1].DishID)
+	require.Equal(t, botAPI.Rename, previewResp.JSON200.Changes[1].Action)
+	require.Equal(t, "Tofu", previewResp.JSON200.Changes[1].NormalizedName)
+	require.Equal(t, []string{"vegan"}, previewResp.JSON200.Changes[1].Tags)
+
+	applyResp, err := botApiClient.PostNameNormalizationApplyWithResponse(context.Background(),
+		botAPI.PostNameNormalizationApplyJSONRequestBody{Rename: true, Merge: true}, apiKeyEditor)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, applyResp.StatusCode())
+	for _, v := range applyResp.JSON200.Changes {
+		require.True(t, v.Applied)
+		require.Nil(t, v.Error)
+	}
+
+	dishResp, err := botApiClient.GetDishesDishIDWithResponse(context.Background(), legacyTofuID, apiKeyEditor)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, dishResp.StatusCode())
+	require.Equal(t, "Tofu", dishResp.JSON200.Name)
+	require.Equal(t, []string{"vegan"}, dishResp.JSON200.Tags)
+
+	isMerged, mergedDishID, err := app.dishRepo.IsDishPartOfMergedDisByID(context.Background(), legacyPastaID)
+	require.NoError(t, err)
+	require.True(t, isMerged)
+	_, otherMergedDishID, err := app.dishRepo.IsDishPartOfMergedDisByID(context.Background(), pastaID)
+	require.NoError(t, err)
+	require.Equal(t, mergedDishID, otherMergedDishID)
+
+	previewResp, err = botApiClient.GetNameNormalizationPreviewWithResponse(context.Background(),
+		&botAPI.GetNameNormalizationPreviewParams{}, apiKeyEditor)
+	require.NoError(t, err)
+	require.Empty(t, previewResp.JSON200.Changes)
+}
+
+func TestLocations(t *testing.T) {
+	//Setup test env
+
+	app, ts, cleanup, _, err := setupTestEnv()
+	defer ts.Close()
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("Failed to cleanup test env : %v", err)
+		}
+	}()
+	require.NoError(t, err)
+
+	botApiClient, err := botAPI.NewClientWithResponses(ts.URL+"/botAPI/v1/", botAPI.WithHTTPClient(ts.Client()))
+	require.NoError(t, err)
+	apiKeyEditor := func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("X-API-KEY", app.conf.botAPIToken)
+		return nil
+	}
+	user1, err := newUserClient("testUser1@test.mail", ts)
+	require.NoError(t, err)
+
+	//
+	// RUN TEST
+	//
+
+	createDish := func(name, servedAt string) *botAPI.CreateOrUpdateDishResp {
+		resp, err := botApiClient.PostCreateOrUpdateDishWithResponse(context.Background(),
+			botAPI.PostCreateOrUpdateDishJSONRequestBody{DishName: name, ServedAt: servedAt}, apiKeyEditor)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		return resp.JSON200
+	}
+	createDish("Pasta", "Mensa")
+	createDish("Curry", "Cafeteria")
+
+	listResp, err := user1.client.GetLocationsWithResponse(context.Background(), &userAPI.GetLocationsParams{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, listResp.StatusCode())
+	require.Len(t, listResp.JSON200.Locations, 2)
+	cafeteria := listResp.JSON200.Locations[0]
+	mensa := listResp.JSON200.Locations[1]
+	require.Equal(t, "Cafeteria", cafeteria.Name)
+	require.Equal(t, "Mensa", mensa.Name)
+	require.Empty(t, mensa.Aliases)
+	require.False(t, mensa.Archived)
+
+	//update metadata
+	displayName := "Main Mensa"
+	aliases := []string{"MENSA", "Mensa Hauptgebäude"}
+	patchResp, err := user1.client.PatchLocationsLocationIDWithResponse(context.Background(), mensa.Id,
+		userAPI.PatchLocationsLocationIDJSONRequestBody{DisplayName: &displayName, Aliases: &aliases})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, patchResp.StatusCode())
+	require.Equal(t, displayName, patchResp.JSON200.DisplayName)
+	require.Equal(t, aliases, patchResp.JSON200.Aliases)
+
+	getResp, err := user1.client.GetLocationsLocationIDWithResponse(context.Background(), mensa.Id)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, getResp.StatusCode())
+	require.Equal(t, *patchResp.JSON200, *getResp.JSON200)
+
+	//aliases are resolved when dishes are reported
+	resp := createDish("Pasta", "MENSA")
+	require.False(t, resp.CreatedNewDish)
+	require.False(t, resp.CreatedNewLocation)
+	dish, err := app.dishRepo.GetDishByID(context.Background(), resp.DishID)
+	require.NoError(t, err)
+	require.Equal(t, "Mensa", dish.ServedAt)
+
+	//alias that is used by another location
+	conflictingAliases := []string{"MENSA"}
+	patchResp, err = user1.client.PatchLocationsLocationIDWithResponse(context.Background(), cafeteria.Id,
+		userAPI.PatchLocationsLocationIDJSONRequestBody{Aliases: &conflictingAliases})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, patchResp.StatusCode())
+
+	//archived locations are only listed on request
+	archived := true
+	patchResp, err = user1.client.PatchLocationsLocationIDWithResponse(context.Background(), cafeteria.Id,
+		userAPI.PatchLocationsLocationIDJSONRequestBody{Archived: &archived})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, patchResp.StatusCode())
+	listResp, err = user1.client.GetLocationsWithResponse(context.Background(), &userAPI.GetLocationsParams{})
+	require.NoError(t, err)
+	require.Len(t, listResp.JSON200.Locations, 1)
+	require.Equal(t, "Mensa", listResp.JSON200.Locations[0].Name)
+	listResp, err = user1.client.GetLocationsWithResponse(context.Background(),
+		&userAPI.GetLocationsParams{IncludeArchived: &archived})
+	require.NoError(t, err)
+	require.Len(t, listResp.JSON200.Locations, 2)
+
+	getResp, err = user1.client.GetLocationsLocationIDWithResponse(context.Background(), 4242)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, getResp.StatusCode())
+}
+
+func TestDishFamilies(t *testing.T) {
+	//Setup test env
+
+	app, ts, cleanup, _, err := setupTestEnv()
+	defer ts.Close()
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("Failed to cleanup test env : %v", err)
+		}
+	}()
+	require.NoError(t, err)
+
+	botApiClient, err := botAPI.NewClientWithResponses(ts.URL+"/botAPI/v1/", botAPI.WithHTTPClient(ts.Client()))
+	require.NoError(t, err)
+	user1, err := newUserClient("testUser1@test.mail", ts)
+	require.NoError(t, err)
+
+	testDishes, mergedDishID := setupTestDishes(t, botApiClient, user1, app)
+
+	dish1L1 := testDishes[0]
+	dish2L1 := testDishes[1]
+	dish3L1 := testDishes[2]
+	dish1L2 := testDishes[3]
+
+	//
+	// RUN TEST
+	// 1) Rate dish1L1, dish2L1 (both part of the merged dish) and dish1L2
+	// 2) Link dish1L1 and dish1L2 in a family. The ratings at Test Location 1 must cover the whole merged dish
+	// 3) Update and delete the family
+	//
+
+	for dishID, rating := range map[int64]userAPI.RateDishReqRating{
+		dish1L1.id: userAPI.RateDishReqRatingN3,
+		dish2L1.id: userAPI.RateDishReqRatingN5,
+		dish1L2.id: userAPI.RateDishReqRatingN1,
+	} {
+		rateResp, err := user1.client.PostDishesDishIDWithResponse(context.Background(), dishID,
+			userAPI.PostDishesDishIDJSONRequestBody{Rating: rating})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rateResp.StatusCode())
+	}
+
+	//a family needs dishes at different locations
+	createResp, err := user1.client.PostDishFamiliesWithResponse(context.Background(),
+		userAPI.CreateDishFamilyReq{Name: "Test Family", DishIDs: []int64{dish1L1.id, dish3L1.id}})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, createResp.StatusCode())
+
+	createResp, err = user1.client.PostDishFamiliesWithResponse(context.Background(),
+		userAPI.CreateDishFamilyReq{Name: "Test Family", DishIDs: []int64{dish1L1.id, dish1L2.id}})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, createResp.StatusCode())
+	familyID := createResp.JSON200.DishFamilyID
+
+	//dishes can only be part of a single family
+	createResp, err = user1.client.PostDishFamiliesWithResponse(context.Background(),
+		userAPI.CreateDishFamilyReq{Name: "Other Family", DishIDs: []int64{dish3L1.id, dish1L2.id}})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, createResp.StatusCode())
+
+	getResp, err := user1.client.GetDishFamiliesDishFamilyIDWithResponse(context.Background(), familyID)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, getResp.StatusCode())
+	family := getResp.JSON200
+	require.Equal(t, "Test Family", family.Name)
+	require.Equal(t, float32(3), *family.AvgRating)
+	require.Equal(t, map[string]int{"1": 1, "3": 1, "5": 1}, family.Ratings)
+	require.Len(t, family.Locations, 2)
+	require.Equal(t, dish1L1.location, family.Locations[0].ServedAt)
+	require.Equal(t, mergedDishID, *family.Locations[0].MergedDishID)
+	require.Equal(t, float32(4), *family.Locations[0].AvgRating)
+	require.Equal(t, dish1L2.location, family.Locations[1].ServedAt)
+	require.Nil(t, family.Locations[1].MergedDishID)
+	require.Equal(t, float32(1), *family.Locations[1].AvgRating)
+
+	dishResp, err := user1.client.GetDishesDishIDWithResponse(context.Background(), dish1L2.id)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, dishResp.StatusCode())
+	require.Equal(t, familyID, *dishResp.JSON200.DishFamilyID)
+
+	//rename and swap the dish at Test Location 1
+	newName := "Renamed Family"
+	patchResp, err := user1.client.PatchDishFamiliesDishFamilyIDWithResponse(context.Background(), familyID,
+		userAPI.DishFamilyUpdateReq{
+			Name:          &newName,
+			AddDishIDs:    &[]int64{dish3L1.id},
+			RemoveDishIDs: &[]int64{dish1L1.id},
+		})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, patchResp.StatusCode(), "dish3L1 shares the location with dish1L1")
+
+	patchResp, err = user1.client.PatchDishFamiliesDishFamilyIDWithResponse(context.Background(), familyID,
+		userAPI.DishFamilyUpdateReq{Name: &newName})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, patchResp.StatusCode())
+
+	listResp, err := user1.client.GetDishFamiliesWithResponse(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, listResp.StatusCode())
+	require.Equal(t, []userAPI.DishFamilyEntry{
+		{Id: familyID, Name: newName, DishIDs: []int64{dish1L1.id, dish1L2.id}},
+	}, listResp.JSON200.DishFamilies)
+
+	deleteResp, err := user1.client.DeleteDishFamiliesDishFamilyIDWithResponse(context.Background(), familyID)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, deleteResp.StatusCode())
+
+	getResp, err = user1.client.GetDishFamiliesDishFamilyIDWithResponse(context.Background(), familyID)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, getResp.StatusCode())
+
+	dishResp, err = user1.client.GetDishesDishIDWithResponse(context.Background(), dish1L2.id)
+	require.NoError(t, err)
+	require.Nil(t, dishResp.JSON200.DishFamilyID)
+}
+
+func TestTelemetry(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	previousProvider := otel.GetTracerProvider()
+	telemetry.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	defer otel.SetTracerProvider(previousProvider)
+
+	//Setup test env
+
+	app, ts, cleanup, _, err := setupTestEnv()
+	defer ts.Close()
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("Failed to cleanup test env : %v", err)
+		}
+	}()
+	require.NoError(t, err)
+
+	botApiClient, err := botAPI.NewClientWithResponses(ts.URL+"/botAPI/v1/", botAPI.WithHTTPClient(ts.Client()))
+	require.NoError(t, err)
+	user1, err := newUserClient("testUser1@test.mail", ts)
+	require.NoError(t, err)
+
+	//
+	// RUN TEST
+	//
+
+	createResp, err := botApiClient.PostCreateOrUpdateDishWithResponse(context.Background(),
+		botAPI.PostCreateOrUpdateDishJSONRequestBody{DishName: "Pasta", ServedAt: "Mensa"},
+		func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("X-API-KEY", app.conf.botAPIToken)
+			return nil
+		})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, createResp.StatusCode())
+
+	exporter.Reset()
+	rateResp, err := user1.client.PostDishesDishIDWithResponse(context.Background(), createResp.JSON200.DishID,
+		userAPI.PostDishesDishIDJSONRequestBody{Rating: userAPI.RateDishReqRatingN4})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rateResp.StatusCode())
+
+	//the strict handler span is a child of the request span
+	spans := exporter.GetSpans()
+	spanByName := make(map[string]tracetest.SpanStub)
+	for _, v := range spans {
+		spanByName[v.Name] = v
+	}
+	requestSpan, ok := spanByName["POST /userAPI/v1/dishes/{dishID}"]
+	require.Truef(t, ok, "missing request span in %v", spans)
+	handlerSpan, ok := spanByName["PostDishesDishID"]
+	require.Truef(t, ok, "missing handler span in %v", spans)
+	require.Equal(t, requestSpan.SpanContext.TraceID(), handlerSpan.SpanContext.TraceID())
+	require.Equal(t, requestSpan.SpanContext.SpanID(), handlerSpan.Parent.SpanID())
+
+	//metrics require the bot api key
+	metricsResp, err := ts.Client().Get(ts.URL + "/metrics")
+	require.NoError(t, err)
+	metricsResp.Body.Close()
+	require.Equal(t, http.StatusUnauthorized, metricsResp.StatusCode)
+	metricsReq, err := http.NewRequest(http.MethodGet, ts.URL+"/metrics", nil)
+	require.NoError(t, err)
+	metricsReq.Header.Set("X-API-KEY", app.conf.botAPIToken)
+	metricsResp, err = ts.Client().Do(metricsReq)
+	require.NoError(t, err)
+	defer metricsResp.Body.Close()
+	require.Equal(t, http.StatusOK, metricsResp.StatusCode)
+	metrics, err := io.ReadAll(metricsResp.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(metrics), `itstasty_http_request_duration_seconds_count{method="POST",route="/userAPI/v1/dishes/{dishID}",status="200"}`)
+	require.Contains(t, string(metrics), "itstasty_active_sessions 1")
+	require.Contains(t, string(metrics), "itstasty_ratings_total")
+}
+
+func TestRequestLogging(t *testing.T) {
+	logs := &bytes.Buffer{}
+	logger, err := logging.NewLogger(logs, logging.FormatText, slog.LevelDebug)
+	require.NoError(t, err)
+	previousLogger := slog.Default()
+	slog.SetDefault(logger)
+	defer slog.SetDefault(previousLogger)
+
+	//Setup test env
+
+	app, ts, cleanup, _, err := setupTestEnv()
+	defer ts.Close()
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("Failed to cleanup test env : %v", err)
+		}
+	}()
+	require.NoError(t, err)
+
+	botApiClient, err := botAPI.NewClientWithResponses(ts.URL+"/botAPI/v1/", botAPI.WithHTTPClient(ts.Client()))
+	require.NoError(t, err)
+
+	//
+	// RUN TEST
+	//
+
+	//the bot api key must never be logged, not even if it is wrong
+	for _, apiKey := range []string{app.conf.botAPIToken, "wrongBotApiToken"} {
+		resp, err := botApiClient.GetDishesDishIDWithResponse(context.Background(), 4242,
+			func(ctx context.Context, req *http.Request) error {
+				req.Header.Set("X-API-KEY", apiKey)
+				req.Header.Set(logging.HeaderRequestID, "request-"+apiKey[:5])
+				return nil
+			})
+		require.NoError(t, err)
+		require.Equal(t, "request-"+apiKey[:5], resp.HTTPResponse.Header.Get(logging.HeaderRequestID))
+	}
+	require.NotContains(t, logs.String(), app.conf.botAPIToken)
+	require.NotContains(t, logs.String(), "wrongBotApiToken")
+
+	//handler logs carry the request id, identity and route
+	var handlerLog string
+	for _, line := range strings.Split(logs.String(), "\n") {
+		if strings.Contains(line, "FetchBasicDishData failed") {
+			handlerLog = line
+		}
+	}
+	require.Contains(t, handlerLog, "requestID=request-testB")
+	require.Contains(t, handlerLog, "identity=bot")
+	require.Contains(t, handlerLog, "route=/botAPI/v1/dishes/{dishID}")
+}
+
+type testUser struct {
+	Email  string
+	client *userAPI.ClientWithResponses
+	//httpClient carries the session cookie of the user
+	httpClient *http.Client
+	csrfToken  string
+}
+
+type testDish struct {
+	name     string
+	location string
+	id       int64
+}
+
+func TestHealthEndpoints(t *testing.T) {
+	//Setup test env
+
+	app, ts, cleanup, _, err := setupTestEnv()
+	defer ts.Close()
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("Failed to cleanup test env : %v", err)
+		}
+	}()
+	require.NoError(t, err)
+
+	//
+	// RUN TEST
+	//
+
+	get := func(path, apiKey string) (int, string) {
+		req, err := http.NewRequest(http.MethodGet, ts.URL+path, nil)
+		require.NoError(t, err)
+		if apiKey != "" {
+			req.Header.Set("X-API-KEY", apiKey)
+		}
+		resp, err := ts.Client().Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(body)
+	}
+
+	status, _ := get("/healthz", "")
+	require.Equal(t, http.StatusOK, status)
+
+	status, body := get("/readyz", "")
+	require.Equal(t, http.StatusOK, status, body)
+	report := health.Report{}
+	require.NoError(t, json.Unmarshal([]byte(body), &report))
+	require.Equal(t, health.StatusOK, report.Status)
+	checks := make([]string, 0)
+	for _, v := range report.Checks {
+		checks = append(checks, v.Name)
+	}
+	require.Equal(t, []string{"db", "oidc", "vacation"}, checks)
+
+	//a failing dependency makes the app unready
+	app.healthChecker.Add("broken", func(_ context.Context) error { return errors.New("dependency down") })
+	status, body = get("/readyz", "")
+	require.Equal(t, http.StatusServiceUnavailable, status)
+	require.Contains(t, body, `{"name":"broken","status":"error"}`)
+	//the error is only shown on the protected status page
+	require.NotContains(t, body, "dependency down")
+
+	//the status page requires the bot api key
+	status, _ = get("/status", "")
+	require.Equal(t, http.StatusUnauthorized, status)
+
+	app.jobScheduler.StartAsync()
+	defer app.jobScheduler.Stop()
+	require.Eventually(t, func() bool {
+		_, streakOK := telemetry.LastJobRun("updateRatingStreaks")
+		_, refreshOK := telemetry.LastJobRun("oidcRefresh")
+		return streakOK && refreshOK
+	}, 10*time.Second, 50*time.Millisecond)
+
+	status, body = get("/status", app.conf.botAPIToken)
+	require.Equal(t, http.StatusOK, status)
+	require.Contains(t, body, "<td>updateRatingStreaks</td><td>1h0m0s</td>")
+	require.Contains(t, body, "<td>oidcRefresh</td>")
+	require.Contains(t, body, "dependency down")
+}
+
+func TestHTTPHardening(t *testing.T) {
+	//Setup test env
+
+	app, ts, cleanup, _, err := setupTestEnv()
+	defer ts.Close()
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("Failed to cleanup test env : %v", err)
+		}
+	}()
+	require.NoError(t, err)
+
+	user1, err := newUserClient("testUser1@test.mail", ts)
+	require.NoError(t, err)
+
+	//
+	// RUN TEST
+	//
+
+	resp, err := ts.Client().Get(ts.URL + "/healthz")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, "DENY", resp.Header.Get("X-Frame-Options"))
+	require.Equal(t, "nosniff", resp.Header.Get("X-Content-Type-Options"))
+	require.Equal(t, httpSecurity.DefaultContentSecurityPolicy, resp.Header.Get("Content-Security-Policy"))
+
+	//bodies with announced size are rejected before authentication
+	tooLarge := `{"dishName":"` + strings.Repeat("a", int(app.conf.maxBodyBytes)) + `","servedAt":"Mensa"}`
+	req, err := http.NewRequest(http.MethodPost, ts.URL+"/botAPI/v1/createOrUpdateDish", strings.NewReader(tooLarge))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err = ts.Client().Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+
+	//streamed bodies fail once the limit is reached
+	rateResp, err := user1.client.PostDishesDishIDWithBodyWithResponse(context.Background(), 1, "application/json",
+		io.MultiReader(strings.NewReader(tooLarge)))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusRequestEntityTooLarge, rateResp.StatusCode())
+}
+
+func TestCSRF(t *testing.T) {
+	//Setup test env
+
+	app, ts, cleanup, _, err := setupTestEnv()
+	defer ts.Close()
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("Failed to cleanup test env : %v", err)
+		}
+	}()
+	require.NoError(t, err)
+
+	user1, err := newUserClient("testUser1@test.mail", ts)
+	require.NoError(t, err)
+
+	//
+	// RUN TEST
+	//
+
+	//the token stays the same for the session
+	token, err := fetchCSRFToken(user1.httpClient, ts.URL)
+	require.NoError(t, err)
+	require.Equal(t, user1.csrfToken, token)
+
+	//other sessions get another token
+	user2, err := newUserClient("testUser2@test.mail", ts)
+	require.NoError(t, err)
+	require.NotEqual(t, user1.csrfToken, user2.csrfToken)
+
+	searchDish := func(user *testUser, header http.Header) int {
+		req, err := http.NewRequest(http.MethodPost, ts.URL+"/userAPI/v1/searchDish",
+			strings.NewReader(`{"dishName":"Pizza","servedAt":"Mensa"}`))
+		require.NoError(t, err)
+		req.Header = header
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := user.httpClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return resp.StatusCode
+	}
+
+	tests := []struct {
+		name     string
+		header   http.Header
+		expected int
+	}{
+		{name: "missing token", header: http.Header{}, expected: http.StatusForbidden},
+		{name: "token of other session", header: http.Header{csrf.HeaderToken: {user2.csrfToken}},
+			expected: http.StatusForbidden},
+		{name: "foreign origin", header: http.Header{csrf.HeaderToken: {user1.csrfToken},
+			"Origin": {"https://evil.test"}}, expected: http.StatusForbidden},
+		{name: "cross-site without origin", header: http.Header{csrf.HeaderToken: {user1.csrfToken},
+			"Sec-Fetch-Site": {"cross-site"}}, expected: http.StatusForbidden},
+		{name: "same origin", header: http.Header{csrf.HeaderToken: {user1.csrfToken}, "Origin": {ts.URL}},
+			expected: http.StatusOK},
+		{name: "dev frontend", header: http.Header{csrf.HeaderToken: {user1.csrfToken},
+			"Origin": {app.conf.devCORS}}, expected: http.StatusOK},
+		{name: "non-browser client", header: http.Header{csrf.HeaderToken: {user1.csrfToken}},
+			expected: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, searchDish(user1, tt.header))
+		})
+	}
+
+	//safe methods do not need a token
+	resp, err := user1.httpClient.Get(ts.URL + "/userAPI/v1/users/me")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestRateLimit(t *testing.T) {
+	//Setup test env
+
+	app, ts, cleanup, mockTime, err := setupTestEnv()
+	defer ts.Close()
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("Failed to cleanup test env : %v", err)
+		}
+	}()
+	require.NoError(t, err)
+
+	user1, err := newUserClient("testUser1@test.mail", ts)
+	require.NoError(t, err)
+	user2, err := newUserClient("testUser2@test.mail", ts)
+	require.NoError(t, err)
+
+	//
+	// RUN TEST
+	//
+
+	mockTime.CurrentTime = mockTime.CurrentTime.Truncate(time.Minute).Add(10 * time.Second)
+	limit := app.conf.rateLimits[rateLimitClassExpensive].Requests
+
+	getStatistics := func(user *testUser) *http.Response {
+		resp, err := user.httpClient.Get(ts.URL + "/userAPI/v1/users/me/statistics")
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return resp
+	}
+
+	for i := 0; i < limit; i++ {
+		require.Equal(t, http.StatusOK, getStatistics(user1).StatusCode)
+	}
+	resp := getStatistics(user1)
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	require.Equal(t, "50", resp.Header.Get("Retry-After"))
+
+	//other users and route classes have their own counters
+	require.Equal(t, http.StatusOK, getStatistics(user2).StatusCode)
+	meResp, err := user1.client.GetUsersMeWithResponse(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, meResp.StatusCode())
+
+	//the counter is reset in the next window
+	mockTime.CurrentTime = mockTime.CurrentTime.Add(time.Minute)
+	require.Equal(t, http.StatusOK, getStatistics(user1).StatusCode)
+}
+
+func TestAccessTokens(t *testing.T) {
+	//Setup test env
+
+	_, ts, cleanup, _, err := setupTestEnv()
+	defer ts.Close()
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("Failed to cleanup test env : %v", err)
+		}
+	}()
+	require.NoError(t, err)
+
+	user1, err := newUserClient("testUser1@test.mail", ts)
+	require.NoError(t, err)
+	user2, err := newUserClient("testUser2@test.mail", ts)
+	require.NoError(t, err)
+
+	//tokenClient does not send a session cookie but only the given token
+	tokenClient := func(token string) *userAPI.ClientWithResponses {
+		c, err := userAPI.NewClientWithResponses(ts.URL+"/userAPI/v1", userAPI.WithHTTPClient(ts.Client()),
+			userAPI.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+				req.Header.Set("Authorization", "Bearer "+token)
+				return nil
+			}))
+		require.NoError(t, err)
+		return c
+	}
+
+	//
+	// RUN TEST
+	//
+
+	ctx := context.Background()
+	readOnly := true
+	createResp, err := user1.client.PostUsersMeTokensWithResponse(ctx, userAPI.CreateAccessTokenReq{Name: "cli"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, createResp.StatusCode())
+	fullToken := createResp.JSON200
+	createResp, err = user1.client.PostUsersMeTokensWithResponse(ctx,
+		userAPI.CreateAccessTokenReq{Name: "dashboard", ReadOnly: &readOnly})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, createResp.StatusCode())
+	readOnlyToken := createResp.JSON200
+
+	createResp, err = user1.client.PostUsersMeTokensWithResponse(ctx, userAPI.CreateAccessTokenReq{Name: " "})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, createResp.StatusCode())
+
+	listResp, err := user1.client.GetUsersMeTokensWithResponse(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, listResp.StatusCode())
+	require.Equal(t, []userAPI.AccessTokenEntry{fullToken.Entry, readOnlyToken.Entry}, listResp.JSON200.Tokens)
+
+	//tokens authenticate as the user without session or csrf token
+	meResp, err := tokenClient(fullToken.Token).GetUsersMeWithResponse(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, meResp.StatusCode())
+	require.Equal(t, user1.Email, meResp.JSON200.Email)
+	searchResp, err := tokenClient(fullToken.Token).PostSearchDishWithResponse(ctx,
+		userAPI.SearchDishReq{DishName: "Pizza", ServedAt: "Mensa"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, searchResp.StatusCode())
+
+	listResp, err = user1.client.GetUsersMeTokensWithResponse(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, listResp.JSON200.Tokens[0].LastUsedAt)
+	require.Nil(t, listResp.JSON200.Tokens[1].LastUsedAt)
+
+	//read-only tokens can only be used for GET requests
+	meResp, err = tokenClient(readOnlyToken.Token).GetUsersMeWithResponse(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, meResp.StatusCode())
+	searchResp, err = tokenClient(readOnlyToken.Token).PostSearchDishWithResponse(ctx,
+		userAPI.SearchDishReq{DishName: "Pizza", ServedAt: "Mensa"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, searchResp.StatusCode())
+
+	//tokens cannot be managed with a token
+	tokensResp, err := tokenClient(fullToken.Token).GetUsersMeTokensWithResponse(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, tokensResp.StatusCode())
+	deleteResp, err := tokenClient(fullToken.Token).DeleteUsersMeTokensTokenIDWithResponse(ctx, fullToken.Entry.Id)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, deleteResp.StatusCode())
+
+	//users can only revoke their own tokens
+	deleteResp, err = user2.client.DeleteUsersMeTokensTokenIDWithResponse(ctx, fullToken.Entry.Id)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, deleteResp.StatusCode())
+	deleteResp, err = user1.client.DeleteUsersMeTokensTokenIDWithResponse(ctx, fullToken.Entry.Id)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, deleteResp.StatusCode())
+
+	for _, token := range []string{fullToken.Token, "itt_unknown", "not-a-token"} {
+		meResp, err = tokenClient(token).GetUsersMeWithResponse(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusUnauthorized, meResp.StatusCode(), token)
+		require.Equal(t, `Bearer error="invalid_token"`, meResp.HTTPResponse.Header.Get("WWW-Authenticate"))
+	}
+
+	listResp, err = user1.client.GetUsersMeTokensWithResponse(ctx)
+	require.NoError(t, err)
+	require.Len(t, listResp.JSON200.Tokens, 1)
+	require.Equal(t, readOnlyToken.Entry.Id, listResp.JSON200.Tokens[0].Id)
+}
+
+func TestOIDCBearerTokens(t *testing.T) {
+	//Setup test env
+
+	app, ts, cleanup, _, err := setupTestEnv()
+	defer ts.Close()
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("Failed to cleanup test env : %v", err)
+		}
+	}()
+	require.NoError(t, err)
+
+	//without an oidc provider, JWTs are rejected
+	provider, err := oidcAuth.NewTestProvider()
+	require.NoError(t, err)
+	defer provider.Close()
+	token, err := provider.NewToken("mobileUser@test.mail", "itsTastyAPI", time.Minute)
+	require.NoError(t, err)
+
+	getUsersMe := func(token string) *userAPI.GetUsersMeResponse {
+		c, err := userAPI.NewClientWithResponses(ts.URL+"/userAPI/v1", userAPI.WithHTTPClient(ts.Client()),
+			userAPI.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+				req.Header.Set("Authorization", "Bearer "+token)
+				return nil
+			}))
+		require.NoError(t, err)
+		resp, err := c.GetUsersMeWithResponse(context.Background())
+		require.NoError(t, err)
+		return resp
+	}
+	require.Equal(t, http.StatusUnauthorized, getUsersMe(token).StatusCode())
+
+	app.bearerVerifier, err = oidcAuth.NewBearerVerifier(provider.URL(), "itsTastyAPI")
+	require.NoError(t, err)
+
+	//
+	// RUN TEST
+	//
+
+	resp := getUsersMe(token)
+	require.Equal(t, http.StatusOK, resp.StatusCode())
+	require.Equal(t, "mobileUser@test.mail", resp.JSON200.Email)
+
+	otherAudience, err := provider.NewToken("mobileUser@test.mail", "otherClient", time.Minute)
+	require.NoError(t, err)
+	//ID tokens of the web client are issued for the client id, not the bearer audience
+	webClient, err := provider.NewToken("mobileUser@test.mail", "itsTasty", time.Minute)
+	require.NoError(t, err)
+	expired, err := provider.NewToken("mobileUser@test.mail", "itsTastyAPI", -time.Minute)
+	require.NoError(t, err)
+	unverified, err := provider.SignToken(map[string]any{"iss": provider.URL(), "sub": "mobileUser@test.mail",
+		"aud": "itsTastyAPI", "exp": time.Now().Add(time.Minute).Unix(), "email": "mobileUser@test.mail"})
+	require.NoError(t, err)
+	for _, token := range []string{otherAudience, webClient, expired, unverified, token + "x"} {
+		resp = getUsersMe(token)
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+		require.Equal(t, `Bearer error="invalid_token"`, resp.HTTPResponse.Header.Get("WWW-Authenticate"))
+	}
+}
+
+func TestSessions(t *testing.T) {
+	//Setup test env
+
+	app, ts, cleanup, _, err := setupTestEnv()
+	defer ts.Close()
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("Failed to cleanup test env : %v", err)
+		}
+	}()
+	require.NoError(t, err)
+
+	//user1 is logged in on two devices
+	user1Laptop, err := newUserClient("testUser1@test.mail", ts)
+	require.NoError(t, err)
+	user1Phone, err := newUserClient("testUser1@test.mail", ts)
+	require.NoError(t, err)
+	user2, err := newUserClient("testUser2@test.mail", ts)
+	require.NoError(t, err)
+
+	isLoggedIn := func(user *testUser) bool {
+		resp, err := user.client.GetUsersMeWithResponse(context.Background())
+		require.NoError(t, err)
+		return resp.StatusCode() == http.StatusOK
+	}
+
+	//
+	// RUN TEST
+	//
+
+	ctx := context.Background()
+	listResp, err := user1Laptop.client.GetUsersMeSessionsWithResponse(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, listResp.StatusCode())
+	sessions := listResp.JSON200.Sessions
+	require.Len(t, sessions, 2)
+	require.NotEqual(t, sessions[0].Id, sessions[1].Id)
+	currentSessions := 0
+	for _, v := range sessions {
+		require.Equal(t, "Go-http-client/1.1", v.UserAgent)
+		require.NotEmpty(t, v.IpHash)
+		require.False(t, v.CreatedAt.IsZero())
+		if v.Current {
+			currentSessions += 1
+		}
+	}
+	require.Equal(t, 1, currentSessions)
+	phoneSessionID := sessions[0].Id
+	if sessions[0].Current {
+		phoneSessionID = sessions[1].Id
+	}
+
+	//personal access tokens cannot manage sessions
+	createResp, err := user1Laptop.client.PostUsersMeTokensWithResponse(ctx, userAPI.CreateAccessTokenReq{Name: "cli"})
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodGet, ts.URL+"/userAPI/v1/users/me/sessions", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+createResp.JSON200.Token)
+	resp, err := ts.Client().Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	//users can only revoke their own sessions
+	revokeResp, err := user2.client.DeleteUsersMeSessionsSessionIDWithResponse(ctx, phoneSessionID)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, revokeResp.StatusCode())
+	revokeResp, err = user1Laptop.client.DeleteUsersMeSessionsSessionIDWithResponse(ctx, phoneSessionID)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, revokeResp.StatusCode())
+	require.False(t, isLoggedIn(user1Phone))
+	require.True(t, isLoggedIn(user1Laptop))
+
+	user1Phone, err = newUserClient("testUser1@test.mail", ts)
+	require.NoError(t, err)
+	revokeAllResp, err := user1Laptop.client.DeleteUsersMeSessionsWithResponse(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, revokeAllResp.StatusCode())
+	require.Equal(t, 2, revokeAllResp.JSON200.Revoked)
+	require.False(t, isLoggedIn(user1Laptop))
+	require.False(t, isLoggedIn(user1Phone))
+	require.True(t, isLoggedIn(user2))
+
+	//back-channel logout from the oidc provider
+	form := func(token string) url.Values { return url.Values{"logout_token": {token}} }
+	resp, err = ts.Client().PostForm(ts.URL+"/authAPI/backchannelLogout", form("any"))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+
+	provider, err := oidcAuth.NewTestProvider()
+	require.NoError(t, err)
+	defer provider.Close()
+	app.logoutVerifier, err = oidcAuth.NewLogoutTokenVerifier(provider.URL(), "itsTasty")
+	require.NoError(t, err)
+
+	invalidToken, err := provider.NewLogoutToken(user2.Email, "", "otherClient")
+	require.NoError(t, err)
+	resp, err = ts.Client().PostForm(ts.URL+"/authAPI/backchannelLogout", form(invalidToken))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	require.True(t, isLoggedIn(user2))
+
+	logoutToken, err := provider.NewLogoutToken(user2.Email, "", "itsTasty")
+	require.NoError(t, err)
+	resp, err = ts.Client().PostForm(ts.URL+"/authAPI/backchannelLogout", form(logoutToken))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.False(t, isLoggedIn(user2))
+}
+
+func TestWebhooks(t *testing.T) {
+	//Setup test env
+
+	app, ts, cleanup, mockTime, err := setupTestEnv()
+	defer ts.Close()
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("Failed to cleanup test env : %v", err)
+		}
+	}()
+	require.NoError(t, err)
+
+	botApiClient, err := botAPI.NewClientWithResponses(ts.URL+"/botAPI/v1/", botAPI.WithHTTPClient(ts.Client()))
+	require.NoError(t, err)
+	user1, err := newUserClient("testUser1@test.mail", ts)
+	require.NoError(t, err)
+
+	apiKeyEditor := func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("X-API-KEY", app.conf.botAPIToken)
+		return nil
+	}
+
+	//receiver records all deliveries
+	type delivery struct {
+		event      string
+		deliveryID string
+		signature  string
+		body       []byte
+	}
+	deliveries := make(chan delivery, 10)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		deliveries <- delivery{
+			event:      r.Header.Get(webhookService.HeaderEvent),
+			deliveryID: r.Header.Get(webhookService.HeaderDeliveryID),
+			signature:  r.Header.Get(webhookService.HeaderSignature),
+			body:       body,
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer receiver.Close()
+
+	//
+	// RUN TEST
+	//
+
+	//invalid input
+	invalidResp, err := botApiClient.PostWebhooksWithResponse(context.Background(), botAPI.PostWebhooksJSONRequestBody{
+		Url:        receiver.URL,
+		EventTypes: &[]string{"not.an.event"},
+	}, apiKeyEditor)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, invalidResp.StatusCode())
+
+	//register webhook for rating events
+	createResp, err := botApiClient.PostWebhooksWithResponse(context.Background(), botAPI.PostWebhooksJSONRequestBody{
+		Url:        receiver.URL,
+		EventTypes: &[]string{string(domain.EventRatingCreated)},
+	}, apiKeyEditor)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, createResp.StatusCode())
+	webhookID := createResp.JSON200.Id
+	secret := createResp.JSON200.Secret
+
+	listResp, err := botApiClient.GetWebhooksWithResponse(context.Background(), apiKeyEditor)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, listResp.StatusCode())
+	require.Len(t, listResp.JSON200.Webhooks, 1)
+	require.Equal(t, webhookID, listResp.JSON200.Webhooks[0].Id)
+	require.Equal(t, receiver.URL, listResp.JSON200.Webhooks[0].Url)
+	require.Equal(t, []string{string(domain.EventRatingCreated)}, listResp.JSON200.Webhooks[0].EventTypes)
+
+	//creating a dish must not trigger the webhook, rating it must
+	createDishResp, err := botApiClient.PostCreateOrUpdateDishWithResponse(context.Background(),
+		botAPI.PostCreateOrUpdateDishJSONRequestBody{DishName: "Webhook Dish", ServedAt: "Webhook Location"}, apiKeyEditor)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, createDishResp.StatusCode())
+
+	postDishResp, err := user1.client.PostDishesDishIDWithResponse(
+		context.Background(),
+		createDishResp.JSON200.DishID,
+		userAPI.PostDishesDishIDJSONRequestBody{
+			Rating: userAPI.RateDishReqRatingN5,
+		})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, postDishResp.StatusCode())
+
+	require.NoError(t, app.webhookService.ProcessOutbox(context.Background()))
+
+	require.Len(t, deliveries, 1)
+	got := <-deliveries
+	require.Equal(t, string(domain.EventRatingCreated), got.event)
+	require.True(t, webhookService.VerifySignature(secret, got.deliveryID, got.body, got.signature, mockTime.Now(),
+		webhookService.DefaultSignatureTolerance))
+	require.Contains(t, string(got.body), fmt.Sprintf(`"dishID":%v`, createDishResp.JSON200.DishID))
+	require.NotContains(t, string(got.body), "testUser1@test.mail")
+
+	//delete webhook
+	deleteResp, err := botApiClient.DeleteWebhooksWebhookIDWithResponse(context.Background(), webhookID, apiKeyEditor)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, deleteResp.StatusCode())
+
+	deleteResp, err = botApiClient.DeleteWebhooksWebhookIDWithResponse(context.Background(), webhookID, apiKeyEditor)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, deleteResp.StatusCode())
+}
+
+// setupTestDishes creates the 4 and a merged dish.
+// Returns dishes dish1L1, dish2L1, dish3L1, dish1L2 (in that order) and the id of the merged
+// dish "Merged Dish" that contains dish1L1 and dish2L1
+func setupTestDishes(t *testing.T, botApiClient botAPI.ClientWithResponsesInterface,
+	user *testUser, app *application) ([]*testDish, int64) {
+	//
+	//create test dishes
+	//
+
+	dish1L1 := testDish{
+		name:     "Test Dish 1",
+		location: "Test Location 1",
+	}
+	dish2L1 := testDish{
+		name:     "Test Dish 2",
+		location: "Test Location 1",
+	}
+	dish3L1 := testDish{
+		name:     "Test Dish 3",
+		location: "Test Location 1",
+	}
+	dish1L2 := testDish{
+		name:     "Test Dish 4",
+		location: "Test Location 2",
+	}
+
+	testDishes := []*testDish{&dish1L1, &dish2L1, &dish3L1, &dish1L2}
+
+	for i, v := range testDishes {
+		resp, err := botApiClient.PostCreateOrUpdateDishWithResponse(
+			context.Background(),
+			botAPI.PostCreateOrUpdateDishJSONRequestBody{
+				DishName: v.name,
+				ServedAt: v.location},
+			func(ctx context.Context, req *http.Request) error {
+				req.Header.Set("X-API-KEY", app.conf.botAPIToken)
+				return nil
+			})
+		require.NoError(t, err)
+		require.Equal(t, resp.StatusCode(), http.StatusOK)
+		//for the first and the last test dish, a new location should be created
+		require.Equal(t, i == 0 || i == 3, resp.JSON200.CreatedNewLocation)
+		require.True(t, resp.JSON200.CreatedNewDish)
+
+		v.id = resp.JSON200.DishID
+	}
+
+	//
+	// Create merged dish via user api
+	//
+
+	wantMergedDishName := "Merged Dish"
+	wantMergedDishIDsV1 := []int64{dish1L1.id, dish2L1.id}
+
+	jsonResp, err := user.client.PostMergedDishesWithResponse(context.Background(),
+		userAPI.CreateMergedDishReq{
+			MergedDishes: wantMergedDishIDsV1,
+			Name:         wantMergedDishName,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, jsonResp.StatusCode())
+	mergedDishID := jsonResp.JSON200.MergedDishID
+
+	return testDishes, mergedDishID
+}
+
+// newUserClient is a helper function that creates a client that is logged in as the given user
+func newUserClient(userEmail string, server *httptest.Server) (*testUser, error) {
+	certpool := x509.NewCertPool()
+	certpool.AddCert(server.Certificate())
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cookie jar : %v", err)
+	}
+
+	c := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: certpool,
+			},
+		},
+		Jar: jar,
+	}
+
+	//the token is only known after login, it is set below
+	user := &testUser{Email: userEmail, httpClient: c}
+	apiClient, err := userAPI.NewClientWithResponses(server.URL+"/userAPI/v1", userAPI.WithHTTPClient(c),
+		userAPI.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			req.Header.Set(csrf.HeaderToken, user.csrfToken)
+			return nil
+		}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate userAPI client : %v", err)
+	}
+
+	loginURL, err := url.Parse(server.URL + "/authAPI/login")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse login url : %v", err)
+	}
+	q := loginURL.Query()
+	q.Add("userEmail", userEmail)
+	q.Add("redirectTo", server.URL)
+	loginURL.RawQuery = q.Encode()
+	resp, err := c.Get(loginURL.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform login request : %v", err)
+	}
+	//this is a bit hacky. Our request gets redirected to the frontend during login.
+	//However, in the test environment we do not serve the frontend. Thus we expect a 404 here
+
+	if resp.StatusCode != http.StatusNotFound {
+		return nil, fmt.Errorf("login failed : %v", resp.StatusCode)
+	}
+
+	//To counteract the uncertainty from the hack 404 return code, we use the GetUsersMe endpoint
+	//to check that we are actually logged in
+	apiResp, err := apiClient.GetUsersMeWithResponse(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if login was successful : %v", err)
+	}
+	if apiResp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("login was not successful : %v", err)
+	}
+	if apiResp.JSON200.Email != userEmail {
+		return nil, fmt.Errorf("endpoint GetUsersMe returned user %v instead of expected user %v",
+			apiResp.JSON200.Email, userEmail)
+	}
+
+	user.client = apiClient
+	user.csrfToken, err = fetchCSRFToken(c, server.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// fetchCSRFToken fetches the csrf token of the session of the client
+func fetchCSRFToken(c *http.Client, serverURL string) (string, error) {
+	resp, err := c.Get(serverURL + "/userAPI/v1/csrfToken")
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch csrf token : %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to fetch csrf token : unexpected status %v", resp.StatusCode)
+	}
+	token := csrf.TokenResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("failed to decode csrf token : %v", err)
+	}
+	return token.Token, nil
+}
+
+// setupTestRepo creates the storage backend for the e2e tests. By default, a dockerized postgres db is used.
+// If envVarDBDriver is set to dbDriverMemory or dbDriverSQLite, the corresponding repo is used instead. Neither
+// requires docker
+func setupTestRepo() (repo appRepo, cleanupFN func() error, err error) {
+	switch strings.ToLower(os.Getenv(envVarDBDriver)) {
+	case dbDriverMemory:
+		return dishRepo.NewMemoryRepo(), func() error { return nil }, nil
+	case dbDriverSQLite:
+		return setupTestSQLiteRepo()
+	}
+
+	db, err := testutils.GlobalDockerPool.GetPostgresIntegrationTestDB()
+	if err != nil {
+		err = fmt.Errorf("getPostgresIntegrationTestDB failed : %v", err)
+		return
+	}
+
+	cleanupFN = func() error {
+		if err := testutils.GlobalDockerPool.Cleanup(); err != nil {
+			return fmt.Errorf("failed to cleanup docker pool : %v", err)
+		}
+		return nil
+	}
+
+	migrationSource := &migrate.FileMigrationSource{Dir: "../../migrations/postgres"}
+	repo, err = dishRepo.NewPostgresRepo(db, migrationSource)
+	if err != nil {
+		err = fmt.Errorf("NewPostgresRepo failed : %v", err)
+		if cleanupErr := cleanupFN(); cleanupErr != nil {
+			err = errors.Join(err, cleanupErr)
+		}
+		return
+	}
+
+	return repo, cleanupFN, nil
+}
+
+// setupTestSQLiteRepo creates a SQLite repo backed by a file in a fresh temporary directory
+func setupTestSQLiteRepo() (appRepo, func() error, error) {
+	dir, err := os.MkdirTemp("", "itsTasty-e2e")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create temp dir : %v", err)
+	}
+
+	db, err := dishRepo.OpenSQLiteDB(filepath.Join(dir, "itsTasty.db"))
+	if err != nil {
+		return nil, nil, errors.Join(fmt.Errorf("OpenSQLiteDB failed : %v", err), os.RemoveAll(dir))
+	}
+	repo, err := dishRepo.NewSQLiteRepo(db, &migrate.FileMigrationSource{Dir: "../../migrations/sqlite"})
+	if err != nil {
+		return nil, nil, errors.Join(fmt.Errorf("NewSQLiteRepo failed : %v", err), db.Close(), os.RemoveAll(dir))
+	}
+
+	cleanupFN := func() error {
+		return errors.Join(repo.Close(), os.RemoveAll(dir))
+	}
+	return repo, cleanupFN, nil
+}
+
+// setupTestEnv prepares a new test environment. The caller must call the returned cleanup function
+// to terminate/free the resources allocated by this function. The caller must close ts once they are done with it
+func setupTestEnv() (app *application, ts *httptest.Server, cleanupFN func() error, mockTime *mockTimeSource, err error) {
+	//
+	//instantiate db backend
+	//
+
+	repo, dbCleanupFN, err := setupTestRepo()
+	if err != nil {
+		err = fmt.Errorf("setupTestRepo failed : %v", err)
+		return
+	}
+	defer func() {
+		if err != nil {
+			if cleanupErr := dbCleanupFN(); cleanupErr != nil {
+				err = errors.Join(err, fmt.Errorf("failed to cleanup db resources : %v", err))
+			}
+		}
+	}()
+
+	mockTime = NewMockTimeSourceToday()
 
 	dishRepoFactory := func() (domain.DishRepo, error) {
 		return repo, nil
@@ -1151,6 +2624,21 @@ func setupTestEnv() (app *application, ts *httptest.Server, cleanupFN func() err
 	streakRepoFactory := func() (domain.RatingStreakRepo, error) {
 		return repo, nil
 	}
+	webhookRepoFactory := func() (domain.WebhookRepo, error) {
+		return repo, nil
+	}
+	locationRepoFactory := func() (domain.LocationRepo, error) {
+		return repo, nil
+	}
+	dishFamilyRepoFactory := func() (domain.DishFamilyRepo, error) {
+		return repo, nil
+	}
+	accessTokenRepoFactory := func() (domain.AccessTokenRepo, error) {
+		return repo, nil
+	}
+	dbHealthCheckFactory := func() (health.Check, error) {
+		return repo.CheckHealth, nil
+	}
 	holidayClientFactory := func() (domain.PublicHolidayDataSource, error) {
 		return publicHoliday.NewDefaultRegionHolidayChecker("Schleswig-Holstein")
 	}
@@ -1158,16 +2646,29 @@ func setupTestEnv() (app *application, ts *httptest.Server, cleanupFN func() err
 		return vacation.NewEmptyVacationClient(), nil
 	}
 
-	botApiFactory := func(repo domain.DishRepo, service statisticsService.StreakService) *botAPI.Service {
-		return botAPI.NewServiceCustomTime(repo, service, mockTime)
+	botApiFactory := func(repo domain.DishRepo, locations domain.LocationRepo, service statisticsService.StreakService,
+		webhooks webhookService.WebhookService, normalizer *nameNormalizer.Normalizer) *botAPI.Service {
+		return botAPI.NewServiceCustomTime(repo, locations, service, webhooks, normalizer, mockTime)
 	}
-	userApiFactory := func(repo domain.DishRepo) *userAPI.HttpServer {
-		return userAPI.NewHttpServerCustomTime(repo, mockTime)
+	userApiFactory := func(repo domain.DishRepo, locations domain.LocationRepo, families domain.DishFamilyRepo, userStats statisticsService.UserStatisticsService, tokens accessTokenService.AccessTokenService, sessions sessionService.SessionService, events domain.EventPublisher) *userAPI.HttpServer {
+		return userAPI.NewHttpServerCustomTime(repo, locations, families, userStats, tokens, sessions, events, mockTime)
 	}
 
-	streakServiceFactory := func(statsRepo domain.StatisticsRepo, vacationStreakRepo domain.RatingStreakRepo, vacationClient domain.VacationDataSource, holidayClient domain.PublicHolidayDataSource) (service statisticsService.StreakService, err2 error) {
+	streakServiceFactory := func(statsRepo domain.StatisticsRepo, vacationStreakRepo domain.RatingStreakRepo, vacationClient domain.VacationDataSource, holidayClient domain.PublicHolidayDataSource, events domain.EventPublisher) (service statisticsService.StreakService, err2 error) {
 		return statisticsService.NewDefaultStreakService(
-			statsRepo, vacationStreakRepo, vacationClient, holidayClient, mockTime), nil
+			statsRepo, vacationStreakRepo, vacationClient, holidayClient, mockTime, events), nil
+	}
+
+	webhookServiceFactory := func(repo domain.WebhookRepo) (webhookService.WebhookService, error) {
+		return webhookService.NewDefaultWebhookServiceCustom(repo, http.DefaultClient, mockTime), nil
+	}
+
+	notifierFactory := func() (domain.Notifier, error) {
+		return notifier.NewFakeNotifier(), nil
+	}
+
+	rateLimiterFactory := func(limits map[string]rateLimit.Limit) (*rateLimit.Limiter, error) {
+		return rateLimit.NewLimiterCustom(rateLimit.NewMemoryStore(), limits, mockTime), nil
 	}
 
 	repoCleanupFN := func() error {
@@ -1195,17 +2696,43 @@ func setupTestEnv() (app *application, ts *httptest.Server, cleanupFN func() err
 		devMode:         true,
 		devCORS:         "https://localhost",
 		sessionLifetime: 10 * time.Minute,
+		vacationMaxAge:  time.Hour,
+
+		maxBodyBytes: 64 << 10,
+		securityHeaders: httpSecurity.HeadersConfig{
+			ContentSecurityPolicy: httpSecurity.DefaultContentSecurityPolicy,
+		},
+
+		oidcRefreshIntervall:    time.Hour,
+		webhookDeliveryInterval: 30 * time.Second,
+		streakUpdateInterval:    time.Hour,
+		ratingReminderInterval:  5 * time.Minute,
+
+		rateLimits: map[string]rateLimit.Limit{
+			rateLimitClassRead:      {Requests: 1000, Window: time.Minute},
+			rateLimitClassWrite:     {Requests: 1000, Window: time.Minute},
+			rateLimitClassExpensive: {Requests: 20, Window: time.Minute},
+			rateLimitClassBot:       {Requests: 1000, Window: time.Minute},
+		},
 	}
 
 	factories := appComponentFactories{
-		dishRepoFactory:       dishRepoFactory,
-		streakRepoFactory:     streakRepoFactory,
-		statsRepoFactory:      statsRepoFactory,
-		holidayClientFactory:  holidayClientFactory,
-		vacationClientFactory: vacationClientFactory,
-		botAPIFactory:         botApiFactory,
-		userAPIFactory:        userApiFactory,
-		streakServiceFactory:  streakServiceFactory,
+		dishRepoFactory:        dishRepoFactory,
+		streakRepoFactory:      streakRepoFactory,
+		statsRepoFactory:       statsRepoFactory,
+		webhookRepoFactory:     webhookRepoFactory,
+		locationRepoFactory:    locationRepoFactory,
+		dishFamilyRepoFactory:  dishFamilyRepoFactory,
+		accessTokenRepoFactory: accessTokenRepoFactory,
+		dbHealthCheckFactory:   dbHealthCheckFactory,
+		rateLimiterFactory:     rateLimiterFactory,
+		holidayClientFactory:   holidayClientFactory,
+		vacationClientFactory:  vacationClientFactory,
+		botAPIFactory:          botApiFactory,
+		userAPIFactory:         userApiFactory,
+		streakServiceFactory:   streakServiceFactory,
+		webhookServiceFactory:  webhookServiceFactory,
+		notifierFactory:        notifierFactory,
 	}
 	app, err = newApplication(&config, factories)
 	if err != nil {
@@ -1227,8 +2754,8 @@ func setupTestEnv() (app *application, ts *httptest.Server, cleanupFN func() err
 
 	cleanupFN = func() error {
 		repoErr := repoCleanupFN()
-		dockerErr := dockerCleanupFN()
-		return errors.Join(repoErr, dockerErr)
+		dbErr := dbCleanupFN()
+		return errors.Join(repoErr, dbErr)
 	}
 	return app, ts, cleanupFN, mockTime, nil
 }
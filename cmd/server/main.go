@@ -3,24 +3,36 @@ package main
 import (
 	"context"
 	"crypto/subtle"
-	"database/sql"
+	"crypto/tls"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"flag"
 	"fmt"
+	"itsTasty/pkg/api/accessTokenService"
 	"itsTasty/pkg/api/adapters/dishRepo"
+	"itsTasty/pkg/api/adapters/notifier"
 	"itsTasty/pkg/api/adapters/publicHoliday"
 	"itsTasty/pkg/api/adapters/vacation"
 	"itsTasty/pkg/api/domain"
+	"itsTasty/pkg/api/nameNormalizer"
 	"itsTasty/pkg/api/ports/botAPI"
 	"itsTasty/pkg/api/ports/userAPI"
+	"itsTasty/pkg/api/reminderService"
+	"itsTasty/pkg/api/sessionService"
 	"itsTasty/pkg/api/statisticsService"
+	"itsTasty/pkg/api/webhookService"
+	"itsTasty/pkg/csrf"
+	"itsTasty/pkg/health"
+	"itsTasty/pkg/httpSecurity"
+	"itsTasty/pkg/logging"
 	"itsTasty/pkg/oidcAuth"
-	"log"
+	"itsTasty/pkg/rateLimit"
+	"itsTasty/pkg/telemetry"
+	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
-	"strconv"
 	"strings"
 	"sync"
 	"syscall"
@@ -30,91 +42,11 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/cors"
 	"github.com/go-co-op/gocron"
-	_ "github.com/jackc/pgx/v5/stdlib"
 	migrate "github.com/rubenv/sql-migrate"
 	"golang.org/x/crypto/sha3"
 	"golang.org/x/sync/errgroup"
 )
 
-const (
-	envVarDBURL  = "DB_URL"
-	envVarDBName = "DB_NAME"
-	envVarDBUser = "DB_USER"
-	envVarDBPW   = "DB_PW"
-
-	envOIDCSecret                 = "OIDC_SECRET"
-	envOIDCCallbackURL            = "OIDC_CALLBACK_URL"
-	envOIDCProviderURL            = "OIDC_PROVIDER_URL"
-	envOIDCID                     = "OIDC_ID"
-	envOIDCRefreshIntervalMinutes = "OIDC_REFRESH_INTERVAL_MINUTES"
-
-	envVacationServerURL    = "VACATION_SERVER_URL"
-	envVacationServerApiKey = "VACATION_SERVER_API_KEY"
-	envPublicHolidayRegion  = "PUBLIC_HOLIDAY_REGION"
-
-	envBotAPIToken = "BOT_API_TOKEN"
-
-	//envURLAfterLogin sets the default url after login if no login target is given to the authenticator
-	envURLAfterLogin  = "URL_AFTER_LOGIN"
-	envURLAfterLogout = "URL_AFTER_LOGOUT"
-
-	//envVarDevMode if set to true, mock login backend is used and X-Site cookies are allowed.
-	//Make sure to also configure envVarDevCORS
-	envVarDevMode = "DEV_MODE"
-
-	//envVarDevCORS one URL that is allowed for CORS requests
-	envVarDevCORS = "DEV_CORS"
-
-	//envVarSessionLifetime is the maximum lifetime of the session cookie. Afterward the user
-	//has to log in again
-	//see https://pkg.go.dev/time#ParseDuration for input format
-	envVarSessionLifetime = "SESSION_LIFETIME"
-)
-
-type config struct {
-
-	//DB config
-	dbURL  string
-	dbName string
-	dbUser string
-	dbPW   string
-
-	//OIDC Config
-
-	oidcSecret           string
-	oidcCallbackURL      string
-	oidcProviderURL      string
-	oidcID               string
-	urlAfterLogin        string
-	urlAfterLogout       string
-	oidcRefreshIntervall time.Duration
-
-	//Adapters Config
-	vacationServerURL    string
-	vacationServerAPIKey string
-	publicHolidayRegion  string
-
-	//Bot Auth Config
-
-	botAPIToken string
-
-	// Session Config
-
-	sessionSecret string
-
-	//HTTP Config
-
-	listen string
-
-	//Config for local development
-
-	devMode bool
-	devCORS string
-
-	//sessionLifetime is the expiry time of the session cookie
-	sessionLifetime time.Duration
-}
-
 // defaultTimeSource simply wraps time.Now()
 type defaultTimeSource struct {
 }
@@ -124,160 +56,58 @@ func (d defaultTimeSource) Now() time.Time {
 }
 
 type application struct {
-	conf                *config
-	authenticator       oidcAuth.Authenticator
+	conf          *config
+	authenticator oidcAuth.Authenticator
+	//bearerVerifier checks JWTs of the oidc provider sent to the user API. Nil if there is no provider or no
+	//bearer audience
+	bearerVerifier *oidcAuth.BearerVerifier
+	//logoutVerifier checks the tokens of the back-channel logout. Nil if there is no provider
+	logoutVerifier      *oidcAuth.LogoutTokenVerifier
+	sessionService      *sessionService.DefaultSessionService
 	session             *scs.SessionManager
 	router              chi.Router
 	dishRepo            domain.DishRepo
+	locationRepo        domain.LocationRepo
+	dishFamilyRepo      domain.DishFamilyRepo
 	ratingStreakService statisticsService.StreakService
+	userStatsService    statisticsService.UserStatisticsService
+	webhookService      webhookService.WebhookService
+	accessTokenService  accessTokenService.AccessTokenService
+	nameNormalizer      *nameNormalizer.Normalizer
 	jobScheduler        *gocron.Scheduler
-}
-
-func parseConfig() (*config, error) {
-
-	setEnvErr := func(envVarName string) error {
-		return fmt.Errorf("missing env var %v", envVarName)
-	}
-
-	cfg := config{}
-
-	cfg.devMode = "true" == strings.ToLower(os.Getenv(envVarDevMode))
-	if cfg.devMode {
-		log.Printf("DEV MODE: ENABLED")
-	}
-
-	if dbURL := os.Getenv(envVarDBURL); dbURL == "" {
-		return nil, setEnvErr(envVarDBURL)
-	} else {
-		cfg.dbURL = dbURL
-	}
-
-	if dbName := os.Getenv(envVarDBName); dbName == "" {
-		return nil, setEnvErr(envVarDBName)
-	} else {
-		cfg.dbName = dbName
-	}
-
-	if dbUser := os.Getenv(envVarDBUser); dbUser == "" {
-		return nil, setEnvErr(envVarDBUser)
-	} else {
-		cfg.dbUser = dbUser
-	}
-
-	if dbPW := os.Getenv(envVarDBPW); dbPW == "" {
-		return nil, setEnvErr(envVarDBPW)
-	} else {
-		cfg.dbPW = dbPW
-	}
-
-	if oidcSecret := os.Getenv(envOIDCSecret); oidcSecret == "" && !cfg.devMode {
-		return nil, setEnvErr(envOIDCSecret)
-	} else {
-		cfg.oidcSecret = oidcSecret
-	}
-
-	if oidcCallbackURL := os.Getenv(envOIDCCallbackURL); oidcCallbackURL == "" && !cfg.devMode {
-		return nil, setEnvErr(envOIDCCallbackURL)
-	} else {
-		cfg.oidcCallbackURL = oidcCallbackURL
-	}
-
-	if oidcProviderURL := os.Getenv(envOIDCProviderURL); oidcProviderURL == "" && !cfg.devMode {
-		return nil, setEnvErr(envOIDCProviderURL)
-	} else {
-		cfg.oidcProviderURL = oidcProviderURL
-	}
-
-	if oidcID := os.Getenv(envOIDCID); oidcID == "" && !cfg.devMode {
-		return nil, setEnvErr(envOIDCID)
-	} else {
-		cfg.oidcID = oidcID
-	}
-
-	{
-		oidcRefreshIntervalStr := os.Getenv(envOIDCRefreshIntervalMinutes)
-		//if not specified set default value
-		if oidcRefreshIntervalStr == "" {
-			cfg.oidcRefreshIntervall = 60 * time.Minute
-			log.Printf("%v was not specified and defaults to %v", envOIDCRefreshIntervalMinutes, cfg.oidcRefreshIntervall)
-		} else {
-			oidcRefreshIntervalUint, err := strconv.ParseUint(oidcRefreshIntervalStr, 10, 64)
-			if err != nil {
-				return nil, fmt.Errorf("failed to parse value %v of %s to uint64 : %v", oidcRefreshIntervalStr, envOIDCRefreshIntervalMinutes, err)
-			}
-			cfg.oidcRefreshIntervall = time.Duration(oidcRefreshIntervalUint) * time.Minute
-		}
-
-	}
-
-	if vacationServerURL := os.Getenv(envVacationServerURL); vacationServerURL == "" {
-		return nil, setEnvErr(envVacationServerURL)
-	} else {
-		cfg.vacationServerURL = vacationServerURL
-	}
-
-	if vacationServerAPIKey := os.Getenv(envVacationServerApiKey); vacationServerAPIKey == "" {
-		return nil, setEnvErr(envVacationServerApiKey)
-	} else {
-		cfg.vacationServerAPIKey = vacationServerAPIKey
-	}
-
-	if publicHolidayRegion := os.Getenv(envPublicHolidayRegion); publicHolidayRegion == "" {
-		return nil, setEnvErr(envPublicHolidayRegion)
-	} else {
-		cfg.publicHolidayRegion = publicHolidayRegion
-	}
-
-	if botApiToken := os.Getenv(envBotAPIToken); botApiToken == "" {
-		return nil, setEnvErr(envBotAPIToken)
-	} else {
-		cfg.botAPIToken = botApiToken
-	}
-
-	if urlAfterLogin := os.Getenv(envURLAfterLogin); urlAfterLogin == "" {
-		return nil, setEnvErr(envURLAfterLogin)
-	} else {
-		cfg.urlAfterLogin = urlAfterLogin
-	}
-
-	if urlAfterLogout := os.Getenv(envURLAfterLogout); urlAfterLogout == "" {
-		return nil, setEnvErr(envURLAfterLogout)
-	} else {
-		cfg.urlAfterLogout = urlAfterLogout
-	}
-
-	if devCORS := os.Getenv(envVarDevCORS); devCORS != "" {
-		cfg.devCORS = devCORS
-	}
-
-	if sessionLifetimeAsStr := os.Getenv(envVarSessionLifetime); sessionLifetimeAsStr == "" {
-		cfg.sessionLifetime = 7 * 24 * time.Hour
-		log.Printf("%s was not specified and defaults to : %v", envVarSessionLifetime, cfg.sessionLifetime)
-	} else {
-		sessionLifetime, err := time.ParseDuration(sessionLifetimeAsStr)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse value %v of %s to time duration : %v",
-				sessionLifetimeAsStr, envVarSessionLifetime, err)
-		}
-		cfg.sessionLifetime = sessionLifetime
-	}
-	cfg.listen = ":80"
-
-	return &cfg, nil
+	//jobs are shown on the status page
+	jobs          []health.Job
+	healthChecker *health.Checker
+	rateLimiter   *rateLimit.Limiter
 }
 
 type dishRepoFactoryFunc func() (domain.DishRepo, error)
 type streakRepoFactoryFunc func() (domain.RatingStreakRepo, error)
 type statisticsRepoFactoryFunc func() (domain.StatisticsRepo, error)
+type webhookRepoFactoryFunc func() (domain.WebhookRepo, error)
+type locationRepoFactoryFunc func() (domain.LocationRepo, error)
+type dishFamilyRepoFactoryFunc func() (domain.DishFamilyRepo, error)
+type accessTokenRepoFactoryFunc func() (domain.AccessTokenRepo, error)
+type dbHealthCheckFactoryFunc func() (health.Check, error)
+type rateLimiterFactoryFunc func(limits map[string]rateLimit.Limit) (*rateLimit.Limiter, error)
 
 type appComponentFactories struct {
-	dishRepoFactory       dishRepoFactoryFunc
-	streakRepoFactory     streakRepoFactoryFunc
-	statsRepoFactory      statisticsRepoFactoryFunc
-	holidayClientFactory  func() (domain.PublicHolidayDataSource, error)
-	vacationClientFactory func() (domain.VacationDataSource, error)
-	streakServiceFactory  func(statsRepo domain.StatisticsRepo, vacationStreakRepo domain.RatingStreakRepo,
-		vacationClient domain.VacationDataSource, holidayClient domain.PublicHolidayDataSource) (service statisticsService.StreakService, err2 error)
+	dishRepoFactory        dishRepoFactoryFunc
+	streakRepoFactory      streakRepoFactoryFunc
+	statsRepoFactory       statisticsRepoFactoryFunc
+	webhookRepoFactory     webhookRepoFactoryFunc
+	locationRepoFactory    locationRepoFactoryFunc
+	dishFamilyRepoFactory  dishFamilyRepoFactoryFunc
+	accessTokenRepoFactory accessTokenRepoFactoryFunc
+	dbHealthCheckFactory   dbHealthCheckFactoryFunc
+	rateLimiterFactory     rateLimiterFactoryFunc
+	holidayClientFactory   func() (domain.PublicHolidayDataSource, error)
+	vacationClientFactory  func() (domain.VacationDataSource, error)
+	streakServiceFactory   func(statsRepo domain.StatisticsRepo, vacationStreakRepo domain.RatingStreakRepo,
+		vacationClient domain.VacationDataSource, holidayClient domain.PublicHolidayDataSource,
+		events domain.EventPublisher) (service statisticsService.StreakService, err2 error)
+	webhookServiceFactory func(repo domain.WebhookRepo) (webhookService.WebhookService, error)
+	notifierFactory       func() (domain.Notifier, error)
 
 	botAPIFactory  botAPI.ServiceFactory
 	userAPIFactory userAPI.HttpServerFactory
@@ -287,24 +117,33 @@ func newApplication(cfg *config, factories appComponentFactories) (*application,
 
 	//Build Session Storage
 
-	log.Printf("Building session storage...")
+	slog.Info("building session storage...")
 	session := scs.New()
 	session.Lifetime = cfg.sessionLifetime
 	session.Cookie.Name = "its_tasty_session"
 	session.Cookie.Secure = true
 
 	if cfg.devMode {
-		log.Printf("DEV MODE: Enabling SameSiteNoneMode")
+		slog.Info("DEV MODE: enabling SameSiteNoneMode")
 		session.Cookie.SameSite = http.SameSiteNoneMode
 	}
 
+	telemetry.SetActiveSessionsSource(func() (int, error) {
+		store, ok := session.Store.(scs.IterableStore)
+		if !ok {
+			return 0, fmt.Errorf("session store %T does not support iteration", session.Store)
+		}
+		sessions, err := store.All()
+		return len(sessions), err
+	})
+
 	//Build oidc authenticator
-	log.Printf("Building auth backend...")
+	slog.Info("building auth backend...")
 	authStorageAdapter := NewAuthSessionStorageManager(session)
 
 	var authenticator oidcAuth.Authenticator
 	if cfg.devMode {
-		log.Printf("DEV MODE: Enabling Mock Login Backend")
+		slog.Info("DEV MODE: enabling mock login backend")
 		authenticator = oidcAuth.NewMockAuthenticator(cfg.urlAfterLogin, cfg.urlAfterLogout, authStorageAdapter)
 	} else {
 		var err error
@@ -315,16 +154,40 @@ func newApplication(cfg *config, factories appComponentFactories) (*application,
 		}
 	}
 
+	var bearerVerifier *oidcAuth.BearerVerifier
+	var logoutVerifier *oidcAuth.LogoutTokenVerifier
+	if cfg.oidcProviderURL != "" {
+		var err error
+		if cfg.oidcBearerAudience != "" {
+			bearerVerifier, err = oidcAuth.NewBearerVerifier(cfg.oidcProviderURL, cfg.oidcBearerAudience)
+			if err != nil {
+				return nil, fmt.Errorf("oidcAuth.NewBearerVerifier : %v", err)
+			}
+		}
+		logoutVerifier, err = oidcAuth.NewLogoutTokenVerifier(cfg.oidcProviderURL, cfg.oidcID)
+		if err != nil {
+			return nil, fmt.Errorf("oidcAuth.NewLogoutTokenVerifier : %v", err)
+		}
+	}
+
 	//Schedule job to periodically refresh the oidc access tokens for all known sessions.
 	//This way, our session token lifetime dictates the max lifetime of the session and not the oidc refresh
 	//interval of the oidc provider
 
 	jobScheduler := gocron.NewScheduler(time.Local)
-	_, err := jobScheduler.Every(cfg.oidcRefreshIntervall).Do(func() {
-		log.Printf("OIDC Refresh Job : Starting refresh...")
-		//time limit for refresh jobs
-		iterateCtx, iterateCtxCancel := context.WithTimeout(context.Background(), 5*time.Minute)
-		defer iterateCtxCancel()
+	jobs := make([]health.Job, 0)
+	//scheduleJob runs job every interval and registers it for the status page
+	scheduleJob := func(name string, interval time.Duration, job func(ctx context.Context) error) error {
+		scheduled, err := jobScheduler.Every(interval).Do(telemetry.InstrumentJob(name, 5*time.Minute, job))
+		if err != nil {
+			return err
+		}
+		jobs = append(jobs, health.Job{Name: name, Interval: interval, Scheduled: scheduled})
+		return nil
+	}
+
+	err := scheduleJob("oidcRefresh", cfg.oidcRefreshIntervall, func(iterateCtx context.Context) error {
+		logging.FromContext(iterateCtx).Info("starting refresh...")
 
 		refreshJobs, _ := errgroup.WithContext(iterateCtx)
 		refreshJobs.SetLimit(5)
@@ -346,7 +209,7 @@ func newApplication(cfg *config, factories appComponentFactories) (*application,
 				defer func() {
 					_, _, err := session.Commit(ctx)
 					if err != nil {
-						log.Printf("OIDC Refresh Job: Token id %v, failed to commit session data :%v", tokenID, err)
+						logging.FromContext(ctx).Error("failed to commit session data", "sessionID", tokenID, "err", err)
 						return
 					}
 				}()
@@ -356,11 +219,11 @@ func newApplication(cfg *config, factories appComponentFactories) (*application,
 					if err == nil {
 						break
 					}
-					log.Printf("OIDC Refresh Job: Refresh for token id %v failed (%v retries left) : %v", tokenID, retries, err)
+					logging.FromContext(ctx).Warn("refresh failed", "sessionID", tokenID, "retriesLeft", retries, "err", err)
 					time.Sleep(3 * time.Second)
 				}
 				if err == nil {
-					log.Printf("OIDC Refresh Job: Refreshed session  %v", tokenID)
+					logging.FromContext(ctx).Info("refreshed session", "sessionID", tokenID)
 					successCounterLock.Lock()
 					successCounter += 1
 					successCounterLock.Unlock()
@@ -369,11 +232,11 @@ func newApplication(cfg *config, factories appComponentFactories) (*application,
 
 				//if we get here, refresh has failed
 
-				log.Printf("OIDC Refresh Job: Failed to refresh session %v : %v", tokenID, err)
+				logging.FromContext(ctx).Warn("failed to refresh session", "sessionID", tokenID, "err", err)
 				if err := session.Destroy(ctx); err != nil {
-					log.Printf("OIDC Refresh Job: Failed to destroy session  %v after refresh failure : %v", tokenID, err)
+					logging.FromContext(ctx).Error("failed to destroy session after refresh failure", "sessionID", tokenID, "err", err)
 				} else {
-					log.Printf("OIDC Refresh Job: Destroyed session for %v after refresh failure : %v", tokenID, err)
+					logging.FromContext(ctx).Info("destroyed session after refresh failure", "sessionID", tokenID, "err", err)
 				}
 
 				//we do not want to propagate individual refresh errors beyond this function
@@ -388,13 +251,14 @@ func newApplication(cfg *config, factories appComponentFactories) (*application,
 			return nil
 		})
 		if err != nil {
-			log.Printf("OIDC Refresh Job : Iterate failed : %v", err)
+			logging.FromContext(iterateCtx).Error("iterate failed", "err", err)
 		}
 
 		if err := refreshJobs.Wait(); err != nil {
-			log.Printf("OIDC Refresh Job : errgroup.Wait failed : %v", err)
+			logging.FromContext(iterateCtx).Error("errgroup.Wait failed", "err", err)
 		}
-		log.Printf("OIDC Refresh Job: Found %v sessions, refreshed %v, terminated %v", sessionCounter, successCounter, sessionCounter-successCounter)
+		logging.FromContext(iterateCtx).Info("refresh done", "sessions", sessionCounter, "refreshed", successCounter, "terminated", sessionCounter-successCounter)
+		return err
 	})
 
 	if err != nil {
@@ -406,6 +270,21 @@ func newApplication(cfg *config, factories appComponentFactories) (*application,
 		return nil, fmt.Errorf("failed to instantiate dish repo : %v", err)
 	}
 
+	locationRepo, err := factories.locationRepoFactory()
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate location repo : %v", err)
+	}
+
+	dishFamilyRepo, err := factories.dishFamilyRepoFactory()
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate dish family repo : %v", err)
+	}
+
+	accessTokenRepo, err := factories.accessTokenRepoFactory()
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate access token repo : %v", err)
+	}
+
 	statsRepo, err := factories.statsRepoFactory()
 	if err != nil {
 		return nil, fmt.Errorf("failed to instantiate statistics repo : %v", err)
@@ -416,40 +295,135 @@ func newApplication(cfg *config, factories appComponentFactories) (*application,
 		return nil, fmt.Errorf("failed to instantiate streak repo : %v", err)
 	}
 
-	vacationClient, err := factories.vacationClientFactory()
+	vacationDataSource, err := factories.vacationClientFactory()
 	if err != nil {
 		return nil, fmt.Errorf("failed to instantiate vacation client : %v", err)
 	}
+	vacationClient := vacation.NewMonitoredClient(vacationDataSource)
+
+	dbHealthCheck, err := factories.dbHealthCheckFactory()
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate db health check : %v", err)
+	}
+
+	rateLimiter, err := factories.rateLimiterFactory(cfg.rateLimits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate rate limiter : %v", err)
+	}
+
+	healthChecker := health.NewChecker(5 * time.Second)
+	healthChecker.Add("db", dbHealthCheck)
+	healthChecker.Add("oidc", authenticator.CheckProvider)
+	healthChecker.Add("vacation", func(_ context.Context) error {
+		return vacationClient.CheckFreshness(cfg.vacationMaxAge)
+	})
 
 	holidayClient, err := factories.holidayClientFactory()
 	if err != nil {
 		return nil, fmt.Errorf("failed to instantiate holiday client : %v", err)
 	}
 
-	streakService, err := factories.streakServiceFactory(statsRepo, streakRepo, vacationClient, holidayClient)
+	webhookRepo, err := factories.webhookRepoFactory()
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate webhook repo : %v", err)
+	}
+
+	webhooks, err := factories.webhookServiceFactory(webhookRepo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate webhook service : %v", err)
+	}
+
+	err = scheduleJob("webhookDelivery", cfg.webhookDeliveryInterval, func(ctx context.Context) error {
+		err := webhooks.ProcessOutbox(ctx)
+		if err != nil {
+			logging.FromContext(ctx).Error("ProcessOutbox failed", "err", err)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule webhook delivery job : %v", err)
+	}
+
+	streakService, err := factories.streakServiceFactory(statsRepo, streakRepo, vacationClient, holidayClient, webhooks)
 	if err != nil {
 		return nil, fmt.Errorf("failed to instantiate streak service : %v", err)
 
 	}
 
-	_, err = jobScheduler.Every(time.Hour).Do(func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
-		defer cancel()
-		if err := streakService.UpdateRatingStreaks(ctx); err != nil {
-			log.Printf("UpdateRatingStreaks failed : %v", err)
+	err = scheduleJob("updateRatingStreaks", cfg.streakUpdateInterval, func(ctx context.Context) error {
+		err := streakService.UpdateRatingStreaks(ctx)
+		if err != nil {
+			logging.FromContext(ctx).Error("UpdateRatingStreaks failed", "err", err)
 		}
+		return err
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to schedule UpdateRatingStreaks jobs : %v", err)
 	}
 
+	if cfg.ratingReminderEnabled {
+		ratingNotifier, err := factories.notifierFactory()
+		if err != nil {
+			return nil, fmt.Errorf("failed to instantiate notifier : %v", err)
+		}
+		reminders := reminderService.NewDefaultReminderService(statsRepo, streakService, vacationClient, holidayClient,
+			ratingNotifier, defaultTimeSource{}, cfg.ratingReminderAfter)
+
+		//the service makes sure to only send reminders once per day
+		err = scheduleJob("ratingReminders", cfg.ratingReminderInterval, func(ctx context.Context) error {
+			sent, err := reminders.SendRatingReminders(ctx)
+			if err != nil {
+				logging.FromContext(ctx).Error("SendRatingReminders failed", "err", err)
+			}
+			if sent > 0 {
+				logging.FromContext(ctx).Info("sent rating reminders", "count", sent)
+			}
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to schedule rating reminder job : %v", err)
+		}
+	}
+
+	userStatsService := statisticsService.NewDefaultUserStatisticsService(statsRepo, streakRepo, streakService)
+
+	sessions, err := sessionService.NewDefaultSessionService(session)
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate session service : %v", err)
+	}
+
+	normalizerConfig := nameNormalizer.DefaultConfig()
+	if cfg.dishNameRulesPath != "" {
+		slog.Info("loading dish name rules", "path", cfg.dishNameRulesPath)
+		normalizerConfig, err = nameNormalizer.LoadConfig(cfg.dishNameRulesPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load dish name rules : %v", err)
+		}
+	}
+	normalizer, err := nameNormalizer.NewNormalizer(normalizerConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate dish name normalizer : %v", err)
+	}
+
 	app := application{
 		conf:                cfg,
 		authenticator:       authenticator,
+		bearerVerifier:      bearerVerifier,
+		logoutVerifier:      logoutVerifier,
+		sessionService:      sessions,
 		session:             session,
 		dishRepo:            dishesRepo,
+		locationRepo:        locationRepo,
+		dishFamilyRepo:      dishFamilyRepo,
 		jobScheduler:        jobScheduler,
+		jobs:                jobs,
+		healthChecker:       healthChecker,
+		rateLimiter:         rateLimiter,
 		ratingStreakService: streakService,
+		userStatsService:    userStatsService,
+		webhookService:      webhooks,
+		accessTokenService:  accessTokenService.NewDefaultAccessTokenService(accessTokenRepo),
+		nameNormalizer:      normalizer,
 	}
 
 	app.router, err = app.setupRouter(factories.botAPIFactory, factories.userAPIFactory)
@@ -461,116 +435,100 @@ func newApplication(cfg *config, factories appComponentFactories) (*application,
 
 }
 
-// connectToMariaDB connects to the db waiting some time for the db to come online before giving up
-func connectToPostgresDB(ctx context.Context, dbUser, dbPW, dbURL, dbName string) (*sql.DB, error) {
-
-	db, err := sql.Open("pgx", fmt.Sprintf("postgres://%v:%v@%s/%s?sslmode=disable", dbUser, dbPW, dbURL, dbName))
-	if err != nil {
-		return nil, fmt.Errorf("sql.Open : %v", err)
-	}
-
-	log.Printf("Trying to connect to db...")
-	retries := 10
-	connected := false
-	for retries > 0 && !connected {
-		pingCtx, pingCancel := context.WithTimeout(ctx, 10*time.Second)
-		err := db.PingContext(pingCtx)
-		if err != nil {
-			log.Printf("Error connecting to db : %v", err)
-			log.Printf("%v retries remaining", retries)
-			retries -= 1
-			time.Sleep(3 * time.Second)
-		} else {
-			log.Printf("Connected to db")
-			connected = true
-		}
-		pingCancel()
-	}
-
-	if !connected {
-		return nil, fmt.Errorf("error connecting to db")
-	}
-
-	return db, nil
-}
-
 func (app *application) setupRouter(botAPIFactory botAPI.ServiceFactory, userAPiFactory userAPI.HttpServerFactory) (chi.Router, error) {
-	log.Printf("Configuring router...")
+	slog.Info("configuring router...")
 	router := chi.NewRouter()
+	router.Use(telemetry.HTTPMiddleware)
+	router.Use(logging.RequestMiddleware)
+	router.Use(httpSecurity.Headers(app.conf.securityHeaders))
 
 	if app.conf.devCORS != "" {
-		log.Printf("DEV MODE: Allowing CORS + Credentials from %v", app.conf.devCORS)
+		slog.Info("DEV MODE: allowing CORS + credentials", "origin", app.conf.devCORS)
 		router.Use(cors.Handler(cors.Options{
 			AllowedOrigins:   []string{app.conf.devCORS},
 			AllowedMethods:   []string{http.MethodOptions, http.MethodGet, http.MethodPost, http.MethodHead, http.MethodPatch, http.MethodDelete},
-			AllowedHeaders:   []string{"Content-Type"},
+			AllowedHeaders:   []string{"Content-Type", csrf.HeaderToken},
 			AllowCredentials: true,
 			Debug:            false,
 		}))
 	}
 
 	router.Use(app.session.LoadAndSave)
+	//metrics are scraped with the bot api key as well, they reveal routes and usage
+	router.With(app.requireBotAPIKey).Handle("/metrics", telemetry.MetricsHandler())
+	router.Get("/healthz", health.LivenessHandler)
+	router.Get("/readyz", app.healthChecker.ReadinessHandler)
+	//the status page is meant for operators, who authenticate with the bot api key
+	router.With(app.requireBotAPIKey).Get("/status", health.StatusHandler(app.healthChecker, app.jobs))
 	router.Handle("/authAPI/callback", http.HandlerFunc(app.authenticator.CallbackHandler))
 	router.Handle("/authAPI/login", http.HandlerFunc(app.authenticator.LoginHandler))
 	router.Handle("/authAPI/logout", http.HandlerFunc(app.authenticator.LogoutHandler))
+	router.With(httpSecurity.LimitBody(app.conf.maxBodyBytes)).Post("/authAPI/backchannelLogout", app.backChannelLogoutHandler)
 	//Builder User API for dishes
 
 	userAPiRouter := chi.NewRouter()
-	userAPiRouter.Use(app.authenticator.CheckSession)
-	//only allow authenticated and setup context as api expects is
-	userAPiRouter.Use(func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			p := oidcAuth.UserProfile{}
-			raw := app.session.GetString(r.Context(), oidcAuth.SessionKeyProfile)
-			if raw == "" {
-				log.Printf("Blocked unauthenticated access to userAPI")
-				http.Error(w, "Unauthorized", http.StatusUnauthorized)
-				return
-			}
-			if err := json.Unmarshal([]byte(raw), &p); err != nil {
-				log.Printf("Failed to unmarshal user profile : %v", err)
-				http.Error(w, "", http.StatusInternalServerError)
-			}
-
-			//add user email to context
-			r = r.WithContext(userAPI.ContextWithUserEmail(r.Context(), p.Email))
-
-			next.ServeHTTP(w, r)
+	userAPiRouter.Use(httpSecurity.LimitBody(app.conf.maxBodyBytes))
+	//the session cookie requires a csrf token for state-changing requests, bearer tokens do not
+	csrfProtector := csrf.NewProtector(app.session, app.conf.devCORS)
+	userAPiRouter.Use(app.authenticateUserAPI(csrfProtector))
+	userAPiRouter.Use(app.rateLimiter.Middleware(userAPIRateLimitClass, func(r *http.Request) string {
+		email, err := userAPI.GetUserEmailFromCTX(r.Context())
+		if err != nil {
+			return ""
+		}
+		return email
+	}))
+	userAPiRouter.Get("/csrfToken", csrfProtector.TokenHandler)
+
+	userAPIServer := userAPiFactory(app.dishRepo, app.locationRepo, app.dishFamilyRepo, app.userStatsService,
+		app.accessTokenService, app.sessionService, app.webhookService)
+	userAPIHandlers := userAPI.NewStrictHandlerWithOptions(userAPIServer,
+		[]userAPI.StrictMiddlewareFunc{telemetry.StrictHandlerSpan[userAPI.StrictHandlerFunc]},
+		userAPI.StrictHTTPServerOptions{
+			RequestErrorHandlerFunc:  httpSecurity.RequestErrorHandler,
+			ResponseErrorHandlerFunc: responseErrorHandler,
 		})
-	})
-
-	userAPIServer := userAPiFactory(app.dishRepo)
-	userAPIHandlers := userAPI.NewStrictHandler(userAPIServer, nil)
 	userAPI.HandlerFromMux(userAPIHandlers, userAPiRouter)
 	router.Mount("/userAPI/v1", userAPiRouter)
 
 	//Build bot api
 	botAPIRouter := chi.NewRouter()
-
-	botAPIRouter.Use(func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			log.Printf("Bot APi api middleware called")
-			gotAPIKey := r.Header.Get("X-API-KEY")
-			log.Printf("parsed api key %v", gotAPIKey)
-			if 0 == subtle.ConstantTimeCompare([]byte(gotAPIKey), []byte(app.conf.botAPIToken)) {
-				log.Printf("wrong api key")
-				http.Error(w, "", http.StatusUnauthorized)
-				return
-			}
-			next.ServeHTTP(w, r)
+	botAPIMiddlewares := chi.Middlewares{
+		app.requireBotAPIKey,
+		app.rateLimiter.Middleware(
+			func(_ *http.Request) string { return rateLimitClassBot },
+			func(r *http.Request) string {
+				//do not keep the api key itself in the rate limit store
+				b := sha3.Sum256([]byte(r.Header.Get("X-API-KEY")))
+				return hex.EncodeToString(b[:])
+			}),
+	}
+
+	botAPIRouter.Use(httpSecurity.LimitBody(app.conf.maxBodyBytes))
+	botAPIRouter.Use(botAPIMiddlewares...)
+
+	botAPIServer := botAPIFactory(app.dishRepo, app.locationRepo, app.ratingStreakService, app.webhookService, app.nameNormalizer)
+	botAPIHandlers := botAPI.NewStrictHandlerWithOptions(botAPIServer,
+		[]botAPI.StrictMiddlewareFunc{telemetry.StrictHandlerSpan[botAPI.StrictHandlerFunc]},
+		botAPI.StrictHTTPServerOptions{
+			RequestErrorHandlerFunc:  httpSecurity.RequestErrorHandler,
+			ResponseErrorHandlerFunc: responseErrorHandler,
 		})
-	})
-
-	botAPIServer := botAPIFactory(app.dishRepo, app.ratingStreakService)
-	botAPIHandlers := botAPI.NewStrictHandler(botAPIServer, nil)
 	botAPI.HandlerFromMux(botAPIHandlers, botAPIRouter)
 	router.Mount("/botAPI/v1", botAPIRouter)
+	//menu imports upload whole files. This route takes precedence over the mounted bot api and thus its body limit
+	menuImportHandler := botAPI.ServerInterfaceWrapper{
+		Handler:          botAPIHandlers,
+		ErrorHandlerFunc: httpSecurity.RequestErrorHandler,
+	}
+	router.With(httpSecurity.LimitBody(botAPI.MaxMenuImportSize)).With(botAPIMiddlewares...).
+		Post("/botAPI/v1/menu/import", menuImportHandler.PostMenuImport)
 
 	//serve react frontend
 	frontendRouter := chi.NewRouter()
 	frontendRouter.Use(func(handler http.Handler) http.Handler {
 		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
-			log.Printf("frontendRouter used for request to %v", request.URL.String())
+			logging.FromContext(request.Context()).Debug("frontendRouter used")
 			handler.ServeHTTP(writer, request)
 		})
 	})
@@ -580,7 +538,7 @@ func (app *application) setupRouter(botAPIFactory botAPI.ServiceFactory, userAPi
 	frontendRouter.Handle("/static/*", fsHandler)
 	//see https://stackoverflow.com/questions/53876700/trying-to-serve-react-spa-that-uses-react-router
 	frontendRouter.NotFound(func(w http.ResponseWriter, r *http.Request) {
-		log.Printf("not found hack for %v", r.URL.String())
+		logging.FromContext(r.Context()).Debug("serving index.html for unknown path")
 		http.ServeFile(w, r, "/frontend/index.html")
 	})
 	router.Mount("/", frontendRouter)
@@ -588,36 +546,197 @@ func (app *application) setupRouter(botAPIFactory botAPI.ServiceFactory, userAPi
 	return router, nil
 }
 
+// responseErrorHandler is the default of the strict handlers generated by oapi-codegen
+func responseErrorHandler(w http.ResponseWriter, _ *http.Request, err error) {
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
+
+// userAPIRateLimitClass returns the rate limit class of a user api request
+func userAPIRateLimitClass(r *http.Request) string {
+	switch {
+	case strings.Contains(r.URL.Path, "/dishes/mergeCandidates/"), strings.HasSuffix(r.URL.Path, "/getAllDishes"),
+		strings.HasSuffix(r.URL.Path, "/users/me/statistics"):
+		return rateLimitClassExpensive
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		return rateLimitClassRead
+	}
+	return rateLimitClassWrite
+}
+
+// authenticateUserAPI only allows authenticated requests and sets up the context as the user api expects it.
+// Requests are either authenticated by a bearer token in the Authorization header, which is a personal access
+// token or a JWT of the oidc provider, or by the session cookie
+func (app *application) authenticateUserAPI(csrfProtector *csrf.Protector) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		withSession := app.authenticator.CheckSession(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			p := oidcAuth.UserProfile{}
+			raw := app.session.GetString(r.Context(), oidcAuth.SessionKeyProfile)
+			if raw == "" {
+				logging.FromContext(r.Context()).Warn("blocked unauthenticated access to userAPI")
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+			if err := json.Unmarshal([]byte(raw), &p); err != nil {
+				logging.FromContext(r.Context()).Error("failed to unmarshal user profile", "err", err)
+				http.Error(w, "", http.StatusInternalServerError)
+				return
+			}
+
+			//add user email to context
+			ctx := userAPI.ContextWithUserEmail(r.Context(), p.Email)
+			r = r.WithContext(logging.WithAttrs(ctx, "identity", p.Email))
+
+			app.sessionService.Track(csrfProtector.Middleware(next)).ServeHTTP(w, r)
+		}))
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if scheme, token, found := strings.Cut(r.Header.Get("Authorization"), " "); found && strings.EqualFold(scheme, "Bearer") {
+				if domain.IsAccessTokenSecret(token) {
+					app.serveWithAccessToken(w, r, token, next)
+				} else {
+					app.serveWithJWT(w, r, token, next)
+				}
+				return
+			}
+			withSession.ServeHTTP(w, r)
+		})
+	}
+}
+
+// serveWithAccessToken passes the request to next if token is a valid personal access token with sufficient scope
+func (app *application) serveWithAccessToken(w http.ResponseWriter, r *http.Request, token string, next http.Handler) {
+	accessToken, err := app.accessTokenService.Authenticate(r.Context(), token)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			logging.FromContext(r.Context()).Warn("blocked access to userAPI with invalid access token")
+			w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		logging.FromContext(r.Context()).Error("failed to authenticate access token", "err", err)
+		http.Error(w, "", http.StatusInternalServerError)
+		return
+	}
+
+	ctx := logging.WithAttrs(r.Context(), "identity", accessToken.UserEmail, "patName", accessToken.Name)
+	//a leaked token must not be able to create new tokens or to end the sessions of the user
+	readOnlyViolation := accessToken.ReadOnly && r.Method != http.MethodGet && r.Method != http.MethodHead
+	if readOnlyViolation || strings.Contains(r.URL.Path, "/users/me/tokens") ||
+		strings.Contains(r.URL.Path, "/users/me/sessions") {
+		logging.FromContext(ctx).Warn("blocked access to userAPI outside of access token scope", "method", r.Method)
+		w.Header().Set("WWW-Authenticate", `Bearer error="insufficient_scope"`)
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	next.ServeHTTP(w, r.WithContext(userAPI.ContextWithUserEmail(ctx, accessToken.UserEmail)))
+}
+
+// serveWithJWT passes the request to next if token is a valid JWT of the oidc provider
+func (app *application) serveWithJWT(w http.ResponseWriter, r *http.Request, token string, next http.Handler) {
+	if app.bearerVerifier == nil {
+		logging.FromContext(r.Context()).Warn("blocked access to userAPI with JWT, as there is no oidc provider")
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	profile, err := app.bearerVerifier.Verify(r.Context(), token)
+	if err != nil {
+		logging.FromContext(r.Context()).Warn("blocked access to userAPI with invalid JWT", "err", err)
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx := userAPI.ContextWithUserEmail(r.Context(), profile.Email)
+	next.ServeHTTP(w, r.WithContext(logging.WithAttrs(ctx, "identity", profile.Email)))
+}
+
+// backChannelLogoutHandler ends the sessions the oidc provider logged out,
+// see https://openid.net/specs/openid-connect-backchannel-1_0.html
+func (app *application) backChannelLogoutHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "no-store")
+	if app.logoutVerifier == nil {
+		logging.FromContext(r.Context()).Warn("received back-channel logout, but there is no oidc provider")
+		http.Error(w, "", http.StatusNotImplemented)
+		return
+	}
+
+	logoutToken, err := app.logoutVerifier.Verify(r.Context(), r.PostFormValue("logout_token"))
+	if err != nil {
+		logging.FromContext(r.Context()).Warn("invalid logout token", "err", err)
+		http.Error(w, "invalid logout token", http.StatusBadRequest)
+		return
+	}
+
+	revoked, err := app.sessionService.RevokeProviderSessions(r.Context(), logoutToken.Subject, logoutToken.SessionID)
+	if err != nil {
+		logging.FromContext(r.Context()).Error("failed to end sessions for back-channel logout", "revoked", revoked, "err", err)
+		http.Error(w, "", http.StatusInternalServerError)
+		return
+	}
+	logging.FromContext(r.Context()).Info("back-channel logout ended sessions", "revoked", revoked)
+}
+
+// requireBotAPIKey rejects all requests that do not carry the bot api token in the X-API-KEY header
+func (app *application) requireBotAPIKey(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAPIKey := r.Header.Get("X-API-KEY")
+		if 0 == subtle.ConstantTimeCompare([]byte(gotAPIKey), []byte(app.conf.botAPIToken)) {
+			logging.FromContext(r.Context()).Warn("wrong api key")
+			http.Error(w, "", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(logging.WithAttrs(r.Context(), "identity", "bot")))
+	})
+}
+
 func run(app *application) error {
 	mainCtx, mainCancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer mainCancel()
 
-	log.Printf("Starting http server on %v", app.conf.listen)
+	slog.Info("starting http server", "listen", app.conf.listen)
 	srv := &http.Server{
-		Handler: app.router,
-		Addr:    app.conf.listen,
+		Handler:           app.router,
+		Addr:              app.conf.listen,
+		ReadHeaderTimeout: app.conf.readHeaderTimeout,
+		ReadTimeout:       app.conf.readTimeout,
+		WriteTimeout:      app.conf.writeTimeout,
+		IdleTimeout:       app.conf.idleTimeout,
+		MaxHeaderBytes:    app.conf.maxHeaderBytes,
+		ErrorLog:          slog.NewLogLogger(slog.Default().Handler(), slog.LevelWarn),
+	}
+
+	if app.conf.tlsCertFile != "" {
+		if app.conf.devMode {
+			slog.Info("DEV MODE: starting with TLS to be able to use SameSite=None. Create a self signed cert " +
+				"with scripts/gen-local-certs.sh if you did not configure one")
+		}
+		slog.Info("using TLS", "certPath", app.conf.tlsCertFile, "keyPath", app.conf.tlsKeyFile)
+		certs, err := httpSecurity.NewCertReloader(app.conf.tlsCertFile, app.conf.tlsKeyFile)
+		if err != nil {
+			return fmt.Errorf("failed to load TLS certificate : %v", err)
+		}
+		go certs.Watch(mainCtx, app.conf.tlsReloadInterval)
+		srv.TLSConfig = &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: certs.GetCertificate,
+		}
 	}
+
 	go func() {
 
-		if !app.conf.devMode {
-			if err := srv.ListenAndServe(); err != nil {
-				if errors.Is(err, http.ErrServerClosed) {
-					log.Printf("Http server performs gracefull shutdown")
-				} else {
-					log.Printf("Error in HTTP server : %v", err)
-				}
-			}
+		var err error
+		if srv.TLSConfig == nil {
+			err = srv.ListenAndServe()
 		} else {
-			const certPath = "./selfSignedTLS/server.crt"
-			const keyPath = "./selfSignedTLS/server.key"
-			log.Printf("DEV MODE: Starting with self signed TLS to be able to use SameSite=None. Make sure to"+
-				"place self signed cert under %v and key under %v", certPath, keyPath)
-			if err := srv.ListenAndServeTLS(certPath, keyPath); err != nil {
-				if errors.Is(err, http.ErrServerClosed) {
-					log.Printf("Http server performs gracefull shutdown")
-				} else {
-					log.Printf("Error in HTTP server : %v", err)
-				}
+			//the certificate is provided by TLSConfig.GetCertificate
+			err = srv.ListenAndServeTLS("", "")
+		}
+		if err != nil {
+			if errors.Is(err, http.ErrServerClosed) {
+				slog.Info("http server performs graceful shutdown")
+			} else {
+				slog.Error("error in http server", "err", err)
 			}
 		}
 
@@ -629,43 +748,116 @@ func run(app *application) error {
 	//run jobs periodically in background
 	app.jobScheduler.StartAsync()
 
-	log.Printf("Startup complete :)")
+	slog.Info("startup complete :)")
 	<-mainCtx.Done()
-	log.Printf("Beginning Shutdown...")
+	slog.Info("beginning shutdown...")
 
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 20*time.Second)
 	defer shutdownCancel()
-	log.Printf("Shutting down http server...")
+	slog.Info("shutting down http server...")
 	if err := srv.Shutdown(shutdownCtx); err != nil {
-		log.Printf("error requesting http server shutdown : %v", err)
+		slog.Error("error requesting http server shutdown", "err", err)
 	}
 
 	return nil
 }
 
+// appRepo is implemented by all storage backends
+type appRepo interface {
+	domain.DishRepo
+	domain.StatisticsRepo
+	domain.RatingStreakRepo
+	domain.WebhookRepo
+	domain.LocationRepo
+	domain.DishFamilyRepo
+	domain.AccessTokenRepo
+	//CheckHealth returns an error if the storage backend is unreachable or not fully migrated
+	CheckHealth(ctx context.Context) error
+}
+
+// buildRepo creates the storage backend selected by cfg.dbDriver
+func buildRepo(cfg *config) (appRepo, error) {
+	switch cfg.dbDriver {
+	case dbDriverMemory:
+		return dishRepo.NewMemoryRepo(), nil
+	case dbDriverSQLite:
+		db, err := dishRepo.OpenSQLiteDB(cfg.sqlitePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open sqlite db : %v", err)
+		}
+		repo, err := dishRepo.NewSQLiteRepo(db, &migrate.FileMigrationSource{Dir: "/migrations/sqlite"})
+		if err != nil {
+			return nil, fmt.Errorf("NewSQLiteRepo : %v", err)
+		}
+		return repo, nil
+	}
+
+	db, err := dishRepo.ConnectToPostgresDB(context.Background(), cfg.dbUser, cfg.dbPW, cfg.dbURL, cfg.dbName,
+		cfg.dbOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect do db : %v", err)
+	}
+
+	migrations := &migrate.FileMigrationSource{Dir: "/migrations/postgres"}
+	repo, err := dishRepo.NewPostgresRepo(db, migrations)
+	if err != nil {
+		return nil, fmt.Errorf("NewPostgresRepo : %v", err)
+	}
+	return repo, nil
+}
+
 func main() {
+	configPath := flag.String("config", os.Getenv(envVarConfigFile),
+		"path of a YAML or TOML config file. Env vars take precedence over its values")
+	printConfig := flag.Bool("print-config", false, "print the effective config with secrets redacted and exit")
+	flag.Parse()
+
+	if *printConfig {
+		if err := printEffectiveConfig(os.Stdout, *configPath); err != nil {
+			fmt.Fprintf(os.Stderr, "invalid config :\n%v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
 
-	log.SetFlags(log.Flags() | log.Llongfile)
+	slog.Info("parsing config...")
+	cfg, err := parseConfig(*configPath)
+	if err != nil {
+		//the logger is not configured yet and the aggregated errors are easier to read without escaping
+		fmt.Fprintf(os.Stderr, "parseConfig failed : %v\n", err)
+		os.Exit(1)
+	}
 
-	log.Printf("Parsing Config...")
-	cfg, err := parseConfig()
+	logger, err := logging.NewLogger(os.Stderr, cfg.logFormat, cfg.logLevel)
 	if err != nil {
-		log.Printf("parseConfig : %v", err)
+		slog.Error("failed to create logger", "err", err)
 		return
 	}
+	slog.SetDefault(logger)
+	if cfg.devMode {
+		slog.Info("DEV MODE: ENABLED")
+	}
+	if cfg.dbDriver == dbDriverMemory {
+		slog.Warn("using in-memory storage. All data is lost on shutdown")
+	}
 
-	//Connect to db
-	db, err := connectToPostgresDB(context.Background(), cfg.dbUser, cfg.dbPW, cfg.dbURL, cfg.dbName)
+	shutdownTracing, err := telemetry.SetupTracing(context.Background(), cfg.tracesExporter)
 	if err != nil {
-		log.Printf("Failed to connect do db : %v", err)
+		slog.Error("failed to setup tracing", "err", err)
 		return
 	}
+	defer func() {
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer shutdownCancel()
+		if err := shutdownTracing(shutdownCtx); err != nil {
+			slog.Error("failed to flush spans", "err", err)
+		}
+	}()
 
 	//Build dish repo
-	migrations := &migrate.FileMigrationSource{Dir: "/migrations/postgres"}
-	repo, err := dishRepo.NewPostgresRepo(db, migrations)
+	repo, err := buildRepo(cfg)
 	if err != nil {
-		log.Printf("Failed to build dish repo : %v", err)
+		slog.Error("failed to build dish repo", "err", err)
 		return
 	}
 
@@ -681,12 +873,47 @@ func main() {
 		return repo, nil
 	}
 
-	defaultBotApiFactory := func(repo domain.DishRepo, streakService statisticsService.StreakService) *botAPI.Service {
-		return botAPI.NewService(repo, streakService)
+	defaultWebhookRepoFactory := func() (domain.WebhookRepo, error) {
+		return repo, nil
+	}
+
+	defaultLocationRepoFactory := func() (domain.LocationRepo, error) {
+		return repo, nil
+	}
+
+	defaultDishFamilyRepoFactory := func() (domain.DishFamilyRepo, error) {
+		return repo, nil
+	}
+
+	defaultAccessTokenRepoFactory := func() (domain.AccessTokenRepo, error) {
+		return repo, nil
+	}
+
+	defaultDBHealthCheckFactory := func() (health.Check, error) {
+		return repo.CheckHealth, nil
+	}
+
+	defaultRateLimiterFactory := func(limits map[string]rateLimit.Limit) (*rateLimit.Limiter, error) {
+		if cfg.rateLimitStore != rateLimitStorePostgres {
+			return rateLimit.NewLimiter(rateLimit.NewMemoryStore(), limits), nil
+		}
+		postgresRepo, ok := repo.(*dishRepo.PostgresRepo)
+		if !ok {
+			return nil, fmt.Errorf("the postgres rate limit store requires the postgres db driver")
+		}
+		return rateLimit.NewLimiter(rateLimit.NewPostgresStore(postgresRepo.DB()), limits), nil
+	}
+
+	defaultBotApiFactory := func(repo domain.DishRepo, locations domain.LocationRepo,
+		streakService statisticsService.StreakService, webhooks webhookService.WebhookService,
+		normalizer *nameNormalizer.Normalizer) *botAPI.Service {
+		return botAPI.NewService(repo, locations, streakService, webhooks, normalizer)
 	}
 
-	defaultUserApiFactory := func(repo domain.DishRepo) *userAPI.HttpServer {
-		return userAPI.NewHttpServer(repo)
+	defaultUserApiFactory := func(repo domain.DishRepo, locations domain.LocationRepo, families domain.DishFamilyRepo,
+		userStats statisticsService.UserStatisticsService, tokens accessTokenService.AccessTokenService,
+		sessions sessionService.SessionService, events domain.EventPublisher) *userAPI.HttpServer {
+		return userAPI.NewHttpServer(repo, locations, families, userStats, tokens, sessions, events)
 	}
 
 	defaultVacationClientFactory := func() (domain.VacationDataSource, error) {
@@ -698,36 +925,61 @@ func main() {
 		return publicHoliday.NewDefaultRegionHolidayChecker(cfg.publicHolidayRegion)
 	}
 	defaultStreakServiceFactory := func(statsRepo domain.StatisticsRepo, vacationStreakRepo domain.RatingStreakRepo,
-		vacationClient domain.VacationDataSource, holidayClient domain.PublicHolidayDataSource) (service statisticsService.StreakService, err2 error) {
+		vacationClient domain.VacationDataSource, holidayClient domain.PublicHolidayDataSource,
+		events domain.EventPublisher) (service statisticsService.StreakService, err2 error) {
 		return statisticsService.NewDefaultStreakService(
 			statsRepo,
 			vacationStreakRepo,
 			vacationClient,
 			holidayClient,
 			defaultTimeSource{},
+			events,
 		), nil
 	}
 
+	defaultWebhookServiceFactory := func(repo domain.WebhookRepo) (webhookService.WebhookService, error) {
+		return webhookService.NewDefaultWebhookService(repo), nil
+	}
+
+	defaultNotifierFactory := func() (domain.Notifier, error) {
+		switch cfg.ratingReminderNotifier {
+		case "smtp":
+			return notifier.NewSMTPNotifier(cfg.smtpConfig)
+		case "webhook":
+			return notifier.NewWebhookNotifier(cfg.ratingReminderWebhookURL), nil
+		default:
+			return notifier.NewFakeNotifier(), nil
+		}
+	}
+
 	factories := appComponentFactories{
-		dishRepoFactory:       defaultDishRepoFactory,
-		streakRepoFactory:     defaultStreakRepoFactory,
-		statsRepoFactory:      defaultStatsRepoFactory,
-		holidayClientFactory:  defaultHolidayClientFactory,
-		vacationClientFactory: defaultVacationClientFactory,
-		botAPIFactory:         defaultBotApiFactory,
-		userAPIFactory:        defaultUserApiFactory,
-		streakServiceFactory:  defaultStreakServiceFactory,
-	}
-	log.Printf("Building application...")
+		dishRepoFactory:        defaultDishRepoFactory,
+		streakRepoFactory:      defaultStreakRepoFactory,
+		statsRepoFactory:       defaultStatsRepoFactory,
+		webhookRepoFactory:     defaultWebhookRepoFactory,
+		locationRepoFactory:    defaultLocationRepoFactory,
+		dishFamilyRepoFactory:  defaultDishFamilyRepoFactory,
+		accessTokenRepoFactory: defaultAccessTokenRepoFactory,
+		dbHealthCheckFactory:   defaultDBHealthCheckFactory,
+		rateLimiterFactory:     defaultRateLimiterFactory,
+		holidayClientFactory:   defaultHolidayClientFactory,
+		vacationClientFactory:  defaultVacationClientFactory,
+		botAPIFactory:          defaultBotApiFactory,
+		userAPIFactory:         defaultUserApiFactory,
+		streakServiceFactory:   defaultStreakServiceFactory,
+		webhookServiceFactory:  defaultWebhookServiceFactory,
+		notifierFactory:        defaultNotifierFactory,
+	}
+	slog.Info("building application...")
 	app, err := newApplication(cfg, factories)
 	if err != nil {
-		log.Printf("newApplication : %v", err)
+		slog.Error("newApplication failed", "err", err)
 		return
 	}
 
-	log.Printf("Entering run...")
+	slog.Info("entering run...")
 	if err := run(app); err != nil {
-		log.Printf("run : %v", err)
+		slog.Error("run failed", "err", err)
 		return
 	}
 }
@@ -33,3 +33,20 @@ export const OpenAPI: OpenAPIConfig = {
 OpenAPI.BASE = process.env.REACT_APP_USER_API_BASE_URL
 // @ts-ignore
 OpenAPI.WITH_CREDENTIALS = process.env.NODE_ENV === "development" ? true : false
+
+// State-changing requests need the csrf token of the session. It is fetched for each such request, as it changes
+// whenever the session does, e.g. after logging out and in again
+const CSRF_SAFE_METHODS = ['GET', 'HEAD', 'OPTIONS'];
+OpenAPI.HEADERS = async (options: ApiRequestOptions): Promise<Headers> => {
+    if (CSRF_SAFE_METHODS.includes(options.method)) {
+        return {};
+    }
+    const resp = await fetch(`${OpenAPI.BASE}/csrfToken`, {
+        credentials: OpenAPI.WITH_CREDENTIALS ? OpenAPI.CREDENTIALS : 'same-origin',
+    });
+    if (!resp.ok) {
+        throw new Error(`failed to fetch csrf token: ${resp.status}`);
+    }
+    const body: { token: string } = await resp.json();
+    return {'X-CSRF-Token': body.token};
+};
@@ -0,0 +1,30 @@
+
+-- +migrate Up
+create table webhook_endpoints (
+    id serial primary key,
+    url text not null,
+    secret varchar(200) not null,
+    -- comma separated list of event types. Empty means all events
+    event_types text not null,
+    created_at timestamp with time zone not null
+);
+
+create table webhook_deliveries (
+    id serial primary key,
+    endpoint_id integer not null references webhook_endpoints(id) on delete cascade,
+    event_type varchar(100) not null,
+    payload bytea not null,
+    state varchar(20) not null,
+    attempts integer not null,
+    next_attempt_at timestamp with time zone not null,
+    last_error text not null,
+    created_at timestamp with time zone not null,
+    delivered_at timestamp with time zone
+);
+
+create index webhook_deliveries_due_idx on webhook_deliveries (state, next_attempt_at);
+
+-- +migrate Down
+
+drop table webhook_deliveries;
+drop table webhook_endpoints;
@@ -0,0 +1,11 @@
+-- +migrate Up
+create table dish_tags (
+    dish_id int not null references dishes(id) on delete cascade,
+    tag varchar(100) not null,
+    primary key (dish_id, tag)
+);
+comment on column dish_tags.tag is 'Metadata like "vegan" that was extracted from the dish name during normalization';
+
+-- +migrate Down
+
+drop table dish_tags;
@@ -0,0 +1,21 @@
+-- +migrate Up
+alter table locations add column display_name varchar(200) not null default '';
+alter table locations add column address text not null default '';
+alter table locations add column opening_hours text not null default '';
+alter table locations add column archived boolean not null default false;
+comment on column locations.display_name is 'Name shown to users instead of the name used by the scrapers. Empty if not set';
+comment on column locations.archived is 'Archived locations no longer serve dishes but are kept for their history';
+
+create table location_aliases (
+    alias varchar(200) primary key,
+    location_id int not null references locations(id) on delete cascade
+);
+comment on table location_aliases is 'Alternative spellings of location names used by the scrapers';
+
+-- +migrate Down
+
+drop table location_aliases;
+alter table locations drop column archived;
+alter table locations drop column opening_hours;
+alter table locations drop column address;
+alter table locations drop column display_name;
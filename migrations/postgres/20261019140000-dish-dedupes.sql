@@ -0,0 +1,20 @@
+-- +migrate Up
+create table dish_dedupes (
+    id serial primary key,
+    canonical_dish_id int not null references dishes(id) on delete cascade,
+    duplicate_dish_id int not null,
+    duplicate_dish_name varchar(1000) not null,
+    performed_by varchar(200) not null,
+    performed_at timestamp with time zone not null,
+    moved_occurrences int not null,
+    dropped_occurrences int not null,
+    moved_ratings int not null,
+    dropped_ratings int not null
+);
+create index dish_dedupes_canonical_dish_id_idx on dish_dedupes (canonical_dish_id);
+comment on table dish_dedupes is 'Audit log of duplicate dishes that were folded into a canonical dish';
+comment on column dish_dedupes.duplicate_dish_id is 'The duplicate dish is deleted by the dedupe, thus there is no foreign key';
+
+-- +migrate Down
+
+drop table dish_dedupes;
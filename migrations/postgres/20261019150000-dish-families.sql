@@ -0,0 +1,17 @@
+-- +migrate Up
+create table dish_families (
+    id serial primary key,
+    name varchar(1000) not null
+);
+comment on table dish_families is 'Groups dishes of different locations that are the same recipe';
+
+create table dish_family_dishes (
+    dish_id int primary key references dishes(id) on delete cascade,
+    dish_family_id int not null references dish_families(id) on delete cascade
+);
+create index dish_family_dishes_dish_family_id_idx on dish_family_dishes (dish_family_id);
+
+-- +migrate Down
+
+drop table dish_family_dishes;
+drop table dish_families;
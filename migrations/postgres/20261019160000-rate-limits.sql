@@ -0,0 +1,11 @@
+-- +migrate Up
+create table rate_limit_counters (
+    key text primary key,
+    window_start timestamptz not null,
+    hits int not null
+);
+comment on table rate_limit_counters is 'Request counters of the rate limiter, shared by all replicas. Only used with RATE_LIMIT_STORE=postgres';
+
+-- +migrate Down
+
+drop table rate_limit_counters;
@@ -0,0 +1,16 @@
+-- +migrate Up
+create table access_tokens (
+    id serial primary key,
+    user_email varchar(1000) not null,
+    name varchar(1000) not null,
+    read_only boolean not null,
+    secret_hash varchar(64) not null unique,
+    created_at timestamptz not null,
+    last_used_at timestamptz
+);
+comment on table access_tokens is 'Personal access tokens for the user API. Only the sha256 hash of the token is stored';
+create index access_tokens_user_email_idx on access_tokens (user_email);
+
+-- +migrate Down
+
+drop table access_tokens;
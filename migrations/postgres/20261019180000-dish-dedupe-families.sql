@@ -0,0 +1,11 @@
+-- +migrate Up
+alter table dish_dedupes add column dish_family_id int;
+alter table dish_dedupes add column canonical_joined_dish_family boolean not null default false;
+alter table dish_dedupes add column dish_family_dissolved boolean not null default false;
+comment on column dish_dedupes.dish_family_id is 'Family of the duplicate dish. The family may have been dissolved by the dedupe, thus there is no foreign key';
+
+-- +migrate Down
+
+alter table dish_dedupes drop column dish_family_dissolved;
+alter table dish_dedupes drop column canonical_joined_dish_family;
+alter table dish_dedupes drop column dish_family_id;
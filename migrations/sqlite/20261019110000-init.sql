@@ -0,0 +1,92 @@
+-- +migrate Up
+
+-- SQLite has no dedicated timestamp type. All points in time are stored as unix nanoseconds, which keeps
+-- comparisons and ordering in queries correct regardless of the time zone
+
+create table users (
+    id integer primary key autoincrement,
+    email varchar(200) not null unique,
+    created integer not null
+);
+
+create table locations (
+    id integer primary key autoincrement,
+    name varchar(200) not null unique,
+    created integer not null
+);
+
+create table merged_dishes (
+    id integer primary key autoincrement,
+    name varchar(1000) not null,
+    location_id integer not null references locations(id) on delete restrict,
+    created_at integer not null,
+    updated_at integer,
+    unique (name,location_id)
+);
+
+create table dishes (
+    id integer primary key autoincrement,
+    location_id integer not null references locations(id) on delete restrict,
+    name varchar(1000) not null,
+    merged_dish_id integer default null references merged_dishes(id) on delete set null,
+    merged_at integer default null,
+    unique (name,location_id)
+);
+
+create table dish_occurrences (
+    id integer primary key autoincrement,
+    dish_id integer not null references dishes(id) on delete cascade,
+    date integer not null
+);
+
+create table dish_ratings (
+    id integer primary key autoincrement,
+    dish_id integer not null references dishes(id) on delete cascade,
+    user_id integer not null references users(id) on delete cascade,
+    date integer not null,
+    rating integer not null
+);
+
+create table rating_streaks (
+    id integer primary key autoincrement,
+    name varchar(200) not null,
+    start_date integer not null,
+    end_date integer not null,
+    unique (name,start_date,end_date)
+);
+
+create table webhook_endpoints (
+    id integer primary key autoincrement,
+    url text not null,
+    secret varchar(200) not null,
+    -- comma separated list of event types. Empty means all events
+    event_types text not null,
+    created_at integer not null
+);
+
+create table webhook_deliveries (
+    id integer primary key autoincrement,
+    endpoint_id integer not null references webhook_endpoints(id) on delete cascade,
+    event_type varchar(100) not null,
+    payload blob not null,
+    state varchar(20) not null,
+    attempts integer not null,
+    next_attempt_at integer not null,
+    last_error text not null,
+    created_at integer not null,
+    delivered_at integer
+);
+
+create index webhook_deliveries_due_idx on webhook_deliveries (state, next_attempt_at);
+
+-- +migrate Down
+
+drop table webhook_deliveries;
+drop table webhook_endpoints;
+drop table rating_streaks;
+drop table dish_ratings;
+drop table dish_occurrences;
+drop table dishes;
+drop table merged_dishes;
+drop table locations;
+drop table users;
@@ -0,0 +1,10 @@
+-- +migrate Up
+create table dish_tags (
+    dish_id integer not null references dishes(id) on delete cascade,
+    tag varchar(100) not null,
+    primary key (dish_id, tag)
+);
+
+-- +migrate Down
+
+drop table dish_tags;
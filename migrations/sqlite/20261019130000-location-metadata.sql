@@ -0,0 +1,18 @@
+-- +migrate Up
+alter table locations add column display_name varchar(200) not null default '';
+alter table locations add column address text not null default '';
+alter table locations add column opening_hours text not null default '';
+alter table locations add column archived integer not null default 0;
+
+create table location_aliases (
+    alias varchar(200) primary key,
+    location_id integer not null references locations(id) on delete cascade
+);
+
+-- +migrate Down
+
+drop table location_aliases;
+alter table locations drop column archived;
+alter table locations drop column opening_hours;
+alter table locations drop column address;
+alter table locations drop column display_name;
@@ -0,0 +1,18 @@
+-- +migrate Up
+create table dish_dedupes (
+    id integer primary key autoincrement,
+    canonical_dish_id integer not null references dishes(id) on delete cascade,
+    duplicate_dish_id integer not null,
+    duplicate_dish_name text not null,
+    performed_by text not null,
+    performed_at integer not null,
+    moved_occurrences integer not null,
+    dropped_occurrences integer not null,
+    moved_ratings integer not null,
+    dropped_ratings integer not null
+);
+create index dish_dedupes_canonical_dish_id_idx on dish_dedupes (canonical_dish_id);
+
+-- +migrate Down
+
+drop table dish_dedupes;
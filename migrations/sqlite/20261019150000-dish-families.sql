@@ -0,0 +1,16 @@
+-- +migrate Up
+create table dish_families (
+    id integer primary key autoincrement,
+    name text not null
+);
+
+create table dish_family_dishes (
+    dish_id integer primary key references dishes(id) on delete cascade,
+    dish_family_id integer not null references dish_families(id) on delete cascade
+);
+create index dish_family_dishes_dish_family_id_idx on dish_family_dishes (dish_family_id);
+
+-- +migrate Down
+
+drop table dish_family_dishes;
+drop table dish_families;
@@ -0,0 +1,15 @@
+-- +migrate Up
+create table access_tokens (
+    id integer primary key autoincrement,
+    user_email text not null,
+    name text not null,
+    read_only integer not null,
+    secret_hash text not null unique,
+    created_at integer not null,
+    last_used_at integer
+);
+create index access_tokens_user_email_idx on access_tokens (user_email);
+
+-- +migrate Down
+
+drop table access_tokens;
@@ -0,0 +1,10 @@
+-- +migrate Up
+alter table dish_dedupes add column dish_family_id integer;
+alter table dish_dedupes add column canonical_joined_dish_family integer not null default 0;
+alter table dish_dedupes add column dish_family_dissolved integer not null default 0;
+
+-- +migrate Down
+
+alter table dish_dedupes drop column dish_family_dissolved;
+alter table dish_dedupes drop column canonical_joined_dish_family;
+alter table dish_dedupes drop column dish_family_id;
@@ -0,0 +1,104 @@
+package accessTokenService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsTasty/pkg/api/domain"
+	"itsTasty/pkg/logging"
+	"time"
+)
+
+// lastUsedResolution limits how often the last use of a token is written to the repo, as scripts may send many
+// requests in a short time
+const lastUsedResolution = time.Minute
+
+type TimeSource interface {
+	//Now returns the current local time.
+	Now() time.Time
+}
+
+type defaultTimeSource struct {
+}
+
+func (d defaultTimeSource) Now() time.Time {
+	return time.Now()
+}
+
+type AccessTokenService interface {
+	//CreateToken creates a personal access token for the user. Returns the id and the token as well as its secret,
+	//which cannot be retrieved again.
+	//Marker errors: domain.ErrInvalidAccessToken
+	CreateToken(ctx context.Context, userEmail, name string, readOnly bool) (int64, domain.AccessToken, string, error)
+	//GetTokens returns all tokens of the user indexed by their id
+	GetTokens(ctx context.Context, userEmail string) (map[int64]domain.AccessToken, error)
+	//RevokeToken deletes the token of the user.
+	//Marker errors: domain.ErrNotFound
+	RevokeToken(ctx context.Context, userEmail string, id int64) error
+	//Authenticate returns the token with the given secret and records its use.
+	//Marker errors: domain.ErrNotFound if there is no such token
+	Authenticate(ctx context.Context, secret string) (domain.AccessToken, error)
+}
+
+type DefaultAccessTokenService struct {
+	repo       domain.AccessTokenRepo
+	timeSource TimeSource
+}
+
+func NewDefaultAccessTokenService(repo domain.AccessTokenRepo) *DefaultAccessTokenService {
+	return NewDefaultAccessTokenServiceCustom(repo, defaultTimeSource{})
+}
+
+func NewDefaultAccessTokenServiceCustom(repo domain.AccessTokenRepo, timeSource TimeSource) *DefaultAccessTokenService {
+	return &DefaultAccessTokenService{
+		repo:       repo,
+		timeSource: timeSource,
+	}
+}
+
+func (d *DefaultAccessTokenService) CreateToken(ctx context.Context, userEmail, name string, readOnly bool) (int64, domain.AccessToken, string, error) {
+	token, secret, err := domain.NewAccessToken(userEmail, name, readOnly, d.timeSource.Now())
+	if err != nil {
+		return 0, domain.AccessToken{}, "", err
+	}
+	id, err := d.repo.CreateAccessToken(ctx, token)
+	if err != nil {
+		return 0, domain.AccessToken{}, "", fmt.Errorf("failed to store access token : %w", err)
+	}
+	return id, token, secret, nil
+}
+
+func (d *DefaultAccessTokenService) GetTokens(ctx context.Context, userEmail string) (map[int64]domain.AccessToken, error) {
+	tokens, err := d.repo.GetAccessTokensOfUser(ctx, userEmail)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get access tokens : %w", err)
+	}
+	return tokens, nil
+}
+
+func (d *DefaultAccessTokenService) RevokeToken(ctx context.Context, userEmail string, id int64) error {
+	if err := d.repo.DeleteAccessToken(ctx, userEmail, id); err != nil {
+		return fmt.Errorf("failed to delete access token : %w", err)
+	}
+	return nil
+}
+
+func (d *DefaultAccessTokenService) Authenticate(ctx context.Context, secret string) (domain.AccessToken, error) {
+	if !domain.IsAccessTokenSecret(secret) {
+		return domain.AccessToken{}, fmt.Errorf("malformed access token : %w", domain.ErrNotFound)
+	}
+	id, token, err := d.repo.GetAccessTokenBySecretHash(ctx, domain.HashAccessTokenSecret(secret))
+	if err != nil {
+		return domain.AccessToken{}, fmt.Errorf("failed to get access token : %w", err)
+	}
+
+	now := d.timeSource.Now()
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= lastUsedResolution {
+		//the token is valid even if we fail to record its use
+		if err := d.repo.SetAccessTokenLastUsed(ctx, id, now); err != nil && !errors.Is(err, domain.ErrNotFound) {
+			logging.FromContext(ctx).Error("failed to record use of access token", "patID", id, "err", err)
+		}
+		token.LastUsedAt = &now
+	}
+	return token, nil
+}
@@ -0,0 +1,131 @@
+package accessTokenService
+
+import (
+	"context"
+	"errors"
+	"itsTasty/pkg/api/domain"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+//
+// Mocks
+//
+
+type mockAccessTokenRepo struct {
+	sync.Mutex
+	nextID int64
+	tokens map[int64]domain.AccessToken
+	//lastUsedUpdates counts the calls to SetAccessTokenLastUsed
+	lastUsedUpdates int
+}
+
+func newMockAccessTokenRepo() *mockAccessTokenRepo {
+	return &mockAccessTokenRepo{nextID: 1, tokens: make(map[int64]domain.AccessToken)}
+}
+
+func (m *mockAccessTokenRepo) CreateAccessToken(_ context.Context, token domain.AccessToken) (int64, error) {
+	m.Lock()
+	defer m.Unlock()
+	id := m.nextID
+	m.nextID += 1
+	m.tokens[id] = token
+	return id, nil
+}
+
+func (m *mockAccessTokenRepo) GetAccessTokensOfUser(_ context.Context, userEmail string) (map[int64]domain.AccessToken, error) {
+	m.Lock()
+	defer m.Unlock()
+	result := make(map[int64]domain.AccessToken)
+	for id, v := range m.tokens {
+		if v.UserEmail == userEmail {
+			result[id] = v
+		}
+	}
+	return result, nil
+}
+
+func (m *mockAccessTokenRepo) GetAccessTokenBySecretHash(_ context.Context, secretHash string) (int64, domain.AccessToken, error) {
+	m.Lock()
+	defer m.Unlock()
+	for id, v := range m.tokens {
+		if v.SecretHash == secretHash {
+			return id, v, nil
+		}
+	}
+	return 0, domain.AccessToken{}, domain.ErrNotFound
+}
+
+func (m *mockAccessTokenRepo) SetAccessTokenLastUsed(_ context.Context, id int64, lastUsed time.Time) error {
+	m.Lock()
+	defer m.Unlock()
+	token, ok := m.tokens[id]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	token.LastUsedAt = &lastUsed
+	m.tokens[id] = token
+	m.lastUsedUpdates += 1
+	return nil
+}
+
+func (m *mockAccessTokenRepo) DeleteAccessToken(_ context.Context, userEmail string, id int64) error {
+	m.Lock()
+	defer m.Unlock()
+	token, ok := m.tokens[id]
+	if !ok || token.UserEmail != userEmail {
+		return domain.ErrNotFound
+	}
+	delete(m.tokens, id)
+	return nil
+}
+
+type mockTimeSource struct {
+	CurrentTime time.Time
+}
+
+func (m *mockTimeSource) Now() time.Time {
+	return m.CurrentTime
+}
+
+//
+// Tests
+//
+
+func TestDefaultAccessTokenService_Authenticate(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockAccessTokenRepo()
+	mockTime := &mockTimeSource{CurrentTime: time.Now()}
+	service := NewDefaultAccessTokenServiceCustom(repo, mockTime)
+
+	id, _, secret, err := service.CreateToken(ctx, "user@test.mail", "cli", true)
+	require.NoError(t, err)
+
+	token, err := service.Authenticate(ctx, secret)
+	require.NoError(t, err)
+	require.Equal(t, "user@test.mail", token.UserEmail)
+	require.True(t, token.ReadOnly)
+	require.Equal(t, 1, repo.lastUsedUpdates)
+
+	//the last use is only written once per lastUsedResolution
+	mockTime.CurrentTime = mockTime.CurrentTime.Add(lastUsedResolution / 2)
+	_, err = service.Authenticate(ctx, secret)
+	require.NoError(t, err)
+	require.Equal(t, 1, repo.lastUsedUpdates)
+	mockTime.CurrentTime = mockTime.CurrentTime.Add(lastUsedResolution)
+	_, err = service.Authenticate(ctx, secret)
+	require.NoError(t, err)
+	require.Equal(t, 2, repo.lastUsedUpdates)
+
+	for _, invalid := range []string{"", "not-a-token", secret + "x"} {
+		_, err = service.Authenticate(ctx, invalid)
+		require.True(t, errors.Is(err, domain.ErrNotFound), invalid)
+	}
+
+	require.NoError(t, service.RevokeToken(ctx, "user@test.mail", id))
+	_, err = service.Authenticate(ctx, secret)
+	require.True(t, errors.Is(err, domain.ErrNotFound))
+}
@@ -0,0 +1,40 @@
+package dishRepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+// checkSQLHealth pings db and makes sure that all migrations of source have been applied. dialect is the
+// sql-migrate dialect of db
+func checkSQLHealth(ctx context.Context, db *sql.DB, dialect string, source migrate.MigrationSource) error {
+	if err := db.PingContext(ctx); err != nil {
+		return fmt.Errorf("failed to ping db : %w", err)
+	}
+	pending, _, err := migrate.PlanMigration(db, dialect, source, migrate.Up, 0)
+	if err != nil {
+		return fmt.Errorf("failed to plan migrations : %w", err)
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%v migrations are pending, first is %v", len(pending), pending[0].Id)
+	}
+	return nil
+}
+
+// CheckHealth returns an error if the db is unreachable or not fully migrated
+func (p *PostgresRepo) CheckHealth(ctx context.Context) error {
+	return checkSQLHealth(ctx, p.db, "postgres", p.migrationSource)
+}
+
+// CheckHealth returns an error if the db is unreachable or not fully migrated
+func (s *SQLiteRepo) CheckHealth(ctx context.Context) error {
+	return checkSQLHealth(ctx, s.db, "sqlite3", s.migrationSource)
+}
+
+// CheckHealth always succeeds, as there is nothing that could become unavailable
+func (m *MemoryRepo) CheckHealth(_ context.Context) error {
+	return nil
+}
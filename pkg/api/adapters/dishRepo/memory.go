@@ -0,0 +1,1548 @@
+package dishRepo
+
+import (
+	"context"
+	"fmt"
+	"itsTasty/pkg/api/domain"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MemoryRepo is an in-memory implementation of domain.DishRepo, domain.StatisticsRepo, domain.RatingStreakRepo,
+// domain.WebhookRepo, domain.LocationRepo, domain.DishFamilyRepo and domain.AccessTokenRepo. It is intended for tests
+// and local development.
+// All data is lost once the process exits. All methods are safe for concurrent use. Update callbacks are executed while holding the lock of the repo, thus
+// they must not call back into the repo
+type MemoryRepo struct {
+	lock sync.RWMutex
+
+	locations      map[int64]domain.Location
+	nextLocationID int64
+
+	dishes     map[int64]*memoryDish
+	nextDishID int64
+
+	mergedDishes     map[int64]*memoryMergedDish
+	nextMergedDishID int64
+
+	//dishDedupes are stored in insertion order
+	dishDedupes []domain.DishDedupe
+
+	dishFamilies     map[int64]*memoryDishFamily
+	nextDishFamilyID int64
+
+	//ratings are stored in insertion order
+	ratings []*memoryRating
+
+	//users are stored in creation order
+	users []string
+
+	streaks      map[int]*memoryStreak
+	nextStreakID int
+
+	webhookEndpoints      map[int64]domain.WebhookEndpoint
+	nextWebhookEndpointID int64
+
+	webhookDeliveries     map[int64]domain.WebhookDelivery
+	nextWebhookDeliveryID int64
+
+	accessTokens      map[int64]domain.AccessToken
+	nextAccessTokenID int64
+}
+
+type memoryDish struct {
+	name         string
+	locationID   int64
+	occurrences  []time.Time
+	mergedDishID *int64
+	tags         map[string]interface{}
+}
+
+type memoryMergedDish struct {
+	name       string
+	locationID int64
+}
+
+type memoryDishFamily struct {
+	name    string
+	dishIDs []int64
+}
+
+type memoryRating struct {
+	dishID    int64
+	userEmail string
+	value     domain.Rating
+	date      time.Time
+}
+
+type memoryStreak struct {
+	name  string
+	begin domain.DayPrecisionTime
+	end   domain.DayPrecisionTime
+}
+
+func NewMemoryRepo() *MemoryRepo {
+	r := &MemoryRepo{}
+	r.reset()
+	return r
+}
+
+// reset clears all data. Caller must hold the write lock
+func (m *MemoryRepo) reset() {
+	m.locations = make(map[int64]domain.Location)
+	m.nextLocationID = 1
+	m.dishes = make(map[int64]*memoryDish)
+	m.nextDishID = 1
+	m.mergedDishes = make(map[int64]*memoryMergedDish)
+	m.nextMergedDishID = 1
+	m.dishDedupes = make([]domain.DishDedupe, 0)
+	m.dishFamilies = make(map[int64]*memoryDishFamily)
+	m.nextDishFamilyID = 1
+	m.ratings = make([]*memoryRating, 0)
+	m.users = make([]string, 0)
+	m.streaks = make(map[int]*memoryStreak)
+	m.nextStreakID = 1
+	m.webhookEndpoints = make(map[int64]domain.WebhookEndpoint)
+	m.nextWebhookEndpointID = 1
+	m.webhookDeliveries = make(map[int64]domain.WebhookDelivery)
+	m.nextWebhookDeliveryID = 1
+	m.accessTokens = make(map[int64]domain.AccessToken)
+	m.nextAccessTokenID = 1
+}
+
+//
+// Helpers. Caller must hold the lock
+//
+
+func (m *MemoryRepo) locationID(name string) (int64, bool) {
+	for id, v := range m.locations {
+		if v.Name == name {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+func (m *MemoryRepo) dishIDByName(dishName, servedAt string) (int64, bool) {
+	locationID, ok := m.locationID(servedAt)
+	if !ok {
+		return 0, false
+	}
+	for id, v := range m.dishes {
+		if v.locationID == locationID && v.name == dishName {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+func (m *MemoryRepo) toDomainDish(d *memoryDish) *domain.Dish {
+	occurrences := make([]time.Time, len(d.occurrences))
+	copy(occurrences, d.occurrences)
+	return domain.NewDishFromDB(d.name, m.locations[d.locationID].Name, occurrences)
+}
+
+func (m *MemoryRepo) mergedDishIDByName(name, servedAt string) (int64, bool) {
+	locationID, ok := m.locationID(servedAt)
+	if !ok {
+		return 0, false
+	}
+	for id, v := range m.mergedDishes {
+		if v.locationID == locationID && v.name == name {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+func (m *MemoryRepo) toDomainMergedDish(id int64) *domain.MergedDish {
+	mergedDish := m.mergedDishes[id]
+	condensedDishNames := make(map[string]interface{})
+	for _, v := range m.dishes {
+		if v.mergedDishID != nil && *v.mergedDishID == id {
+			condensedDishNames[v.name] = nil
+		}
+	}
+	return domain.NewMergedDishFomDB(mergedDish.name, m.locations[mergedDish.locationID].Name, condensedDishNames)
+}
+
+func (m *MemoryRepo) hasUser(email string) bool {
+	for _, v := range m.users {
+		if v == email {
+			return true
+		}
+	}
+	return false
+}
+
+// mostRecentRating returns the most recent rating of the user for the dish or nil if there is none
+func (m *MemoryRepo) mostRecentRating(userEmail string, dishID int64) *memoryRating {
+	var mostRecent *memoryRating
+	for _, v := range m.ratings {
+		if v.userEmail != userEmail || v.dishID != dishID {
+			continue
+		}
+		if mostRecent == nil || v.date.After(mostRecent.date) {
+			mostRecent = v
+		}
+	}
+	return mostRecent
+}
+
+func (m *MemoryRepo) mostRecentStreak(name string) (int, *memoryStreak) {
+	var id int
+	var mostRecent *memoryStreak
+	for k, v := range m.streaks {
+		if v.name != name {
+			continue
+		}
+		if mostRecent == nil || v.end.After(mostRecent.end.Time) {
+			id = k
+			mostRecent = v
+		}
+	}
+	return id, mostRecent
+}
+
+//
+// domain.DishRepo
+//
+
+func (m *MemoryRepo) GetOrCreateDish(_ context.Context, dishName string, servedAt string) (*domain.Dish, bool, bool, int64, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if id, ok := m.dishIDByName(dishName, servedAt); ok {
+		return m.toDomainDish(m.dishes[id]), false, false, id, nil
+	}
+
+	locationID, isNewLocation := m.getOrCreateLocation(servedAt)
+
+	newDomainDish := domain.NewDishToday(dishName, servedAt)
+	dishID := m.createDish(dishName, locationID)
+	dish := m.dishes[dishID]
+	dish.occurrences = []time.Time{newDomainDish.Occurrences()[0]}
+
+	return m.toDomainDish(dish), true, isNewLocation, dishID, nil
+}
+
+// getOrCreateLocation returns the id of the location, creating it if required. Caller must hold the write lock
+func (m *MemoryRepo) getOrCreateLocation(servedAt string) (int64, bool) {
+	if locationID, ok := m.locationID(servedAt); ok {
+		return locationID, false
+	}
+	locationID := m.nextLocationID
+	m.nextLocationID += 1
+	m.locations[locationID] = domain.NewLocation(servedAt)
+	return locationID, true
+}
+
+// createDish creates a dish without occurrences. Caller must hold the write lock
+func (m *MemoryRepo) createDish(dishName string, locationID int64) int64 {
+	dishID := m.nextDishID
+	m.nextDishID += 1
+	m.dishes[dishID] = &memoryDish{
+		name:        dishName,
+		locationID:  locationID,
+		occurrences: make([]time.Time, 0),
+	}
+	return dishID
+}
+
+func (m *MemoryRepo) GetOrCreateDishWithOccurrences(_ context.Context, dishName string, servedAt string, occurrences []time.Time) (int64, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	dishID, ok := m.dishIDByName(dishName, servedAt)
+	if !ok {
+		locationID, _ := m.getOrCreateLocation(servedAt)
+		dishID = m.createDish(dishName, locationID)
+	}
+
+	dish := m.dishes[dishID]
+	for _, newOccurrence := range occurrences {
+		exists := false
+		for _, v := range dish.occurrences {
+			if v.Equal(newOccurrence) {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			dish.occurrences = append(dish.occurrences, newOccurrence.Local())
+		}
+	}
+
+	return dishID, nil
+}
+
+func (m *MemoryRepo) GetDishByName(_ context.Context, dishName, servedAt string) (*domain.Dish, int64, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	id, ok := m.dishIDByName(dishName, servedAt)
+	if !ok {
+		return nil, 0, domain.ErrNotFound
+	}
+	return m.toDomainDish(m.dishes[id]), id, nil
+}
+
+func (m *MemoryRepo) GetDishByID(_ context.Context, dishID int64) (*domain.Dish, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	dish, ok := m.dishes[dishID]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return m.toDomainDish(dish), nil
+}
+
+func (m *MemoryRepo) GetDishByDate(_ context.Context, when time.Time, optionalLocation *string) ([]int64, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	ids := make([]int64, 0)
+	for id, dish := range m.dishes {
+		if optionalLocation != nil && m.locations[dish.locationID].Name != *optionalLocation {
+			continue
+		}
+		for _, v := range dish.occurrences {
+			if v.Equal(when) {
+				ids = append(ids, id)
+				break
+			}
+		}
+	}
+	return ids, nil
+}
+
+func (m *MemoryRepo) UpdateMostRecentServing(_ context.Context, dishID int64,
+	updateFN func(currenMostRecent *time.Time) (*time.Time, error)) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	dish, ok := m.dishes[dishID]
+	if !ok {
+		return fmt.Errorf("failed to fetch dish %v : %w", dishID, domain.ErrNotFound)
+	}
+
+	var mostRecent *time.Time
+	for i := range dish.occurrences {
+		if mostRecent == nil || dish.occurrences[i].After(*mostRecent) {
+			t := dish.occurrences[i]
+			mostRecent = &t
+		}
+	}
+
+	newMostRecent, err := updateFN(mostRecent)
+	if err != nil {
+		return fmt.Errorf("updateFN failed : %v", err)
+	}
+	//updateFN does not want to add new value
+	if newMostRecent == nil {
+		return nil
+	}
+
+	dish.occurrences = append(dish.occurrences, newMostRecent.Local())
+	return nil
+}
+
+func (m *MemoryRepo) GetAllDishesSimple(_ context.Context) ([]domain.SimpleDishView, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	result := make([]domain.SimpleDishView, 0, len(m.dishes))
+	for id, dish := range m.dishes {
+		v := domain.SimpleDishView{
+			Id:       id,
+			Name:     dish.name,
+			ServedAt: m.locations[dish.locationID].Name,
+		}
+		if dish.mergedDishID != nil {
+			mergedDishID := *dish.mergedDishID
+			v.MergedDishID = &mergedDishID
+		}
+		result = append(result, v)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Id < result[j].Id
+	})
+	return result, nil
+}
+
+func (m *MemoryRepo) RenameDish(_ context.Context, dishID int64, newName string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	dish, ok := m.dishes[dishID]
+	if !ok {
+		return fmt.Errorf("failed to fetch dish %v : %w", dishID, domain.ErrNotFound)
+	}
+	if dish.name == newName {
+		return nil
+	}
+	if _, exists := m.dishIDByName(newName, m.locations[dish.locationID].Name); exists {
+		return fmt.Errorf("%w : \"%v\" on location \"%v\"", domain.ErrDishAlreadyExists, newName,
+			m.locations[dish.locationID].Name)
+	}
+	dish.name = newName
+	return nil
+}
+
+func (m *MemoryRepo) AddDishTags(_ context.Context, dishID int64, tags []string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	dish, ok := m.dishes[dishID]
+	if !ok {
+		return fmt.Errorf("failed to fetch dish %v : %w", dishID, domain.ErrNotFound)
+	}
+	if dish.tags == nil {
+		dish.tags = make(map[string]interface{})
+	}
+	for _, v := range tags {
+		dish.tags[v] = nil
+	}
+	return nil
+}
+
+func (m *MemoryRepo) GetDishTags(_ context.Context, dishID int64) ([]string, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	dish, ok := m.dishes[dishID]
+	if !ok {
+		return nil, fmt.Errorf("failed to fetch dish %v : %w", dishID, domain.ErrNotFound)
+	}
+	tags := make([]string, 0, len(dish.tags))
+	for v := range dish.tags {
+		tags = append(tags, v)
+	}
+	sort.Strings(tags)
+	return tags, nil
+}
+
+func (m *MemoryRepo) CreateMergedDish(_ context.Context, mergedDish *domain.MergedDish) (int64, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	locationID, ok := m.locationID(mergedDish.ServedAt)
+	if !ok {
+		return 0, fmt.Errorf("failed to fetch location with name \"%v\" : %w", mergedDish.ServedAt, domain.ErrNotFound)
+	}
+	if _, exists := m.mergedDishIDByName(mergedDish.Name, mergedDish.ServedAt); exists {
+		return 0, fmt.Errorf("merged dish \"%v\" already exists on location \"%v\"", mergedDish.Name, mergedDish.ServedAt)
+	}
+
+	//check all dishes before changing anything
+	condensedDishIDs := make([]int64, 0)
+	for _, v := range mergedDish.GetCondensedDishNames() {
+		dishID, ok := m.dishIDByName(v, mergedDish.ServedAt)
+		if !ok {
+			return 0, fmt.Errorf("failed to query dish %v : %w", v, domain.ErrNotFound)
+		}
+		condensedDishIDs = append(condensedDishIDs, dishID)
+	}
+
+	id := m.nextMergedDishID
+	m.nextMergedDishID += 1
+	m.mergedDishes[id] = &memoryMergedDish{
+		name:       mergedDish.Name,
+		locationID: locationID,
+	}
+	for _, v := range condensedDishIDs {
+		mergedDishID := id
+		m.dishes[v].mergedDishID = &mergedDishID
+	}
+
+	return id, nil
+}
+
+func (m *MemoryRepo) GetMergedDish(_ context.Context, name, servedAt string) (*domain.MergedDish, int64, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	id, ok := m.mergedDishIDByName(name, servedAt)
+	if !ok {
+		return nil, 0, fmt.Errorf("getMergedDish failed (name: %v, servedAt: %v) : %w", name, servedAt, domain.ErrNotFound)
+	}
+	return m.toDomainMergedDish(id), id, nil
+}
+
+func (m *MemoryRepo) GetMergedDishByID(_ context.Context, id int64) (*domain.MergedDish, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	if _, ok := m.mergedDishes[id]; !ok {
+		return nil, fmt.Errorf("getMergedDishByID failed (id: %v) : %w", id, domain.ErrNotFound)
+	}
+	return m.toDomainMergedDish(id), nil
+}
+
+func (m *MemoryRepo) GetMostRecentDishForMergedDish(_ context.Context, mergedDishID int64) (*domain.Dish, int64, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	var mostRecentID int64
+	var mostRecentServing *time.Time
+	for id, dish := range m.dishes {
+		if dish.mergedDishID == nil || *dish.mergedDishID != mergedDishID {
+			continue
+		}
+		for i := range dish.occurrences {
+			if mostRecentServing == nil || dish.occurrences[i].After(*mostRecentServing) {
+				mostRecentServing = &dish.occurrences[i]
+				mostRecentID = id
+			}
+		}
+	}
+	if mostRecentServing == nil {
+		return nil, 0, domain.ErrNotFound
+	}
+
+	return m.toDomainDish(m.dishes[mostRecentID]), mostRecentID, nil
+}
+
+func (m *MemoryRepo) DeleteMergedDish(_ context.Context, mergedDishName, servedAt string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	id, ok := m.mergedDishIDByName(mergedDishName, servedAt)
+	if !ok {
+		return fmt.Errorf("failed to fetch merged dish (name: %v, servedAt: %v) : %w",
+			mergedDishName, servedAt, domain.ErrNotFound)
+	}
+	m.deleteMergedDish(id)
+	return nil
+}
+
+func (m *MemoryRepo) DeleteMergedDishByID(_ context.Context, mergedDishID int64) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.mergedDishes[mergedDishID]; !ok {
+		return fmt.Errorf("failed to fetch merged dish %v : %w", mergedDishID, domain.ErrNotFound)
+	}
+	m.deleteMergedDish(mergedDishID)
+	return nil
+}
+
+// deleteMergedDish removes the merged dish and releases all of its dishes. Caller must hold the write lock
+func (m *MemoryRepo) deleteMergedDish(id int64) {
+	for _, v := range m.dishes {
+		if v.mergedDishID != nil && *v.mergedDishID == id {
+			v.mergedDishID = nil
+		}
+	}
+	delete(m.mergedDishes, id)
+}
+
+func (m *MemoryRepo) UpdateMergedDishByID(_ context.Context, id int64,
+	updateFN func(current *domain.MergedDish) (*domain.MergedDish, error)) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.mergedDishes[id]; !ok {
+		return fmt.Errorf("getMergedDishByID failed : %w", domain.ErrNotFound)
+	}
+	oldValue := m.toDomainMergedDish(id)
+
+	updatedValue, err := updateFN(oldValue.DeepCopy())
+	if err != nil {
+		return fmt.Errorf("updateFN failed : %w", err)
+	}
+
+	removedDishNames, addedDishNames := arrayDiff(oldValue.GetCondensedDishNames(), updatedValue.GetCondensedDishNames())
+
+	//check all changes before applying them
+	removedDishIDs := make([]int64, 0, len(removedDishNames))
+	for _, v := range removedDishNames {
+		dishID, ok := m.dishIDByName(v, oldValue.ServedAt)
+		if !ok {
+			return fmt.Errorf("failed to remove dish %v from merged dish : %w", v, domain.ErrNotFound)
+		}
+		removedDishIDs = append(removedDishIDs, dishID)
+	}
+	addedDishIDs := make([]int64, 0, len(addedDishNames))
+	for _, v := range addedDishNames {
+		dishID, ok := m.dishIDByName(v, oldValue.ServedAt)
+		if !ok {
+			return fmt.Errorf("failed to add dish %v to merged dish : %w", v, domain.ErrNotFound)
+		}
+		addedDishIDs = append(addedDishIDs, dishID)
+	}
+	if oldValue.Name != updatedValue.Name {
+		if _, exists := m.mergedDishIDByName(updatedValue.Name, oldValue.ServedAt); exists {
+			return fmt.Errorf("merged dish \"%v\" already exists on location \"%v\"", updatedValue.Name, oldValue.ServedAt)
+		}
+	}
+
+	for _, v := range removedDishIDs {
+		m.dishes[v].mergedDishID = nil
+	}
+	for _, v := range addedDishIDs {
+		mergedDishID := id
+		m.dishes[v].mergedDishID = &mergedDishID
+	}
+	m.mergedDishes[id].name = updatedValue.Name
+
+	return nil
+}
+
+func (m *MemoryRepo) GetRatings(_ context.Context, userEmail string, dishID int64, onlyMostRecent bool) ([]domain.DishRating, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	if onlyMostRecent {
+		mostRecent := m.mostRecentRating(userEmail, dishID)
+		if mostRecent == nil {
+			return nil, domain.ErrNotFound
+		}
+		return []domain.DishRating{domain.NewDishRating(userEmail, mostRecent.value, mostRecent.date)}, nil
+	}
+
+	result := make([]domain.DishRating, 0)
+	for _, v := range m.ratings {
+		if v.userEmail == userEmail && v.dishID == dishID {
+			result = append(result, domain.NewDishRating(userEmail, v.value, v.date))
+		}
+	}
+	if len(result) == 0 {
+		return nil, domain.ErrNotFound
+	}
+	return result, nil
+}
+
+func (m *MemoryRepo) CreateOrUpdateRating(_ context.Context, userEmail string, dishID int64,
+	updateFN func(currentRating *domain.DishRating) (updatedRating *domain.DishRating, createNew bool, err error)) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	mostRecent := m.mostRecentRating(userEmail, dishID)
+	var oldRating *domain.DishRating
+	if mostRecent != nil {
+		r := domain.NewDishRating(userEmail, mostRecent.value, mostRecent.date)
+		oldRating = &r
+	}
+
+	newRating, createNew, err := updateFN(oldRating)
+	if err != nil {
+		return fmt.Errorf("updateFN failed : %w", err)
+	}
+	//updateFN does not want to change anything
+	if newRating == nil {
+		return nil
+	}
+
+	if !createNew {
+		if mostRecent == nil {
+			return fmt.Errorf("updateFN requested to update entry but there is none")
+		}
+		mostRecent.value = newRating.Value
+		mostRecent.date = newRating.RatingWhen.Local()
+		return nil
+	}
+
+	if _, ok := m.dishes[dishID]; !ok {
+		return fmt.Errorf("failed to create rating for dish %v : %w", dishID, domain.ErrNotFound)
+	}
+	if !m.hasUser(userEmail) {
+		m.users = append(m.users, userEmail)
+	}
+	m.ratings = append(m.ratings, &memoryRating{
+		dishID:    dishID,
+		userEmail: userEmail,
+		value:     newRating.Value,
+		date:      newRating.RatingWhen.Local(),
+	})
+	return nil
+}
+
+func (m *MemoryRepo) GetAllRatingsForDish(_ context.Context, dishID int64) ([]domain.DishRating, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	result := make([]domain.DishRating, 0)
+	for _, v := range m.ratings {
+		if v.dishID == dishID {
+			result = append(result, domain.NewDishRating(v.userEmail, v.value, v.date))
+		}
+	}
+	return result, nil
+}
+
+// DropRepo removes all data
+func (m *MemoryRepo) DropRepo(_ context.Context) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.reset()
+	return nil
+}
+
+func (m *MemoryRepo) Close() error {
+	return nil
+}
+
+func (m *MemoryRepo) IsDishPartOfMergedDish(_ context.Context, dishName string, servedAt string) (bool, int64, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	id, ok := m.dishIDByName(dishName, servedAt)
+	if !ok {
+		return false, 0, domain.ErrNotFound
+	}
+	if m.dishes[id].mergedDishID == nil {
+		return false, 0, nil
+	}
+	return true, *m.dishes[id].mergedDishID, nil
+}
+
+func (m *MemoryRepo) IsDishPartOfMergedDisByID(_ context.Context, dishID int64) (bool, int64, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	dish, ok := m.dishes[dishID]
+	if !ok {
+		return false, 0, domain.ErrNotFound
+	}
+	if dish.mergedDishID == nil {
+		return false, 0, nil
+	}
+	return true, *dish.mergedDishID, nil
+}
+
+//
+// domain.StatisticsRepo
+//
+
+func (m *MemoryRepo) GetAllUsers(_ context.Context) ([]domain.User, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	result := make([]domain.User, len(m.users))
+	for i, v := range m.users {
+		result[i] = domain.User{Email: v}
+	}
+	return result, nil
+}
+
+func (m *MemoryRepo) GetAllRatingsForDate(_ context.Context, date domain.DayPrecisionTime) ([]domain.DishRating, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	startOfDay := date.Time
+	startOfNextDay := date.NextDay().Time
+	result := make([]domain.DishRating, 0)
+	for _, v := range m.ratings {
+		if v.date.Before(startOfDay) || !v.date.Before(startOfNextDay) {
+			continue
+		}
+		result = append(result, domain.NewDishRating(v.userEmail, v.value, v.date))
+	}
+	return result, nil
+}
+
+func (m *MemoryRepo) GetAllRatingsOfUser(_ context.Context, userEmail string) ([]domain.RatingOfDish, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	result := make([]domain.RatingOfDish, 0)
+	for _, v := range m.ratings {
+		if v.userEmail != userEmail {
+			continue
+		}
+		result = append(result, domain.RatingOfDish{
+			DishID: v.dishID,
+			Rating: domain.NewDishRating(userEmail, v.value, v.date),
+		})
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Rating.RatingWhen.Before(result[j].Rating.RatingWhen)
+	})
+	return result, nil
+}
+
+func (m *MemoryRepo) GetRatingSummaryPerUser(_ context.Context) ([]domain.UserRatingSummary, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	summaryIndex := make(map[string]int)
+	result := make([]domain.UserRatingSummary, 0)
+	for _, v := range m.ratings {
+		i, ok := summaryIndex[v.userEmail]
+		if !ok {
+			i = len(result)
+			summaryIndex[v.userEmail] = i
+			result = append(result, domain.UserRatingSummary{User: domain.User{Email: v.userEmail}})
+		}
+		result[i].RatingCount += 1
+		result[i].RatingSum += int(v.value)
+	}
+	return result, nil
+}
+
+//
+// domain.RatingStreakRepo
+//
+
+func (m *MemoryRepo) UpdateMostRecentRatingStreak(_ context.Context, name string, updateFN domain.StreakUpdateFN) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	_, current := m.mostRecentStreak(name)
+	if current == nil {
+		return fmt.Errorf("failed to query most recent streak : %w", domain.ErrNotFound)
+	}
+
+	updated, err := updateFN(domain.NewRatingStreakFromDB(current.begin, current.end))
+	if err != nil {
+		return fmt.Errorf("update function failed : %w", err)
+	}
+	//no update requested
+	if updated == nil {
+		return nil
+	}
+
+	current.begin = updated.Begin
+	current.end = updated.End
+	return nil
+}
+
+func (m *MemoryRepo) CreateRatingStreak(_ context.Context, name string, data domain.RatingStreak) (int, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for _, v := range m.streaks {
+		if v.name == name && v.begin.Equal(data.Begin.Time) && v.end.Equal(data.End.Time) {
+			return 0, fmt.Errorf("failed to insert rating streak: streak from %v to %v already exists for %v",
+				data.Begin, data.End, name)
+		}
+	}
+
+	id := m.nextStreakID
+	m.nextStreakID += 1
+	m.streaks[id] = &memoryStreak{
+		name:  name,
+		begin: data.Begin,
+		end:   data.End,
+	}
+	return id, nil
+}
+
+func (m *MemoryRepo) GetMostRecentStreak(_ context.Context, name string) (domain.RatingStreak, int, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	id, streak := m.mostRecentStreak(name)
+	if streak == nil {
+		return domain.RatingStreak{}, 0, domain.ErrNotFound
+	}
+	return domain.NewRatingStreakFromDB(streak.begin, streak.end), id, nil
+}
+
+func (m *MemoryRepo) GetLongestStreak(_ context.Context, name string) (domain.RatingStreak, int, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	var id int
+	var longest *memoryStreak
+	for k, v := range m.streaks {
+		if v.name != name {
+			continue
+		}
+		if longest == nil || v.end.Sub(v.begin.Time) > longest.end.Sub(longest.begin.Time) {
+			id = k
+			longest = v
+		}
+	}
+	if longest == nil {
+		return domain.RatingStreak{}, 0, domain.ErrNotFound
+	}
+	return domain.NewRatingStreakFromDB(longest.begin, longest.end), id, nil
+}
+
+func (m *MemoryRepo) GetLongestIndividualStreak(_ context.Context) ([]string, domain.RatingStreak, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	var longest *memoryStreak
+	users := make([]string, 0)
+	for _, v := range m.streaks {
+		if !m.hasUser(v.name) {
+			continue
+		}
+		length := v.end.Sub(v.begin.Time)
+		if longest == nil || length > longest.end.Sub(longest.begin.Time) {
+			longest = v
+			users = []string{v.name}
+		} else if length == longest.end.Sub(longest.begin.Time) {
+			users = append(users, v.name)
+		}
+	}
+	if longest == nil {
+		return nil, domain.RatingStreak{}, domain.ErrNotFound
+	}
+	return users, domain.NewRatingStreakFromDB(longest.begin, longest.end), nil
+}
+
+func (m *MemoryRepo) GetAllStreaks(_ context.Context) (map[string][]domain.RatingStreak, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	result := make(map[string][]domain.RatingStreak)
+	for _, v := range m.streaks {
+		result[v.name] = append(result[v.name], domain.NewRatingStreakFromDB(v.begin, v.end))
+	}
+	for _, v := range result {
+		sort.Slice(v, func(i, j int) bool {
+			return v[i].Begin.Before(v[j].Begin.Time)
+		})
+	}
+	return result, nil
+}
+
+//
+// domain.WebhookRepo
+//
+
+func (m *MemoryRepo) CreateWebhookEndpoint(_ context.Context, endpoint domain.WebhookEndpoint) (int64, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	eventTypes := make([]domain.EventType, len(endpoint.EventTypes))
+	copy(eventTypes, endpoint.EventTypes)
+	endpoint.EventTypes = eventTypes
+
+	id := m.nextWebhookEndpointID
+	m.nextWebhookEndpointID += 1
+	m.webhookEndpoints[id] = endpoint
+	return id, nil
+}
+
+func (m *MemoryRepo) GetAllWebhookEndpoints(_ context.Context) (map[int64]domain.WebhookEndpoint, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	result := make(map[int64]domain.WebhookEndpoint, len(m.webhookEndpoints))
+	for id, v := range m.webhookEndpoints {
+		eventTypes := make([]domain.EventType, len(v.EventTypes))
+		copy(eventTypes, v.EventTypes)
+		v.EventTypes = eventTypes
+		result[id] = v
+	}
+	return result, nil
+}
+
+func (m *MemoryRepo) DeleteWebhookEndpoint(_ context.Context, id int64) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.webhookEndpoints[id]; !ok {
+		return domain.ErrNotFound
+	}
+	delete(m.webhookEndpoints, id)
+	for k, v := range m.webhookDeliveries {
+		if v.EndpointID == id {
+			delete(m.webhookDeliveries, k)
+		}
+	}
+	return nil
+}
+
+func (m *MemoryRepo) CreateWebhookDeliveries(_ context.Context, deliveries []domain.WebhookDelivery) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for _, v := range deliveries {
+		if _, ok := m.webhookEndpoints[v.EndpointID]; !ok {
+			return fmt.Errorf("failed to insert webhook delivery : endpoint %v : %w", v.EndpointID, domain.ErrNotFound)
+		}
+	}
+	for _, v := range deliveries {
+		m.webhookDeliveries[m.nextWebhookDeliveryID] = copyWebhookDelivery(v)
+		m.nextWebhookDeliveryID += 1
+	}
+	return nil
+}
+
+func (m *MemoryRepo) GetDueWebhookDeliveries(_ context.Context, now time.Time, limit int) (map[int64]domain.WebhookDelivery, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	dueIDs := make([]int64, 0)
+	for id, v := range m.webhookDeliveries {
+		if v.State == domain.WebhookDeliveryPending && !v.NextAttemptAt.After(now) {
+			dueIDs = append(dueIDs, id)
+		}
+	}
+	sort.Slice(dueIDs, func(i, j int) bool {
+		return m.webhookDeliveries[dueIDs[i]].NextAttemptAt.Before(m.webhookDeliveries[dueIDs[j]].NextAttemptAt)
+	})
+	if len(dueIDs) > limit {
+		dueIDs = dueIDs[:limit]
+	}
+
+	result := make(map[int64]domain.WebhookDelivery, len(dueIDs))
+	for _, id := range dueIDs {
+		result[id] = copyWebhookDelivery(m.webhookDeliveries[id])
+	}
+	return result, nil
+}
+
+func (m *MemoryRepo) UpdateWebhookDelivery(_ context.Context, id int64, updateFN domain.WebhookDeliveryUpdateFN) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	current, ok := m.webhookDeliveries[id]
+	if !ok {
+		return domain.ErrNotFound
+	}
+
+	updated, err := updateFN(copyWebhookDelivery(current))
+	if err != nil {
+		return fmt.Errorf("update function failed : %w", err)
+	}
+	//no update requested
+	if updated == nil {
+		return nil
+	}
+
+	//like the postgres adapter, only the mutable fields are updated
+	current.State = updated.State
+	current.Attempts = updated.Attempts
+	current.NextAttemptAt = updated.NextAttemptAt
+	current.LastError = updated.LastError
+	current.DeliveredAt = updated.DeliveredAt
+	m.webhookDeliveries[id] = copyWebhookDelivery(current)
+	return nil
+}
+
+// copyWebhookDelivery returns a deep copy of d, so that callers cannot modify the stored value
+func copyWebhookDelivery(d domain.WebhookDelivery) domain.WebhookDelivery {
+	payload := make([]byte, len(d.Payload))
+	copy(payload, d.Payload)
+	d.Payload = payload
+	if d.DeliveredAt != nil {
+		deliveredAt := *d.DeliveredAt
+		d.DeliveredAt = &deliveredAt
+	}
+	return d
+}
+
+//
+// domain.LocationRepo
+//
+
+func (m *MemoryRepo) GetAllLocations(_ context.Context) (map[int64]domain.Location, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	result := make(map[int64]domain.Location, len(m.locations))
+	for id, v := range m.locations {
+		result[id] = copyLocation(v)
+	}
+	return result, nil
+}
+
+func (m *MemoryRepo) GetLocationByID(_ context.Context, id int64) (domain.Location, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	location, ok := m.locations[id]
+	if !ok {
+		return domain.Location{}, domain.ErrNotFound
+	}
+	return copyLocation(location), nil
+}
+
+func (m *MemoryRepo) UpdateLocation(_ context.Context, id int64, updateFN domain.LocationUpdateFN) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	current, ok := m.locations[id]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	updated, err := updateFN(copyLocation(current))
+	if err != nil {
+		return fmt.Errorf("update function failed : %w", err)
+	}
+	//no update requested
+	if updated == nil {
+		return nil
+	}
+	if updated.Name != current.Name {
+		return fmt.Errorf("%w : name cannot be changed", domain.ErrInvalidLocation)
+	}
+	if err := updated.Validate(); err != nil {
+		return err
+	}
+	for _, alias := range updated.Aliases {
+		for otherID, other := range m.locations {
+			if otherID == id {
+				continue
+			}
+			if other.Name == alias || containsString(other.Aliases, alias) {
+				return fmt.Errorf("%w : \"%v\" is used by location %v", domain.ErrLocationAliasConflict, alias, other.Name)
+			}
+		}
+	}
+	m.locations[id] = copyLocation(*updated)
+	return nil
+}
+
+func (m *MemoryRepo) ResolveLocationName(_ context.Context, name string) (string, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	for _, v := range m.locations {
+		if containsString(v.Aliases, name) {
+			return v.Name, nil
+		}
+	}
+	return name, nil
+}
+
+func (m *MemoryRepo) MergeLocations(_ context.Context, sourceID, targetID int64, dryRun bool) (domain.LocationMergeReport, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	source, ok := m.locations[sourceID]
+	if !ok {
+		return domain.LocationMergeReport{}, fmt.Errorf("source location %v : %w", sourceID, domain.ErrNotFound)
+	}
+	target, ok := m.locations[targetID]
+	if !ok {
+		return domain.LocationMergeReport{}, fmt.Errorf("target location %v : %w", targetID, domain.ErrNotFound)
+	}
+
+	dishesOfLocation := func(locationID int64) []domain.LocationMergeDish {
+		result := make([]domain.LocationMergeDish, 0)
+		for id, v := range m.dishes {
+			if v.locationID == locationID {
+				result = append(result, domain.LocationMergeDish{ID: id, Name: v.name, MergedDishID: v.mergedDishID})
+			}
+		}
+		return result
+	}
+	mergedDishesOfLocation := func(locationID int64) []domain.LocationMergeMergedDish {
+		result := make([]domain.LocationMergeMergedDish, 0)
+		for id, v := range m.mergedDishes {
+			if v.locationID == locationID {
+				result = append(result, domain.LocationMergeMergedDish{ID: id, Name: v.name})
+			}
+		}
+		return result
+	}
+	report, err := domain.PlanLocationMerge(source.Name, target.Name, dishesOfLocation(sourceID),
+		dishesOfLocation(targetID), mergedDishesOfLocation(sourceID), mergedDishesOfLocation(targetID),
+		m.allDishFamilies())
+	if err != nil {
+		return domain.LocationMergeReport{}, err
+	}
+	report.DryRun = dryRun
+	if dryRun {
+		return report, nil
+	}
+
+	//the plan is computed upfront, thus nothing below can fail and leave a partial merge behind
+	m.applyDishFamilyPlan(report.DishFamilyChanges)
+	for _, v := range report.CombinedDishes {
+		m.foldDish(v.SourceDishID, v.TargetDishID)
+	}
+	for _, v := range report.MovedDishes {
+		m.dishes[v].locationID = targetID
+	}
+	for _, v := range report.MovedMergedDishes {
+		m.mergedDishes[v].locationID = targetID
+	}
+	for _, v := range report.MembershipChanges {
+		mergedDishID := v.MergedDishID
+		m.dishes[v.DishID].mergedDishID = &mergedDishID
+	}
+	for _, v := range report.CombinedMergedDishes {
+		delete(m.mergedDishes, v.SourceMergedDishID)
+	}
+
+	target.Aliases = append(append(target.Aliases, source.Aliases...), source.Name)
+	sort.Strings(target.Aliases)
+	m.locations[targetID] = target
+	delete(m.locations, sourceID)
+
+	return report, nil
+}
+
+// foldDish moves the occurrences, ratings and tags of the source dish to the target dish and deletes the source
+// dish, see domain.PlanDishFold. Merged dish and dish family memberships are not changed, see applyDishFamilyPlan.
+// Caller must hold the write lock
+func (m *MemoryRepo) foldDish(sourceDishID, targetDishID int64) domain.DishFoldPlan {
+	source := m.dishes[sourceDishID]
+	target := m.dishes[targetDishID]
+
+	toFoldOccurrences := func(occurrences []time.Time) []domain.FoldOccurrence {
+		result := make([]domain.FoldOccurrence, len(occurrences))
+		for i, v := range occurrences {
+			result[i] = domain.FoldOccurrence{ID: int64(i), Date: v}
+		}
+		return result
+	}
+	//the index in m.ratings serves as id of the ratings
+	ratingsOfDish := func(dishID int64) []domain.FoldRating {
+		result := make([]domain.FoldRating, 0)
+		for i, v := range m.ratings {
+			if v.dishID == dishID {
+				result = append(result, domain.FoldRating{ID: int64(i), UserEmail: v.userEmail, Date: v.date})
+			}
+		}
+		return result
+	}
+	plan := domain.PlanDishFold(toFoldOccurrences(source.occurrences), toFoldOccurrences(target.occurrences),
+		ratingsOfDish(sourceDishID), ratingsOfDish(targetDishID))
+
+	for _, v := range plan.MoveOccurrences {
+		target.occurrences = append(target.occurrences, source.occurrences[v])
+	}
+	for _, v := range plan.MoveRatings {
+		m.ratings[v].dishID = targetDishID
+	}
+	dropRatings := make(map[int]interface{}, len(plan.DropRatings))
+	for _, v := range plan.DropRatings {
+		dropRatings[int(v)] = nil
+	}
+	remainingRatings := make([]*memoryRating, 0, len(m.ratings))
+	for i, v := range m.ratings {
+		if _, drop := dropRatings[i]; drop {
+			continue
+		}
+		remainingRatings = append(remainingRatings, v)
+	}
+	m.ratings = remainingRatings
+
+	if len(source.tags) > 0 && target.tags == nil {
+		target.tags = make(map[string]interface{})
+	}
+	for v := range source.tags {
+		target.tags[v] = nil
+	}
+	delete(m.dishes, sourceDishID)
+	return plan
+}
+
+func (m *MemoryRepo) DedupeDish(_ context.Context, canonicalDishID, duplicateDishID int64, performedBy string) (domain.DishDedupe, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if canonicalDishID == duplicateDishID || performedBy == "" {
+		return domain.DishDedupe{}, fmt.Errorf("%w : cannot fold dish %v into dish %v by \"%v\"",
+			domain.ErrInvalidDishDedupe, duplicateDishID, canonicalDishID, performedBy)
+	}
+	canonical, ok := m.dishes[canonicalDishID]
+	if !ok {
+		return domain.DishDedupe{}, fmt.Errorf("failed to fetch dish %v : %w", canonicalDishID, domain.ErrNotFound)
+	}
+	duplicate, ok := m.dishes[duplicateDishID]
+	if !ok {
+		return domain.DishDedupe{}, fmt.Errorf("failed to fetch dish %v : %w", duplicateDishID, domain.ErrNotFound)
+	}
+	if canonical.locationID != duplicate.locationID {
+		return domain.DishDedupe{}, domain.ErrNotOnSameLocation
+	}
+
+	mergedDishMembers := make(map[int64][]int64)
+	for id, v := range m.dishes {
+		if v.mergedDishID != nil {
+			mergedDishMembers[*v.mergedDishID] = append(mergedDishMembers[*v.mergedDishID], id)
+		}
+	}
+	membership := domain.PlanDedupeMembership(canonicalDishID, duplicateDishID, canonical.mergedDishID,
+		duplicate.mergedDishID, mergedDishMembers)
+
+	familyChanges := domain.PlanDishFamilyChanges(m.allDishFamilies(), map[int64]domain.DishFamilyMember{
+		duplicateDishID: {DishID: canonicalDishID, ServedAt: m.locations[canonical.locationID].Name},
+	}, nil)
+	m.applyDishFamilyPlan(familyChanges)
+
+	plan := m.foldDish(duplicateDishID, canonicalDishID)
+	for _, v := range membership.JoiningDishes {
+		mergedDishID := membership.JoinMergedDishID
+		m.dishes[v].mergedDishID = &mergedDishID
+	}
+	if membership.DeleteMergedDishID != nil {
+		m.deleteMergedDish(*membership.DeleteMergedDishID)
+	}
+
+	dedupe := domain.NewDishDedupe(canonicalDishID, duplicateDishID, duplicate.name, performedBy, time.Now(), plan,
+		familyChanges)
+	m.dishDedupes = append(m.dishDedupes, dedupe)
+	return dedupe, nil
+}
+
+func (m *MemoryRepo) GetDishDedupes(_ context.Context, canonicalDishID int64) ([]domain.DishDedupe, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	if _, ok := m.dishes[canonicalDishID]; !ok {
+		return nil, fmt.Errorf("failed to fetch dish %v : %w", canonicalDishID, domain.ErrNotFound)
+	}
+	result := make([]domain.DishDedupe, 0)
+	for _, v := range m.dishDedupes {
+		if v.CanonicalDishID == canonicalDishID {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+//
+// Dish families
+//
+
+// toDomainDishFamily resolves the locations of the dishes of the family. Deleted dishes are skipped, like the
+// foreign keys of the sql based repos do. Caller must hold the lock
+func (m *MemoryRepo) toDomainDishFamily(f *memoryDishFamily) domain.DishFamily {
+	result := domain.DishFamily{Name: f.name, Members: make([]domain.DishFamilyMember, 0, len(f.dishIDs))}
+	for _, v := range f.dishIDs {
+		if dish, ok := m.dishes[v]; ok {
+			result.Members = append(result.Members, domain.DishFamilyMember{
+				DishID:   v,
+				ServedAt: m.locations[dish.locationID].Name,
+			})
+		}
+	}
+	sort.Slice(result.Members, func(i, j int) bool { return result.Members[i].DishID < result.Members[j].DishID })
+	return result
+}
+
+// allDishFamilies returns all families. Caller must hold the lock
+func (m *MemoryRepo) allDishFamilies() map[int64]domain.DishFamily {
+	result := make(map[int64]domain.DishFamily, len(m.dishFamilies))
+	for id, v := range m.dishFamilies {
+		result[id] = m.toDomainDishFamily(v)
+	}
+	return result
+}
+
+// applyDishFamilyPlan changes the dish families according to plan, see domain.PlanDishFamilyChanges.
+// Caller must hold the write lock
+func (m *MemoryRepo) applyDishFamilyPlan(plan domain.DishFamilyPlan) {
+	for _, v := range plan.LeavingDishes {
+		f := m.dishFamilies[v.DishFamilyID]
+		remaining := make([]int64, 0, len(f.dishIDs))
+		for _, dishID := range f.dishIDs {
+			if dishID != v.DishID {
+				remaining = append(remaining, dishID)
+			}
+		}
+		f.dishIDs = remaining
+	}
+	for _, v := range plan.DissolvedFamilies {
+		delete(m.dishFamilies, v)
+	}
+	for _, v := range plan.JoiningDishes {
+		f := m.dishFamilies[v.DishFamilyID]
+		f.dishIDs = append(f.dishIDs, v.DishID)
+	}
+}
+
+// storeDishFamily validates the family and replaces the family with the given id. Caller must hold the write lock
+func (m *MemoryRepo) storeDishFamily(id int64, family domain.DishFamily) error {
+	if err := family.Validate(); err != nil {
+		return err
+	}
+	for _, v := range family.Members {
+		dish, ok := m.dishes[v.DishID]
+		if !ok {
+			return fmt.Errorf("failed to fetch dish %v : %w", v.DishID, domain.ErrNotFound)
+		}
+		if servedAt := m.locations[dish.locationID].Name; servedAt != v.ServedAt {
+			return fmt.Errorf("%w : dish %v is served at %v", domain.ErrInvalidDishFamily, v.DishID, servedAt)
+		}
+		for familyID, f := range m.dishFamilies {
+			if familyID != id && containsInt64(f.dishIDs, v.DishID) {
+				return fmt.Errorf("%w : dish %v", domain.ErrDishAlreadyInFamily, v.DishID)
+			}
+		}
+	}
+	m.dishFamilies[id] = &memoryDishFamily{name: family.Name, dishIDs: family.DishIDs()}
+	return nil
+}
+
+func (m *MemoryRepo) CreateDishFamily(_ context.Context, family domain.DishFamily) (int64, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	id := m.nextDishFamilyID
+	if err := m.storeDishFamily(id, family); err != nil {
+		return 0, err
+	}
+	m.nextDishFamilyID += 1
+	return id, nil
+}
+
+func (m *MemoryRepo) GetAllDishFamilies(_ context.Context) (map[int64]domain.DishFamily, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.allDishFamilies(), nil
+}
+
+func (m *MemoryRepo) GetDishFamilyByID(_ context.Context, id int64) (domain.DishFamily, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	f, ok := m.dishFamilies[id]
+	if !ok {
+		return domain.DishFamily{}, fmt.Errorf("failed to fetch dish family %v : %w", id, domain.ErrNotFound)
+	}
+	return m.toDomainDishFamily(f), nil
+}
+
+func (m *MemoryRepo) GetDishFamilyOfDish(_ context.Context, dishID int64) (int64, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	if _, ok := m.dishes[dishID]; ok {
+		for id, v := range m.dishFamilies {
+			if containsInt64(v.dishIDs, dishID) {
+				return id, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("dish %v is not part of a dish family : %w", dishID, domain.ErrNotFound)
+}
+
+func (m *MemoryRepo) UpdateDishFamily(_ context.Context, id int64, updateFN domain.DishFamilyUpdateFN) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	f, ok := m.dishFamilies[id]
+	if !ok {
+		return fmt.Errorf("failed to fetch dish family %v : %w", id, domain.ErrNotFound)
+	}
+	updated, err := updateFN(m.toDomainDishFamily(f))
+	if err != nil {
+		return err
+	}
+	if updated == nil {
+		return nil
+	}
+	return m.storeDishFamily(id, *updated)
+}
+
+func (m *MemoryRepo) DeleteDishFamily(_ context.Context, id int64) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.dishFamilies[id]; !ok {
+		return fmt.Errorf("failed to delete dish family %v : %w", id, domain.ErrNotFound)
+	}
+	delete(m.dishFamilies, id)
+	return nil
+}
+
+func containsInt64(values []int64, v int64) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+// copyLocation returns a deep copy of l, so that callers cannot modify the stored value
+func copyLocation(l domain.Location) domain.Location {
+	aliases := make([]string, len(l.Aliases))
+	copy(aliases, l.Aliases)
+	l.Aliases = aliases
+	return l
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+//
+// Access Tokens
+//
+
+// copyAccessToken returns a copy of t that does not share LastUsedAt
+func copyAccessToken(t domain.AccessToken) domain.AccessToken {
+	if t.LastUsedAt != nil {
+		lastUsed := *t.LastUsedAt
+		t.LastUsedAt = &lastUsed
+	}
+	return t
+}
+
+func (m *MemoryRepo) CreateAccessToken(_ context.Context, token domain.AccessToken) (int64, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for _, v := range m.accessTokens {
+		if v.SecretHash == token.SecretHash {
+			return 0, fmt.Errorf("token with the same secret already exists")
+		}
+	}
+	id := m.nextAccessTokenID
+	m.nextAccessTokenID += 1
+	m.accessTokens[id] = copyAccessToken(token)
+	return id, nil
+}
+
+func (m *MemoryRepo) GetAccessTokensOfUser(_ context.Context, userEmail string) (map[int64]domain.AccessToken, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	result := make(map[int64]domain.AccessToken)
+	for id, v := range m.accessTokens {
+		if v.UserEmail == userEmail {
+			result[id] = copyAccessToken(v)
+		}
+	}
+	return result, nil
+}
+
+func (m *MemoryRepo) GetAccessTokenBySecretHash(_ context.Context, secretHash string) (int64, domain.AccessToken, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	for id, v := range m.accessTokens {
+		if v.SecretHash == secretHash {
+			return id, copyAccessToken(v), nil
+		}
+	}
+	return 0, domain.AccessToken{}, domain.ErrNotFound
+}
+
+func (m *MemoryRepo) SetAccessTokenLastUsed(_ context.Context, id int64, lastUsed time.Time) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	token, ok := m.accessTokens[id]
+	if !ok {
+		return fmt.Errorf("access token %v : %w", id, domain.ErrNotFound)
+	}
+	token.LastUsedAt = &lastUsed
+	m.accessTokens[id] = token
+	return nil
+}
+
+func (m *MemoryRepo) DeleteAccessToken(_ context.Context, userEmail string, id int64) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	token, ok := m.accessTokens[id]
+	if !ok || token.UserEmail != userEmail {
+		return fmt.Errorf("access token %v : %w", id, domain.ErrNotFound)
+	}
+	delete(m.accessTokens, id)
+	return nil
+}
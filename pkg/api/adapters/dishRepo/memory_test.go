@@ -0,0 +1,53 @@
+package dishRepo
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"itsTasty/pkg/api/domain"
+	"sync"
+	"testing"
+	"time"
+)
+
+func Test_Memory_RunCommon(t *testing.T) {
+
+	commonFactory := func() (commonTestRepo, factoryCleanupFunc, error) {
+		repo := NewMemoryRepo()
+		return repo, repo.Close, nil
+	}
+
+	runCommonDbTests(t, commonFactory)
+}
+
+func Test_Memory_ConcurrentRatings(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryRepo()
+
+	_, _, _, dishID, err := repo.GetOrCreateDish(ctx, "testDish", "testLocation")
+	require.NoError(t, err)
+
+	const users = 50
+	wg := sync.WaitGroup{}
+	for i := 0; i < users; i++ {
+		email := fmt.Sprintf("user%v@testuser", i)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := repo.CreateOrUpdateRating(ctx, email, dishID, func(currentRating *domain.DishRating) (*domain.DishRating, bool, error) {
+				rating := domain.NewDishRating(email, domain.FourStars, time.Now())
+				return &rating, true, nil
+			})
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	ratings, err := repo.GetAllRatingsForDish(ctx, dishID)
+	require.NoError(t, err)
+	require.Len(t, ratings, users)
+
+	gotUsers, err := repo.GetAllUsers(ctx)
+	require.NoError(t, err)
+	require.Len(t, gotUsers, users)
+}
@@ -8,11 +8,15 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"itsTasty/pkg/api/adapters/dishRepo/sqlboilerPSQL"
 	"itsTasty/pkg/api/domain"
-	"log"
+	"itsTasty/pkg/logging"
+	"log/slog"
+	"net/url"
 	"time"
 
 	"github.com/volatiletech/null/v8"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
 	migrate "github.com/rubenv/sql-migrate"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -115,6 +119,22 @@ func (p *PostgresRepo) GetLongestIndividualStreak(ctx context.Context) ([]string
 	return users, domainStreak, nil
 }
 
+func (p *PostgresRepo) GetAllStreaks(ctx context.Context) (map[string][]domain.RatingStreak, error) {
+	dbStreaks, err := sqlboilerPSQL.RatingStreaks(
+		qm.OrderBy(sqlboilerPSQL.RatingStreakColumns.Name+", "+sqlboilerPSQL.RatingStreakColumns.StartDate),
+	).All(ctx, p.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query streaks : %w", err)
+	}
+
+	result := make(map[string][]domain.RatingStreak)
+	for _, v := range dbStreaks {
+		result[v.Name] = append(result[v.Name], domain.NewRatingStreakFromDB(domain.NewDayPrecisionTime(v.StartDate),
+			domain.NewDayPrecisionTime(v.EndDate)))
+	}
+	return result, nil
+}
+
 func (p *PostgresRepo) GetLongestStreak(ctx context.Context, name string) (domain.RatingStreak, int, error) {
 	dbStreak, err := sqlboilerPSQL.RatingStreaks(
 		qm.Limit(1),
@@ -207,6 +227,67 @@ func (p *PostgresRepo) GetAllRatingsForDate(ctx context.Context, date domain.Day
 	return domainRatings, nil
 }
 
+func (p *PostgresRepo) GetAllRatingsOfUser(ctx context.Context, userEmail string) ([]domain.RatingOfDish, error) {
+	dbRatings, err := sqlboilerPSQL.DishRatings(
+		qm.InnerJoin(fmt.Sprintf("%s on %s = %s",
+			sqlboilerPSQL.TableNames.Users,
+			sqlboilerPSQL.UserTableColumns.ID,
+			sqlboilerPSQL.DishRatingTableColumns.UserID,
+		)),
+		sqlboilerPSQL.UserWhere.Email.EQ(userEmail),
+		qm.OrderBy(sqlboilerPSQL.DishRatingTableColumns.Date+" asc"),
+	).All(ctx, p.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ratings : %w", err)
+	}
+
+	result := make([]domain.RatingOfDish, len(dbRatings))
+	for i, v := range dbRatings {
+		domainRating, err := domain.NewDishRatingFromDB(userEmail, v.Rating, v.Date.In(time.Local))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create domain rating from %+v : %w", v, err)
+		}
+		result[i] = domain.RatingOfDish{
+			DishID: int64(v.DishID),
+			Rating: domainRating,
+		}
+	}
+	return result, nil
+}
+
+func (p *PostgresRepo) GetRatingSummaryPerUser(ctx context.Context) ([]domain.UserRatingSummary, error) {
+	type dbSummary struct {
+		Email       string `boil:"email"`
+		RatingCount int    `boil:"rating_count"`
+		RatingSum   int    `boil:"rating_sum"`
+	}
+	query := fmt.Sprintf("SELECT %s as email, COUNT(*) as rating_count, SUM(%s) as rating_sum FROM %s "+
+		"INNER JOIN %s ON %s = %s GROUP BY %s",
+		sqlboilerPSQL.UserTableColumns.Email,
+		sqlboilerPSQL.DishRatingTableColumns.Rating,
+		sqlboilerPSQL.TableNames.DishRatings,
+		sqlboilerPSQL.TableNames.Users,
+		sqlboilerPSQL.UserTableColumns.ID,
+		sqlboilerPSQL.DishRatingTableColumns.UserID,
+		sqlboilerPSQL.UserTableColumns.Email,
+	)
+
+	var dbSummaries []dbSummary
+	if err := queries.Raw(query).Bind(ctx, p.db, &dbSummaries); err != nil {
+		return nil, fmt.Errorf("failed to query rating summaries : %w", err)
+	}
+
+	result := make([]domain.UserRatingSummary, len(dbSummaries))
+	for i, v := range dbSummaries {
+		result[i] = domain.UserRatingSummary{
+			User:        domain.User{Email: v.Email},
+			RatingCount: v.RatingCount,
+			RatingSum:   v.RatingSum,
+		}
+	}
+	return result, nil
+}
+
 func (p *PostgresRepo) GetMostRecentDishForMergedDish(ctx context.Context, mergedDishID int64) (*domain.Dish, int64, error) {
 	//fetch merged dish from db
 	dbDish, err := sqlboilerPSQL.Dishes(
@@ -283,22 +364,79 @@ func (p *PostgresRepo) IsDishPartOfMergedDisByID(ctx context.Context, dishID int
 	return true, int64(*dbDish.MergedDishID.Ptr()), nil
 }
 
+// ConnectToPostgresDB connects to the db at dbURL, which is given as host:port. options are added as parameters to
+// the connection string, e.g. sslmode. Without sslmode, TLS is disabled
+func ConnectToPostgresDB(ctx context.Context, dbUser, dbPW, dbURL, dbName string, options url.Values) (*sql.DB, error) {
+
+	query := url.Values{"sslmode": {"disable"}}
+	for k, v := range options {
+		query[k] = v
+	}
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(dbUser, dbPW),
+		Host:     dbURL,
+		Path:     "/" + dbName,
+		RawQuery: query.Encode(),
+	}
+	connConfig, err := pgx.ParseConfig(dsn.String())
+	if err != nil {
+		return nil, fmt.Errorf("pgx.ParseConfig : %v", err)
+	}
+	connConfig.Tracer = queryTracer{}
+	db := stdlib.OpenDB(*connConfig)
+
+	logging.FromContext(ctx).Info("trying to connect to db...")
+	retries := 10
+	connected := false
+	for retries > 0 && !connected {
+		pingCtx, pingCancel := context.WithTimeout(ctx, 10*time.Second)
+		err := db.PingContext(pingCtx)
+		if err != nil {
+			logging.FromContext(ctx).Warn("error connecting to db", "err", err)
+			logging.FromContext(ctx).Info("retrying to connect to db", "retriesRemaining", retries)
+			retries -= 1
+			time.Sleep(3 * time.Second)
+		} else {
+			logging.FromContext(ctx).Info("connected to db")
+			connected = true
+		}
+		pingCancel()
+	}
+
+	if !connected {
+		return nil, fmt.Errorf("error connecting to db")
+	}
+
+	return db, nil
+}
+
 func NewPostgresRepo(db *sql.DB, migrationSource migrate.MigrationSource) (*PostgresRepo, error) {
 	appliedMigrations, err := migrate.Exec(db, "postgres", migrationSource, migrate.Up)
 	if err != nil {
 		return nil, fmt.Errorf("failed to apply db migrations : %v", err)
 	}
 	if appliedMigrations != 0 {
-		log.Printf("Applied %v migrations", appliedMigrations)
+		slog.Info("applied migrations", "count", appliedMigrations)
 	}
 
 	repo := &PostgresRepo{db: db, migrationSource: migrationSource}
 	return repo, nil
 }
 
+// DB returns the connection pool of the repo, e.g. to share it with other components that store data in postgres
+func (p *PostgresRepo) DB() *sql.DB {
+	return p.db
+}
+
 // finishTransaction is a helper functions that performs a rollback if err != nil and commits the transaction otherwise
 // the returned error includes potentials errors from a failed commit or rollback
 func (p *PostgresRepo) finishTransaction(err error, tx *sql.Tx) error {
+	return finishTransaction(err, tx)
+}
+
+// finishTransaction is shared by all sql based repos. See PostgresRepo.finishTransaction
+func finishTransaction(err error, tx *sql.Tx) error {
 	if err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			return fmt.Errorf("transaction failed with \"%v\" and rollback failed with \"%v\"", err, rollbackErr)
@@ -306,7 +444,7 @@ func (p *PostgresRepo) finishTransaction(err error, tx *sql.Tx) error {
 		return err
 	} else {
 		if commitErr := tx.Commit(); commitErr != nil {
-			return fmt.Errorf("failed to commit : %w", err)
+			return fmt.Errorf("failed to commit : %w", commitErr)
 		}
 		return nil
 	}
@@ -443,6 +581,79 @@ func (p *PostgresRepo) GetOrCreateDish(ctx context.Context, dishName string, ser
 	return p.getOrCreateDish(ctx, dishName, servedAt)
 }
 
+func (p *PostgresRepo) GetOrCreateDishWithOccurrences(ctx context.Context, dishName string, servedAt string,
+	occurrences []time.Time) (dishID int64, err error) {
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		err = fmt.Errorf("BeginTX : %w", err)
+		return
+	}
+	defer func() {
+		err = p.finishTransaction(err, tx)
+	}()
+
+	dbLocation, err := sqlboilerPSQL.Locations(sqlboilerPSQL.LocationWhere.Name.EQ(servedAt)).One(ctx, tx)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			err = fmt.Errorf("failed to check if location exists : %v", err)
+			return
+		}
+		dbLocation = &sqlboilerPSQL.Location{
+			Name:    servedAt,
+			Created: time.Now(),
+		}
+		if err = dbLocation.Insert(ctx, tx, boil.Infer()); err != nil {
+			err = fmt.Errorf("failed to insert new location : %v", err)
+			return
+		}
+	}
+
+	dbDish, err := sqlboilerPSQL.Dishes(
+		sqlboilerPSQL.DishWhere.LocationID.EQ(dbLocation.ID),
+		sqlboilerPSQL.DishWhere.Name.EQ(dishName),
+		qm.Load(sqlboilerPSQL.DishRels.DishOccurrences),
+	).One(ctx, tx)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			err = fmt.Errorf("failed to check if dish exists : %v", err)
+			return
+		}
+		dbDish = &sqlboilerPSQL.Dish{
+			LocationID: dbLocation.ID,
+			Name:       dishName,
+		}
+		if err = dbDish.Insert(ctx, tx, boil.Infer()); err != nil {
+			err = fmt.Errorf("failed to insert new dish : %v", err)
+			return
+		}
+		dbDish.R = dbDish.R.NewStruct()
+	}
+	dishID = int64(dbDish.ID)
+
+	newOccurrences := make([]*sqlboilerPSQL.DishOccurrence, 0)
+	for _, v := range occurrences {
+		exists := false
+		for _, existing := range dbDish.R.DishOccurrences {
+			if existing.Date.Equal(v) {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			newOccurrences = append(newOccurrences, &sqlboilerPSQL.DishOccurrence{DishID: dbDish.ID, Date: v})
+		}
+	}
+	if len(newOccurrences) == 0 {
+		return
+	}
+	if err = dbDish.AddDishOccurrences(ctx, tx, true, newOccurrences...); err != nil {
+		err = fmt.Errorf("failed to insert occurrences : %v", err)
+		return
+	}
+	return
+}
+
 func (p *PostgresRepo) getDishByName(exec boil.ContextExecutor, ctx context.Context, dishName, servedAt string) (dish *domain.Dish, dishID int64, err error) {
 
 	//
@@ -659,6 +870,48 @@ func (p *PostgresRepo) GetAllDishesSimple(ctx context.Context) ([]domain.SimpleD
 	return result, nil
 }
 
+func (p *PostgresRepo) RenameDish(ctx context.Context, dishID int64, newName string) (err error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		err = fmt.Errorf("BeginTX : %w", err)
+		return
+	}
+	defer func() {
+		err = p.finishTransaction(err, tx)
+	}()
+
+	dbDish, err := sqlboilerPSQL.Dishes(
+		sqlboilerPSQL.DishWhere.ID.EQ(int(dishID)),
+		qm.For("update"),
+	).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to fetch dish %v : %w", dishID, domain.ErrNotFound)
+		}
+		return fmt.Errorf("failed to fetch dish %v : %v", dishID, err)
+	}
+	if dbDish.Name == newName {
+		return nil
+	}
+
+	exists, err := sqlboilerPSQL.Dishes(
+		sqlboilerPSQL.DishWhere.Name.EQ(newName),
+		sqlboilerPSQL.DishWhere.LocationID.EQ(dbDish.LocationID),
+	).Exists(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("failed to check for existing dish : %v", err)
+	}
+	if exists {
+		return fmt.Errorf("%w : \"%v\"", domain.ErrDishAlreadyExists, newName)
+	}
+
+	dbDish.Name = newName
+	if _, err := dbDish.Update(ctx, tx, boil.Whitelist(sqlboilerPSQL.DishColumns.Name)); err != nil {
+		return fmt.Errorf("failed to update dish : %v", err)
+	}
+	return nil
+}
+
 func (p *PostgresRepo) GetRatings(ctx context.Context, userEmail string, dishID int64, onlyMostRecent bool) ([]domain.DishRating, error) {
 
 	query := []qm.QueryMod{
@@ -0,0 +1,89 @@
+package dishRepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"itsTasty/pkg/api/adapters/dishRepo/sqlboilerPSQL"
+	"itsTasty/pkg/api/domain"
+	"time"
+)
+
+func accessTokenToDomain(dbToken *sqlboilerPSQL.AccessToken) domain.AccessToken {
+	return domain.AccessToken{
+		UserEmail:  dbToken.UserEmail,
+		Name:       dbToken.Name,
+		ReadOnly:   dbToken.ReadOnly,
+		SecretHash: dbToken.SecretHash,
+		CreatedAt:  dbToken.CreatedAt,
+		LastUsedAt: dbToken.LastUsedAt.Ptr(),
+	}
+}
+
+func (p *PostgresRepo) CreateAccessToken(ctx context.Context, token domain.AccessToken) (int64, error) {
+	dbToken := &sqlboilerPSQL.AccessToken{
+		UserEmail:  token.UserEmail,
+		Name:       token.Name,
+		ReadOnly:   token.ReadOnly,
+		SecretHash: token.SecretHash,
+		CreatedAt:  token.CreatedAt,
+		LastUsedAt: null.TimeFromPtr(token.LastUsedAt),
+	}
+	if err := dbToken.Insert(ctx, p.db, boil.Infer()); err != nil {
+		return 0, fmt.Errorf("failed to insert access token : %w", err)
+	}
+	return int64(dbToken.ID), nil
+}
+
+func (p *PostgresRepo) GetAccessTokensOfUser(ctx context.Context, userEmail string) (map[int64]domain.AccessToken, error) {
+	dbTokens, err := sqlboilerPSQL.AccessTokens(sqlboilerPSQL.AccessTokenWhere.UserEmail.EQ(userEmail)).All(ctx, p.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query access tokens : %w", err)
+	}
+
+	result := make(map[int64]domain.AccessToken, len(dbTokens))
+	for _, v := range dbTokens {
+		result[int64(v.ID)] = accessTokenToDomain(v)
+	}
+	return result, nil
+}
+
+func (p *PostgresRepo) GetAccessTokenBySecretHash(ctx context.Context, secretHash string) (int64, domain.AccessToken, error) {
+	dbToken, err := sqlboilerPSQL.AccessTokens(sqlboilerPSQL.AccessTokenWhere.SecretHash.EQ(secretHash)).One(ctx, p.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, domain.AccessToken{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return 0, domain.AccessToken{}, fmt.Errorf("failed to query access token : %w", err)
+	}
+	return int64(dbToken.ID), accessTokenToDomain(dbToken), nil
+}
+
+func (p *PostgresRepo) SetAccessTokenLastUsed(ctx context.Context, id int64, lastUsed time.Time) error {
+	affected, err := sqlboilerPSQL.AccessTokens(sqlboilerPSQL.AccessTokenWhere.ID.EQ(int(id))).
+		UpdateAll(ctx, p.db, sqlboilerPSQL.M{sqlboilerPSQL.AccessTokenColumns.LastUsedAt: lastUsed})
+	if err != nil {
+		return fmt.Errorf("failed to update access token %v : %w", id, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("failed to update access token %v : %w", id, domain.ErrNotFound)
+	}
+	return nil
+}
+
+func (p *PostgresRepo) DeleteAccessToken(ctx context.Context, userEmail string, id int64) error {
+	affected, err := sqlboilerPSQL.AccessTokens(
+		sqlboilerPSQL.AccessTokenWhere.ID.EQ(int(id)),
+		sqlboilerPSQL.AccessTokenWhere.UserEmail.EQ(userEmail),
+	).DeleteAll(ctx, p.db)
+	if err != nil {
+		return fmt.Errorf("failed to delete access token %v : %w", id, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("failed to delete access token %v : %w", id, domain.ErrNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package dishRepo
+
+import (
+	"context"
+	"fmt"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"itsTasty/pkg/api/adapters/dishRepo/sqlboilerPSQL"
+	"itsTasty/pkg/api/domain"
+	"time"
+)
+
+func (p *PostgresRepo) DedupeDish(ctx context.Context, canonicalDishID, duplicateDishID int64, performedBy string) (dedupe domain.DishDedupe, err error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.DishDedupe{}, fmt.Errorf("BeginTX : %w", err)
+	}
+	defer func() {
+		err = p.finishTransaction(err, tx)
+	}()
+	//lock both dishes to prevent concurrent ratings and dedupes
+	_, err = sqlboilerPSQL.Dishes(
+		sqlboilerPSQL.DishWhere.ID.IN([]int{int(canonicalDishID), int(duplicateDishID)}),
+		qm.For("update"),
+	).All(ctx, tx)
+	if err != nil {
+		return domain.DishDedupe{}, fmt.Errorf("failed to lock dishes : %v", err)
+	}
+	return postgresDialect.dedupeDish(ctx, tx, canonicalDishID, duplicateDishID, performedBy, time.Now())
+}
+
+func (p *PostgresRepo) GetDishDedupes(ctx context.Context, canonicalDishID int64) ([]domain.DishDedupe, error) {
+	return postgresDialect.getDishDedupes(ctx, p.db, canonicalDishID)
+}
@@ -0,0 +1,51 @@
+package dishRepo
+
+import (
+	"context"
+	"fmt"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"itsTasty/pkg/api/adapters/dishRepo/sqlboilerPSQL"
+	"itsTasty/pkg/api/domain"
+)
+
+func (p *PostgresRepo) CreateDishFamily(ctx context.Context, family domain.DishFamily) (id int64, err error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("BeginTX : %w", err)
+	}
+	defer func() {
+		err = p.finishTransaction(err, tx)
+	}()
+	return postgresDialect.createDishFamily(ctx, tx, family)
+}
+
+func (p *PostgresRepo) GetAllDishFamilies(ctx context.Context) (map[int64]domain.DishFamily, error) {
+	return postgresDialect.queryDishFamilies(ctx, p.db, "")
+}
+
+func (p *PostgresRepo) GetDishFamilyByID(ctx context.Context, id int64) (domain.DishFamily, error) {
+	return postgresDialect.getDishFamilyByID(ctx, p.db, id)
+}
+
+func (p *PostgresRepo) GetDishFamilyOfDish(ctx context.Context, dishID int64) (int64, error) {
+	return postgresDialect.getDishFamilyOfDish(ctx, p.db, dishID)
+}
+
+func (p *PostgresRepo) UpdateDishFamily(ctx context.Context, id int64, updateFN domain.DishFamilyUpdateFN) (err error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("BeginTX : %w", err)
+	}
+	defer func() {
+		err = p.finishTransaction(err, tx)
+	}()
+	_, err = sqlboilerPSQL.DishFamilies(sqlboilerPSQL.DishFamilyWhere.ID.EQ(int(id)), qm.For("update")).All(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("failed to lock dish family : %v", err)
+	}
+	return postgresDialect.updateDishFamily(ctx, tx, id, updateFN)
+}
+
+func (p *PostgresRepo) DeleteDishFamily(ctx context.Context, id int64) error {
+	return postgresDialect.deleteDishFamily(ctx, p.db, id)
+}
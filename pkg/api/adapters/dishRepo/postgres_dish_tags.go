@@ -0,0 +1,61 @@
+package dishRepo
+
+import (
+	"context"
+	"fmt"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"itsTasty/pkg/api/adapters/dishRepo/sqlboilerPSQL"
+	"itsTasty/pkg/api/domain"
+)
+
+func (p *PostgresRepo) checkDishExists(ctx context.Context, dishID int64) error {
+	exists, err := sqlboilerPSQL.DishExists(ctx, p.db, int(dishID))
+	if err != nil {
+		return fmt.Errorf("failed to fetch dish %v : %v", dishID, err)
+	}
+	if !exists {
+		return fmt.Errorf("failed to fetch dish %v : %w", dishID, domain.ErrNotFound)
+	}
+	return nil
+}
+
+func (p *PostgresRepo) AddDishTags(ctx context.Context, dishID int64, tags []string) (err error) {
+	if err := p.checkDishExists(ctx, dishID); err != nil {
+		return err
+	}
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("BeginTX : %w", err)
+	}
+	defer func() {
+		err = p.finishTransaction(err, tx)
+	}()
+	for _, v := range tags {
+		dbTag := &sqlboilerPSQL.DishTag{DishID: int(dishID), Tag: v}
+		//existing tags are kept as they are
+		if err := dbTag.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+			return fmt.Errorf("failed to add tag %v : %v", v, err)
+		}
+	}
+	return nil
+}
+
+func (p *PostgresRepo) GetDishTags(ctx context.Context, dishID int64) ([]string, error) {
+	if err := p.checkDishExists(ctx, dishID); err != nil {
+		return nil, err
+	}
+	dbTags, err := sqlboilerPSQL.DishTags(
+		sqlboilerPSQL.DishTagWhere.DishID.EQ(int(dishID)),
+		qm.OrderBy(sqlboilerPSQL.DishTagColumns.Tag),
+	).All(ctx, p.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tags : %v", err)
+	}
+	tags := make([]string, 0, len(dbTags))
+	for _, v := range dbTags {
+		tags = append(tags, v.Tag)
+	}
+	return tags, nil
+}
@@ -0,0 +1,169 @@
+package dishRepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"itsTasty/pkg/api/adapters/dishRepo/sqlboilerPSQL"
+	"itsTasty/pkg/api/domain"
+	"time"
+)
+
+// locationToDomain expects the LocationAliases relation to be loaded
+func locationToDomain(dbLocation *sqlboilerPSQL.Location) domain.Location {
+	location := domain.NewLocation(dbLocation.Name)
+	location.DisplayName = dbLocation.DisplayName
+	location.Address = dbLocation.Address
+	location.OpeningHours = dbLocation.OpeningHours
+	location.Archived = dbLocation.Archived
+	for _, v := range dbLocation.R.GetLocationAliases() {
+		location.Aliases = append(location.Aliases, v.Alias)
+	}
+	return location
+}
+
+func (p *PostgresRepo) GetAllLocations(ctx context.Context) (map[int64]domain.Location, error) {
+	dbLocations, err := sqlboilerPSQL.Locations(
+		qm.Load(sqlboilerPSQL.LocationRels.LocationAliases, qm.OrderBy(sqlboilerPSQL.LocationAliasColumns.Alias)),
+	).All(ctx, p.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query locations : %v", err)
+	}
+
+	result := make(map[int64]domain.Location, len(dbLocations))
+	for _, v := range dbLocations {
+		result[int64(v.ID)] = locationToDomain(v)
+	}
+	return result, nil
+}
+
+func (p *PostgresRepo) GetLocationByID(ctx context.Context, id int64) (domain.Location, error) {
+	dbLocation, err := sqlboilerPSQL.Locations(
+		sqlboilerPSQL.LocationWhere.ID.EQ(int(id)),
+		qm.Load(sqlboilerPSQL.LocationRels.LocationAliases, qm.OrderBy(sqlboilerPSQL.LocationAliasColumns.Alias)),
+	).One(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Location{}, domain.ErrNotFound
+		}
+		return domain.Location{}, fmt.Errorf("failed to query location : %v", err)
+	}
+	return locationToDomain(dbLocation), nil
+}
+
+func (p *PostgresRepo) UpdateLocation(ctx context.Context, id int64, updateFN domain.LocationUpdateFN) (err error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("BeginTX : %w", err)
+	}
+	defer func() {
+		err = p.finishTransaction(err, tx)
+	}()
+
+	dbLocation, err := sqlboilerPSQL.Locations(
+		sqlboilerPSQL.LocationWhere.ID.EQ(int(id)),
+		qm.Load(sqlboilerPSQL.LocationRels.LocationAliases, qm.OrderBy(sqlboilerPSQL.LocationAliasColumns.Alias)),
+		qm.For("update"),
+	).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("failed to query location : %v", err)
+	}
+	current := locationToDomain(dbLocation)
+	updated, err := updateFN(current)
+	if err != nil {
+		return fmt.Errorf("update function failed : %w", err)
+	}
+	//no update requested
+	if updated == nil {
+		return nil
+	}
+	if updated.Name != current.Name {
+		return fmt.Errorf("%w : name cannot be changed", domain.ErrInvalidLocation)
+	}
+	if err := updated.Validate(); err != nil {
+		return err
+	}
+
+	for _, alias := range updated.Aliases {
+		nameConflict, err := sqlboilerPSQL.Locations(
+			sqlboilerPSQL.LocationWhere.Name.EQ(alias),
+			sqlboilerPSQL.LocationWhere.ID.NEQ(int(id)),
+		).Exists(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to check for conflicting location : %v", err)
+		}
+		aliasConflict, err := sqlboilerPSQL.LocationAliases(
+			sqlboilerPSQL.LocationAliasWhere.Alias.EQ(alias),
+			sqlboilerPSQL.LocationAliasWhere.LocationID.NEQ(int(id)),
+		).Exists(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to check for conflicting location alias : %v", err)
+		}
+		if nameConflict || aliasConflict {
+			return fmt.Errorf("%w : \"%v\"", domain.ErrLocationAliasConflict, alias)
+		}
+	}
+
+	dbLocation.DisplayName = updated.DisplayName
+	dbLocation.Address = updated.Address
+	dbLocation.OpeningHours = updated.OpeningHours
+	dbLocation.Archived = updated.Archived
+	_, err = dbLocation.Update(ctx, tx, boil.Whitelist(
+		sqlboilerPSQL.LocationColumns.DisplayName,
+		sqlboilerPSQL.LocationColumns.Address,
+		sqlboilerPSQL.LocationColumns.OpeningHours,
+		sqlboilerPSQL.LocationColumns.Archived,
+	))
+	if err != nil {
+		return fmt.Errorf("failed to update location : %v", err)
+	}
+	if _, err := dbLocation.R.GetLocationAliases().DeleteAll(ctx, tx); err != nil {
+		return fmt.Errorf("failed to delete old aliases : %v", err)
+	}
+	for _, alias := range updated.Aliases {
+		dbAlias := &sqlboilerPSQL.LocationAlias{Alias: alias, LocationID: dbLocation.ID}
+		if err := dbAlias.Insert(ctx, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to insert alias %v : %v", alias, err)
+		}
+	}
+	return nil
+}
+
+func (p *PostgresRepo) ResolveLocationName(ctx context.Context, name string) (string, error) {
+	dbAlias, err := sqlboilerPSQL.LocationAliases(
+		sqlboilerPSQL.LocationAliasWhere.Alias.EQ(name),
+		qm.Load(sqlboilerPSQL.LocationAliasRels.Location),
+	).One(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return name, nil
+		}
+		return "", fmt.Errorf("failed to query location alias : %v", err)
+	}
+	return dbAlias.R.Location.Name, nil
+}
+
+func (p *PostgresRepo) MergeLocations(ctx context.Context, sourceID, targetID int64, dryRun bool) (report domain.LocationMergeReport, err error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.LocationMergeReport{}, fmt.Errorf("BeginTX : %w", err)
+	}
+	defer func() {
+		err = p.finishTransaction(err, tx)
+	}()
+	//lock both locations to prevent concurrent merges and new dishes at the source location
+	_, err = sqlboilerPSQL.Locations(
+		sqlboilerPSQL.LocationWhere.ID.IN([]int{int(sourceID), int(targetID)}),
+		qm.For("update"),
+	).All(ctx, tx)
+	if err != nil {
+		return domain.LocationMergeReport{}, fmt.Errorf("failed to lock locations : %v", err)
+	}
+	return postgresDialect.mergeLocations(ctx, tx, sourceID, targetID, dryRun, time.Now())
+}
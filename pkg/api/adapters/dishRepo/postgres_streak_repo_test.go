@@ -94,3 +94,29 @@ func testStreak_Create_Get_Update(t *testing.T, repo domain.RatingStreakRepo) {
 	require.ElementsMatch(t, []string{user1, user2}, maxStreakUsers)
 	require.Equal(t, wantRS2, maxStreak)*/
 }
+
+func testStreak_GetAllStreaks(t *testing.T, repo domain.RatingStreakRepo) {
+	ctx := context.Background()
+	today := domain.NewDayPrecisionTime(time.Date(2023, time.April, 30, 0, 0, 0, 0, time.Local))
+
+	got, err := repo.GetAllStreaks(ctx)
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	newer := domain.NewRatingStreakFromDB(today.PrevDay(), today)
+	older := domain.NewRatingStreakFromDB(today.PrevDay().PrevDay().PrevDay().PrevDay(), today.PrevDay().PrevDay().PrevDay())
+	other := domain.NewRatingStreakFromDB(today, today)
+	_, err = repo.CreateRatingStreak(ctx, "a@test", newer)
+	require.NoError(t, err)
+	_, err = repo.CreateRatingStreak(ctx, "a@test", older)
+	require.NoError(t, err)
+	_, err = repo.CreateRatingStreak(ctx, "allUsers", other)
+	require.NoError(t, err)
+
+	got, err = repo.GetAllStreaks(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]domain.RatingStreak{
+		"a@test":   {older, newer},
+		"allUsers": {other},
+	}, got)
+}
@@ -7,7 +7,6 @@ import (
 	migrate "github.com/rubenv/sql-migrate"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"itsTasty/pkg/api/domain"
 	"itsTasty/pkg/testutils"
 	"testing"
 )
@@ -33,7 +32,7 @@ func Test_NewPostgresRepo(t *testing.T) {
 
 func Test_Postgres_RunCommon(t *testing.T) {
 
-	commonFactory := func() (*PostgresRepo, factoryCleanupFunc, error) {
+	commonFactory := func() (commonTestRepo, factoryCleanupFunc, error) {
 		db, err := testutils.GlobalDockerPool.GetPostgresIntegrationTestDB()
 		if err != nil {
 			t.Fatalf("GetPostgresIntegrationTestDB failed : %v", err)
@@ -57,14 +56,7 @@ func Test_Postgres_RunCommon(t *testing.T) {
 		return repo, cleanupFunc, nil
 	}
 
-	dishFactory := func() (domain.DishRepo, factoryCleanupFunc, error) {
-		return commonFactory()
-	}
-	streakFactory := func() (domain.RatingStreakRepo, factoryCleanupFunc, error) {
-		return commonFactory()
-	}
-
-	runCommonDbTests(t, dishFactory, streakFactory, commonFactory)
+	runCommonDbTests(t, commonFactory)
 }
 
 func Test_arrayDiff(t *testing.T) {
@@ -0,0 +1,33 @@
+package dishRepo
+
+import (
+	"context"
+	"itsTasty/pkg/telemetry"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// queryTracer creates a span for each query of the PostgresRepo and counts failed queries.
+// The query arguments are not recorded as they contain user data
+type queryTracer struct{}
+
+func (q queryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	//span name is the sql command, e.g. SELECT, as suggested by the otel semantic conventions
+	name := "postgres"
+	if fields := strings.Fields(data.SQL); len(fields) > 0 {
+		name = strings.ToUpper(fields[0])
+	}
+	ctx, _ = telemetry.Tracer().Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemPostgreSQL, semconv.DBStatement(data.SQL)))
+	return ctx
+}
+
+func (q queryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	if data.Err != nil {
+		telemetry.DBErrors.WithLabelValues("postgres").Inc()
+	}
+	telemetry.EndSpan(trace.SpanFromContext(ctx), data.Err)
+}
@@ -0,0 +1,197 @@
+package dishRepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"itsTasty/pkg/api/adapters/dishRepo/sqlboilerPSQL"
+	"itsTasty/pkg/api/domain"
+	"strings"
+	"time"
+)
+
+func webhookEndpointToDomain(dbEndpoint *sqlboilerPSQL.WebhookEndpoint) domain.WebhookEndpoint {
+	return domain.WebhookEndpoint{
+		URL:        dbEndpoint.URL,
+		Secret:     dbEndpoint.Secret,
+		EventTypes: eventTypesFromDB(dbEndpoint.EventTypes),
+		CreatedAt:  dbEndpoint.CreatedAt,
+	}
+}
+
+func webhookDeliveryToDomain(dbDelivery *sqlboilerPSQL.WebhookDelivery) domain.WebhookDelivery {
+	return domain.WebhookDelivery{
+		EndpointID:    int64(dbDelivery.EndpointID),
+		EventType:     domain.EventType(dbDelivery.EventType),
+		Payload:       dbDelivery.Payload,
+		State:         domain.WebhookDeliveryState(dbDelivery.State),
+		Attempts:      dbDelivery.Attempts,
+		NextAttemptAt: dbDelivery.NextAttemptAt,
+		LastError:     dbDelivery.LastError,
+		CreatedAt:     dbDelivery.CreatedAt,
+		DeliveredAt:   dbDelivery.DeliveredAt.Ptr(),
+	}
+}
+
+// eventTypesToDB converts the event types to the comma separated list stored by all sql based repos
+func eventTypesToDB(eventTypes []domain.EventType) string {
+	asStrings := make([]string, len(eventTypes))
+	for i, v := range eventTypes {
+		asStrings[i] = string(v)
+	}
+	return strings.Join(asStrings, ",")
+}
+
+// eventTypesFromDB is the inverse of eventTypesToDB
+func eventTypesFromDB(eventTypes string) []domain.EventType {
+	result := make([]domain.EventType, 0)
+	if eventTypes != "" {
+		for _, v := range strings.Split(eventTypes, ",") {
+			result = append(result, domain.EventType(v))
+		}
+	}
+	return result
+}
+
+func (p *PostgresRepo) CreateWebhookEndpoint(ctx context.Context, endpoint domain.WebhookEndpoint) (int64, error) {
+	dbEndpoint := &sqlboilerPSQL.WebhookEndpoint{
+		URL:        endpoint.URL,
+		Secret:     endpoint.Secret,
+		EventTypes: eventTypesToDB(endpoint.EventTypes),
+		CreatedAt:  endpoint.CreatedAt,
+	}
+	if err := dbEndpoint.Insert(ctx, p.db, boil.Infer()); err != nil {
+		return 0, fmt.Errorf("failed to insert webhook endpoint : %w", err)
+	}
+	return int64(dbEndpoint.ID), nil
+}
+
+func (p *PostgresRepo) GetAllWebhookEndpoints(ctx context.Context) (map[int64]domain.WebhookEndpoint, error) {
+	dbEndpoints, err := sqlboilerPSQL.WebhookEndpoints().All(ctx, p.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhook endpoints : %w", err)
+	}
+
+	result := make(map[int64]domain.WebhookEndpoint, len(dbEndpoints))
+	for _, v := range dbEndpoints {
+		result[int64(v.ID)] = webhookEndpointToDomain(v)
+	}
+	return result, nil
+}
+
+func (p *PostgresRepo) DeleteWebhookEndpoint(ctx context.Context, id int64) error {
+	//deliveries are removed via "on delete cascade"
+	affected, err := sqlboilerPSQL.WebhookEndpoints(sqlboilerPSQL.WebhookEndpointWhere.ID.EQ(int(id))).DeleteAll(ctx, p.db)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook endpoint : %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (p *PostgresRepo) CreateWebhookDeliveries(ctx context.Context, deliveries []domain.WebhookDelivery) (err error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		err = fmt.Errorf("BeginTX : %w", err)
+		return
+	}
+	defer func() {
+		err = p.finishTransaction(err, tx)
+	}()
+
+	for _, v := range deliveries {
+		dbDelivery := &sqlboilerPSQL.WebhookDelivery{
+			EndpointID:    int(v.EndpointID),
+			EventType:     string(v.EventType),
+			Payload:       v.Payload,
+			State:         string(v.State),
+			Attempts:      v.Attempts,
+			NextAttemptAt: v.NextAttemptAt,
+			LastError:     v.LastError,
+			CreatedAt:     v.CreatedAt,
+			DeliveredAt:   null.TimeFromPtr(v.DeliveredAt),
+		}
+		if err = dbDelivery.Insert(ctx, tx, boil.Infer()); err != nil {
+			err = fmt.Errorf("failed to insert webhook delivery : %w", err)
+			return
+		}
+	}
+	return
+}
+
+func (p *PostgresRepo) GetDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) (map[int64]domain.WebhookDelivery, error) {
+	dbDeliveries, err := sqlboilerPSQL.WebhookDeliveries(
+		sqlboilerPSQL.WebhookDeliveryWhere.State.EQ(string(domain.WebhookDeliveryPending)),
+		sqlboilerPSQL.WebhookDeliveryWhere.NextAttemptAt.LTE(now),
+		qm.OrderBy(sqlboilerPSQL.WebhookDeliveryColumns.NextAttemptAt),
+		qm.Limit(limit),
+	).All(ctx, p.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query due webhook deliveries : %w", err)
+	}
+
+	result := make(map[int64]domain.WebhookDelivery, len(dbDeliveries))
+	for _, v := range dbDeliveries {
+		result[int64(v.ID)] = webhookDeliveryToDomain(v)
+	}
+	return result, nil
+}
+
+func (p *PostgresRepo) UpdateWebhookDelivery(ctx context.Context, id int64, updateFN domain.WebhookDeliveryUpdateFN) (err error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		err = fmt.Errorf("BeginTX : %w", err)
+		return
+	}
+	defer func() {
+		err = p.finishTransaction(err, tx)
+	}()
+
+	dbDelivery, err := sqlboilerPSQL.WebhookDeliveries(
+		sqlboilerPSQL.WebhookDeliveryWhere.ID.EQ(int(id)),
+		qm.For("update"),
+	).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = domain.ErrNotFound
+			return
+		}
+		err = fmt.Errorf("failed to query webhook delivery : %w", err)
+		return
+	}
+
+	var updated *domain.WebhookDelivery
+	updated, err = updateFN(webhookDeliveryToDomain(dbDelivery))
+	if err != nil {
+		err = fmt.Errorf("update function failed : %w", err)
+		return
+	}
+	//no update requested
+	if updated == nil {
+		return
+	}
+
+	dbDelivery.State = string(updated.State)
+	dbDelivery.Attempts = updated.Attempts
+	dbDelivery.NextAttemptAt = updated.NextAttemptAt
+	dbDelivery.LastError = updated.LastError
+	dbDelivery.DeliveredAt = null.TimeFromPtr(updated.DeliveredAt)
+	_, err = dbDelivery.Update(ctx, tx, boil.Whitelist(
+		sqlboilerPSQL.WebhookDeliveryColumns.State,
+		sqlboilerPSQL.WebhookDeliveryColumns.Attempts,
+		sqlboilerPSQL.WebhookDeliveryColumns.NextAttemptAt,
+		sqlboilerPSQL.WebhookDeliveryColumns.LastError,
+		sqlboilerPSQL.WebhookDeliveryColumns.DeliveredAt,
+	))
+	if err != nil {
+		err = fmt.Errorf("failed to update webhook delivery : %w", err)
+		return
+	}
+	return
+}
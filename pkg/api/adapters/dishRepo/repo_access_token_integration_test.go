@@ -0,0 +1,66 @@
+package dishRepo
+
+import (
+	"context"
+	"errors"
+	"itsTasty/pkg/api/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testAccessTokens_Create_Get_Delete(t *testing.T, repo domain.AccessTokenRepo) {
+	ctx := context.Background()
+	now := roundTimeToDBResolution(time.Now())
+
+	cliToken, cliSecret, err := domain.NewAccessToken("user1@test.mail", "cli", false, now)
+	require.NoError(t, err)
+	slackToken, _, err := domain.NewAccessToken("user1@test.mail", "slack", true, now)
+	require.NoError(t, err)
+	otherUserToken, _, err := domain.NewAccessToken("user2@test.mail", "cli", false, now)
+	require.NoError(t, err)
+
+	cliID, err := repo.CreateAccessToken(ctx, cliToken)
+	require.NoError(t, err)
+	slackID, err := repo.CreateAccessToken(ctx, slackToken)
+	require.NoError(t, err)
+	otherUserID, err := repo.CreateAccessToken(ctx, otherUserToken)
+	require.NoError(t, err)
+
+	got, err := repo.GetAccessTokensOfUser(ctx, "user1@test.mail")
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, "cli", got[cliID].Name)
+	require.False(t, got[cliID].ReadOnly)
+	require.True(t, got[slackID].ReadOnly)
+	require.True(t, cliToken.CreatedAt.Equal(got[cliID].CreatedAt))
+	require.Nil(t, got[cliID].LastUsedAt)
+
+	gotID, gotToken, err := repo.GetAccessTokenBySecretHash(ctx, domain.HashAccessTokenSecret(cliSecret))
+	require.NoError(t, err)
+	require.Equal(t, cliID, gotID)
+	require.Equal(t, "user1@test.mail", gotToken.UserEmail)
+	_, _, err = repo.GetAccessTokenBySecretHash(ctx, domain.HashAccessTokenSecret("itt_unknown"))
+	require.True(t, errors.Is(err, domain.ErrNotFound))
+
+	lastUsed := now.Add(time.Minute)
+	require.NoError(t, repo.SetAccessTokenLastUsed(ctx, cliID, lastUsed))
+	_, gotToken, err = repo.GetAccessTokenBySecretHash(ctx, cliToken.SecretHash)
+	require.NoError(t, err)
+	require.NotNil(t, gotToken.LastUsedAt)
+	require.True(t, lastUsed.Equal(*gotToken.LastUsedAt))
+
+	//users can only delete their own tokens
+	require.True(t, errors.Is(repo.DeleteAccessToken(ctx, "user1@test.mail", otherUserID), domain.ErrNotFound))
+	require.NoError(t, repo.DeleteAccessToken(ctx, "user1@test.mail", cliID))
+	require.True(t, errors.Is(repo.DeleteAccessToken(ctx, "user1@test.mail", cliID), domain.ErrNotFound))
+	require.True(t, errors.Is(repo.SetAccessTokenLastUsed(ctx, cliID, lastUsed), domain.ErrNotFound))
+
+	got, err = repo.GetAccessTokensOfUser(ctx, "user1@test.mail")
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	got, err = repo.GetAccessTokensOfUser(ctx, "user2@test.mail")
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+}
@@ -0,0 +1,312 @@
+package dishRepo
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"itsTasty/pkg/api/domain"
+	"testing"
+)
+
+// createDishFamilyTestDishes is a helper that creates the dish "Curry" at locationA, locationB and locationC as well
+// as "Pasta" at locationA
+func createDishFamilyTestDishes(t *testing.T, repo dishFamilyTestRepo) (curryA, curryB, curryC, pastaA int64) {
+	ctx := context.Background()
+	ids := make([]int64, 0, 4)
+	for _, v := range []struct{ name, location string }{
+		{"Curry", "locationA"}, {"Curry", "locationB"}, {"Curry", "locationC"}, {"Pasta", "locationA"},
+	} {
+		_, _, _, id, err := repo.GetOrCreateDish(ctx, v.name, v.location)
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	return ids[0], ids[1], ids[2], ids[3]
+}
+
+func testDishFamilies_Create_Get_Delete(t *testing.T, repo dishFamilyTestRepo) {
+	ctx := context.Background()
+	curryA, curryB, curryC, pastaA := createDishFamilyTestDishes(t, repo)
+
+	families, err := repo.GetAllDishFamilies(ctx)
+	require.NoError(t, err)
+	require.Empty(t, families)
+
+	family, err := domain.NewDishFamily(" Curry ", []domain.DishFamilyMember{
+		{DishID: curryB, ServedAt: "locationB"},
+		{DishID: curryA, ServedAt: "locationA"},
+	})
+	require.NoError(t, err)
+	id, err := repo.CreateDishFamily(ctx, *family)
+	require.NoError(t, err)
+
+	want := domain.DishFamily{
+		Name: "Curry",
+		Members: []domain.DishFamilyMember{
+			{DishID: curryA, ServedAt: "locationA"},
+			{DishID: curryB, ServedAt: "locationB"},
+		},
+	}
+	got, err := repo.GetDishFamilyByID(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+	families, err = repo.GetAllDishFamilies(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[int64]domain.DishFamily{id: want}, families)
+
+	familyID, err := repo.GetDishFamilyOfDish(ctx, curryB)
+	require.NoError(t, err)
+	require.Equal(t, id, familyID)
+	_, err = repo.GetDishFamilyOfDish(ctx, curryC)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+
+	//a dish may only be part of a single family
+	_, err = repo.CreateDishFamily(ctx, domain.DishFamily{Name: "Other", Members: []domain.DishFamilyMember{
+		{DishID: curryA, ServedAt: "locationA"},
+		{DishID: curryC, ServedAt: "locationC"},
+	}})
+	require.ErrorIs(t, err, domain.ErrDishAlreadyInFamily)
+	//the members must match the locations of the dishes
+	_, err = repo.CreateDishFamily(ctx, domain.DishFamily{Name: "Other", Members: []domain.DishFamilyMember{
+		{DishID: pastaA, ServedAt: "locationB"},
+		{DishID: curryC, ServedAt: "locationC"},
+	}})
+	require.ErrorIs(t, err, domain.ErrInvalidDishFamily)
+	//dishes of the same location are grouped by merged dishes
+	_, err = repo.CreateDishFamily(ctx, domain.DishFamily{Name: "Other", Members: []domain.DishFamilyMember{
+		{DishID: pastaA, ServedAt: "locationA"},
+		{DishID: curryA, ServedAt: "locationA"},
+	}})
+	require.ErrorIs(t, err, domain.ErrInvalidDishFamily)
+	_, err = repo.CreateDishFamily(ctx, domain.DishFamily{Name: "Other", Members: []domain.DishFamilyMember{
+		{DishID: 4242, ServedAt: "locationA"},
+		{DishID: curryC, ServedAt: "locationC"},
+	}})
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	families, err = repo.GetAllDishFamilies(ctx)
+	require.NoError(t, err)
+	require.Len(t, families, 1)
+
+	require.NoError(t, repo.DeleteDishFamily(ctx, id))
+	_, err = repo.GetDishFamilyByID(ctx, id)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = repo.GetDishFamilyOfDish(ctx, curryA)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	require.ErrorIs(t, repo.DeleteDishFamily(ctx, id), domain.ErrNotFound)
+	//the dishes are kept
+	_, err = repo.GetDishByID(ctx, curryA)
+	require.NoError(t, err)
+}
+
+func testDishFamilies_Update(t *testing.T, repo dishFamilyTestRepo) {
+	ctx := context.Background()
+	curryA, curryB, curryC, pastaA := createDishFamilyTestDishes(t, repo)
+
+	family, err := domain.NewDishFamily("Curry", []domain.DishFamilyMember{
+		{DishID: curryA, ServedAt: "locationA"},
+		{DishID: curryB, ServedAt: "locationB"},
+	})
+	require.NoError(t, err)
+	id, err := repo.CreateDishFamily(ctx, *family)
+	require.NoError(t, err)
+
+	err = repo.UpdateDishFamily(ctx, id, func(current domain.DishFamily) (*domain.DishFamily, error) {
+		require.NoError(t, current.AddDish(curryC, "locationC"))
+		require.NoError(t, current.RemoveDish(curryA))
+		current.Name = "Red Curry"
+		return &current, nil
+	})
+	require.NoError(t, err)
+	got, err := repo.GetDishFamilyByID(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, domain.DishFamily{
+		Name: "Red Curry",
+		Members: []domain.DishFamilyMember{
+			{DishID: curryB, ServedAt: "locationB"},
+			{DishID: curryC, ServedAt: "locationC"},
+		},
+	}, got)
+	_, err = repo.GetDishFamilyOfDish(ctx, curryA)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+
+	//(nil,nil) does not change anything
+	require.NoError(t, repo.UpdateDishFamily(ctx, id, func(current domain.DishFamily) (*domain.DishFamily, error) {
+		return nil, nil
+	}))
+	//errors of updateFN are passed on and abort the update
+	errUpdate := errors.New("update failed")
+	err = repo.UpdateDishFamily(ctx, id, func(current domain.DishFamily) (*domain.DishFamily, error) {
+		current.Name = "changed"
+		return nil, errUpdate
+	})
+	require.ErrorIs(t, err, errUpdate)
+	//invalid results are rejected
+	err = repo.UpdateDishFamily(ctx, id, func(current domain.DishFamily) (*domain.DishFamily, error) {
+		current.Members = append(current.Members, domain.DishFamilyMember{DishID: pastaA, ServedAt: "locationA"})
+		current.Name = ""
+		return &current, nil
+	})
+	require.ErrorIs(t, err, domain.ErrInvalidDishFamily)
+	got, err = repo.GetDishFamilyByID(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, "Red Curry", got.Name)
+	require.Len(t, got.Members, 2)
+
+	err = repo.UpdateDishFamily(ctx, 4242, func(current domain.DishFamily) (*domain.DishFamily, error) {
+		return &current, nil
+	})
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func testDishFamilies_MergeLocations(t *testing.T, repo dishFamilyTestRepo) {
+	ctx := context.Background()
+	curryA, curryB, curryC, pastaA := createDishFamilyTestDishes(t, repo)
+	createDish := func(name, location string) int64 {
+		_, _, _, id, err := repo.GetOrCreateDish(ctx, name, location)
+		require.NoError(t, err)
+		return id
+	}
+	createFamily := func(name string, members ...domain.DishFamilyMember) int64 {
+		family, err := domain.NewDishFamily(name, members)
+		require.NoError(t, err)
+		id, err := repo.CreateDishFamily(ctx, *family)
+		require.NoError(t, err)
+		return id
+	}
+	pastaB := createDish("Pasta", "locationB")
+	stewA := createDish("Stew", "locationA")
+	broth := createDish("Broth", "locationB")
+	stewC := createDish("Stew", "locationC")
+
+	//curryB is combined with curryA and takes its place
+	curry := createFamily("Curry", domain.DishFamilyMember{DishID: curryA, ServedAt: "locationA"},
+		domain.DishFamilyMember{DishID: curryC, ServedAt: "locationC"})
+	//pastaA is combined with pastaB, which leaves a single dish
+	pasta := createFamily("Pasta", domain.DishFamilyMember{DishID: pastaA, ServedAt: "locationA"},
+		domain.DishFamilyMember{DishID: pastaB, ServedAt: "locationB"})
+	//stewA is moved to the location of broth
+	stews := createFamily("Stews", domain.DishFamilyMember{DishID: stewA, ServedAt: "locationA"},
+		domain.DishFamilyMember{DishID: broth, ServedAt: "locationB"},
+		domain.DishFamilyMember{DishID: stewC, ServedAt: "locationC"})
+
+	want := domain.DishFamilyPlan{
+		JoiningDishes: []domain.DishFamilyMembership{{DishID: curryB, DishFamilyID: curry}},
+		LeavingDishes: []domain.DishFamilyMembership{
+			{DishID: curryA, DishFamilyID: curry},
+			{DishID: stewA, DishFamilyID: stews},
+		},
+		DissolvedFamilies: []int64{pasta},
+	}
+	sourceID := locationIDByName(t, repo, "locationA")
+	targetID := locationIDByName(t, repo, "locationB")
+	report, err := repo.MergeLocations(ctx, sourceID, targetID, true)
+	require.NoError(t, err)
+	require.Equal(t, want, report.DishFamilyChanges)
+	_, err = repo.GetDishFamilyByID(ctx, pasta)
+	require.NoError(t, err)
+
+	report, err = repo.MergeLocations(ctx, sourceID, targetID, false)
+	require.NoError(t, err)
+	require.Equal(t, want, report.DishFamilyChanges)
+
+	got, err := repo.GetDishFamilyByID(ctx, curry)
+	require.NoError(t, err)
+	require.Equal(t, []domain.DishFamilyMember{
+		{DishID: curryB, ServedAt: "locationB"},
+		{DishID: curryC, ServedAt: "locationC"},
+	}, got.Members)
+	_, err = repo.GetDishFamilyByID(ctx, pasta)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = repo.GetDishFamilyOfDish(ctx, pastaB)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	got, err = repo.GetDishFamilyByID(ctx, stews)
+	require.NoError(t, err)
+	require.Equal(t, []domain.DishFamilyMember{
+		{DishID: broth, ServedAt: "locationB"},
+		{DishID: stewC, ServedAt: "locationC"},
+	}, got.Members)
+	_, err = repo.GetDishFamilyOfDish(ctx, stewA)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	//all remaining families are still valid
+	families, err := repo.GetAllDishFamilies(ctx)
+	require.NoError(t, err)
+	require.Len(t, families, 2)
+	for _, v := range families {
+		require.NoError(t, v.Validate())
+	}
+}
+
+func testDishFamilies_DedupeDish(t *testing.T, repo dishFamilyTestRepo) {
+	ctx := context.Background()
+	const admin = "admin@test.mail"
+	curryA, curryB, curryC, _ := createDishFamilyTestDishes(t, repo)
+	createDish := func(name, location string) int64 {
+		_, _, _, id, err := repo.GetOrCreateDish(ctx, name, location)
+		require.NoError(t, err)
+		return id
+	}
+	createFamily := func(name string, members ...domain.DishFamilyMember) int64 {
+		family, err := domain.NewDishFamily(name, members)
+		require.NoError(t, err)
+		id, err := repo.CreateDishFamily(ctx, *family)
+		require.NoError(t, err)
+		return id
+	}
+
+	//the canonical dish takes the place of the duplicate dish
+	curry := createFamily("Curry", domain.DishFamilyMember{DishID: curryA, ServedAt: "locationA"},
+		domain.DishFamilyMember{DishID: curryB, ServedAt: "locationB"})
+	canonicalCurry := createDish("curry", "locationA")
+	dedupe, err := repo.DedupeDish(ctx, canonicalCurry, curryA, admin)
+	require.NoError(t, err)
+	require.Equal(t, &curry, dedupe.DishFamilyID)
+	require.True(t, dedupe.CanonicalJoinedDishFamily)
+	require.False(t, dedupe.DishFamilyDissolved)
+	got, err := repo.GetDishFamilyByID(ctx, curry)
+	require.NoError(t, err)
+	require.Equal(t, []domain.DishFamilyMember{
+		{DishID: curryB, ServedAt: "locationB"},
+		{DishID: canonicalCurry, ServedAt: "locationA"},
+	}, got.Members)
+	dedupes, err := repo.GetDishDedupes(ctx, canonicalCurry)
+	require.NoError(t, err)
+	require.Len(t, dedupes, 1)
+	require.Equal(t, dedupe.DishFamilyID, dedupes[0].DishFamilyID)
+	require.True(t, dedupes[0].CanonicalJoinedDishFamily)
+
+	//the canonical dish already belongs to a family, which leaves a single dish in the family of the duplicate dish
+	require.NoError(t, repo.UpdateDishFamily(ctx, curry, func(current domain.DishFamily) (*domain.DishFamily, error) {
+		require.NoError(t, current.AddDish(curryC, "locationC"))
+		require.NoError(t, current.RemoveDish(curryB))
+		return &current, nil
+	}))
+	duplicateCurry := createDish("CURRY", "locationC")
+	curries := createFamily("Curries", domain.DishFamilyMember{DishID: duplicateCurry, ServedAt: "locationC"},
+		domain.DishFamilyMember{DishID: curryB, ServedAt: "locationB"})
+	dedupe, err = repo.DedupeDish(ctx, curryC, duplicateCurry, admin)
+	require.NoError(t, err)
+	require.Equal(t, &curries, dedupe.DishFamilyID)
+	require.False(t, dedupe.CanonicalJoinedDishFamily)
+	require.True(t, dedupe.DishFamilyDissolved)
+	_, err = repo.GetDishFamilyByID(ctx, curries)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	got, err = repo.GetDishFamilyByID(ctx, curry)
+	require.NoError(t, err)
+	require.Equal(t, []domain.DishFamilyMember{
+		{DishID: curryC, ServedAt: "locationC"},
+		{DishID: canonicalCurry, ServedAt: "locationA"},
+	}, got.Members)
+	dedupes, err = repo.GetDishDedupes(ctx, curryC)
+	require.NoError(t, err)
+	require.Len(t, dedupes, 1)
+	require.Equal(t, &curries, dedupes[0].DishFamilyID)
+	require.True(t, dedupes[0].DishFamilyDissolved)
+
+	//dishes without family are not affected
+	plain := createDish("Pasta", "locationB")
+	duplicatePlain := createDish("pasta", "locationB")
+	dedupe, err = repo.DedupeDish(ctx, plain, duplicatePlain, admin)
+	require.NoError(t, err)
+	require.Nil(t, dedupe.DishFamilyID)
+	require.False(t, dedupe.CanonicalJoinedDishFamily)
+	require.False(t, dedupe.DishFamilyDissolved)
+}
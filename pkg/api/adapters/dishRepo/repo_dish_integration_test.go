@@ -10,9 +10,41 @@ import (
 )
 
 type factoryCleanupFunc func() error
-type dishRepoFactory func() (domain.DishRepo, factoryCleanupFunc, error)
-type ratingStreakRepoFactory func() (domain.RatingStreakRepo, factoryCleanupFunc, error)
-type statisticsRepoFactory func() (repo *PostgresRepo, cleanupFunc factoryCleanupFunc, err error)
+
+// commonTestRepo is implemented by all repos that run the common tests. A new repo interface is added here instead
+// of adding another factory, thus each backend only provides a single repoFactory
+type commonTestRepo interface {
+	domain.DishRepo
+	domain.RatingStreakRepo
+	domain.StatisticsRepo
+	domain.WebhookRepo
+	domain.LocationRepo
+	domain.DishFamilyRepo
+	domain.AccessTokenRepo
+}
+
+// repoFactory returns a new, empty repo for each test
+type repoFactory func() (commonTestRepo, factoryCleanupFunc, error)
+
+// statisticsTestRepo is required by the statistics tests, as they need to create dishes and ratings before
+// they can query any statistics
+type statisticsTestRepo interface {
+	domain.DishRepo
+	domain.StatisticsRepo
+}
+
+// locationTestRepo is required by the location tests, as locations are created implicitly with their first dish
+type locationTestRepo interface {
+	domain.DishRepo
+	domain.LocationRepo
+}
+
+// dishFamilyTestRepo is required by the dish family tests, as they need dishes to group and locations to merge
+type dishFamilyTestRepo interface {
+	domain.DishRepo
+	domain.DishFamilyRepo
+	domain.LocationRepo
+}
 
 type dbTestFunc func(t *testing.T, repo domain.DishRepo)
 
@@ -22,8 +54,7 @@ func roundTimeToDBResolution(t time.Time) time.Time {
 	return t.Round(time.Second)
 }
 
-func runCommonDbTests(t *testing.T, dishFactory dishRepoFactory, ratingStreakFactory ratingStreakRepoFactory,
-	statisticsFactory statisticsRepoFactory) {
+func runCommonDbTests(t *testing.T, factory repoFactory) {
 
 	type commonDbTest struct {
 		Name     string
@@ -38,10 +69,26 @@ func runCommonDbTests(t *testing.T, dishFactory dishRepoFactory, ratingStreakFac
 			Name:     "GetOrCreateDish_CheckNotFoundError",
 			TestFunc: testRepo_GetOrCreateDish_CheckNotFoundError,
 		},
+		{
+			Name:     "GetOrCreateDishWithOccurrences",
+			TestFunc: testRepo_GetOrCreateDishWithOccurrences,
+		},
 		{
 			Name:     "GetAllDishesSimple",
 			TestFunc: testRepo_GetAllDishIDs,
 		},
+		{
+			Name:     "RenameDish",
+			TestFunc: testRepo_RenameDish,
+		},
+		{
+			Name:     "DishTags",
+			TestFunc: testRepo_DishTags,
+		},
+		{
+			Name:     "DedupeDish",
+			TestFunc: testRepo_DedupeDish,
+		},
 		{
 			Name:     "UpdateMostRecentServing",
 			TestFunc: testRepo_UpdateMostRecentServing,
@@ -76,7 +123,7 @@ func runCommonDbTests(t *testing.T, dishFactory dishRepoFactory, ratingStreakFac
 		test := tests[i]
 		t.Run(test.Name, func(t *testing.T) {
 			t.Parallel()
-			repo, cleanup, err := dishFactory()
+			repo, cleanup, err := factory()
 			require.NoError(t, err)
 			defer func() {
 				if err := cleanup(); err != nil {
@@ -96,13 +143,17 @@ func runCommonDbTests(t *testing.T, dishFactory dishRepoFactory, ratingStreakFac
 			Name:     "Create_Get_Update",
 			TestFunc: testStreak_Create_Get_Update,
 		},
+		{
+			Name:     "GetAllStreaks",
+			TestFunc: testStreak_GetAllStreaks,
+		},
 	}
 
 	for i := range streakTests {
 		test := streakTests[i]
 		t.Run(test.Name, func(t *testing.T) {
 			t.Parallel()
-			repo, cleanup, err := ratingStreakFactory()
+			repo, cleanup, err := factory()
 			require.NoError(t, err)
 			defer func() {
 				if err := cleanup(); err != nil {
@@ -116,7 +167,7 @@ func runCommonDbTests(t *testing.T, dishFactory dishRepoFactory, ratingStreakFac
 
 	type statisticsDbTest struct {
 		Name     string
-		TestFunc func(t *testing.T, repo *PostgresRepo)
+		TestFunc func(t *testing.T, repo statisticsTestRepo)
 	}
 	statisticsTests := []statisticsDbTest{
 		{
@@ -127,12 +178,148 @@ func runCommonDbTests(t *testing.T, dishFactory dishRepoFactory, ratingStreakFac
 			Name:     "GetAllRatingsForDate",
 			TestFunc: testStatistics_GetAllRatingsForDate,
 		},
+		{
+			Name:     "GetAllRatingsOfUser",
+			TestFunc: testStatistics_GetAllRatingsOfUser,
+		},
+		{
+			Name:     "GetRatingSummaryPerUser",
+			TestFunc: testStatistics_GetRatingSummaryPerUser,
+		},
 	}
 	for i := range statisticsTests {
 		test := statisticsTests[i]
 		t.Run(test.Name, func(t *testing.T) {
 			t.Parallel()
-			repo, cleanup, err := statisticsFactory()
+			repo, cleanup, err := factory()
+			require.NoError(t, err)
+			defer func() {
+				if err := cleanup(); err != nil {
+					t.Fatalf("Cleanup failed : %v", err)
+				}
+			}()
+
+			test.TestFunc(t, repo)
+		})
+	}
+
+	type webhookDbTest struct {
+		Name     string
+		TestFunc func(t *testing.T, repo domain.WebhookRepo)
+	}
+	webhookTests := []webhookDbTest{
+		{
+			Name:     "WebhookEndpoints_Create_Get_Delete",
+			TestFunc: testWebhook_Endpoints_Create_Get_Delete,
+		},
+		{
+			Name:     "WebhookDeliveries_Due_Update",
+			TestFunc: testWebhook_Deliveries_Due_Update,
+		},
+	}
+	for i := range webhookTests {
+		test := webhookTests[i]
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			repo, cleanup, err := factory()
+			require.NoError(t, err)
+			defer func() {
+				if err := cleanup(); err != nil {
+					t.Fatalf("Cleanup failed : %v", err)
+				}
+			}()
+
+			test.TestFunc(t, repo)
+		})
+	}
+
+	type locationDbTest struct {
+		Name     string
+		TestFunc func(t *testing.T, repo locationTestRepo)
+	}
+	locationTests := []locationDbTest{
+		{
+			Name:     "Locations_Get_Update",
+			TestFunc: testLocations_Get_Update,
+		},
+		{
+			Name:     "Locations_Aliases",
+			TestFunc: testLocations_Aliases,
+		},
+		{
+			Name:     "Locations_Merge",
+			TestFunc: testLocations_Merge,
+		},
+	}
+	for i := range locationTests {
+		test := locationTests[i]
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			repo, cleanup, err := factory()
+			require.NoError(t, err)
+			defer func() {
+				if err := cleanup(); err != nil {
+					t.Fatalf("Cleanup failed : %v", err)
+				}
+			}()
+
+			test.TestFunc(t, repo)
+		})
+	}
+
+	type dishFamilyDbTest struct {
+		Name     string
+		TestFunc func(t *testing.T, repo dishFamilyTestRepo)
+	}
+	dishFamilyTests := []dishFamilyDbTest{
+		{
+			Name:     "DishFamilies_Create_Get_Delete",
+			TestFunc: testDishFamilies_Create_Get_Delete,
+		},
+		{
+			Name:     "DishFamilies_Update",
+			TestFunc: testDishFamilies_Update,
+		},
+		{
+			Name:     "DishFamilies_MergeLocations",
+			TestFunc: testDishFamilies_MergeLocations,
+		},
+		{
+			Name:     "DishFamilies_DedupeDish",
+			TestFunc: testDishFamilies_DedupeDish,
+		},
+	}
+	for i := range dishFamilyTests {
+		test := dishFamilyTests[i]
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			repo, cleanup, err := factory()
+			require.NoError(t, err)
+			defer func() {
+				if err := cleanup(); err != nil {
+					t.Fatalf("Cleanup failed : %v", err)
+				}
+			}()
+
+			test.TestFunc(t, repo)
+		})
+	}
+
+	type accessTokenDbTest struct {
+		Name     string
+		TestFunc func(t *testing.T, repo domain.AccessTokenRepo)
+	}
+	accessTokenTests := []accessTokenDbTest{
+		{
+			Name:     "AccessTokens_Create_Get_Delete",
+			TestFunc: testAccessTokens_Create_Get_Delete,
+		},
+	}
+	for i := range accessTokenTests {
+		test := accessTokenTests[i]
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			repo, cleanup, err := factory()
 			require.NoError(t, err)
 			defer func() {
 				if err := cleanup(); err != nil {
@@ -200,6 +387,214 @@ func testRepo_GetOrCreateDish_CheckNotFoundError(t *testing.T, repo domain.DishR
 	require.Equal(t, err, domain.ErrNotFound)
 }
 
+func testRepo_GetOrCreateDishWithOccurrences(t *testing.T, repo domain.DishRepo) {
+	ctx := context.Background()
+	day1 := roundTimeToDBResolution(time.Date(2023, time.March, 1, 0, 0, 0, 0, time.Local))
+	day2 := day1.Add(24 * time.Hour)
+	day3 := day2.Add(24 * time.Hour)
+
+	//creates dish and location without adding a serving for today
+	dishID, err := repo.GetOrCreateDishWithOccurrences(ctx, "dishA", "locationA", []time.Time{day1, day2})
+	require.NoError(t, err)
+	dish, err := repo.GetDishByID(ctx, dishID)
+	require.NoError(t, err)
+	require.Equal(t, "dishA", dish.Name)
+	require.Equal(t, "locationA", dish.ServedAt)
+	require.Len(t, dish.Occurrences(), 2)
+	require.True(t, day1.Equal(dish.Occurrences()[0]))
+	require.True(t, day2.Equal(dish.Occurrences()[1]))
+
+	//known occurrences are not added twice
+	gotDishID, err := repo.GetOrCreateDishWithOccurrences(ctx, "dishA", "locationA", []time.Time{day2, day3})
+	require.NoError(t, err)
+	require.Equal(t, dishID, gotDishID)
+	dish, err = repo.GetDishByID(ctx, dishID)
+	require.NoError(t, err)
+	require.Len(t, dish.Occurrences(), 3)
+	require.True(t, day3.Equal(dish.Occurrences()[2]))
+
+	//works for dishes created via GetOrCreateDish
+	_, _, _, otherDishID, err := repo.GetOrCreateDish(ctx, "dishB", "locationA")
+	require.NoError(t, err)
+	gotDishID, err = repo.GetOrCreateDishWithOccurrences(ctx, "dishB", "locationA", []time.Time{day1})
+	require.NoError(t, err)
+	require.Equal(t, otherDishID, gotDishID)
+	dish, err = repo.GetDishByID(ctx, otherDishID)
+	require.NoError(t, err)
+	require.Len(t, dish.Occurrences(), 2)
+}
+
+func testRepo_RenameDish(t *testing.T, repo domain.DishRepo) {
+	ctx := context.Background()
+	_, _, _, dishA, err := repo.GetOrCreateDish(ctx, "dishA", "locationA")
+	require.NoError(t, err)
+	_, _, _, dishB, err := repo.GetOrCreateDish(ctx, "dishB", "locationA")
+	require.NoError(t, err)
+	_, _, _, _, err = repo.GetOrCreateDish(ctx, "dishA renamed", "locationB")
+	require.NoError(t, err)
+	mergedDish, err := domain.NewMergedDish("merged", domain.NewDishToday("dishA", "locationA"),
+		domain.NewDishToday("dishB", "locationA"), nil)
+	require.NoError(t, err)
+	mergedDishID, err := repo.CreateMergedDish(ctx, mergedDish)
+	require.NoError(t, err)
+
+	//name is only unique per location
+	require.NoError(t, repo.RenameDish(ctx, dishA, "dishA renamed"))
+	dish, err := repo.GetDishByID(ctx, dishA)
+	require.NoError(t, err)
+	require.Equal(t, "dishA renamed", dish.Name)
+	require.Len(t, dish.Occurrences(), 1)
+	_, _, err = repo.GetDishByName(ctx, "dishA", "locationA")
+	require.ErrorIs(t, err, domain.ErrNotFound)
+
+	//merged dish membership is kept
+	isMerged, gotMergedDishID, err := repo.IsDishPartOfMergedDisByID(ctx, dishA)
+	require.NoError(t, err)
+	require.True(t, isMerged)
+	require.Equal(t, mergedDishID, gotMergedDishID)
+
+	//renaming to the current name is a no-op
+	require.NoError(t, repo.RenameDish(ctx, dishA, "dishA renamed"))
+
+	require.ErrorIs(t, repo.RenameDish(ctx, dishB, "dishA renamed"), domain.ErrDishAlreadyExists)
+	require.ErrorIs(t, repo.RenameDish(ctx, 4242, "dishC"), domain.ErrNotFound)
+}
+
+func testRepo_DishTags(t *testing.T, repo domain.DishRepo) {
+	ctx := context.Background()
+	_, _, _, dishID, err := repo.GetOrCreateDish(ctx, "dishA", "locationA")
+	require.NoError(t, err)
+
+	tags, err := repo.GetDishTags(ctx, dishID)
+	require.NoError(t, err)
+	require.Empty(t, tags)
+
+	require.NoError(t, repo.AddDishTags(ctx, dishID, []string{"vegan", "spicy"}))
+	//known tags are ignored
+	require.NoError(t, repo.AddDishTags(ctx, dishID, []string{"vegan", "new"}))
+	tags, err = repo.GetDishTags(ctx, dishID)
+	require.NoError(t, err)
+	require.Equal(t, []string{"new", "spicy", "vegan"}, tags)
+
+	require.ErrorIs(t, repo.AddDishTags(ctx, 4242, []string{"vegan"}), domain.ErrNotFound)
+	_, err = repo.GetDishTags(ctx, 4242)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func testRepo_DedupeDish(t *testing.T, repo domain.DishRepo) {
+	ctx := context.Background()
+	day1 := roundTimeToDBResolution(time.Date(2023, time.March, 1, 12, 0, 0, 0, time.Local))
+	day2 := day1.Add(24 * time.Hour)
+	day3 := day2.Add(24 * time.Hour)
+	const admin = "admin@test.mail"
+
+	createDish := func(name, location string, occurrences ...time.Time) int64 {
+		id, err := repo.GetOrCreateDishWithOccurrences(ctx, name, location, occurrences)
+		require.NoError(t, err)
+		return id
+	}
+	rate := func(user string, dishID int64, value domain.Rating, when time.Time) {
+		err := repo.CreateOrUpdateRating(ctx, user, dishID, func(*domain.DishRating) (*domain.DishRating, bool, error) {
+			rating := domain.NewDishRating(user, value, when)
+			return &rating, true, nil
+		})
+		require.NoError(t, err)
+	}
+	createMergedDish := func(name, location, dish1, dish2 string) int64 {
+		mergedDish, err := domain.NewMergedDish(name, domain.NewDishToday(dish1, location),
+			domain.NewDishToday(dish2, location), nil)
+		require.NoError(t, err)
+		id, err := repo.CreateMergedDish(ctx, mergedDish)
+		require.NoError(t, err)
+		return id
+	}
+
+	//only the duplicate is part of a merged dish, the canonical dish takes its place
+	pasta := createDish("Pasta", "locationA", day1, day2)
+	duplicatePasta := createDish("pasta ", "locationA", day2, day3)
+	createDish("Curry", "locationA", day1)
+	noodles := createMergedDish("Noodles", "locationA", "pasta ", "Curry")
+	require.NoError(t, repo.AddDishTags(ctx, duplicatePasta, []string{"vegan"}))
+	rate("userA@test.mail", pasta, domain.OneStar, day2)
+	rate("userA@test.mail", duplicatePasta, domain.FiveStars, day2.Add(time.Hour))
+	rate("userB@test.mail", duplicatePasta, domain.ThreeStars, day3)
+
+	dedupe, err := repo.DedupeDish(ctx, pasta, duplicatePasta, admin)
+	require.NoError(t, err)
+	require.NotZero(t, dedupe.PerformedAt)
+	dedupe.PerformedAt = time.Time{}
+	want := domain.DishDedupe{
+		CanonicalDishID:    pasta,
+		DuplicateDishID:    duplicatePasta,
+		DuplicateDishName:  "pasta ",
+		PerformedBy:        admin,
+		MovedOccurrences:   1,
+		DroppedOccurrences: 1,
+		MovedRatings:       2,
+		DroppedRatings:     1,
+	}
+	require.Equal(t, want, dedupe)
+
+	_, err = repo.GetDishByID(ctx, duplicatePasta)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	dish, err := repo.GetDishByID(ctx, pasta)
+	require.NoError(t, err)
+	require.Len(t, dish.Occurrences(), 3)
+	ratings, err := repo.GetAllRatingsForDish(ctx, pasta)
+	require.NoError(t, err)
+	gotRatings := make(map[string][]domain.Rating)
+	for _, v := range ratings {
+		gotRatings[v.Who] = append(gotRatings[v.Who], v.Value)
+	}
+	require.Equal(t, map[string][]domain.Rating{
+		"userA@test.mail": {domain.FiveStars},
+		"userB@test.mail": {domain.ThreeStars},
+	}, gotRatings)
+	tags, err := repo.GetDishTags(ctx, pasta)
+	require.NoError(t, err)
+	require.Equal(t, []string{"vegan"}, tags)
+	isMerged, mergedDishID, err := repo.IsDishPartOfMergedDisByID(ctx, pasta)
+	require.NoError(t, err)
+	require.True(t, isMerged)
+	require.Equal(t, noodles, mergedDishID)
+	mergedDish, err := repo.GetMergedDishByID(ctx, noodles)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"Pasta", "Curry"}, mergedDish.GetCondensedDishNames())
+
+	dedupes, err := repo.GetDishDedupes(ctx, pasta)
+	require.NoError(t, err)
+	require.Len(t, dedupes, 1)
+	dedupes[0].PerformedAt = time.Time{}
+	require.Equal(t, want, dedupes[0])
+
+	//merged dishes that are left with a single dish are deleted
+	soup := createDish("Soup", "locationA", day1)
+	createDish("SOUP", "locationA", day2)
+	soups := createMergedDish("Soups", "locationA", "Soup", "SOUP")
+	_, duplicateSoup, err := repo.GetDishByName(ctx, "SOUP", "locationA")
+	require.NoError(t, err)
+	_, err = repo.DedupeDish(ctx, soup, duplicateSoup, admin)
+	require.NoError(t, err)
+	_, err = repo.GetMergedDishByID(ctx, soups)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	isMerged, _, err = repo.IsDishPartOfMergedDisByID(ctx, soup)
+	require.NoError(t, err)
+	require.False(t, isMerged)
+
+	//invalid requests
+	other := createDish("Pasta", "locationB", day1)
+	_, err = repo.DedupeDish(ctx, pasta, other, admin)
+	require.ErrorIs(t, err, domain.ErrNotOnSameLocation)
+	_, err = repo.DedupeDish(ctx, pasta, pasta, admin)
+	require.ErrorIs(t, err, domain.ErrInvalidDishDedupe)
+	_, err = repo.DedupeDish(ctx, pasta, soup, "")
+	require.ErrorIs(t, err, domain.ErrInvalidDishDedupe)
+	_, err = repo.DedupeDish(ctx, pasta, 4242, admin)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = repo.GetDishDedupes(ctx, 4242)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
+
 func testRepo_GetAllDishIDs(t *testing.T, repo domain.DishRepo) {
 	//Initially there should be no dish
 	ids, err := repo.GetAllDishesSimple(context.Background())
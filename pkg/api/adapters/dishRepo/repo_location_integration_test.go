@@ -0,0 +1,266 @@
+package dishRepo
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"itsTasty/pkg/api/domain"
+	"testing"
+	"time"
+)
+
+// locationIDByName is a helper that returns the id of the location with the given name
+func locationIDByName(t *testing.T, repo locationTestRepo, name string) int64 {
+	locations, err := repo.GetAllLocations(context.Background())
+	require.NoError(t, err)
+	for id, v := range locations {
+		if v.Name == name {
+			return id
+		}
+	}
+	t.Fatalf("location %v not found", name)
+	return 0
+}
+
+func testLocations_Get_Update(t *testing.T, repo locationTestRepo) {
+	ctx := context.Background()
+
+	locations, err := repo.GetAllLocations(ctx)
+	require.NoError(t, err)
+	require.Empty(t, locations)
+
+	_, _, _, _, err = repo.GetOrCreateDish(ctx, "dishA", "locationA")
+	require.NoError(t, err)
+	_, _, _, _, err = repo.GetOrCreateDish(ctx, "dishB", "locationB")
+	require.NoError(t, err)
+
+	locations, err = repo.GetAllLocations(ctx)
+	require.NoError(t, err)
+	require.Len(t, locations, 2)
+	idA := locationIDByName(t, repo, "locationA")
+	require.Equal(t, domain.NewLocation("locationA"), locations[idA])
+
+	want := domain.Location{
+		Name:         "locationA",
+		DisplayName:  "Location A",
+		Address:      "Main Street 1",
+		OpeningHours: "Mo-Fr 11:30-14:00",
+		Aliases:      []string{"locA", "Location-A"},
+		Archived:     true,
+	}
+	err = repo.UpdateLocation(ctx, idA, func(current domain.Location) (*domain.Location, error) {
+		require.Equal(t, "locationA", current.Name)
+		updated := want
+		return &updated, nil
+	})
+	require.NoError(t, err)
+	got, err := repo.GetLocationByID(ctx, idA)
+	require.NoError(t, err)
+	want.Aliases = []string{"Location-A", "locA"}
+	require.Equal(t, want, got)
+
+	//(nil,nil) does not change anything
+	require.NoError(t, repo.UpdateLocation(ctx, idA, func(current domain.Location) (*domain.Location, error) {
+		return nil, nil
+	}))
+	got, err = repo.GetLocationByID(ctx, idA)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	//errors of updateFN are passed on and abort the update
+	errUpdate := errors.New("update failed")
+	err = repo.UpdateLocation(ctx, idA, func(current domain.Location) (*domain.Location, error) {
+		return nil, errUpdate
+	})
+	require.ErrorIs(t, err, errUpdate)
+
+	//the name is immutable
+	err = repo.UpdateLocation(ctx, idA, func(current domain.Location) (*domain.Location, error) {
+		current.Name = "renamed"
+		return &current, nil
+	})
+	require.ErrorIs(t, err, domain.ErrInvalidLocation)
+
+	_, err = repo.GetLocationByID(ctx, 4242)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	err = repo.UpdateLocation(ctx, 4242, func(current domain.Location) (*domain.Location, error) {
+		return &current, nil
+	})
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func testLocations_Aliases(t *testing.T, repo locationTestRepo) {
+	ctx := context.Background()
+	_, _, _, _, err := repo.GetOrCreateDish(ctx, "dishA", "locationA")
+	require.NoError(t, err)
+	_, _, _, _, err = repo.GetOrCreateDish(ctx, "dishB", "locationB")
+	require.NoError(t, err)
+	idA := locationIDByName(t, repo, "locationA")
+	idB := locationIDByName(t, repo, "locationB")
+
+	setAliases := func(id int64, aliases ...string) error {
+		return repo.UpdateLocation(ctx, id, func(current domain.Location) (*domain.Location, error) {
+			current.Aliases = aliases
+			return &current, nil
+		})
+	}
+
+	require.NoError(t, setAliases(idA, "locA", "  A  "))
+	for alias, want := range map[string]string{"locA": "locationA", "A": "locationA", "locationA": "locationA",
+		"unknown": "unknown"} {
+		got, err := repo.ResolveLocationName(ctx, alias)
+		require.NoError(t, err)
+		require.Equal(t, want, got, alias)
+	}
+
+	//aliases may neither be used twice nor collide with the name of another location
+	require.ErrorIs(t, setAliases(idB, "locA"), domain.ErrLocationAliasConflict)
+	require.ErrorIs(t, setAliases(idB, "locationA"), domain.ErrLocationAliasConflict)
+	require.ErrorIs(t, setAliases(idB, "locationB"), domain.ErrInvalidLocation)
+	require.ErrorIs(t, setAliases(idB, "dup", "dup"), domain.ErrInvalidLocation)
+	require.ErrorIs(t, setAliases(idB, ""), domain.ErrInvalidLocation)
+
+	//updating the aliases replaces the old ones
+	require.NoError(t, setAliases(idA, "newA"))
+	require.NoError(t, setAliases(idB, "locA"))
+	got, err := repo.ResolveLocationName(ctx, "locA")
+	require.NoError(t, err)
+	require.Equal(t, "locationB", got)
+	location, err := repo.GetLocationByID(ctx, idA)
+	require.NoError(t, err)
+	require.Equal(t, []string{"newA"}, location.Aliases)
+}
+
+func testLocations_Merge(t *testing.T, repo locationTestRepo) {
+	ctx := context.Background()
+	day1 := roundTimeToDBResolution(time.Date(2023, time.March, 1, 12, 0, 0, 0, time.Local))
+	day2 := day1.Add(24 * time.Hour)
+	day3 := day2.Add(24 * time.Hour)
+
+	createDish := func(name, location string, occurrences ...time.Time) int64 {
+		id, err := repo.GetOrCreateDishWithOccurrences(ctx, name, location, occurrences)
+		require.NoError(t, err)
+		return id
+	}
+	rate := func(user string, dishID int64, value domain.Rating, when time.Time) {
+		err := repo.CreateOrUpdateRating(ctx, user, dishID, func(*domain.DishRating) (*domain.DishRating, bool, error) {
+			rating := domain.NewDishRating(user, value, when)
+			return &rating, true, nil
+		})
+		require.NoError(t, err)
+	}
+	createMergedDish := func(name, location string, dishes ...string) int64 {
+		mergedDish, err := domain.NewMergedDish(name, domain.NewDishToday(dishes[0], location),
+			domain.NewDishToday(dishes[1], location), nil)
+		require.NoError(t, err)
+		id, err := repo.CreateMergedDish(ctx, mergedDish)
+		require.NoError(t, err)
+		return id
+	}
+
+	//the source location contains a dish that also exists at the target location and a merged dish whose
+	//dish conflicts with a dish of a merged dish at the target location
+	sourcePasta := createDish("Pasta", "Mensa Alt", day1, day2)
+	curry := createDish("Curry", "Mensa Alt", day1)
+	sourceSoup := createDish("Soup", "Mensa Alt", day1)
+	stew := createDish("Stew", "Mensa Alt", day1)
+	stews := createMergedDish("Stews", "Mensa Alt", "Soup", "Stew")
+	targetPasta := createDish("Pasta", "Mensa", day2, day3)
+	targetSoup := createDish("Soup", "Mensa", day2)
+	createDish("Broth", "Mensa", day2)
+	broths := createMergedDish("Broths", "Mensa", "Soup", "Broth")
+	require.NoError(t, repo.AddDishTags(ctx, sourcePasta, []string{"vegan"}))
+
+	//userA rated the same serving at both locations, only the newest rating is kept
+	rate("userA@test.mail", sourcePasta, domain.FiveStars, day2.Add(time.Hour))
+	rate("userA@test.mail", targetPasta, domain.OneStar, day2)
+	rate("userB@test.mail", sourcePasta, domain.ThreeStars, day1)
+	rate("userB@test.mail", targetPasta, domain.FourStars, day3)
+
+	sourceID := locationIDByName(t, repo, "Mensa Alt")
+	targetID := locationIDByName(t, repo, "Mensa")
+	require.NoError(t, repo.UpdateLocation(ctx, sourceID, func(current domain.Location) (*domain.Location, error) {
+		current.Aliases = []string{"Old Mensa"}
+		return &current, nil
+	}))
+
+	want := domain.LocationMergeReport{
+		SourceLocation: "Mensa Alt",
+		TargetLocation: "Mensa",
+		MovedDishes:    []int64{curry, stew},
+		CombinedDishes: []domain.DishCombination{
+			{SourceDishID: sourcePasta, TargetDishID: targetPasta, Name: "Pasta"},
+			{SourceDishID: sourceSoup, TargetDishID: targetSoup, Name: "Soup"},
+		},
+		MovedMergedDishes:    []int64{},
+		CombinedMergedDishes: []domain.MergedDishCombination{{SourceMergedDishID: stews, TargetMergedDishID: broths}},
+		MembershipChanges:    []domain.MembershipChange{{DishID: stew, MergedDishID: broths}},
+		DishFamilyChanges: domain.DishFamilyPlan{
+			JoiningDishes:     []domain.DishFamilyMembership{},
+			LeavingDishes:     []domain.DishFamilyMembership{},
+			DissolvedFamilies: []int64{},
+		},
+		DryRun: true,
+	}
+
+	//dry run does not change anything
+	report, err := repo.MergeLocations(ctx, sourceID, targetID, true)
+	require.NoError(t, err)
+	require.Equal(t, want, report)
+	_, err = repo.GetLocationByID(ctx, sourceID)
+	require.NoError(t, err)
+	_, _, err = repo.GetDishByName(ctx, "Curry", "Mensa Alt")
+	require.NoError(t, err)
+
+	report, err = repo.MergeLocations(ctx, sourceID, targetID, false)
+	require.NoError(t, err)
+	want.DryRun = false
+	require.Equal(t, want, report)
+
+	//the source location is gone but its names resolve to the target location
+	_, err = repo.GetLocationByID(ctx, sourceID)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	target, err := repo.GetLocationByID(ctx, targetID)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Mensa Alt", "Old Mensa"}, target.Aliases)
+	resolved, err := repo.ResolveLocationName(ctx, "Mensa Alt")
+	require.NoError(t, err)
+	require.Equal(t, "Mensa", resolved)
+
+	//moved dishes keep their id
+	dish, err := repo.GetDishByID(ctx, curry)
+	require.NoError(t, err)
+	require.Equal(t, "Mensa", dish.ServedAt)
+
+	//combined dishes
+	_, err = repo.GetDishByID(ctx, sourcePasta)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	pasta, err := repo.GetDishByID(ctx, targetPasta)
+	require.NoError(t, err)
+	require.Len(t, pasta.Occurrences(), 3)
+	ratings, err := repo.GetAllRatingsForDish(ctx, targetPasta)
+	require.NoError(t, err)
+	gotRatings := make(map[string][]domain.Rating)
+	for _, v := range ratings {
+		gotRatings[v.Who] = append(gotRatings[v.Who], v.Value)
+	}
+	require.Len(t, gotRatings["userA@test.mail"], 1)
+	require.Equal(t, domain.FiveStars, gotRatings["userA@test.mail"][0])
+	require.ElementsMatch(t, []domain.Rating{domain.ThreeStars, domain.FourStars}, gotRatings["userB@test.mail"])
+	tags, err := repo.GetDishTags(ctx, targetPasta)
+	require.NoError(t, err)
+	require.Equal(t, []string{"vegan"}, tags)
+
+	//merged dishes
+	_, err = repo.GetMergedDishByID(ctx, stews)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	mergedDish, err := repo.GetMergedDishByID(ctx, broths)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"Soup", "Broth", "Stew"}, mergedDish.GetCondensedDishNames())
+
+	//invalid requests
+	_, err = repo.MergeLocations(ctx, targetID, targetID, false)
+	require.ErrorIs(t, err, domain.ErrInvalidLocation)
+	_, err = repo.MergeLocations(ctx, sourceID, targetID, true)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
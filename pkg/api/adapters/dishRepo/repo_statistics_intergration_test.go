@@ -8,7 +8,7 @@ import (
 	"time"
 )
 
-func testStatistics_GetAllUsers(t *testing.T, repo *PostgresRepo) {
+func testStatistics_GetAllUsers(t *testing.T, repo statisticsTestRepo) {
 
 	ctx := context.Background()
 	wantDishName := "testDish"
@@ -43,7 +43,7 @@ func testStatistics_GetAllUsers(t *testing.T, repo *PostgresRepo) {
 	require.ElementsMatch(t, wantUsers, gotUsers)
 }
 
-func testStatistics_GetAllRatingsForDate(t *testing.T, repo *PostgresRepo) {
+func testStatistics_GetAllRatingsForDate(t *testing.T, repo statisticsTestRepo) {
 
 	ctx := context.Background()
 	wantDishName := "testDish"
@@ -87,3 +87,78 @@ func testStatistics_GetAllRatingsForDate(t *testing.T, repo *PostgresRepo) {
 	require.NoError(t, err)
 	require.ElementsMatch(t, wantRatings, gotRatings)
 }
+
+func testStatistics_GetAllRatingsOfUser(t *testing.T, repo statisticsTestRepo) {
+
+	ctx := context.Background()
+	wantDishLocation := "testLocation"
+
+	wantUser1 := "user1@testuser"
+	wantUser2 := "user2@testuser"
+
+	date1 := roundTimeToDBResolution(time.Now())
+	date2 := roundTimeToDBResolution(time.Now().Add(24 * time.Hour))
+
+	_, _, _, dishID1, err := repo.GetOrCreateDish(ctx, "testDish1", wantDishLocation)
+	require.NoError(t, err)
+	_, _, _, dishID2, err := repo.GetOrCreateDish(ctx, "testDish2", wantDishLocation)
+	require.NoError(t, err)
+
+	//user1 rates both dishes, user2 only rates dish1
+	wantRatings := []domain.RatingOfDish{
+		{DishID: dishID1, Rating: domain.NewDishRating(wantUser1, domain.FiveStars, date1)},
+		{DishID: dishID2, Rating: domain.NewDishRating(wantUser1, domain.TwoStars, date2)},
+	}
+	for i := range wantRatings {
+		rating := wantRatings[i].Rating
+		err = repo.CreateOrUpdateRating(ctx, wantUser1, wantRatings[i].DishID, func(currentRating *domain.DishRating) (updatedRating *domain.DishRating, createNew bool, err error) {
+			return &rating, true, nil
+		})
+		require.NoError(t, err)
+	}
+	err = repo.CreateOrUpdateRating(ctx, wantUser2, dishID1, func(currentRating *domain.DishRating) (updatedRating *domain.DishRating, createNew bool, err error) {
+		rating := domain.NewDishRating(wantUser2, domain.OneStar, date1)
+		return &rating, true, nil
+	})
+	require.NoError(t, err)
+
+	gotRatings, err := repo.GetAllRatingsOfUser(ctx, wantUser1)
+	require.NoError(t, err)
+	require.ElementsMatch(t, wantRatings, gotRatings)
+
+	//unknown users have no ratings
+	gotRatings, err = repo.GetAllRatingsOfUser(ctx, "unknown@testuser")
+	require.NoError(t, err)
+	require.Empty(t, gotRatings)
+}
+
+func testStatistics_GetRatingSummaryPerUser(t *testing.T, repo statisticsTestRepo) {
+
+	ctx := context.Background()
+	wantUser1 := "user1@testuser"
+	wantUser2 := "user2@testuser"
+
+	_, _, _, dishID1, err := repo.GetOrCreateDish(ctx, "testDish1", "testLocation")
+	require.NoError(t, err)
+	_, _, _, dishID2, err := repo.GetOrCreateDish(ctx, "testDish2", "testLocation")
+	require.NoError(t, err)
+
+	addRating := func(user string, dishID int64, value domain.Rating) {
+		err := repo.CreateOrUpdateRating(ctx, user, dishID, func(currentRating *domain.DishRating) (updatedRating *domain.DishRating, createNew bool, err error) {
+			rating := domain.NewDishRating(user, value, roundTimeToDBResolution(time.Now()))
+			return &rating, true, nil
+		})
+		require.NoError(t, err)
+	}
+	addRating(wantUser1, dishID1, domain.FiveStars)
+	addRating(wantUser1, dishID2, domain.ThreeStars)
+	addRating(wantUser2, dishID1, domain.OneStar)
+
+	gotSummaries, err := repo.GetRatingSummaryPerUser(ctx)
+	require.NoError(t, err)
+	wantSummaries := []domain.UserRatingSummary{
+		{User: domain.User{Email: wantUser1}, RatingCount: 2, RatingSum: 8},
+		{User: domain.User{Email: wantUser2}, RatingCount: 1, RatingSum: 1},
+	}
+	require.ElementsMatch(t, wantSummaries, gotSummaries)
+}
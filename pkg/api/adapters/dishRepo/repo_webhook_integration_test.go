@@ -0,0 +1,114 @@
+package dishRepo
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"itsTasty/pkg/api/domain"
+	"testing"
+	"time"
+)
+
+func testWebhook_Endpoints_Create_Get_Delete(t *testing.T, repo domain.WebhookRepo) {
+	ctx := context.Background()
+	now := roundTimeToDBResolution(time.Now())
+
+	allEvents, err := domain.NewWebhookEndpoint("https://example.com/all", "secret1", nil, now)
+	require.NoError(t, err)
+	someEvents, err := domain.NewWebhookEndpoint("https://example.com/some", "secret2",
+		[]domain.EventType{domain.EventDishCreated, domain.EventRatingCreated}, now)
+	require.NoError(t, err)
+
+	allEventsID, err := repo.CreateWebhookEndpoint(ctx, allEvents)
+	require.NoError(t, err)
+	someEventsID, err := repo.CreateWebhookEndpoint(ctx, someEvents)
+	require.NoError(t, err)
+
+	got, err := repo.GetAllWebhookEndpoints(ctx)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, allEvents.URL, got[allEventsID].URL)
+	require.Equal(t, allEvents.Secret, got[allEventsID].Secret)
+	require.Empty(t, got[allEventsID].EventTypes)
+	require.True(t, allEvents.CreatedAt.Equal(got[allEventsID].CreatedAt))
+	require.Equal(t, someEvents.EventTypes, got[someEventsID].EventTypes)
+
+	//deleting the endpoint also deletes its deliveries
+	delivery := domain.NewWebhookDelivery(allEventsID, domain.EventDishCreated, []byte(`{}`), now)
+	require.NoError(t, repo.CreateWebhookDeliveries(ctx, []domain.WebhookDelivery{delivery}))
+
+	require.NoError(t, repo.DeleteWebhookEndpoint(ctx, allEventsID))
+	require.True(t, errors.Is(repo.DeleteWebhookEndpoint(ctx, allEventsID), domain.ErrNotFound))
+
+	got, err = repo.GetAllWebhookEndpoints(ctx)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+
+	due, err := repo.GetDueWebhookDeliveries(ctx, now.Add(time.Hour), 10)
+	require.NoError(t, err)
+	require.Empty(t, due)
+}
+
+func testWebhook_Deliveries_Due_Update(t *testing.T, repo domain.WebhookRepo) {
+	ctx := context.Background()
+	now := roundTimeToDBResolution(time.Now())
+
+	endpoint, err := domain.NewWebhookEndpoint("https://example.com/all", "secret1", nil, now)
+	require.NoError(t, err)
+	endpointID, err := repo.CreateWebhookEndpoint(ctx, endpoint)
+	require.NoError(t, err)
+
+	wantPayload := []byte(`{"type":"dish.created"}`)
+	deliveries := []domain.WebhookDelivery{
+		domain.NewWebhookDelivery(endpointID, domain.EventDishCreated, wantPayload, now),
+		domain.NewWebhookDelivery(endpointID, domain.EventRatingCreated, wantPayload, now.Add(time.Hour)),
+	}
+	require.NoError(t, repo.CreateWebhookDeliveries(ctx, deliveries))
+
+	//only the first delivery is due
+	due, err := repo.GetDueWebhookDeliveries(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	var dueID int64
+	for id, v := range due {
+		dueID = id
+		require.Equal(t, domain.EventDishCreated, v.EventType)
+		require.Equal(t, wantPayload, v.Payload)
+		require.Equal(t, domain.WebhookDeliveryPending, v.State)
+		require.Nil(t, v.DeliveredAt)
+	}
+
+	//limit is respected
+	due, err = repo.GetDueWebhookDeliveries(ctx, now.Add(2*time.Hour), 1)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+
+	//record failure -> not due anymore
+	err = repo.UpdateWebhookDelivery(ctx, dueID, func(current domain.WebhookDelivery) (*domain.WebhookDelivery, error) {
+		current.RecordFailure(now, errors.New("connection refused"))
+		return &current, nil
+	})
+	require.NoError(t, err)
+	due, err = repo.GetDueWebhookDeliveries(ctx, now, 10)
+	require.NoError(t, err)
+	require.Empty(t, due)
+
+	//record success -> never due again
+	err = repo.UpdateWebhookDelivery(ctx, dueID, func(current domain.WebhookDelivery) (*domain.WebhookDelivery, error) {
+		require.Equal(t, 1, current.Attempts)
+		require.Equal(t, "connection refused", current.LastError)
+		current.RecordSuccess(now)
+		return &current, nil
+	})
+	require.NoError(t, err)
+	due, err = repo.GetDueWebhookDeliveries(ctx, now.Add(2*time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	_, ok := due[dueID]
+	require.False(t, ok)
+
+	err = repo.UpdateWebhookDelivery(ctx, dueID+1000, func(current domain.WebhookDelivery) (*domain.WebhookDelivery, error) {
+		return &current, nil
+	})
+	require.True(t, errors.Is(err, domain.ErrNotFound))
+}
@@ -0,0 +1,175 @@
+package dishRepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"itsTasty/pkg/api/domain"
+	"time"
+)
+
+// Like merging locations, deduping dishes is shared by both sql based repos, see sqlDialect
+
+type dedupeDish struct {
+	name         string
+	locationID   int64
+	servedAt     string
+	mergedDishID *int64
+}
+
+func (d sqlDialect) queryDedupeDish(ctx context.Context, tx *sql.Tx, dishID int64) (dedupeDish, error) {
+	var result dedupeDish
+	var mergedDishID sql.NullInt64
+	err := tx.QueryRowContext(ctx, d.rebind("SELECT d.name, d.location_id, l.name, d.merged_dish_id FROM dishes d "+
+		"INNER JOIN locations l ON l.id = d.location_id WHERE d.id = ?"),
+		dishID).Scan(&result.name, &result.locationID, &result.servedAt, &mergedDishID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return dedupeDish{}, fmt.Errorf("failed to fetch dish %v : %w", dishID, domain.ErrNotFound)
+	}
+	if err != nil {
+		return dedupeDish{}, fmt.Errorf("failed to fetch dish %v : %v", dishID, err)
+	}
+	if mergedDishID.Valid {
+		result.mergedDishID = &mergedDishID.Int64
+	}
+	return result, nil
+}
+
+// queryMergedDishMembers returns the ids of all dishes of the merged dish
+func (d sqlDialect) queryMergedDishMembers(ctx context.Context, tx *sql.Tx, mergedDishID int64) ([]int64, error) {
+	rows, err := tx.QueryContext(ctx, d.rebind("SELECT id FROM dishes WHERE merged_dish_id = ?"), mergedDishID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dishes of merged dish %v : %v", mergedDishID, err)
+	}
+	defer rows.Close()
+	result := make([]int64, 0)
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan dish : %v", err)
+		}
+		result = append(result, id)
+	}
+	return result, rows.Err()
+}
+
+// dedupeDish folds the duplicate dish into the canonical dish and records the dedupe. The caller is responsible for
+// the transaction
+func (d sqlDialect) dedupeDish(ctx context.Context, tx *sql.Tx, canonicalDishID, duplicateDishID int64,
+	performedBy string, now time.Time) (domain.DishDedupe, error) {
+
+	if canonicalDishID == duplicateDishID || performedBy == "" {
+		return domain.DishDedupe{}, fmt.Errorf("%w : cannot fold dish %v into dish %v by \"%v\"",
+			domain.ErrInvalidDishDedupe, duplicateDishID, canonicalDishID, performedBy)
+	}
+	canonical, err := d.queryDedupeDish(ctx, tx, canonicalDishID)
+	if err != nil {
+		return domain.DishDedupe{}, err
+	}
+	duplicate, err := d.queryDedupeDish(ctx, tx, duplicateDishID)
+	if err != nil {
+		return domain.DishDedupe{}, err
+	}
+	if canonical.locationID != duplicate.locationID {
+		return domain.DishDedupe{}, domain.ErrNotOnSameLocation
+	}
+
+	mergedDishMembers := make(map[int64][]int64)
+	for _, v := range []*int64{canonical.mergedDishID, duplicate.mergedDishID} {
+		if v == nil {
+			continue
+		}
+		if mergedDishMembers[*v], err = d.queryMergedDishMembers(ctx, tx, *v); err != nil {
+			return domain.DishDedupe{}, err
+		}
+	}
+	membership := domain.PlanDedupeMembership(canonicalDishID, duplicateDishID, canonical.mergedDishID,
+		duplicate.mergedDishID, mergedDishMembers)
+
+	families, err := d.queryDishFamilies(ctx, tx, "")
+	if err != nil {
+		return domain.DishDedupe{}, err
+	}
+	familyChanges := domain.PlanDishFamilyChanges(families,
+		map[int64]domain.DishFamilyMember{duplicateDishID: {DishID: canonicalDishID, ServedAt: canonical.servedAt}}, nil)
+	if err := d.applyDishFamilyPlan(ctx, tx, familyChanges); err != nil {
+		return domain.DishDedupe{}, err
+	}
+
+	plan, err := d.foldDish(ctx, tx, duplicateDishID, canonicalDishID)
+	if err != nil {
+		return domain.DishDedupe{}, err
+	}
+	for _, v := range membership.JoiningDishes {
+		err := d.exec(ctx, tx, "UPDATE dishes SET merged_dish_id = ?, merged_at = ? WHERE id = ?",
+			membership.JoinMergedDishID, d.timeToDB(now), v)
+		if err != nil {
+			return domain.DishDedupe{}, fmt.Errorf("failed to add dish %v to merged dish %v : %v", v,
+				membership.JoinMergedDishID, err)
+		}
+	}
+	if membership.DeleteMergedDishID != nil {
+		//remaining dishes are released by the foreign key
+		if err := d.exec(ctx, tx, "DELETE FROM merged_dishes WHERE id = ?", *membership.DeleteMergedDishID); err != nil {
+			return domain.DishDedupe{}, fmt.Errorf("failed to delete merged dish %v : %v",
+				*membership.DeleteMergedDishID, err)
+		}
+	}
+
+	dedupe := domain.NewDishDedupe(canonicalDishID, duplicateDishID, duplicate.name, performedBy, now, plan,
+		familyChanges)
+	err = d.exec(ctx, tx, "INSERT INTO dish_dedupes (canonical_dish_id, duplicate_dish_id, duplicate_dish_name, "+
+		"performed_by, performed_at, moved_occurrences, dropped_occurrences, moved_ratings, dropped_ratings, "+
+		"dish_family_id, canonical_joined_dish_family, dish_family_dissolved) "+
+		"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", dedupe.CanonicalDishID, dedupe.DuplicateDishID,
+		dedupe.DuplicateDishName, dedupe.PerformedBy, d.timeToDB(dedupe.PerformedAt), dedupe.MovedOccurrences,
+		dedupe.DroppedOccurrences, dedupe.MovedRatings, dedupe.DroppedRatings, dedupe.DishFamilyID,
+		dedupe.CanonicalJoinedDishFamily, dedupe.DishFamilyDissolved)
+	if err != nil {
+		return domain.DishDedupe{}, fmt.Errorf("failed to record dedupe : %v", err)
+	}
+	return dedupe, nil
+}
+
+// getDishDedupes returns all dedupes into the canonical dish, oldest first
+func (d sqlDialect) getDishDedupes(ctx context.Context, exec boil.ContextExecutor, canonicalDishID int64) ([]domain.DishDedupe, error) {
+	var count int
+	err := exec.QueryRowContext(ctx, d.rebind("SELECT count(*) FROM dishes WHERE id = ?"), canonicalDishID).Scan(&count)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch dish %v : %v", canonicalDishID, err)
+	}
+	if count == 0 {
+		return nil, fmt.Errorf("failed to fetch dish %v : %w", canonicalDishID, domain.ErrNotFound)
+	}
+
+	rows, err := exec.QueryContext(ctx, d.rebind("SELECT canonical_dish_id, duplicate_dish_id, duplicate_dish_name, "+
+		"performed_by, performed_at, moved_occurrences, dropped_occurrences, moved_ratings, dropped_ratings, "+
+		"dish_family_id, canonical_joined_dish_family, dish_family_dissolved FROM dish_dedupes WHERE canonical_dish_id = ? ORDER BY performed_at, id"), canonicalDishID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dedupes : %v", err)
+	}
+	defer rows.Close()
+	result := make([]domain.DishDedupe, 0)
+	for rows.Next() {
+		var v domain.DishDedupe
+		var performedAt dbTime
+		var dishFamilyID sql.NullInt64
+		err := rows.Scan(&v.CanonicalDishID, &v.DuplicateDishID, &v.DuplicateDishName, &v.PerformedBy, &performedAt,
+			&v.MovedOccurrences, &v.DroppedOccurrences, &v.MovedRatings, &v.DroppedRatings, &dishFamilyID,
+			&v.CanonicalJoinedDishFamily, &v.DishFamilyDissolved)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan dedupe : %v", err)
+		}
+		v.PerformedAt = performedAt.Time
+		if dishFamilyID.Valid {
+			v.DishFamilyID = &dishFamilyID.Int64
+		}
+		result = append(result, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate dedupes : %v", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,182 @@
+package dishRepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"itsTasty/pkg/api/domain"
+)
+
+// Both sql based repos share the dish family queries below, see sqlDialect
+
+const dishFamilyQuery = "SELECT f.id, f.name, d.id, l.name FROM dish_families f " +
+	"LEFT JOIN dish_family_dishes fd ON fd.dish_family_id = f.id " +
+	"LEFT JOIN dishes d ON d.id = fd.dish_id " +
+	"LEFT JOIN locations l ON l.id = d.location_id"
+
+// queryDishFamilies returns all families matching the optional where clause, e.g. "WHERE f.id = ?"
+func (d sqlDialect) queryDishFamilies(ctx context.Context, exec boil.ContextExecutor, where string, args ...interface{}) (map[int64]domain.DishFamily, error) {
+	rows, err := exec.QueryContext(ctx, d.rebind(dishFamilyQuery+" "+where+" ORDER BY f.id, d.id"), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dish families : %v", err)
+	}
+	defer rows.Close()
+	result := make(map[int64]domain.DishFamily)
+	for rows.Next() {
+		var id int64
+		var name string
+		var dishID sql.NullInt64
+		var servedAt sql.NullString
+		if err := rows.Scan(&id, &name, &dishID, &servedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan dish family : %v", err)
+		}
+		family, ok := result[id]
+		if !ok {
+			family = domain.DishFamily{Name: name, Members: make([]domain.DishFamilyMember, 0)}
+		}
+		//families whose dishes have all been deleted have no members left
+		if dishID.Valid {
+			family.Members = append(family.Members, domain.DishFamilyMember{DishID: dishID.Int64, ServedAt: servedAt.String})
+		}
+		result[id] = family
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate dish families : %v", err)
+	}
+	return result, nil
+}
+
+func (d sqlDialect) getDishFamilyByID(ctx context.Context, exec boil.ContextExecutor, id int64) (domain.DishFamily, error) {
+	families, err := d.queryDishFamilies(ctx, exec, "WHERE f.id = ?", id)
+	if err != nil {
+		return domain.DishFamily{}, err
+	}
+	family, ok := families[id]
+	if !ok {
+		return domain.DishFamily{}, fmt.Errorf("failed to fetch dish family %v : %w", id, domain.ErrNotFound)
+	}
+	return family, nil
+}
+
+func (d sqlDialect) getDishFamilyOfDish(ctx context.Context, exec boil.ContextExecutor, dishID int64) (int64, error) {
+	var id int64
+	err := exec.QueryRowContext(ctx, d.rebind("SELECT dish_family_id FROM dish_family_dishes WHERE dish_id = ?"),
+		dishID).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("dish %v is not part of a dish family : %w", dishID, domain.ErrNotFound)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to query dish family of dish %v : %v", dishID, err)
+	}
+	return id, nil
+}
+
+// storeDishFamily validates the family and replaces the members of the family with the given id
+func (d sqlDialect) storeDishFamily(ctx context.Context, tx *sql.Tx, id int64, family domain.DishFamily) error {
+	if err := family.Validate(); err != nil {
+		return err
+	}
+	for _, v := range family.Members {
+		var servedAt string
+		var familyID sql.NullInt64
+		err := tx.QueryRowContext(ctx, d.rebind("SELECT l.name, fd.dish_family_id FROM dishes d "+
+			"INNER JOIN locations l ON l.id = d.location_id "+
+			"LEFT JOIN dish_family_dishes fd ON fd.dish_id = d.id WHERE d.id = ?"), v.DishID).Scan(&servedAt, &familyID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to fetch dish %v : %w", v.DishID, domain.ErrNotFound)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to fetch dish %v : %v", v.DishID, err)
+		}
+		if servedAt != v.ServedAt {
+			return fmt.Errorf("%w : dish %v is served at %v", domain.ErrInvalidDishFamily, v.DishID, servedAt)
+		}
+		if familyID.Valid && familyID.Int64 != id {
+			return fmt.Errorf("%w : dish %v", domain.ErrDishAlreadyInFamily, v.DishID)
+		}
+	}
+
+	if err := d.exec(ctx, tx, "UPDATE dish_families SET name = ? WHERE id = ?", family.Name, id); err != nil {
+		return fmt.Errorf("failed to update dish family : %v", err)
+	}
+	if err := d.exec(ctx, tx, "DELETE FROM dish_family_dishes WHERE dish_family_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete dishes of dish family : %v", err)
+	}
+	for _, v := range family.Members {
+		err := d.exec(ctx, tx, "INSERT INTO dish_family_dishes (dish_id, dish_family_id) VALUES (?, ?)", v.DishID, id)
+		if err != nil {
+			return fmt.Errorf("failed to add dish %v to dish family : %v", v.DishID, err)
+		}
+	}
+	return nil
+}
+
+// createDishFamily stores a new family. The caller is responsible for the transaction
+func (d sqlDialect) createDishFamily(ctx context.Context, tx *sql.Tx, family domain.DishFamily) (int64, error) {
+	var id int64
+	err := tx.QueryRowContext(ctx, d.rebind("INSERT INTO dish_families (name) VALUES (?) RETURNING id"),
+		family.Name).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert dish family : %v", err)
+	}
+	if err := d.storeDishFamily(ctx, tx, id, family); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// updateDishFamily calls updateFN with the current value of the family. The caller is responsible for the transaction
+func (d sqlDialect) updateDishFamily(ctx context.Context, tx *sql.Tx, id int64, updateFN domain.DishFamilyUpdateFN) error {
+	current, err := d.getDishFamilyByID(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+	updated, err := updateFN(current)
+	if err != nil {
+		return err
+	}
+	if updated == nil {
+		return nil
+	}
+	return d.storeDishFamily(ctx, tx, id, *updated)
+}
+
+// applyDishFamilyPlan changes the dish families according to plan, see domain.PlanDishFamilyChanges. It has to be
+// called before the folded dishes are deleted. The caller is responsible for the transaction
+func (d sqlDialect) applyDishFamilyPlan(ctx context.Context, tx *sql.Tx, plan domain.DishFamilyPlan) error {
+	for _, v := range plan.LeavingDishes {
+		if err := d.exec(ctx, tx, "DELETE FROM dish_family_dishes WHERE dish_id = ?", v.DishID); err != nil {
+			return fmt.Errorf("failed to remove dish %v from dish family %v : %v", v.DishID, v.DishFamilyID, err)
+		}
+	}
+	for _, v := range plan.DissolvedFamilies {
+		if err := d.exec(ctx, tx, "DELETE FROM dish_families WHERE id = ?", v); err != nil {
+			return fmt.Errorf("failed to delete dish family %v : %v", v, err)
+		}
+	}
+	for _, v := range plan.JoiningDishes {
+		err := d.exec(ctx, tx, "INSERT INTO dish_family_dishes (dish_id, dish_family_id) VALUES (?, ?)", v.DishID,
+			v.DishFamilyID)
+		if err != nil {
+			return fmt.Errorf("failed to add dish %v to dish family %v : %v", v.DishID, v.DishFamilyID, err)
+		}
+	}
+	return nil
+}
+
+func (d sqlDialect) deleteDishFamily(ctx context.Context, exec boil.ContextExecutor, id int64) error {
+	res, err := exec.ExecContext(ctx, d.rebind("DELETE FROM dish_families WHERE id = ?"), id)
+	if err != nil {
+		return fmt.Errorf("failed to delete dish family %v : %v", id, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete dish family %v : %v", id, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("failed to delete dish family %v : %w", id, domain.ErrNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,316 @@
+package dishRepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"itsTasty/pkg/api/domain"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Merging locations and folding dishes touches many tables at once. The generated sqlboiler models only work with
+// postgres, so instead of writing these transactions twice, both sql based repos share the hand written queries
+// below. They are written with "?" placeholders and converted by the sqlDialect of the repo
+
+// sqlDialect captures the differences between the sql based repos that matter for hand written queries
+type sqlDialect struct {
+	//rebind converts the "?" placeholders of query to the placeholders of the database
+	rebind func(query string) string
+	//timeToDB converts t to the representation used by the database
+	timeToDB func(t time.Time) interface{}
+}
+
+var sqliteDialect = sqlDialect{
+	rebind: func(query string) string {
+		return query
+	},
+	timeToDB: func(t time.Time) interface{} {
+		return timeToSQLite(t)
+	},
+}
+
+var postgresDialect = sqlDialect{
+	rebind: func(query string) string {
+		b := strings.Builder{}
+		next := 1
+		for _, v := range query {
+			if v == '?' {
+				b.WriteString("$" + strconv.Itoa(next))
+				next += 1
+				continue
+			}
+			b.WriteRune(v)
+		}
+		return b.String()
+	},
+	timeToDB: func(t time.Time) interface{} {
+		return t
+	},
+}
+
+// dbTime scans both the unix nanoseconds of the SQLite schema and native timestamps
+type dbTime struct {
+	time.Time
+}
+
+func (d *dbTime) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case time.Time:
+		d.Time = v
+	case int64:
+		d.Time = timeFromSQLite(v)
+	default:
+		return fmt.Errorf("unsupported time value of type %T", src)
+	}
+	return nil
+}
+
+func (d sqlDialect) exec(ctx context.Context, exec boil.ContextExecutor, query string, args ...interface{}) error {
+	_, err := exec.ExecContext(ctx, d.rebind(query), args...)
+	return err
+}
+
+// queryLocationMergeDishes returns all dishes of the location
+func (d sqlDialect) queryLocationMergeDishes(ctx context.Context, exec boil.ContextExecutor, locationID int64) ([]domain.LocationMergeDish, error) {
+	rows, err := exec.QueryContext(ctx, d.rebind("SELECT id, name, merged_dish_id FROM dishes WHERE location_id = ?"),
+		locationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dishes : %v", err)
+	}
+	defer rows.Close()
+	result := make([]domain.LocationMergeDish, 0)
+	for rows.Next() {
+		var v domain.LocationMergeDish
+		var mergedDishID sql.NullInt64
+		if err := rows.Scan(&v.ID, &v.Name, &mergedDishID); err != nil {
+			return nil, fmt.Errorf("failed to scan dish : %v", err)
+		}
+		if mergedDishID.Valid {
+			v.MergedDishID = &mergedDishID.Int64
+		}
+		result = append(result, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate dishes : %v", err)
+	}
+	return result, nil
+}
+
+// queryLocationMergeMergedDishes returns all merged dishes of the location
+func (d sqlDialect) queryLocationMergeMergedDishes(ctx context.Context, exec boil.ContextExecutor, locationID int64) ([]domain.LocationMergeMergedDish, error) {
+	rows, err := exec.QueryContext(ctx, d.rebind("SELECT id, name FROM merged_dishes WHERE location_id = ?"), locationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query merged dishes : %v", err)
+	}
+	defer rows.Close()
+	result := make([]domain.LocationMergeMergedDish, 0)
+	for rows.Next() {
+		var v domain.LocationMergeMergedDish
+		if err := rows.Scan(&v.ID, &v.Name); err != nil {
+			return nil, fmt.Errorf("failed to scan merged dish : %v", err)
+		}
+		result = append(result, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate merged dishes : %v", err)
+	}
+	return result, nil
+}
+
+// mergeLocations merges the source location into the target location, see domain.PlanLocationMerge.
+// If dryRun is true, only the report is computed. The caller is responsible for the transaction
+func (d sqlDialect) mergeLocations(ctx context.Context, tx *sql.Tx, sourceID, targetID int64, dryRun bool,
+	now time.Time) (domain.LocationMergeReport, error) {
+
+	locations, err := queryLocations(ctx, tx, d.rebind("SELECT "+locationColumns+" FROM locations WHERE id IN (?, ?)"),
+		sourceID, targetID)
+	if err != nil {
+		return domain.LocationMergeReport{}, err
+	}
+	source, ok := locations[sourceID]
+	if !ok {
+		return domain.LocationMergeReport{}, fmt.Errorf("source location %v : %w", sourceID, domain.ErrNotFound)
+	}
+	target, ok := locations[targetID]
+	if !ok {
+		return domain.LocationMergeReport{}, fmt.Errorf("target location %v : %w", targetID, domain.ErrNotFound)
+	}
+
+	sourceDishes, err := d.queryLocationMergeDishes(ctx, tx, sourceID)
+	if err != nil {
+		return domain.LocationMergeReport{}, err
+	}
+	targetDishes, err := d.queryLocationMergeDishes(ctx, tx, targetID)
+	if err != nil {
+		return domain.LocationMergeReport{}, err
+	}
+	sourceMergedDishes, err := d.queryLocationMergeMergedDishes(ctx, tx, sourceID)
+	if err != nil {
+		return domain.LocationMergeReport{}, err
+	}
+	targetMergedDishes, err := d.queryLocationMergeMergedDishes(ctx, tx, targetID)
+	if err != nil {
+		return domain.LocationMergeReport{}, err
+	}
+
+	families, err := d.queryDishFamilies(ctx, tx, "")
+	if err != nil {
+		return domain.LocationMergeReport{}, err
+	}
+
+	report, err := domain.PlanLocationMerge(source.Name, target.Name, sourceDishes, targetDishes,
+		sourceMergedDishes, targetMergedDishes, families)
+	if err != nil {
+		return domain.LocationMergeReport{}, err
+	}
+	report.DryRun = dryRun
+	if dryRun {
+		return report, nil
+	}
+
+	if err := d.applyDishFamilyPlan(ctx, tx, report.DishFamilyChanges); err != nil {
+		return domain.LocationMergeReport{}, err
+	}
+	for _, v := range report.CombinedDishes {
+		if _, err := d.foldDish(ctx, tx, v.SourceDishID, v.TargetDishID); err != nil {
+			return domain.LocationMergeReport{}, fmt.Errorf("failed to combine dish %v with dish %v : %w",
+				v.SourceDishID, v.TargetDishID, err)
+		}
+	}
+	for _, v := range report.MovedDishes {
+		if err := d.exec(ctx, tx, "UPDATE dishes SET location_id = ? WHERE id = ?", targetID, v); err != nil {
+			return domain.LocationMergeReport{}, fmt.Errorf("failed to move dish %v : %v", v, err)
+		}
+	}
+	for _, v := range report.MovedMergedDishes {
+		if err := d.exec(ctx, tx, "UPDATE merged_dishes SET location_id = ? WHERE id = ?", targetID, v); err != nil {
+			return domain.LocationMergeReport{}, fmt.Errorf("failed to move merged dish %v : %v", v, err)
+		}
+	}
+	for _, v := range report.MembershipChanges {
+		err := d.exec(ctx, tx, "UPDATE dishes SET merged_dish_id = ?, merged_at = ? WHERE id = ?",
+			v.MergedDishID, d.timeToDB(now), v.DishID)
+		if err != nil {
+			return domain.LocationMergeReport{}, fmt.Errorf("failed to add dish %v to merged dish %v : %v",
+				v.DishID, v.MergedDishID, err)
+		}
+	}
+	for _, v := range report.CombinedMergedDishes {
+		if err := d.exec(ctx, tx, "DELETE FROM merged_dishes WHERE id = ?", v.SourceMergedDishID); err != nil {
+			return domain.LocationMergeReport{}, fmt.Errorf("failed to delete merged dish %v : %v",
+				v.SourceMergedDishID, err)
+		}
+	}
+
+	//the name of the source location is most likely still used by some scraper
+	if err := d.exec(ctx, tx, "UPDATE location_aliases SET location_id = ? WHERE location_id = ?", targetID, sourceID); err != nil {
+		return domain.LocationMergeReport{}, fmt.Errorf("failed to move aliases : %v", err)
+	}
+	if err := d.exec(ctx, tx, "DELETE FROM locations WHERE id = ?", sourceID); err != nil {
+		return domain.LocationMergeReport{}, fmt.Errorf("failed to delete source location : %v", err)
+	}
+	if err := d.exec(ctx, tx, "INSERT INTO location_aliases (alias, location_id) VALUES (?, ?)", source.Name, targetID); err != nil {
+		return domain.LocationMergeReport{}, fmt.Errorf("failed to add source location as alias : %v", err)
+	}
+
+	return report, nil
+}
+
+// foldDish moves the occurrences, ratings and tags of the source dish to the target dish and deletes the source dish,
+// see domain.PlanDishFold. Merged dish and dish family memberships are not changed, see applyDishFamilyPlan.
+// The caller is responsible for the transaction
+func (d sqlDialect) foldDish(ctx context.Context, tx *sql.Tx, sourceDishID, targetDishID int64) (domain.DishFoldPlan, error) {
+	queryOccurrences := func(dishID int64) ([]domain.FoldOccurrence, error) {
+		rows, err := tx.QueryContext(ctx, d.rebind("SELECT id, date FROM dish_occurrences WHERE dish_id = ?"), dishID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query occurrences : %v", err)
+		}
+		defer rows.Close()
+		result := make([]domain.FoldOccurrence, 0)
+		for rows.Next() {
+			var id int64
+			var date dbTime
+			if err := rows.Scan(&id, &date); err != nil {
+				return nil, fmt.Errorf("failed to scan occurrence : %v", err)
+			}
+			result = append(result, domain.FoldOccurrence{ID: id, Date: date.Time})
+		}
+		return result, rows.Err()
+	}
+	queryRatings := func(dishID int64) ([]domain.FoldRating, error) {
+		rows, err := tx.QueryContext(ctx, d.rebind("SELECT r.id, u.email, r.date FROM dish_ratings r "+
+			"INNER JOIN users u ON r.user_id = u.id WHERE r.dish_id = ?"), dishID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query ratings : %v", err)
+		}
+		defer rows.Close()
+		result := make([]domain.FoldRating, 0)
+		for rows.Next() {
+			var v domain.FoldRating
+			var date dbTime
+			if err := rows.Scan(&v.ID, &v.UserEmail, &date); err != nil {
+				return nil, fmt.Errorf("failed to scan rating : %v", err)
+			}
+			v.Date = date.Time
+			result = append(result, v)
+		}
+		return result, rows.Err()
+	}
+
+	var sourceCount, targetCount int
+	err := tx.QueryRowContext(ctx, d.rebind("SELECT (SELECT count(*) FROM dishes WHERE id = ?), "+
+		"(SELECT count(*) FROM dishes WHERE id = ?)"), sourceDishID, targetDishID).Scan(&sourceCount, &targetCount)
+	if err != nil {
+		return domain.DishFoldPlan{}, fmt.Errorf("failed to fetch dishes : %v", err)
+	}
+	if sourceCount == 0 || targetCount == 0 {
+		return domain.DishFoldPlan{}, fmt.Errorf("failed to fetch dishes : %w", domain.ErrNotFound)
+	}
+
+	sourceOccurrences, err := queryOccurrences(sourceDishID)
+	if err != nil {
+		return domain.DishFoldPlan{}, err
+	}
+	targetOccurrences, err := queryOccurrences(targetDishID)
+	if err != nil {
+		return domain.DishFoldPlan{}, err
+	}
+	sourceRatings, err := queryRatings(sourceDishID)
+	if err != nil {
+		return domain.DishFoldPlan{}, err
+	}
+	targetRatings, err := queryRatings(targetDishID)
+	if err != nil {
+		return domain.DishFoldPlan{}, err
+	}
+	plan := domain.PlanDishFold(sourceOccurrences, targetOccurrences, sourceRatings, targetRatings)
+
+	for _, v := range plan.MoveOccurrences {
+		if err := d.exec(ctx, tx, "UPDATE dish_occurrences SET dish_id = ? WHERE id = ?", targetDishID, v); err != nil {
+			return domain.DishFoldPlan{}, fmt.Errorf("failed to move occurrence %v : %v", v, err)
+		}
+	}
+	for _, v := range plan.MoveRatings {
+		if err := d.exec(ctx, tx, "UPDATE dish_ratings SET dish_id = ? WHERE id = ?", targetDishID, v); err != nil {
+			return domain.DishFoldPlan{}, fmt.Errorf("failed to move rating %v : %v", v, err)
+		}
+	}
+	for _, v := range plan.DropRatings {
+		if err := d.exec(ctx, tx, "DELETE FROM dish_ratings WHERE id = ?", v); err != nil {
+			return domain.DishFoldPlan{}, fmt.Errorf("failed to delete rating %v : %v", v, err)
+		}
+	}
+	err = d.exec(ctx, tx, "INSERT INTO dish_tags (dish_id, tag) SELECT ?, tag FROM dish_tags WHERE dish_id = ? "+
+		"ON CONFLICT DO NOTHING", targetDishID, sourceDishID)
+	if err != nil {
+		return domain.DishFoldPlan{}, fmt.Errorf("failed to move tags : %v", err)
+	}
+	//the remaining occurrences, ratings and tags are deleted by the cascade
+	if err := d.exec(ctx, tx, "DELETE FROM dishes WHERE id = ?", sourceDishID); err != nil {
+		return domain.DishFoldPlan{}, fmt.Errorf("failed to delete dish : %v", err)
+	}
+	return plan, nil
+}
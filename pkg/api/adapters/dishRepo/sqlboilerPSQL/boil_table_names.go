@@ -4,19 +4,37 @@
 package sqlboilerPSQL
 
 var TableNames = struct {
-	DishOccurrences string
-	DishRatings     string
-	Dishes          string
-	Locations       string
-	MergedDishes    string
-	RatingStreaks   string
-	Users           string
+	AccessTokens      string
+	DishDedupes       string
+	DishFamilies      string
+	DishFamilyDishes  string
+	DishOccurrences   string
+	DishRatings       string
+	DishTags          string
+	Dishes            string
+	LocationAliases   string
+	Locations         string
+	MergedDishes      string
+	RateLimitCounters string
+	RatingStreaks     string
+	Users             string
+	WebhookDeliveries string
+	WebhookEndpoints  string
 }{
-	DishOccurrences: "dish_occurrences",
-	DishRatings:     "dish_ratings",
-	Dishes:          "dishes",
-	Locations:       "locations",
-	MergedDishes:    "merged_dishes",
-	RatingStreaks:   "rating_streaks",
-	Users:           "users",
+	AccessTokens:      "access_tokens",
+	DishDedupes:       "dish_dedupes",
+	DishFamilies:      "dish_families",
+	DishFamilyDishes:  "dish_family_dishes",
+	DishOccurrences:   "dish_occurrences",
+	DishRatings:       "dish_ratings",
+	DishTags:          "dish_tags",
+	Dishes:            "dishes",
+	LocationAliases:   "location_aliases",
+	Locations:         "locations",
+	MergedDishes:      "merged_dishes",
+	RateLimitCounters: "rate_limit_counters",
+	RatingStreaks:     "rating_streaks",
+	Users:             "users",
+	WebhookDeliveries: "webhook_deliveries",
+	WebhookEndpoints:  "webhook_endpoints",
 }
@@ -0,0 +1,1197 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlboilerPSQL
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// DishDedupe is an object representing the database table.
+type DishDedupe struct {
+	ID              int `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CanonicalDishID int `boil:"canonical_dish_id" json:"canonical_dish_id" toml:"canonical_dish_id" yaml:"canonical_dish_id"`
+	// The duplicate dish is deleted by the dedupe, thus there is no foreign key
+	DuplicateDishID    int       `boil:"duplicate_dish_id" json:"duplicate_dish_id" toml:"duplicate_dish_id" yaml:"duplicate_dish_id"`
+	DuplicateDishName  string    `boil:"duplicate_dish_name" json:"duplicate_dish_name" toml:"duplicate_dish_name" yaml:"duplicate_dish_name"`
+	PerformedBy        string    `boil:"performed_by" json:"performed_by" toml:"performed_by" yaml:"performed_by"`
+	PerformedAt        time.Time `boil:"performed_at" json:"performed_at" toml:"performed_at" yaml:"performed_at"`
+	MovedOccurrences   int       `boil:"moved_occurrences" json:"moved_occurrences" toml:"moved_occurrences" yaml:"moved_occurrences"`
+	DroppedOccurrences int       `boil:"dropped_occurrences" json:"dropped_occurrences" toml:"dropped_occurrences" yaml:"dropped_occurrences"`
+	MovedRatings       int       `boil:"moved_ratings" json:"moved_ratings" toml:"moved_ratings" yaml:"moved_ratings"`
+	DroppedRatings     int       `boil:"dropped_ratings" json:"dropped_ratings" toml:"dropped_ratings" yaml:"dropped_ratings"`
+	// Family of the duplicate dish. The family may have been dissolved by the dedupe, thus there is no foreign key
+	DishFamilyID              null.Int `boil:"dish_family_id" json:"dish_family_id,omitempty" toml:"dish_family_id" yaml:"dish_family_id,omitempty"`
+	CanonicalJoinedDishFamily bool     `boil:"canonical_joined_dish_family" json:"canonical_joined_dish_family" toml:"canonical_joined_dish_family" yaml:"canonical_joined_dish_family"`
+	DishFamilyDissolved       bool     `boil:"dish_family_dissolved" json:"dish_family_dissolved" toml:"dish_family_dissolved" yaml:"dish_family_dissolved"`
+
+	R *dishDedupeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L dishDedupeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DishDedupeColumns = struct {
+	ID                        string
+	CanonicalDishID           string
+	DuplicateDishID           string
+	DuplicateDishName         string
+	PerformedBy               string
+	PerformedAt               string
+	MovedOccurrences          string
+	DroppedOccurrences        string
+	MovedRatings              string
+	DroppedRatings            string
+	DishFamilyID              string
+	CanonicalJoinedDishFamily string
+	DishFamilyDissolved       string
+}{
+	ID:                        "id",
+	CanonicalDishID:           "canonical_dish_id",
+	DuplicateDishID:           "duplicate_dish_id",
+	DuplicateDishName:         "duplicate_dish_name",
+	PerformedBy:               "performed_by",
+	PerformedAt:               "performed_at",
+	MovedOccurrences:          "moved_occurrences",
+	DroppedOccurrences:        "dropped_occurrences",
+	MovedRatings:              "moved_ratings",
+	DroppedRatings:            "dropped_ratings",
+	DishFamilyID:              "dish_family_id",
+	CanonicalJoinedDishFamily: "canonical_joined_dish_family",
+	DishFamilyDissolved:       "dish_family_dissolved",
+}
+
+var DishDedupeTableColumns = struct {
+	ID                        string
+	CanonicalDishID           string
+	DuplicateDishID           string
+	DuplicateDishName         string
+	PerformedBy               string
+	PerformedAt               string
+	MovedOccurrences          string
+	DroppedOccurrences        string
+	MovedRatings              string
+	DroppedRatings            string
+	DishFamilyID              string
+	CanonicalJoinedDishFamily string
+	DishFamilyDissolved       string
+}{
+	ID:                        "dish_dedupes.id",
+	CanonicalDishID:           "dish_dedupes.canonical_dish_id",
+	DuplicateDishID:           "dish_dedupes.duplicate_dish_id",
+	DuplicateDishName:         "dish_dedupes.duplicate_dish_name",
+	PerformedBy:               "dish_dedupes.performed_by",
+	PerformedAt:               "dish_dedupes.performed_at",
+	MovedOccurrences:          "dish_dedupes.moved_occurrences",
+	DroppedOccurrences:        "dish_dedupes.dropped_occurrences",
+	MovedRatings:              "dish_dedupes.moved_ratings",
+	DroppedRatings:            "dish_dedupes.dropped_ratings",
+	DishFamilyID:              "dish_dedupes.dish_family_id",
+	CanonicalJoinedDishFamily: "dish_dedupes.canonical_joined_dish_family",
+	DishFamilyDissolved:       "dish_dedupes.dish_family_dissolved",
+}
+
+// Generated where
+
+type whereHelpernull_Int struct{ field string }
+
+func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var DishDedupeWhere = struct {
+	ID                        whereHelperint
+	CanonicalDishID           whereHelperint
+	DuplicateDishID           whereHelperint
+	DuplicateDishName         whereHelperstring
+	PerformedBy               whereHelperstring
+	PerformedAt               whereHelpertime_Time
+	MovedOccurrences          whereHelperint
+	DroppedOccurrences        whereHelperint
+	MovedRatings              whereHelperint
+	DroppedRatings            whereHelperint
+	DishFamilyID              whereHelpernull_Int
+	CanonicalJoinedDishFamily whereHelperbool
+	DishFamilyDissolved       whereHelperbool
+}{
+	ID:                        whereHelperint{field: "\"dish_dedupes\".\"id\""},
+	CanonicalDishID:           whereHelperint{field: "\"dish_dedupes\".\"canonical_dish_id\""},
+	DuplicateDishID:           whereHelperint{field: "\"dish_dedupes\".\"duplicate_dish_id\""},
+	DuplicateDishName:         whereHelperstring{field: "\"dish_dedupes\".\"duplicate_dish_name\""},
+	PerformedBy:               whereHelperstring{field: "\"dish_dedupes\".\"performed_by\""},
+	PerformedAt:               whereHelpertime_Time{field: "\"dish_dedupes\".\"performed_at\""},
+	MovedOccurrences:          whereHelperint{field: "\"dish_dedupes\".\"moved_occurrences\""},
+	DroppedOccurrences:        whereHelperint{field: "\"dish_dedupes\".\"dropped_occurrences\""},
+	MovedRatings:              whereHelperint{field: "\"dish_dedupes\".\"moved_ratings\""},
+	DroppedRatings:            whereHelperint{field: "\"dish_dedupes\".\"dropped_ratings\""},
+	DishFamilyID:              whereHelpernull_Int{field: "\"dish_dedupes\".\"dish_family_id\""},
+	CanonicalJoinedDishFamily: whereHelperbool{field: "\"dish_dedupes\".\"canonical_joined_dish_family\""},
+	DishFamilyDissolved:       whereHelperbool{field: "\"dish_dedupes\".\"dish_family_dissolved\""},
+}
+
+// DishDedupeRels is where relationship names are stored.
+var DishDedupeRels = struct {
+	CanonicalDish string
+}{
+	CanonicalDish: "CanonicalDish",
+}
+
+// dishDedupeR is where relationships are stored.
+type dishDedupeR struct {
+	CanonicalDish *Dish `boil:"CanonicalDish" json:"CanonicalDish" toml:"CanonicalDish" yaml:"CanonicalDish"`
+}
+
+// NewStruct creates a new relationship struct
+func (*dishDedupeR) NewStruct() *dishDedupeR {
+	return &dishDedupeR{}
+}
+
+func (r *dishDedupeR) GetCanonicalDish() *Dish {
+	if r == nil {
+		return nil
+	}
+	return r.CanonicalDish
+}
+
+// dishDedupeL is where Load methods for each relationship are stored.
+type dishDedupeL struct{}
+
+var (
+	dishDedupeAllColumns            = []string{"id", "canonical_dish_id", "duplicate_dish_id", "duplicate_dish_name", "performed_by", "performed_at", "moved_occurrences", "dropped_occurrences", "moved_ratings", "dropped_ratings", "dish_family_id", "canonical_joined_dish_family", "dish_family_dissolved"}
+	dishDedupeColumnsWithoutDefault = []string{"canonical_dish_id", "duplicate_dish_id", "duplicate_dish_name", "performed_by", "performed_at", "moved_occurrences", "dropped_occurrences", "moved_ratings", "dropped_ratings"}
+	dishDedupeColumnsWithDefault    = []string{"id", "dish_family_id", "canonical_joined_dish_family", "dish_family_dissolved"}
+	dishDedupePrimaryKeyColumns     = []string{"id"}
+	dishDedupeGeneratedColumns      = []string{}
+)
+
+type (
+	// DishDedupeSlice is an alias for a slice of pointers to DishDedupe.
+	// This should almost always be used instead of []DishDedupe.
+	DishDedupeSlice []*DishDedupe
+	// DishDedupeHook is the signature for custom DishDedupe hook methods
+	DishDedupeHook func(context.Context, boil.ContextExecutor, *DishDedupe) error
+
+	dishDedupeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	dishDedupeType                 = reflect.TypeOf(&DishDedupe{})
+	dishDedupeMapping              = queries.MakeStructMapping(dishDedupeType)
+	dishDedupePrimaryKeyMapping, _ = queries.BindMapping(dishDedupeType, dishDedupeMapping, dishDedupePrimaryKeyColumns)
+	dishDedupeInsertCacheMut       sync.RWMutex
+	dishDedupeInsertCache          = make(map[string]insertCache)
+	dishDedupeUpdateCacheMut       sync.RWMutex
+	dishDedupeUpdateCache          = make(map[string]updateCache)
+	dishDedupeUpsertCacheMut       sync.RWMutex
+	dishDedupeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var dishDedupeAfterSelectHooks []DishDedupeHook
+
+var dishDedupeBeforeInsertHooks []DishDedupeHook
+var dishDedupeAfterInsertHooks []DishDedupeHook
+
+var dishDedupeBeforeUpdateHooks []DishDedupeHook
+var dishDedupeAfterUpdateHooks []DishDedupeHook
+
+var dishDedupeBeforeDeleteHooks []DishDedupeHook
+var dishDedupeAfterDeleteHooks []DishDedupeHook
+
+var dishDedupeBeforeUpsertHooks []DishDedupeHook
+var dishDedupeAfterUpsertHooks []DishDedupeHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DishDedupe) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishDedupeAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DishDedupe) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishDedupeBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DishDedupe) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishDedupeAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DishDedupe) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishDedupeBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DishDedupe) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishDedupeAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DishDedupe) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishDedupeBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DishDedupe) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishDedupeAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DishDedupe) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishDedupeBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DishDedupe) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishDedupeAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDishDedupeHook registers your hook function for all future operations.
+func AddDishDedupeHook(hookPoint boil.HookPoint, dishDedupeHook DishDedupeHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		dishDedupeAfterSelectHooks = append(dishDedupeAfterSelectHooks, dishDedupeHook)
+	case boil.BeforeInsertHook:
+		dishDedupeBeforeInsertHooks = append(dishDedupeBeforeInsertHooks, dishDedupeHook)
+	case boil.AfterInsertHook:
+		dishDedupeAfterInsertHooks = append(dishDedupeAfterInsertHooks, dishDedupeHook)
+	case boil.BeforeUpdateHook:
+		dishDedupeBeforeUpdateHooks = append(dishDedupeBeforeUpdateHooks, dishDedupeHook)
+	case boil.AfterUpdateHook:
+		dishDedupeAfterUpdateHooks = append(dishDedupeAfterUpdateHooks, dishDedupeHook)
+	case boil.BeforeDeleteHook:
+		dishDedupeBeforeDeleteHooks = append(dishDedupeBeforeDeleteHooks, dishDedupeHook)
+	case boil.AfterDeleteHook:
+		dishDedupeAfterDeleteHooks = append(dishDedupeAfterDeleteHooks, dishDedupeHook)
+	case boil.BeforeUpsertHook:
+		dishDedupeBeforeUpsertHooks = append(dishDedupeBeforeUpsertHooks, dishDedupeHook)
+	case boil.AfterUpsertHook:
+		dishDedupeAfterUpsertHooks = append(dishDedupeAfterUpsertHooks, dishDedupeHook)
+	}
+}
+
+// One returns a single dishDedupe record from the query.
+func (q dishDedupeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DishDedupe, error) {
+	o := &DishDedupe{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlboilerPSQL: failed to execute a one query for dish_dedupes")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DishDedupe records from the query.
+func (q dishDedupeQuery) All(ctx context.Context, exec boil.ContextExecutor) (DishDedupeSlice, error) {
+	var o []*DishDedupe
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlboilerPSQL: failed to assign all query results to DishDedupe slice")
+	}
+
+	if len(dishDedupeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DishDedupe records in the query.
+func (q dishDedupeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to count dish_dedupes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q dishDedupeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlboilerPSQL: failed to check if dish_dedupes exists")
+	}
+
+	return count > 0, nil
+}
+
+// CanonicalDish pointed to by the foreign key.
+func (o *DishDedupe) CanonicalDish(mods ...qm.QueryMod) dishQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CanonicalDishID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Dishes(queryMods...)
+}
+
+// LoadCanonicalDish allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (dishDedupeL) LoadCanonicalDish(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDishDedupe interface{}, mods queries.Applicator) error {
+	var slice []*DishDedupe
+	var object *DishDedupe
+
+	if singular {
+		var ok bool
+		object, ok = maybeDishDedupe.(*DishDedupe)
+		if !ok {
+			object = new(DishDedupe)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDishDedupe)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDishDedupe))
+			}
+		}
+	} else {
+		s, ok := maybeDishDedupe.(*[]*DishDedupe)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDishDedupe)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDishDedupe))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &dishDedupeR{}
+		}
+		args = append(args, object.CanonicalDishID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dishDedupeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CanonicalDishID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CanonicalDishID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`dishes`),
+		qm.WhereIn(`dishes.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Dish")
+	}
+
+	var resultSlice []*Dish
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Dish")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for dishes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for dishes")
+	}
+
+	if len(dishDedupeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CanonicalDish = foreign
+		if foreign.R == nil {
+			foreign.R = &dishR{}
+		}
+		foreign.R.CanonicalDishDishDedupes = append(foreign.R.CanonicalDishDishDedupes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CanonicalDishID == foreign.ID {
+				local.R.CanonicalDish = foreign
+				if foreign.R == nil {
+					foreign.R = &dishR{}
+				}
+				foreign.R.CanonicalDishDishDedupes = append(foreign.R.CanonicalDishDishDedupes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCanonicalDish of the dishDedupe to the related item.
+// Sets o.R.CanonicalDish to related.
+// Adds o to related.R.CanonicalDishDishDedupes.
+func (o *DishDedupe) SetCanonicalDish(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Dish) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"dish_dedupes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"canonical_dish_id"}),
+		strmangle.WhereClause("\"", "\"", 2, dishDedupePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CanonicalDishID = related.ID
+	if o.R == nil {
+		o.R = &dishDedupeR{
+			CanonicalDish: related,
+		}
+	} else {
+		o.R.CanonicalDish = related
+	}
+
+	if related.R == nil {
+		related.R = &dishR{
+			CanonicalDishDishDedupes: DishDedupeSlice{o},
+		}
+	} else {
+		related.R.CanonicalDishDishDedupes = append(related.R.CanonicalDishDishDedupes, o)
+	}
+
+	return nil
+}
+
+// DishDedupes retrieves all the records using an executor.
+func DishDedupes(mods ...qm.QueryMod) dishDedupeQuery {
+	mods = append(mods, qm.From("\"dish_dedupes\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"dish_dedupes\".*"})
+	}
+
+	return dishDedupeQuery{q}
+}
+
+// FindDishDedupe retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDishDedupe(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*DishDedupe, error) {
+	dishDedupeObj := &DishDedupe{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"dish_dedupes\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, dishDedupeObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlboilerPSQL: unable to select from dish_dedupes")
+	}
+
+	if err = dishDedupeObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return dishDedupeObj, err
+	}
+
+	return dishDedupeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DishDedupe) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlboilerPSQL: no dish_dedupes provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dishDedupeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	dishDedupeInsertCacheMut.RLock()
+	cache, cached := dishDedupeInsertCache[key]
+	dishDedupeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			dishDedupeAllColumns,
+			dishDedupeColumnsWithDefault,
+			dishDedupeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dishDedupeType, dishDedupeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(dishDedupeType, dishDedupeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"dish_dedupes\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"dish_dedupes\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "sqlboilerPSQL: unable to insert into dish_dedupes")
+	}
+
+	if !cached {
+		dishDedupeInsertCacheMut.Lock()
+		dishDedupeInsertCache[key] = cache
+		dishDedupeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DishDedupe.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DishDedupe) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	dishDedupeUpdateCacheMut.RLock()
+	cache, cached := dishDedupeUpdateCache[key]
+	dishDedupeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			dishDedupeAllColumns,
+			dishDedupePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("sqlboilerPSQL: unable to update dish_dedupes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"dish_dedupes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, dishDedupePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(dishDedupeType, dishDedupeMapping, append(wl, dishDedupePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to update dish_dedupes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to get rows affected by update for dish_dedupes")
+	}
+
+	if !cached {
+		dishDedupeUpdateCacheMut.Lock()
+		dishDedupeUpdateCache[key] = cache
+		dishDedupeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q dishDedupeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to update all for dish_dedupes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to retrieve rows affected for dish_dedupes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DishDedupeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlboilerPSQL: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dishDedupePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"dish_dedupes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, dishDedupePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to update all in dishDedupe slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to retrieve rows affected all in update all dishDedupe")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DishDedupe) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlboilerPSQL: no dish_dedupes provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dishDedupeColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	dishDedupeUpsertCacheMut.RLock()
+	cache, cached := dishDedupeUpsertCache[key]
+	dishDedupeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			dishDedupeAllColumns,
+			dishDedupeColumnsWithDefault,
+			dishDedupeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			dishDedupeAllColumns,
+			dishDedupePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("sqlboilerPSQL: unable to upsert dish_dedupes, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(dishDedupePrimaryKeyColumns))
+			copy(conflict, dishDedupePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"dish_dedupes\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(dishDedupeType, dishDedupeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(dishDedupeType, dishDedupeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "sqlboilerPSQL: unable to upsert dish_dedupes")
+	}
+
+	if !cached {
+		dishDedupeUpsertCacheMut.Lock()
+		dishDedupeUpsertCache[key] = cache
+		dishDedupeUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single DishDedupe record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DishDedupe) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlboilerPSQL: no DishDedupe provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), dishDedupePrimaryKeyMapping)
+	sql := "DELETE FROM \"dish_dedupes\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to delete from dish_dedupes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to get rows affected by delete for dish_dedupes")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q dishDedupeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlboilerPSQL: no dishDedupeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to delete all from dish_dedupes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to get rows affected by deleteall for dish_dedupes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DishDedupeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(dishDedupeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dishDedupePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"dish_dedupes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, dishDedupePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to delete all from dishDedupe slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to get rows affected by deleteall for dish_dedupes")
+	}
+
+	if len(dishDedupeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DishDedupe) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDishDedupe(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DishDedupeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DishDedupeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dishDedupePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"dish_dedupes\".* FROM \"dish_dedupes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, dishDedupePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlboilerPSQL: unable to reload all in DishDedupeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DishDedupeExists checks if the DishDedupe row exists.
+func DishDedupeExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"dish_dedupes\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlboilerPSQL: unable to check if dish_dedupes exists")
+	}
+
+	return exists, nil
+}
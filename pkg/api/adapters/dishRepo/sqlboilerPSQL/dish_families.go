@@ -0,0 +1,1082 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlboilerPSQL
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// DishFamily is an object representing the database table.
+type DishFamily struct {
+	ID   int    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name string `boil:"name" json:"name" toml:"name" yaml:"name"`
+
+	R *dishFamilyR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L dishFamilyL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DishFamilyColumns = struct {
+	ID   string
+	Name string
+}{
+	ID:   "id",
+	Name: "name",
+}
+
+var DishFamilyTableColumns = struct {
+	ID   string
+	Name string
+}{
+	ID:   "dish_families.id",
+	Name: "dish_families.name",
+}
+
+// Generated where
+
+var DishFamilyWhere = struct {
+	ID   whereHelperint
+	Name whereHelperstring
+}{
+	ID:   whereHelperint{field: "\"dish_families\".\"id\""},
+	Name: whereHelperstring{field: "\"dish_families\".\"name\""},
+}
+
+// DishFamilyRels is where relationship names are stored.
+var DishFamilyRels = struct {
+	DishFamilyDishes string
+}{
+	DishFamilyDishes: "DishFamilyDishes",
+}
+
+// dishFamilyR is where relationships are stored.
+type dishFamilyR struct {
+	DishFamilyDishes DishFamilyDishSlice `boil:"DishFamilyDishes" json:"DishFamilyDishes" toml:"DishFamilyDishes" yaml:"DishFamilyDishes"`
+}
+
+// NewStruct creates a new relationship struct
+func (*dishFamilyR) NewStruct() *dishFamilyR {
+	return &dishFamilyR{}
+}
+
+func (r *dishFamilyR) GetDishFamilyDishes() DishFamilyDishSlice {
+	if r == nil {
+		return nil
+	}
+	return r.DishFamilyDishes
+}
+
+// dishFamilyL is where Load methods for each relationship are stored.
+type dishFamilyL struct{}
+
+var (
+	dishFamilyAllColumns            = []string{"id", "name"}
+	dishFamilyColumnsWithoutDefault = []string{"name"}
+	dishFamilyColumnsWithDefault    = []string{"id"}
+	dishFamilyPrimaryKeyColumns     = []string{"id"}
+	dishFamilyGeneratedColumns      = []string{}
+)
+
+type (
+	// DishFamilySlice is an alias for a slice of pointers to DishFamily.
+	// This should almost always be used instead of []DishFamily.
+	DishFamilySlice []*DishFamily
+	// DishFamilyHook is the signature for custom DishFamily hook methods
+	DishFamilyHook func(context.Context, boil.ContextExecutor, *DishFamily) error
+
+	dishFamilyQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	dishFamilyType                 = reflect.TypeOf(&DishFamily{})
+	dishFamilyMapping              = queries.MakeStructMapping(dishFamilyType)
+	dishFamilyPrimaryKeyMapping, _ = queries.BindMapping(dishFamilyType, dishFamilyMapping, dishFamilyPrimaryKeyColumns)
+	dishFamilyInsertCacheMut       sync.RWMutex
+	dishFamilyInsertCache          = make(map[string]insertCache)
+	dishFamilyUpdateCacheMut       sync.RWMutex
+	dishFamilyUpdateCache          = make(map[string]updateCache)
+	dishFamilyUpsertCacheMut       sync.RWMutex
+	dishFamilyUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var dishFamilyAfterSelectHooks []DishFamilyHook
+
+var dishFamilyBeforeInsertHooks []DishFamilyHook
+var dishFamilyAfterInsertHooks []DishFamilyHook
+
+var dishFamilyBeforeUpdateHooks []DishFamilyHook
+var dishFamilyAfterUpdateHooks []DishFamilyHook
+
+var dishFamilyBeforeDeleteHooks []DishFamilyHook
+var dishFamilyAfterDeleteHooks []DishFamilyHook
+
+var dishFamilyBeforeUpsertHooks []DishFamilyHook
+var dishFamilyAfterUpsertHooks []DishFamilyHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DishFamily) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishFamilyAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DishFamily) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishFamilyBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DishFamily) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishFamilyAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DishFamily) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishFamilyBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DishFamily) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishFamilyAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DishFamily) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishFamilyBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DishFamily) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishFamilyAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DishFamily) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishFamilyBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DishFamily) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishFamilyAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDishFamilyHook registers your hook function for all future operations.
+func AddDishFamilyHook(hookPoint boil.HookPoint, dishFamilyHook DishFamilyHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		dishFamilyAfterSelectHooks = append(dishFamilyAfterSelectHooks, dishFamilyHook)
+	case boil.BeforeInsertHook:
+		dishFamilyBeforeInsertHooks = append(dishFamilyBeforeInsertHooks, dishFamilyHook)
+	case boil.AfterInsertHook:
+		dishFamilyAfterInsertHooks = append(dishFamilyAfterInsertHooks, dishFamilyHook)
+	case boil.BeforeUpdateHook:
+		dishFamilyBeforeUpdateHooks = append(dishFamilyBeforeUpdateHooks, dishFamilyHook)
+	case boil.AfterUpdateHook:
+		dishFamilyAfterUpdateHooks = append(dishFamilyAfterUpdateHooks, dishFamilyHook)
+	case boil.BeforeDeleteHook:
+		dishFamilyBeforeDeleteHooks = append(dishFamilyBeforeDeleteHooks, dishFamilyHook)
+	case boil.AfterDeleteHook:
+		dishFamilyAfterDeleteHooks = append(dishFamilyAfterDeleteHooks, dishFamilyHook)
+	case boil.BeforeUpsertHook:
+		dishFamilyBeforeUpsertHooks = append(dishFamilyBeforeUpsertHooks, dishFamilyHook)
+	case boil.AfterUpsertHook:
+		dishFamilyAfterUpsertHooks = append(dishFamilyAfterUpsertHooks, dishFamilyHook)
+	}
+}
+
+// One returns a single dishFamily record from the query.
+func (q dishFamilyQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DishFamily, error) {
+	o := &DishFamily{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlboilerPSQL: failed to execute a one query for dish_families")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DishFamily records from the query.
+func (q dishFamilyQuery) All(ctx context.Context, exec boil.ContextExecutor) (DishFamilySlice, error) {
+	var o []*DishFamily
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlboilerPSQL: failed to assign all query results to DishFamily slice")
+	}
+
+	if len(dishFamilyAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DishFamily records in the query.
+func (q dishFamilyQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to count dish_families rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q dishFamilyQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlboilerPSQL: failed to check if dish_families exists")
+	}
+
+	return count > 0, nil
+}
+
+// DishFamilyDishes retrieves all the dish_family_dish's DishFamilyDishes with an executor.
+func (o *DishFamily) DishFamilyDishes(mods ...qm.QueryMod) dishFamilyDishQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"dish_family_dishes\".\"dish_family_id\"=?", o.ID),
+	)
+
+	return DishFamilyDishes(queryMods...)
+}
+
+// LoadDishFamilyDishes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (dishFamilyL) LoadDishFamilyDishes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDishFamily interface{}, mods queries.Applicator) error {
+	var slice []*DishFamily
+	var object *DishFamily
+
+	if singular {
+		var ok bool
+		object, ok = maybeDishFamily.(*DishFamily)
+		if !ok {
+			object = new(DishFamily)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDishFamily)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDishFamily))
+			}
+		}
+	} else {
+		s, ok := maybeDishFamily.(*[]*DishFamily)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDishFamily)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDishFamily))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &dishFamilyR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dishFamilyR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`dish_family_dishes`),
+		qm.WhereIn(`dish_family_dishes.dish_family_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load dish_family_dishes")
+	}
+
+	var resultSlice []*DishFamilyDish
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice dish_family_dishes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on dish_family_dishes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for dish_family_dishes")
+	}
+
+	if len(dishFamilyDishAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DishFamilyDishes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dishFamilyDishR{}
+			}
+			foreign.R.DishFamily = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.DishFamilyID {
+				local.R.DishFamilyDishes = append(local.R.DishFamilyDishes, foreign)
+				if foreign.R == nil {
+					foreign.R = &dishFamilyDishR{}
+				}
+				foreign.R.DishFamily = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddDishFamilyDishes adds the given related objects to the existing relationships
+// of the dish_family, optionally inserting them as new records.
+// Appends related to o.R.DishFamilyDishes.
+// Sets related.R.DishFamily appropriately.
+func (o *DishFamily) AddDishFamilyDishes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DishFamilyDish) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.DishFamilyID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"dish_family_dishes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"dish_family_id"}),
+				strmangle.WhereClause("\"", "\"", 2, dishFamilyDishPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.DishID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.DishFamilyID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &dishFamilyR{
+			DishFamilyDishes: related,
+		}
+	} else {
+		o.R.DishFamilyDishes = append(o.R.DishFamilyDishes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dishFamilyDishR{
+				DishFamily: o,
+			}
+		} else {
+			rel.R.DishFamily = o
+		}
+	}
+	return nil
+}
+
+// DishFamilies retrieves all the records using an executor.
+func DishFamilies(mods ...qm.QueryMod) dishFamilyQuery {
+	mods = append(mods, qm.From("\"dish_families\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"dish_families\".*"})
+	}
+
+	return dishFamilyQuery{q}
+}
+
+// FindDishFamily retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDishFamily(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*DishFamily, error) {
+	dishFamilyObj := &DishFamily{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"dish_families\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, dishFamilyObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlboilerPSQL: unable to select from dish_families")
+	}
+
+	if err = dishFamilyObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return dishFamilyObj, err
+	}
+
+	return dishFamilyObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DishFamily) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlboilerPSQL: no dish_families provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dishFamilyColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	dishFamilyInsertCacheMut.RLock()
+	cache, cached := dishFamilyInsertCache[key]
+	dishFamilyInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			dishFamilyAllColumns,
+			dishFamilyColumnsWithDefault,
+			dishFamilyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dishFamilyType, dishFamilyMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(dishFamilyType, dishFamilyMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"dish_families\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"dish_families\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "sqlboilerPSQL: unable to insert into dish_families")
+	}
+
+	if !cached {
+		dishFamilyInsertCacheMut.Lock()
+		dishFamilyInsertCache[key] = cache
+		dishFamilyInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DishFamily.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DishFamily) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	dishFamilyUpdateCacheMut.RLock()
+	cache, cached := dishFamilyUpdateCache[key]
+	dishFamilyUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			dishFamilyAllColumns,
+			dishFamilyPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("sqlboilerPSQL: unable to update dish_families, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"dish_families\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, dishFamilyPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(dishFamilyType, dishFamilyMapping, append(wl, dishFamilyPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to update dish_families row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to get rows affected by update for dish_families")
+	}
+
+	if !cached {
+		dishFamilyUpdateCacheMut.Lock()
+		dishFamilyUpdateCache[key] = cache
+		dishFamilyUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q dishFamilyQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to update all for dish_families")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to retrieve rows affected for dish_families")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DishFamilySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlboilerPSQL: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dishFamilyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"dish_families\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, dishFamilyPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to update all in dishFamily slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to retrieve rows affected all in update all dishFamily")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DishFamily) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlboilerPSQL: no dish_families provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dishFamilyColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	dishFamilyUpsertCacheMut.RLock()
+	cache, cached := dishFamilyUpsertCache[key]
+	dishFamilyUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			dishFamilyAllColumns,
+			dishFamilyColumnsWithDefault,
+			dishFamilyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			dishFamilyAllColumns,
+			dishFamilyPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("sqlboilerPSQL: unable to upsert dish_families, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(dishFamilyPrimaryKeyColumns))
+			copy(conflict, dishFamilyPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"dish_families\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(dishFamilyType, dishFamilyMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(dishFamilyType, dishFamilyMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "sqlboilerPSQL: unable to upsert dish_families")
+	}
+
+	if !cached {
+		dishFamilyUpsertCacheMut.Lock()
+		dishFamilyUpsertCache[key] = cache
+		dishFamilyUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single DishFamily record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DishFamily) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlboilerPSQL: no DishFamily provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), dishFamilyPrimaryKeyMapping)
+	sql := "DELETE FROM \"dish_families\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to delete from dish_families")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to get rows affected by delete for dish_families")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q dishFamilyQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlboilerPSQL: no dishFamilyQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to delete all from dish_families")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to get rows affected by deleteall for dish_families")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DishFamilySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(dishFamilyBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dishFamilyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"dish_families\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, dishFamilyPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to delete all from dishFamily slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to get rows affected by deleteall for dish_families")
+	}
+
+	if len(dishFamilyAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DishFamily) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDishFamily(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DishFamilySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DishFamilySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dishFamilyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"dish_families\".* FROM \"dish_families\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, dishFamilyPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlboilerPSQL: unable to reload all in DishFamilySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DishFamilyExists checks if the DishFamily row exists.
+func DishFamilyExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"dish_families\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlboilerPSQL: unable to check if dish_families exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1267 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlboilerPSQL
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// DishFamilyDish is an object representing the database table.
+type DishFamilyDish struct {
+	DishID       int `boil:"dish_id" json:"dish_id" toml:"dish_id" yaml:"dish_id"`
+	DishFamilyID int `boil:"dish_family_id" json:"dish_family_id" toml:"dish_family_id" yaml:"dish_family_id"`
+
+	R *dishFamilyDishR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L dishFamilyDishL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DishFamilyDishColumns = struct {
+	DishID       string
+	DishFamilyID string
+}{
+	DishID:       "dish_id",
+	DishFamilyID: "dish_family_id",
+}
+
+var DishFamilyDishTableColumns = struct {
+	DishID       string
+	DishFamilyID string
+}{
+	DishID:       "dish_family_dishes.dish_id",
+	DishFamilyID: "dish_family_dishes.dish_family_id",
+}
+
+// Generated where
+
+var DishFamilyDishWhere = struct {
+	DishID       whereHelperint
+	DishFamilyID whereHelperint
+}{
+	DishID:       whereHelperint{field: "\"dish_family_dishes\".\"dish_id\""},
+	DishFamilyID: whereHelperint{field: "\"dish_family_dishes\".\"dish_family_id\""},
+}
+
+// DishFamilyDishRels is where relationship names are stored.
+var DishFamilyDishRels = struct {
+	DishFamily string
+	Dish       string
+}{
+	DishFamily: "DishFamily",
+	Dish:       "Dish",
+}
+
+// dishFamilyDishR is where relationships are stored.
+type dishFamilyDishR struct {
+	DishFamily *DishFamily `boil:"DishFamily" json:"DishFamily" toml:"DishFamily" yaml:"DishFamily"`
+	Dish       *Dish       `boil:"Dish" json:"Dish" toml:"Dish" yaml:"Dish"`
+}
+
+// NewStruct creates a new relationship struct
+func (*dishFamilyDishR) NewStruct() *dishFamilyDishR {
+	return &dishFamilyDishR{}
+}
+
+func (r *dishFamilyDishR) GetDishFamily() *DishFamily {
+	if r == nil {
+		return nil
+	}
+	return r.DishFamily
+}
+
+func (r *dishFamilyDishR) GetDish() *Dish {
+	if r == nil {
+		return nil
+	}
+	return r.Dish
+}
+
+// dishFamilyDishL is where Load methods for each relationship are stored.
+type dishFamilyDishL struct{}
+
+var (
+	dishFamilyDishAllColumns            = []string{"dish_id", "dish_family_id"}
+	dishFamilyDishColumnsWithoutDefault = []string{"dish_id", "dish_family_id"}
+	dishFamilyDishColumnsWithDefault    = []string{}
+	dishFamilyDishPrimaryKeyColumns     = []string{"dish_id"}
+	dishFamilyDishGeneratedColumns      = []string{}
+)
+
+type (
+	// DishFamilyDishSlice is an alias for a slice of pointers to DishFamilyDish.
+	// This should almost always be used instead of []DishFamilyDish.
+	DishFamilyDishSlice []*DishFamilyDish
+	// DishFamilyDishHook is the signature for custom DishFamilyDish hook methods
+	DishFamilyDishHook func(context.Context, boil.ContextExecutor, *DishFamilyDish) error
+
+	dishFamilyDishQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	dishFamilyDishType                 = reflect.TypeOf(&DishFamilyDish{})
+	dishFamilyDishMapping              = queries.MakeStructMapping(dishFamilyDishType)
+	dishFamilyDishPrimaryKeyMapping, _ = queries.BindMapping(dishFamilyDishType, dishFamilyDishMapping, dishFamilyDishPrimaryKeyColumns)
+	dishFamilyDishInsertCacheMut       sync.RWMutex
+	dishFamilyDishInsertCache          = make(map[string]insertCache)
+	dishFamilyDishUpdateCacheMut       sync.RWMutex
+	dishFamilyDishUpdateCache          = make(map[string]updateCache)
+	dishFamilyDishUpsertCacheMut       sync.RWMutex
+	dishFamilyDishUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var dishFamilyDishAfterSelectHooks []DishFamilyDishHook
+
+var dishFamilyDishBeforeInsertHooks []DishFamilyDishHook
+var dishFamilyDishAfterInsertHooks []DishFamilyDishHook
+
+var dishFamilyDishBeforeUpdateHooks []DishFamilyDishHook
+var dishFamilyDishAfterUpdateHooks []DishFamilyDishHook
+
+var dishFamilyDishBeforeDeleteHooks []DishFamilyDishHook
+var dishFamilyDishAfterDeleteHooks []DishFamilyDishHook
+
+var dishFamilyDishBeforeUpsertHooks []DishFamilyDishHook
+var dishFamilyDishAfterUpsertHooks []DishFamilyDishHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DishFamilyDish) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishFamilyDishAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DishFamilyDish) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishFamilyDishBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DishFamilyDish) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishFamilyDishAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DishFamilyDish) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishFamilyDishBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DishFamilyDish) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishFamilyDishAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DishFamilyDish) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishFamilyDishBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DishFamilyDish) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishFamilyDishAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DishFamilyDish) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishFamilyDishBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DishFamilyDish) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishFamilyDishAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDishFamilyDishHook registers your hook function for all future operations.
+func AddDishFamilyDishHook(hookPoint boil.HookPoint, dishFamilyDishHook DishFamilyDishHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		dishFamilyDishAfterSelectHooks = append(dishFamilyDishAfterSelectHooks, dishFamilyDishHook)
+	case boil.BeforeInsertHook:
+		dishFamilyDishBeforeInsertHooks = append(dishFamilyDishBeforeInsertHooks, dishFamilyDishHook)
+	case boil.AfterInsertHook:
+		dishFamilyDishAfterInsertHooks = append(dishFamilyDishAfterInsertHooks, dishFamilyDishHook)
+	case boil.BeforeUpdateHook:
+		dishFamilyDishBeforeUpdateHooks = append(dishFamilyDishBeforeUpdateHooks, dishFamilyDishHook)
+	case boil.AfterUpdateHook:
+		dishFamilyDishAfterUpdateHooks = append(dishFamilyDishAfterUpdateHooks, dishFamilyDishHook)
+	case boil.BeforeDeleteHook:
+		dishFamilyDishBeforeDeleteHooks = append(dishFamilyDishBeforeDeleteHooks, dishFamilyDishHook)
+	case boil.AfterDeleteHook:
+		dishFamilyDishAfterDeleteHooks = append(dishFamilyDishAfterDeleteHooks, dishFamilyDishHook)
+	case boil.BeforeUpsertHook:
+		dishFamilyDishBeforeUpsertHooks = append(dishFamilyDishBeforeUpsertHooks, dishFamilyDishHook)
+	case boil.AfterUpsertHook:
+		dishFamilyDishAfterUpsertHooks = append(dishFamilyDishAfterUpsertHooks, dishFamilyDishHook)
+	}
+}
+
+// One returns a single dishFamilyDish record from the query.
+func (q dishFamilyDishQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DishFamilyDish, error) {
+	o := &DishFamilyDish{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlboilerPSQL: failed to execute a one query for dish_family_dishes")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DishFamilyDish records from the query.
+func (q dishFamilyDishQuery) All(ctx context.Context, exec boil.ContextExecutor) (DishFamilyDishSlice, error) {
+	var o []*DishFamilyDish
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlboilerPSQL: failed to assign all query results to DishFamilyDish slice")
+	}
+
+	if len(dishFamilyDishAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DishFamilyDish records in the query.
+func (q dishFamilyDishQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to count dish_family_dishes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q dishFamilyDishQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlboilerPSQL: failed to check if dish_family_dishes exists")
+	}
+
+	return count > 0, nil
+}
+
+// DishFamily pointed to by the foreign key.
+func (o *DishFamilyDish) DishFamily(mods ...qm.QueryMod) dishFamilyQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.DishFamilyID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return DishFamilies(queryMods...)
+}
+
+// Dish pointed to by the foreign key.
+func (o *DishFamilyDish) Dish(mods ...qm.QueryMod) dishQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.DishID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Dishes(queryMods...)
+}
+
+// LoadDishFamily allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (dishFamilyDishL) LoadDishFamily(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDishFamilyDish interface{}, mods queries.Applicator) error {
+	var slice []*DishFamilyDish
+	var object *DishFamilyDish
+
+	if singular {
+		var ok bool
+		object, ok = maybeDishFamilyDish.(*DishFamilyDish)
+		if !ok {
+			object = new(DishFamilyDish)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDishFamilyDish)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDishFamilyDish))
+			}
+		}
+	} else {
+		s, ok := maybeDishFamilyDish.(*[]*DishFamilyDish)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDishFamilyDish)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDishFamilyDish))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &dishFamilyDishR{}
+		}
+		args = append(args, object.DishFamilyID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dishFamilyDishR{}
+			}
+
+			for _, a := range args {
+				if a == obj.DishFamilyID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.DishFamilyID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`dish_families`),
+		qm.WhereIn(`dish_families.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load DishFamily")
+	}
+
+	var resultSlice []*DishFamily
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice DishFamily")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for dish_families")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for dish_families")
+	}
+
+	if len(dishFamilyDishAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.DishFamily = foreign
+		if foreign.R == nil {
+			foreign.R = &dishFamilyR{}
+		}
+		foreign.R.DishFamilyDishes = append(foreign.R.DishFamilyDishes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.DishFamilyID == foreign.ID {
+				local.R.DishFamily = foreign
+				if foreign.R == nil {
+					foreign.R = &dishFamilyR{}
+				}
+				foreign.R.DishFamilyDishes = append(foreign.R.DishFamilyDishes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDish allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (dishFamilyDishL) LoadDish(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDishFamilyDish interface{}, mods queries.Applicator) error {
+	var slice []*DishFamilyDish
+	var object *DishFamilyDish
+
+	if singular {
+		var ok bool
+		object, ok = maybeDishFamilyDish.(*DishFamilyDish)
+		if !ok {
+			object = new(DishFamilyDish)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDishFamilyDish)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDishFamilyDish))
+			}
+		}
+	} else {
+		s, ok := maybeDishFamilyDish.(*[]*DishFamilyDish)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDishFamilyDish)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDishFamilyDish))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &dishFamilyDishR{}
+		}
+		args = append(args, object.DishID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dishFamilyDishR{}
+			}
+
+			for _, a := range args {
+				if a == obj.DishID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.DishID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`dishes`),
+		qm.WhereIn(`dishes.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Dish")
+	}
+
+	var resultSlice []*Dish
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Dish")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for dishes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for dishes")
+	}
+
+	if len(dishFamilyDishAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Dish = foreign
+		if foreign.R == nil {
+			foreign.R = &dishR{}
+		}
+		foreign.R.DishFamilyDish = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.DishID == foreign.ID {
+				local.R.Dish = foreign
+				if foreign.R == nil {
+					foreign.R = &dishR{}
+				}
+				foreign.R.DishFamilyDish = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetDishFamily of the dishFamilyDish to the related item.
+// Sets o.R.DishFamily to related.
+// Adds o to related.R.DishFamilyDishes.
+func (o *DishFamilyDish) SetDishFamily(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DishFamily) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"dish_family_dishes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"dish_family_id"}),
+		strmangle.WhereClause("\"", "\"", 2, dishFamilyDishPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.DishID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.DishFamilyID = related.ID
+	if o.R == nil {
+		o.R = &dishFamilyDishR{
+			DishFamily: related,
+		}
+	} else {
+		o.R.DishFamily = related
+	}
+
+	if related.R == nil {
+		related.R = &dishFamilyR{
+			DishFamilyDishes: DishFamilyDishSlice{o},
+		}
+	} else {
+		related.R.DishFamilyDishes = append(related.R.DishFamilyDishes, o)
+	}
+
+	return nil
+}
+
+// SetDish of the dishFamilyDish to the related item.
+// Sets o.R.Dish to related.
+// Adds o to related.R.DishFamilyDish.
+func (o *DishFamilyDish) SetDish(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Dish) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"dish_family_dishes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"dish_id"}),
+		strmangle.WhereClause("\"", "\"", 2, dishFamilyDishPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.DishID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.DishID = related.ID
+	if o.R == nil {
+		o.R = &dishFamilyDishR{
+			Dish: related,
+		}
+	} else {
+		o.R.Dish = related
+	}
+
+	if related.R == nil {
+		related.R = &dishR{
+			DishFamilyDish: o,
+		}
+	} else {
+		related.R.DishFamilyDish = o
+	}
+
+	return nil
+}
+
+// DishFamilyDishes retrieves all the records using an executor.
+func DishFamilyDishes(mods ...qm.QueryMod) dishFamilyDishQuery {
+	mods = append(mods, qm.From("\"dish_family_dishes\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"dish_family_dishes\".*"})
+	}
+
+	return dishFamilyDishQuery{q}
+}
+
+// FindDishFamilyDish retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDishFamilyDish(ctx context.Context, exec boil.ContextExecutor, dishID int, selectCols ...string) (*DishFamilyDish, error) {
+	dishFamilyDishObj := &DishFamilyDish{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"dish_family_dishes\" where \"dish_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, dishID)
+
+	err := q.Bind(ctx, exec, dishFamilyDishObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlboilerPSQL: unable to select from dish_family_dishes")
+	}
+
+	if err = dishFamilyDishObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return dishFamilyDishObj, err
+	}
+
+	return dishFamilyDishObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DishFamilyDish) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlboilerPSQL: no dish_family_dishes provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dishFamilyDishColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	dishFamilyDishInsertCacheMut.RLock()
+	cache, cached := dishFamilyDishInsertCache[key]
+	dishFamilyDishInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			dishFamilyDishAllColumns,
+			dishFamilyDishColumnsWithDefault,
+			dishFamilyDishColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dishFamilyDishType, dishFamilyDishMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(dishFamilyDishType, dishFamilyDishMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"dish_family_dishes\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"dish_family_dishes\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "sqlboilerPSQL: unable to insert into dish_family_dishes")
+	}
+
+	if !cached {
+		dishFamilyDishInsertCacheMut.Lock()
+		dishFamilyDishInsertCache[key] = cache
+		dishFamilyDishInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DishFamilyDish.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DishFamilyDish) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	dishFamilyDishUpdateCacheMut.RLock()
+	cache, cached := dishFamilyDishUpdateCache[key]
+	dishFamilyDishUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			dishFamilyDishAllColumns,
+			dishFamilyDishPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("sqlboilerPSQL: unable to update dish_family_dishes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"dish_family_dishes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, dishFamilyDishPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(dishFamilyDishType, dishFamilyDishMapping, append(wl, dishFamilyDishPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to update dish_family_dishes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to get rows affected by update for dish_family_dishes")
+	}
+
+	if !cached {
+		dishFamilyDishUpdateCacheMut.Lock()
+		dishFamilyDishUpdateCache[key] = cache
+		dishFamilyDishUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q dishFamilyDishQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to update all for dish_family_dishes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to retrieve rows affected for dish_family_dishes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DishFamilyDishSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlboilerPSQL: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dishFamilyDishPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"dish_family_dishes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, dishFamilyDishPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to update all in dishFamilyDish slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to retrieve rows affected all in update all dishFamilyDish")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DishFamilyDish) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlboilerPSQL: no dish_family_dishes provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dishFamilyDishColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	dishFamilyDishUpsertCacheMut.RLock()
+	cache, cached := dishFamilyDishUpsertCache[key]
+	dishFamilyDishUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			dishFamilyDishAllColumns,
+			dishFamilyDishColumnsWithDefault,
+			dishFamilyDishColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			dishFamilyDishAllColumns,
+			dishFamilyDishPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("sqlboilerPSQL: unable to upsert dish_family_dishes, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(dishFamilyDishPrimaryKeyColumns))
+			copy(conflict, dishFamilyDishPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"dish_family_dishes\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(dishFamilyDishType, dishFamilyDishMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(dishFamilyDishType, dishFamilyDishMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "sqlboilerPSQL: unable to upsert dish_family_dishes")
+	}
+
+	if !cached {
+		dishFamilyDishUpsertCacheMut.Lock()
+		dishFamilyDishUpsertCache[key] = cache
+		dishFamilyDishUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single DishFamilyDish record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DishFamilyDish) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlboilerPSQL: no DishFamilyDish provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), dishFamilyDishPrimaryKeyMapping)
+	sql := "DELETE FROM \"dish_family_dishes\" WHERE \"dish_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to delete from dish_family_dishes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to get rows affected by delete for dish_family_dishes")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q dishFamilyDishQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlboilerPSQL: no dishFamilyDishQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to delete all from dish_family_dishes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to get rows affected by deleteall for dish_family_dishes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DishFamilyDishSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(dishFamilyDishBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dishFamilyDishPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"dish_family_dishes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, dishFamilyDishPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to delete all from dishFamilyDish slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to get rows affected by deleteall for dish_family_dishes")
+	}
+
+	if len(dishFamilyDishAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DishFamilyDish) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDishFamilyDish(ctx, exec, o.DishID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DishFamilyDishSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DishFamilyDishSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dishFamilyDishPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"dish_family_dishes\".* FROM \"dish_family_dishes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, dishFamilyDishPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlboilerPSQL: unable to reload all in DishFamilyDishSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DishFamilyDishExists checks if the DishFamilyDish row exists.
+func DishFamilyDishExists(ctx context.Context, exec boil.ContextExecutor, dishID int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"dish_family_dishes\" where \"dish_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, dishID)
+	}
+	row := exec.QueryRowContext(ctx, sql, dishID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlboilerPSQL: unable to check if dish_family_dishes exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1080 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlboilerPSQL
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// DishTag is an object representing the database table.
+type DishTag struct {
+	DishID int `boil:"dish_id" json:"dish_id" toml:"dish_id" yaml:"dish_id"`
+	// Metadata like "vegan" that was extracted from the dish name during normalization
+	Tag string `boil:"tag" json:"tag" toml:"tag" yaml:"tag"`
+
+	R *dishTagR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L dishTagL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DishTagColumns = struct {
+	DishID string
+	Tag    string
+}{
+	DishID: "dish_id",
+	Tag:    "tag",
+}
+
+var DishTagTableColumns = struct {
+	DishID string
+	Tag    string
+}{
+	DishID: "dish_tags.dish_id",
+	Tag:    "dish_tags.tag",
+}
+
+// Generated where
+
+var DishTagWhere = struct {
+	DishID whereHelperint
+	Tag    whereHelperstring
+}{
+	DishID: whereHelperint{field: "\"dish_tags\".\"dish_id\""},
+	Tag:    whereHelperstring{field: "\"dish_tags\".\"tag\""},
+}
+
+// DishTagRels is where relationship names are stored.
+var DishTagRels = struct {
+	Dish string
+}{
+	Dish: "Dish",
+}
+
+// dishTagR is where relationships are stored.
+type dishTagR struct {
+	Dish *Dish `boil:"Dish" json:"Dish" toml:"Dish" yaml:"Dish"`
+}
+
+// NewStruct creates a new relationship struct
+func (*dishTagR) NewStruct() *dishTagR {
+	return &dishTagR{}
+}
+
+func (r *dishTagR) GetDish() *Dish {
+	if r == nil {
+		return nil
+	}
+	return r.Dish
+}
+
+// dishTagL is where Load methods for each relationship are stored.
+type dishTagL struct{}
+
+var (
+	dishTagAllColumns            = []string{"dish_id", "tag"}
+	dishTagColumnsWithoutDefault = []string{"dish_id", "tag"}
+	dishTagColumnsWithDefault    = []string{}
+	dishTagPrimaryKeyColumns     = []string{"dish_id", "tag"}
+	dishTagGeneratedColumns      = []string{}
+)
+
+type (
+	// DishTagSlice is an alias for a slice of pointers to DishTag.
+	// This should almost always be used instead of []DishTag.
+	DishTagSlice []*DishTag
+	// DishTagHook is the signature for custom DishTag hook methods
+	DishTagHook func(context.Context, boil.ContextExecutor, *DishTag) error
+
+	dishTagQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	dishTagType                 = reflect.TypeOf(&DishTag{})
+	dishTagMapping              = queries.MakeStructMapping(dishTagType)
+	dishTagPrimaryKeyMapping, _ = queries.BindMapping(dishTagType, dishTagMapping, dishTagPrimaryKeyColumns)
+	dishTagInsertCacheMut       sync.RWMutex
+	dishTagInsertCache          = make(map[string]insertCache)
+	dishTagUpdateCacheMut       sync.RWMutex
+	dishTagUpdateCache          = make(map[string]updateCache)
+	dishTagUpsertCacheMut       sync.RWMutex
+	dishTagUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var dishTagAfterSelectHooks []DishTagHook
+
+var dishTagBeforeInsertHooks []DishTagHook
+var dishTagAfterInsertHooks []DishTagHook
+
+var dishTagBeforeUpdateHooks []DishTagHook
+var dishTagAfterUpdateHooks []DishTagHook
+
+var dishTagBeforeDeleteHooks []DishTagHook
+var dishTagAfterDeleteHooks []DishTagHook
+
+var dishTagBeforeUpsertHooks []DishTagHook
+var dishTagAfterUpsertHooks []DishTagHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DishTag) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishTagAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DishTag) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishTagBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DishTag) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishTagAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DishTag) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishTagBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DishTag) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishTagAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DishTag) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishTagBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DishTag) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishTagAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DishTag) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishTagBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DishTag) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dishTagAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDishTagHook registers your hook function for all future operations.
+func AddDishTagHook(hookPoint boil.HookPoint, dishTagHook DishTagHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		dishTagAfterSelectHooks = append(dishTagAfterSelectHooks, dishTagHook)
+	case boil.BeforeInsertHook:
+		dishTagBeforeInsertHooks = append(dishTagBeforeInsertHooks, dishTagHook)
+	case boil.AfterInsertHook:
+		dishTagAfterInsertHooks = append(dishTagAfterInsertHooks, dishTagHook)
+	case boil.BeforeUpdateHook:
+		dishTagBeforeUpdateHooks = append(dishTagBeforeUpdateHooks, dishTagHook)
+	case boil.AfterUpdateHook:
+		dishTagAfterUpdateHooks = append(dishTagAfterUpdateHooks, dishTagHook)
+	case boil.BeforeDeleteHook:
+		dishTagBeforeDeleteHooks = append(dishTagBeforeDeleteHooks, dishTagHook)
+	case boil.AfterDeleteHook:
+		dishTagAfterDeleteHooks = append(dishTagAfterDeleteHooks, dishTagHook)
+	case boil.BeforeUpsertHook:
+		dishTagBeforeUpsertHooks = append(dishTagBeforeUpsertHooks, dishTagHook)
+	case boil.AfterUpsertHook:
+		dishTagAfterUpsertHooks = append(dishTagAfterUpsertHooks, dishTagHook)
+	}
+}
+
+// One returns a single dishTag record from the query.
+func (q dishTagQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DishTag, error) {
+	o := &DishTag{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlboilerPSQL: failed to execute a one query for dish_tags")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DishTag records from the query.
+func (q dishTagQuery) All(ctx context.Context, exec boil.ContextExecutor) (DishTagSlice, error) {
+	var o []*DishTag
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlboilerPSQL: failed to assign all query results to DishTag slice")
+	}
+
+	if len(dishTagAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DishTag records in the query.
+func (q dishTagQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to count dish_tags rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q dishTagQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlboilerPSQL: failed to check if dish_tags exists")
+	}
+
+	return count > 0, nil
+}
+
+// Dish pointed to by the foreign key.
+func (o *DishTag) Dish(mods ...qm.QueryMod) dishQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.DishID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Dishes(queryMods...)
+}
+
+// LoadDish allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (dishTagL) LoadDish(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDishTag interface{}, mods queries.Applicator) error {
+	var slice []*DishTag
+	var object *DishTag
+
+	if singular {
+		var ok bool
+		object, ok = maybeDishTag.(*DishTag)
+		if !ok {
+			object = new(DishTag)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDishTag)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDishTag))
+			}
+		}
+	} else {
+		s, ok := maybeDishTag.(*[]*DishTag)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDishTag)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDishTag))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &dishTagR{}
+		}
+		args = append(args, object.DishID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dishTagR{}
+			}
+
+			for _, a := range args {
+				if a == obj.DishID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.DishID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`dishes`),
+		qm.WhereIn(`dishes.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Dish")
+	}
+
+	var resultSlice []*Dish
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Dish")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for dishes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for dishes")
+	}
+
+	if len(dishTagAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Dish = foreign
+		if foreign.R == nil {
+			foreign.R = &dishR{}
+		}
+		foreign.R.DishTags = append(foreign.R.DishTags, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.DishID == foreign.ID {
+				local.R.Dish = foreign
+				if foreign.R == nil {
+					foreign.R = &dishR{}
+				}
+				foreign.R.DishTags = append(foreign.R.DishTags, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetDish of the dishTag to the related item.
+// Sets o.R.Dish to related.
+// Adds o to related.R.DishTags.
+func (o *DishTag) SetDish(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Dish) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"dish_tags\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"dish_id"}),
+		strmangle.WhereClause("\"", "\"", 2, dishTagPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.DishID, o.Tag}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.DishID = related.ID
+	if o.R == nil {
+		o.R = &dishTagR{
+			Dish: related,
+		}
+	} else {
+		o.R.Dish = related
+	}
+
+	if related.R == nil {
+		related.R = &dishR{
+			DishTags: DishTagSlice{o},
+		}
+	} else {
+		related.R.DishTags = append(related.R.DishTags, o)
+	}
+
+	return nil
+}
+
+// DishTags retrieves all the records using an executor.
+func DishTags(mods ...qm.QueryMod) dishTagQuery {
+	mods = append(mods, qm.From("\"dish_tags\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"dish_tags\".*"})
+	}
+
+	return dishTagQuery{q}
+}
+
+// FindDishTag retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDishTag(ctx context.Context, exec boil.ContextExecutor, dishID int, tag string, selectCols ...string) (*DishTag, error) {
+	dishTagObj := &DishTag{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"dish_tags\" where \"dish_id\"=$1 AND \"tag\"=$2", sel,
+	)
+
+	q := queries.Raw(query, dishID, tag)
+
+	err := q.Bind(ctx, exec, dishTagObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlboilerPSQL: unable to select from dish_tags")
+	}
+
+	if err = dishTagObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return dishTagObj, err
+	}
+
+	return dishTagObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DishTag) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlboilerPSQL: no dish_tags provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dishTagColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	dishTagInsertCacheMut.RLock()
+	cache, cached := dishTagInsertCache[key]
+	dishTagInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			dishTagAllColumns,
+			dishTagColumnsWithDefault,
+			dishTagColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dishTagType, dishTagMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(dishTagType, dishTagMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"dish_tags\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"dish_tags\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "sqlboilerPSQL: unable to insert into dish_tags")
+	}
+
+	if !cached {
+		dishTagInsertCacheMut.Lock()
+		dishTagInsertCache[key] = cache
+		dishTagInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DishTag.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DishTag) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	dishTagUpdateCacheMut.RLock()
+	cache, cached := dishTagUpdateCache[key]
+	dishTagUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			dishTagAllColumns,
+			dishTagPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("sqlboilerPSQL: unable to update dish_tags, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"dish_tags\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, dishTagPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(dishTagType, dishTagMapping, append(wl, dishTagPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to update dish_tags row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to get rows affected by update for dish_tags")
+	}
+
+	if !cached {
+		dishTagUpdateCacheMut.Lock()
+		dishTagUpdateCache[key] = cache
+		dishTagUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q dishTagQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to update all for dish_tags")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to retrieve rows affected for dish_tags")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DishTagSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlboilerPSQL: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dishTagPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"dish_tags\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, dishTagPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to update all in dishTag slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to retrieve rows affected all in update all dishTag")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DishTag) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlboilerPSQL: no dish_tags provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dishTagColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	dishTagUpsertCacheMut.RLock()
+	cache, cached := dishTagUpsertCache[key]
+	dishTagUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			dishTagAllColumns,
+			dishTagColumnsWithDefault,
+			dishTagColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			dishTagAllColumns,
+			dishTagPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("sqlboilerPSQL: unable to upsert dish_tags, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(dishTagPrimaryKeyColumns))
+			copy(conflict, dishTagPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"dish_tags\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(dishTagType, dishTagMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(dishTagType, dishTagMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "sqlboilerPSQL: unable to upsert dish_tags")
+	}
+
+	if !cached {
+		dishTagUpsertCacheMut.Lock()
+		dishTagUpsertCache[key] = cache
+		dishTagUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single DishTag record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DishTag) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlboilerPSQL: no DishTag provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), dishTagPrimaryKeyMapping)
+	sql := "DELETE FROM \"dish_tags\" WHERE \"dish_id\"=$1 AND \"tag\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to delete from dish_tags")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to get rows affected by delete for dish_tags")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q dishTagQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlboilerPSQL: no dishTagQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to delete all from dish_tags")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to get rows affected by deleteall for dish_tags")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DishTagSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(dishTagBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dishTagPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"dish_tags\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, dishTagPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to delete all from dishTag slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to get rows affected by deleteall for dish_tags")
+	}
+
+	if len(dishTagAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DishTag) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDishTag(ctx, exec, o.DishID, o.Tag)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DishTagSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DishTagSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dishTagPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"dish_tags\".* FROM \"dish_tags\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, dishTagPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlboilerPSQL: unable to reload all in DishTagSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DishTagExists checks if the DishTag row exists.
+func DishTagExists(ctx context.Context, exec boil.ContextExecutor, dishID int, tag string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"dish_tags\" where \"dish_id\"=$1 AND \"tag\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, dishID, tag)
+	}
+	row := exec.QueryRowContext(ctx, sql, dishID, tag)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlboilerPSQL: unable to check if dish_tags exists")
+	}
+
+	return exists, nil
+}
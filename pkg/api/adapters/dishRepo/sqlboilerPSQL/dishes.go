@@ -64,91 +64,6 @@ var DishTableColumns = struct {
 
 // Generated where
 
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelpernull_Int struct{ field string }
-
-func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-func (w whereHelpernull_Int) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
-type whereHelpernull_Time struct{ field string }
-
-func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 var DishWhere = struct {
 	ID           whereHelperint
 	LocationID   whereHelperint
@@ -165,23 +80,32 @@ var DishWhere = struct {
 
 // DishRels is where relationship names are stored.
 var DishRels = struct {
-	Location        string
-	MergedDish      string
-	DishOccurrences string
-	DishRatings     string
+	Location                 string
+	MergedDish               string
+	DishFamilyDish           string
+	CanonicalDishDishDedupes string
+	DishOccurrences          string
+	DishRatings              string
+	DishTags                 string
 }{
-	Location:        "Location",
-	MergedDish:      "MergedDish",
-	DishOccurrences: "DishOccurrences",
-	DishRatings:     "DishRatings",
+	Location:                 "Location",
+	MergedDish:               "MergedDish",
+	DishFamilyDish:           "DishFamilyDish",
+	CanonicalDishDishDedupes: "CanonicalDishDishDedupes",
+	DishOccurrences:          "DishOccurrences",
+	DishRatings:              "DishRatings",
+	DishTags:                 "DishTags",
 }
 
 // dishR is where relationships are stored.
 type dishR struct {
-	Location        *Location           `boil:"Location" json:"Location" toml:"Location" yaml:"Location"`
-	MergedDish      *MergedDish         `boil:"MergedDish" json:"MergedDish" toml:"MergedDish" yaml:"MergedDish"`
-	DishOccurrences DishOccurrenceSlice `boil:"DishOccurrences" json:"DishOccurrences" toml:"DishOccurrences" yaml:"DishOccurrences"`
-	DishRatings     DishRatingSlice     `boil:"DishRatings" json:"DishRatings" toml:"DishRatings" yaml:"DishRatings"`
+	Location                 *Location           `boil:"Location" json:"Location" toml:"Location" yaml:"Location"`
+	MergedDish               *MergedDish         `boil:"MergedDish" json:"MergedDish" toml:"MergedDish" yaml:"MergedDish"`
+	DishFamilyDish           *DishFamilyDish     `boil:"DishFamilyDish" json:"DishFamilyDish" toml:"DishFamilyDish" yaml:"DishFamilyDish"`
+	CanonicalDishDishDedupes DishDedupeSlice     `boil:"CanonicalDishDishDedupes" json:"CanonicalDishDishDedupes" toml:"CanonicalDishDishDedupes" yaml:"CanonicalDishDishDedupes"`
+	DishOccurrences          DishOccurrenceSlice `boil:"DishOccurrences" json:"DishOccurrences" toml:"DishOccurrences" yaml:"DishOccurrences"`
+	DishRatings              DishRatingSlice     `boil:"DishRatings" json:"DishRatings" toml:"DishRatings" yaml:"DishRatings"`
+	DishTags                 DishTagSlice        `boil:"DishTags" json:"DishTags" toml:"DishTags" yaml:"DishTags"`
 }
 
 // NewStruct creates a new relationship struct
@@ -203,6 +127,20 @@ func (r *dishR) GetMergedDish() *MergedDish {
 	return r.MergedDish
 }
 
+func (r *dishR) GetDishFamilyDish() *DishFamilyDish {
+	if r == nil {
+		return nil
+	}
+	return r.DishFamilyDish
+}
+
+func (r *dishR) GetCanonicalDishDishDedupes() DishDedupeSlice {
+	if r == nil {
+		return nil
+	}
+	return r.CanonicalDishDishDedupes
+}
+
 func (r *dishR) GetDishOccurrences() DishOccurrenceSlice {
 	if r == nil {
 		return nil
@@ -217,6 +155,13 @@ func (r *dishR) GetDishRatings() DishRatingSlice {
 	return r.DishRatings
 }
 
+func (r *dishR) GetDishTags() DishTagSlice {
+	if r == nil {
+		return nil
+	}
+	return r.DishTags
+}
+
 // dishL is where Load methods for each relationship are stored.
 type dishL struct{}
 
@@ -528,6 +473,31 @@ func (o *Dish) MergedDish(mods ...qm.QueryMod) mergedDishQuery {
 	return MergedDishes(queryMods...)
 }
 
+// DishFamilyDish pointed to by the foreign key.
+func (o *Dish) DishFamilyDish(mods ...qm.QueryMod) dishFamilyDishQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"dish_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return DishFamilyDishes(queryMods...)
+}
+
+// CanonicalDishDishDedupes retrieves all the dish_dedupe's DishDedupes with an executor via canonical_dish_id column.
+func (o *Dish) CanonicalDishDishDedupes(mods ...qm.QueryMod) dishDedupeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"dish_dedupes\".\"canonical_dish_id\"=?", o.ID),
+	)
+
+	return DishDedupes(queryMods...)
+}
+
 // DishOccurrences retrieves all the dish_occurrence's DishOccurrences with an executor.
 func (o *Dish) DishOccurrences(mods ...qm.QueryMod) dishOccurrenceQuery {
 	var queryMods []qm.QueryMod
@@ -556,6 +526,20 @@ func (o *Dish) DishRatings(mods ...qm.QueryMod) dishRatingQuery {
 	return DishRatings(queryMods...)
 }
 
+// DishTags retrieves all the dish_tag's DishTags with an executor.
+func (o *Dish) DishTags(mods ...qm.QueryMod) dishTagQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"dish_tags\".\"dish_id\"=?", o.ID),
+	)
+
+	return DishTags(queryMods...)
+}
+
 // LoadLocation allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (dishL) LoadLocation(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDish interface{}, mods queries.Applicator) error {
@@ -800,6 +784,237 @@ func (dishL) LoadMergedDish(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
+// LoadDishFamilyDish allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (dishL) LoadDishFamilyDish(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDish interface{}, mods queries.Applicator) error {
+	var slice []*Dish
+	var object *Dish
+
+	if singular {
+		var ok bool
+		object, ok = maybeDish.(*Dish)
+		if !ok {
+			object = new(Dish)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDish)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDish))
+			}
+		}
+	} else {
+		s, ok := maybeDish.(*[]*Dish)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDish)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDish))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &dishR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dishR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`dish_family_dishes`),
+		qm.WhereIn(`dish_family_dishes.dish_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load DishFamilyDish")
+	}
+
+	var resultSlice []*DishFamilyDish
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice DishFamilyDish")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for dish_family_dishes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for dish_family_dishes")
+	}
+
+	if len(dishAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.DishFamilyDish = foreign
+		if foreign.R == nil {
+			foreign.R = &dishFamilyDishR{}
+		}
+		foreign.R.Dish = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.DishID {
+				local.R.DishFamilyDish = foreign
+				if foreign.R == nil {
+					foreign.R = &dishFamilyDishR{}
+				}
+				foreign.R.Dish = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCanonicalDishDishDedupes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (dishL) LoadCanonicalDishDishDedupes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDish interface{}, mods queries.Applicator) error {
+	var slice []*Dish
+	var object *Dish
+
+	if singular {
+		var ok bool
+		object, ok = maybeDish.(*Dish)
+		if !ok {
+			object = new(Dish)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDish)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDish))
+			}
+		}
+	} else {
+		s, ok := maybeDish.(*[]*Dish)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDish)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDish))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &dishR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dishR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`dish_dedupes`),
+		qm.WhereIn(`dish_dedupes.canonical_dish_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load dish_dedupes")
+	}
+
+	var resultSlice []*DishDedupe
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice dish_dedupes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on dish_dedupes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for dish_dedupes")
+	}
+
+	if len(dishDedupeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CanonicalDishDishDedupes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dishDedupeR{}
+			}
+			foreign.R.CanonicalDish = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CanonicalDishID {
+				local.R.CanonicalDishDishDedupes = append(local.R.CanonicalDishDishDedupes, foreign)
+				if foreign.R == nil {
+					foreign.R = &dishDedupeR{}
+				}
+				foreign.R.CanonicalDish = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadDishOccurrences allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (dishL) LoadDishOccurrences(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDish interface{}, mods queries.Applicator) error {
@@ -1028,6 +1243,120 @@ func (dishL) LoadDishRatings(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
+// LoadDishTags allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (dishL) LoadDishTags(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDish interface{}, mods queries.Applicator) error {
+	var slice []*Dish
+	var object *Dish
+
+	if singular {
+		var ok bool
+		object, ok = maybeDish.(*Dish)
+		if !ok {
+			object = new(Dish)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDish)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDish))
+			}
+		}
+	} else {
+		s, ok := maybeDish.(*[]*Dish)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDish)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDish))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &dishR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dishR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`dish_tags`),
+		qm.WhereIn(`dish_tags.dish_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load dish_tags")
+	}
+
+	var resultSlice []*DishTag
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice dish_tags")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on dish_tags")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for dish_tags")
+	}
+
+	if len(dishTagAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DishTags = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dishTagR{}
+			}
+			foreign.R.Dish = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.DishID {
+				local.R.DishTags = append(local.R.DishTags, foreign)
+				if foreign.R == nil {
+					foreign.R = &dishTagR{}
+				}
+				foreign.R.Dish = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetLocation of the dish to the related item.
 // Sets o.R.Location to related.
 // Adds o to related.R.Dishes.
@@ -1155,6 +1484,109 @@ func (o *Dish) RemoveMergedDish(ctx context.Context, exec boil.ContextExecutor,
 	return nil
 }
 
+// SetDishFamilyDish of the dish to the related item.
+// Sets o.R.DishFamilyDish to related.
+// Adds o to related.R.Dish.
+func (o *Dish) SetDishFamilyDish(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DishFamilyDish) error {
+	var err error
+
+	if insert {
+		related.DishID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"dish_family_dishes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"dish_id"}),
+			strmangle.WhereClause("\"", "\"", 2, dishFamilyDishPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.DishID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.DishID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &dishR{
+			DishFamilyDish: related,
+		}
+	} else {
+		o.R.DishFamilyDish = related
+	}
+
+	if related.R == nil {
+		related.R = &dishFamilyDishR{
+			Dish: o,
+		}
+	} else {
+		related.R.Dish = o
+	}
+	return nil
+}
+
+// AddCanonicalDishDishDedupes adds the given related objects to the existing relationships
+// of the dish, optionally inserting them as new records.
+// Appends related to o.R.CanonicalDishDishDedupes.
+// Sets related.R.CanonicalDish appropriately.
+func (o *Dish) AddCanonicalDishDishDedupes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DishDedupe) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CanonicalDishID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"dish_dedupes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"canonical_dish_id"}),
+				strmangle.WhereClause("\"", "\"", 2, dishDedupePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CanonicalDishID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &dishR{
+			CanonicalDishDishDedupes: related,
+		}
+	} else {
+		o.R.CanonicalDishDishDedupes = append(o.R.CanonicalDishDishDedupes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dishDedupeR{
+				CanonicalDish: o,
+			}
+		} else {
+			rel.R.CanonicalDish = o
+		}
+	}
+	return nil
+}
+
 // AddDishOccurrences adds the given related objects to the existing relationships
 // of the dish, optionally inserting them as new records.
 // Appends related to o.R.DishOccurrences.
@@ -1261,6 +1693,59 @@ func (o *Dish) AddDishRatings(ctx context.Context, exec boil.ContextExecutor, in
 	return nil
 }
 
+// AddDishTags adds the given related objects to the existing relationships
+// of the dish, optionally inserting them as new records.
+// Appends related to o.R.DishTags.
+// Sets related.R.Dish appropriately.
+func (o *Dish) AddDishTags(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DishTag) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.DishID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"dish_tags\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"dish_id"}),
+				strmangle.WhereClause("\"", "\"", 2, dishTagPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.DishID, rel.Tag}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.DishID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &dishR{
+			DishTags: related,
+		}
+	} else {
+		o.R.DishTags = append(o.R.DishTags, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dishTagR{
+				Dish: o,
+			}
+		} else {
+			rel.R.Dish = o
+		}
+	}
+	return nil
+}
+
 // Dishes retrieves all the records using an executor.
 func Dishes(mods ...qm.QueryMod) dishQuery {
 	mods = append(mods, qm.From("\"dishes\""))
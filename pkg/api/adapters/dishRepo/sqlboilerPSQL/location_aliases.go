@@ -0,0 +1,1079 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlboilerPSQL
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// LocationAlias is an object representing the database table.
+type LocationAlias struct {
+	Alias      string `boil:"alias" json:"alias" toml:"alias" yaml:"alias"`
+	LocationID int    `boil:"location_id" json:"location_id" toml:"location_id" yaml:"location_id"`
+
+	R *locationAliasR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L locationAliasL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LocationAliasColumns = struct {
+	Alias      string
+	LocationID string
+}{
+	Alias:      "alias",
+	LocationID: "location_id",
+}
+
+var LocationAliasTableColumns = struct {
+	Alias      string
+	LocationID string
+}{
+	Alias:      "location_aliases.alias",
+	LocationID: "location_aliases.location_id",
+}
+
+// Generated where
+
+var LocationAliasWhere = struct {
+	Alias      whereHelperstring
+	LocationID whereHelperint
+}{
+	Alias:      whereHelperstring{field: "\"location_aliases\".\"alias\""},
+	LocationID: whereHelperint{field: "\"location_aliases\".\"location_id\""},
+}
+
+// LocationAliasRels is where relationship names are stored.
+var LocationAliasRels = struct {
+	Location string
+}{
+	Location: "Location",
+}
+
+// locationAliasR is where relationships are stored.
+type locationAliasR struct {
+	Location *Location `boil:"Location" json:"Location" toml:"Location" yaml:"Location"`
+}
+
+// NewStruct creates a new relationship struct
+func (*locationAliasR) NewStruct() *locationAliasR {
+	return &locationAliasR{}
+}
+
+func (r *locationAliasR) GetLocation() *Location {
+	if r == nil {
+		return nil
+	}
+	return r.Location
+}
+
+// locationAliasL is where Load methods for each relationship are stored.
+type locationAliasL struct{}
+
+var (
+	locationAliasAllColumns            = []string{"alias", "location_id"}
+	locationAliasColumnsWithoutDefault = []string{"alias", "location_id"}
+	locationAliasColumnsWithDefault    = []string{}
+	locationAliasPrimaryKeyColumns     = []string{"alias"}
+	locationAliasGeneratedColumns      = []string{}
+)
+
+type (
+	// LocationAliasSlice is an alias for a slice of pointers to LocationAlias.
+	// This should almost always be used instead of []LocationAlias.
+	LocationAliasSlice []*LocationAlias
+	// LocationAliasHook is the signature for custom LocationAlias hook methods
+	LocationAliasHook func(context.Context, boil.ContextExecutor, *LocationAlias) error
+
+	locationAliasQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	locationAliasType                 = reflect.TypeOf(&LocationAlias{})
+	locationAliasMapping              = queries.MakeStructMapping(locationAliasType)
+	locationAliasPrimaryKeyMapping, _ = queries.BindMapping(locationAliasType, locationAliasMapping, locationAliasPrimaryKeyColumns)
+	locationAliasInsertCacheMut       sync.RWMutex
+	locationAliasInsertCache          = make(map[string]insertCache)
+	locationAliasUpdateCacheMut       sync.RWMutex
+	locationAliasUpdateCache          = make(map[string]updateCache)
+	locationAliasUpsertCacheMut       sync.RWMutex
+	locationAliasUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var locationAliasAfterSelectHooks []LocationAliasHook
+
+var locationAliasBeforeInsertHooks []LocationAliasHook
+var locationAliasAfterInsertHooks []LocationAliasHook
+
+var locationAliasBeforeUpdateHooks []LocationAliasHook
+var locationAliasAfterUpdateHooks []LocationAliasHook
+
+var locationAliasBeforeDeleteHooks []LocationAliasHook
+var locationAliasAfterDeleteHooks []LocationAliasHook
+
+var locationAliasBeforeUpsertHooks []LocationAliasHook
+var locationAliasAfterUpsertHooks []LocationAliasHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *LocationAlias) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range locationAliasAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *LocationAlias) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range locationAliasBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *LocationAlias) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range locationAliasAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *LocationAlias) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range locationAliasBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *LocationAlias) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range locationAliasAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *LocationAlias) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range locationAliasBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *LocationAlias) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range locationAliasAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *LocationAlias) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range locationAliasBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *LocationAlias) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range locationAliasAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddLocationAliasHook registers your hook function for all future operations.
+func AddLocationAliasHook(hookPoint boil.HookPoint, locationAliasHook LocationAliasHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		locationAliasAfterSelectHooks = append(locationAliasAfterSelectHooks, locationAliasHook)
+	case boil.BeforeInsertHook:
+		locationAliasBeforeInsertHooks = append(locationAliasBeforeInsertHooks, locationAliasHook)
+	case boil.AfterInsertHook:
+		locationAliasAfterInsertHooks = append(locationAliasAfterInsertHooks, locationAliasHook)
+	case boil.BeforeUpdateHook:
+		locationAliasBeforeUpdateHooks = append(locationAliasBeforeUpdateHooks, locationAliasHook)
+	case boil.AfterUpdateHook:
+		locationAliasAfterUpdateHooks = append(locationAliasAfterUpdateHooks, locationAliasHook)
+	case boil.BeforeDeleteHook:
+		locationAliasBeforeDeleteHooks = append(locationAliasBeforeDeleteHooks, locationAliasHook)
+	case boil.AfterDeleteHook:
+		locationAliasAfterDeleteHooks = append(locationAliasAfterDeleteHooks, locationAliasHook)
+	case boil.BeforeUpsertHook:
+		locationAliasBeforeUpsertHooks = append(locationAliasBeforeUpsertHooks, locationAliasHook)
+	case boil.AfterUpsertHook:
+		locationAliasAfterUpsertHooks = append(locationAliasAfterUpsertHooks, locationAliasHook)
+	}
+}
+
+// One returns a single locationAlias record from the query.
+func (q locationAliasQuery) One(ctx context.Context, exec boil.ContextExecutor) (*LocationAlias, error) {
+	o := &LocationAlias{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlboilerPSQL: failed to execute a one query for location_aliases")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all LocationAlias records from the query.
+func (q locationAliasQuery) All(ctx context.Context, exec boil.ContextExecutor) (LocationAliasSlice, error) {
+	var o []*LocationAlias
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlboilerPSQL: failed to assign all query results to LocationAlias slice")
+	}
+
+	if len(locationAliasAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all LocationAlias records in the query.
+func (q locationAliasQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to count location_aliases rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q locationAliasQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlboilerPSQL: failed to check if location_aliases exists")
+	}
+
+	return count > 0, nil
+}
+
+// Location pointed to by the foreign key.
+func (o *LocationAlias) Location(mods ...qm.QueryMod) locationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.LocationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Locations(queryMods...)
+}
+
+// LoadLocation allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (locationAliasL) LoadLocation(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLocationAlias interface{}, mods queries.Applicator) error {
+	var slice []*LocationAlias
+	var object *LocationAlias
+
+	if singular {
+		var ok bool
+		object, ok = maybeLocationAlias.(*LocationAlias)
+		if !ok {
+			object = new(LocationAlias)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLocationAlias)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLocationAlias))
+			}
+		}
+	} else {
+		s, ok := maybeLocationAlias.(*[]*LocationAlias)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLocationAlias)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLocationAlias))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &locationAliasR{}
+		}
+		args = append(args, object.LocationID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &locationAliasR{}
+			}
+
+			for _, a := range args {
+				if a == obj.LocationID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.LocationID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`locations`),
+		qm.WhereIn(`locations.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Location")
+	}
+
+	var resultSlice []*Location
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Location")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for locations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for locations")
+	}
+
+	if len(locationAliasAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Location = foreign
+		if foreign.R == nil {
+			foreign.R = &locationR{}
+		}
+		foreign.R.LocationAliases = append(foreign.R.LocationAliases, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.LocationID == foreign.ID {
+				local.R.Location = foreign
+				if foreign.R == nil {
+					foreign.R = &locationR{}
+				}
+				foreign.R.LocationAliases = append(foreign.R.LocationAliases, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetLocation of the locationAlias to the related item.
+// Sets o.R.Location to related.
+// Adds o to related.R.LocationAliases.
+func (o *LocationAlias) SetLocation(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Location) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"location_aliases\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"location_id"}),
+		strmangle.WhereClause("\"", "\"", 2, locationAliasPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Alias}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.LocationID = related.ID
+	if o.R == nil {
+		o.R = &locationAliasR{
+			Location: related,
+		}
+	} else {
+		o.R.Location = related
+	}
+
+	if related.R == nil {
+		related.R = &locationR{
+			LocationAliases: LocationAliasSlice{o},
+		}
+	} else {
+		related.R.LocationAliases = append(related.R.LocationAliases, o)
+	}
+
+	return nil
+}
+
+// LocationAliases retrieves all the records using an executor.
+func LocationAliases(mods ...qm.QueryMod) locationAliasQuery {
+	mods = append(mods, qm.From("\"location_aliases\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"location_aliases\".*"})
+	}
+
+	return locationAliasQuery{q}
+}
+
+// FindLocationAlias retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLocationAlias(ctx context.Context, exec boil.ContextExecutor, alias string, selectCols ...string) (*LocationAlias, error) {
+	locationAliasObj := &LocationAlias{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"location_aliases\" where \"alias\"=$1", sel,
+	)
+
+	q := queries.Raw(query, alias)
+
+	err := q.Bind(ctx, exec, locationAliasObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlboilerPSQL: unable to select from location_aliases")
+	}
+
+	if err = locationAliasObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return locationAliasObj, err
+	}
+
+	return locationAliasObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *LocationAlias) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlboilerPSQL: no location_aliases provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(locationAliasColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	locationAliasInsertCacheMut.RLock()
+	cache, cached := locationAliasInsertCache[key]
+	locationAliasInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			locationAliasAllColumns,
+			locationAliasColumnsWithDefault,
+			locationAliasColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(locationAliasType, locationAliasMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(locationAliasType, locationAliasMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"location_aliases\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"location_aliases\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "sqlboilerPSQL: unable to insert into location_aliases")
+	}
+
+	if !cached {
+		locationAliasInsertCacheMut.Lock()
+		locationAliasInsertCache[key] = cache
+		locationAliasInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the LocationAlias.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *LocationAlias) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	locationAliasUpdateCacheMut.RLock()
+	cache, cached := locationAliasUpdateCache[key]
+	locationAliasUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			locationAliasAllColumns,
+			locationAliasPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("sqlboilerPSQL: unable to update location_aliases, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"location_aliases\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, locationAliasPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(locationAliasType, locationAliasMapping, append(wl, locationAliasPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to update location_aliases row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to get rows affected by update for location_aliases")
+	}
+
+	if !cached {
+		locationAliasUpdateCacheMut.Lock()
+		locationAliasUpdateCache[key] = cache
+		locationAliasUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q locationAliasQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to update all for location_aliases")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to retrieve rows affected for location_aliases")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LocationAliasSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlboilerPSQL: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), locationAliasPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"location_aliases\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, locationAliasPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to update all in locationAlias slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to retrieve rows affected all in update all locationAlias")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *LocationAlias) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlboilerPSQL: no location_aliases provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(locationAliasColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	locationAliasUpsertCacheMut.RLock()
+	cache, cached := locationAliasUpsertCache[key]
+	locationAliasUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			locationAliasAllColumns,
+			locationAliasColumnsWithDefault,
+			locationAliasColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			locationAliasAllColumns,
+			locationAliasPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("sqlboilerPSQL: unable to upsert location_aliases, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(locationAliasPrimaryKeyColumns))
+			copy(conflict, locationAliasPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"location_aliases\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(locationAliasType, locationAliasMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(locationAliasType, locationAliasMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "sqlboilerPSQL: unable to upsert location_aliases")
+	}
+
+	if !cached {
+		locationAliasUpsertCacheMut.Lock()
+		locationAliasUpsertCache[key] = cache
+		locationAliasUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single LocationAlias record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *LocationAlias) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlboilerPSQL: no LocationAlias provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), locationAliasPrimaryKeyMapping)
+	sql := "DELETE FROM \"location_aliases\" WHERE \"alias\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to delete from location_aliases")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to get rows affected by delete for location_aliases")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q locationAliasQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlboilerPSQL: no locationAliasQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to delete all from location_aliases")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to get rows affected by deleteall for location_aliases")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LocationAliasSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(locationAliasBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), locationAliasPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"location_aliases\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, locationAliasPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: unable to delete all from locationAlias slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlboilerPSQL: failed to get rows affected by deleteall for location_aliases")
+	}
+
+	if len(locationAliasAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *LocationAlias) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindLocationAlias(ctx, exec, o.Alias)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LocationAliasSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LocationAliasSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), locationAliasPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"location_aliases\".* FROM \"location_aliases\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, locationAliasPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlboilerPSQL: unable to reload all in LocationAliasSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LocationAliasExists checks if the LocationAlias row exists.
+func LocationAliasExists(ctx context.Context, exec boil.ContextExecutor, alias string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"location_aliases\" where \"alias\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, alias)
+	}
+	row := exec.QueryRowContext(ctx, sql, alias)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlboilerPSQL: unable to check if location_aliases exists")
+	}
+
+	return exists, nil
+}
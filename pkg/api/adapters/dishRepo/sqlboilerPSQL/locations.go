@@ -26,56 +26,89 @@ type Location struct {
 	ID      int       `boil:"id" json:"id" toml:"id" yaml:"id"`
 	Name    string    `boil:"name" json:"name" toml:"name" yaml:"name"`
 	Created time.Time `boil:"created" json:"created" toml:"created" yaml:"created"`
+	// Name shown to users instead of the name used by the scrapers. Empty if not set
+	DisplayName  string `boil:"display_name" json:"display_name" toml:"display_name" yaml:"display_name"`
+	Address      string `boil:"address" json:"address" toml:"address" yaml:"address"`
+	OpeningHours string `boil:"opening_hours" json:"opening_hours" toml:"opening_hours" yaml:"opening_hours"`
+	// Archived locations no longer serve dishes but are kept for their history
+	Archived bool `boil:"archived" json:"archived" toml:"archived" yaml:"archived"`
 
 	R *locationR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L locationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var LocationColumns = struct {
-	ID      string
-	Name    string
-	Created string
+	ID           string
+	Name         string
+	Created      string
+	DisplayName  string
+	Address      string
+	OpeningHours string
+	Archived     string
 }{
-	ID:      "id",
-	Name:    "name",
-	Created: "created",
+	ID:           "id",
+	Name:         "name",
+	Created:      "created",
+	DisplayName:  "display_name",
+	Address:      "address",
+	OpeningHours: "opening_hours",
+	Archived:     "archived",
 }
 
 var LocationTableColumns = struct {
-	ID      string
-	Name    string
-	Created string
+	ID           string
+	Name         string
+	Created      string
+	DisplayName  string
+	Address      string
+	OpeningHours string
+	Archived     string
 }{
-	ID:      "locations.id",
-	Name:    "locations.name",
-	Created: "locations.created",
+	ID:           "locations.id",
+	Name:         "locations.name",
+	Created:      "locations.created",
+	DisplayName:  "locations.display_name",
+	Address:      "locations.address",
+	OpeningHours: "locations.opening_hours",
+	Archived:     "locations.archived",
 }
 
 // Generated where
 
 var LocationWhere = struct {
-	ID      whereHelperint
-	Name    whereHelperstring
-	Created whereHelpertime_Time
+	ID           whereHelperint
+	Name         whereHelperstring
+	Created      whereHelpertime_Time
+	DisplayName  whereHelperstring
+	Address      whereHelperstring
+	OpeningHours whereHelperstring
+	Archived     whereHelperbool
 }{
-	ID:      whereHelperint{field: "\"locations\".\"id\""},
-	Name:    whereHelperstring{field: "\"locations\".\"name\""},
-	Created: whereHelpertime_Time{field: "\"locations\".\"created\""},
+	ID:           whereHelperint{field: "\"locations\".\"id\""},
+	Name:         whereHelperstring{field: "\"locations\".\"name\""},
+	Created:      whereHelpertime_Time{field: "\"locations\".\"created\""},
+	DisplayName:  whereHelperstring{field: "\"locations\".\"display_name\""},
+	Address:      whereHelperstring{field: "\"locations\".\"address\""},
+	OpeningHours: whereHelperstring{field: "\"locations\".\"opening_hours\""},
+	Archived:     whereHelperbool{field: "\"locations\".\"archived\""},
 }
 
 // LocationRels is where relationship names are stored.
 var LocationRels = struct {
-	Dishes       string
-	MergedDishes string
+	Dishes          string
+	LocationAliases string
+	MergedDishes    string
 }{
-	Dishes:       "Dishes",
-	MergedDishes: "MergedDishes",
+	Dishes:          "Dishes",
+	LocationAliases: "LocationAliases",
+	MergedDishes:    "MergedDishes",
 }
 
 // locationR is where relationships are stored.
 type locationR struct {
-	Dishes       DishSlice       `boil:"Dishes" json:"Dishes" toml:"Dishes" yaml:"Dishes"`
-	MergedDishes MergedDishSlice `boil:"MergedDishes" json:"MergedDishes" toml:"MergedDishes" yaml:"MergedDishes"`
+	Dishes          DishSlice          `boil:"Dishes" json:"Dishes" toml:"Dishes" yaml:"Dishes"`
+	LocationAliases LocationAliasSlice `boil:"LocationAliases" json:"LocationAliases" toml:"LocationAliases" yaml:"LocationAliases"`
+	MergedDishes    MergedDishSlice    `boil:"MergedDishes" json:"MergedDishes" toml:"MergedDishes" yaml:"MergedDishes"`
 }
 
 // NewStruct creates a new relationship struct
@@ -90,6 +123,13 @@ func (r *locationR) GetDishes() DishSlice {
 	return r.Dishes
 }
 
+func (r *locationR) GetLocationAliases() LocationAliasSlice {
+	if r == nil {
+		return nil
+	}
+	return r.LocationAliases
+}
+
 func (r *locationR) GetMergedDishes() MergedDishSlice {
 	if r == nil {
 		return nil
@@ -101,9 +141,9 @@ func (r *locationR) GetMergedDishes() MergedDishSlice {
 type locationL struct{}
 
 var (
-	locationAllColumns            = []string{"id", "name", "created"}
+	locationAllColumns            = []string{"id", "name", "created", "display_name", "address", "opening_hours", "archived"}
 	locationColumnsWithoutDefault = []string{"name", "created"}
-	locationColumnsWithDefault    = []string{"id"}
+	locationColumnsWithDefault    = []string{"id", "display_name", "address", "opening_hours", "archived"}
 	locationPrimaryKeyColumns     = []string{"id"}
 	locationGeneratedColumns      = []string{}
 )
@@ -400,6 +440,20 @@ func (o *Location) Dishes(mods ...qm.QueryMod) dishQuery {
 	return Dishes(queryMods...)
 }
 
+// LocationAliases retrieves all the location_alias's LocationAliases with an executor.
+func (o *Location) LocationAliases(mods ...qm.QueryMod) locationAliasQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"location_aliases\".\"location_id\"=?", o.ID),
+	)
+
+	return LocationAliases(queryMods...)
+}
+
 // MergedDishes retrieves all the merged_dish's MergedDishes with an executor.
 func (o *Location) MergedDishes(mods ...qm.QueryMod) mergedDishQuery {
 	var queryMods []qm.QueryMod
@@ -528,6 +582,120 @@ func (locationL) LoadDishes(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
+// LoadLocationAliases allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (locationL) LoadLocationAliases(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLocation interface{}, mods queries.Applicator) error {
+	var slice []*Location
+	var object *Location
+
+	if singular {
+		var ok bool
+		object, ok = maybeLocation.(*Location)
+		if !ok {
+			object = new(Location)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLocation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLocation))
+			}
+		}
+	} else {
+		s, ok := maybeLocation.(*[]*Location)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLocation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLocation))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &locationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &locationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`location_aliases`),
+		qm.WhereIn(`location_aliases.location_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load location_aliases")
+	}
+
+	var resultSlice []*LocationAlias
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice location_aliases")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on location_aliases")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for location_aliases")
+	}
+
+	if len(locationAliasAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.LocationAliases = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &locationAliasR{}
+			}
+			foreign.R.Location = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.LocationID {
+				local.R.LocationAliases = append(local.R.LocationAliases, foreign)
+				if foreign.R == nil {
+					foreign.R = &locationAliasR{}
+				}
+				foreign.R.Location = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadMergedDishes allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (locationL) LoadMergedDishes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLocation interface{}, mods queries.Applicator) error {
@@ -695,6 +863,59 @@ func (o *Location) AddDishes(ctx context.Context, exec boil.ContextExecutor, ins
 	return nil
 }
 
+// AddLocationAliases adds the given related objects to the existing relationships
+// of the location, optionally inserting them as new records.
+// Appends related to o.R.LocationAliases.
+// Sets related.R.Location appropriately.
+func (o *Location) AddLocationAliases(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*LocationAlias) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.LocationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"location_aliases\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"location_id"}),
+				strmangle.WhereClause("\"", "\"", 2, locationAliasPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Alias}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.LocationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &locationR{
+			LocationAliases: related,
+		}
+	} else {
+		o.R.LocationAliases = append(o.R.LocationAliases, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &locationAliasR{
+				Location: o,
+			}
+		} else {
+			rel.R.Location = o
+		}
+	}
+	return nil
+}
+
 // AddMergedDishes adds the given related objects to the existing relationships
 // of the location, optionally inserting them as new records.
 // Appends related to o.R.MergedDishes.
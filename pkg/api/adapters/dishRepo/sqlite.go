@@ -0,0 +1,1602 @@
+package dishRepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"itsTasty/pkg/api/domain"
+	"log/slog"
+	"time"
+
+	migrate "github.com/rubenv/sql-migrate"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	_ "modernc.org/sqlite"
+)
+
+// SQLiteRepo implements domain.DishRepo, domain.StatisticsRepo, domain.RatingStreakRepo, domain.WebhookRepo,
+// domain.LocationRepo, domain.DishFamilyRepo and domain.AccessTokenRepo on top of a SQLite database file. It allows running the app as a
+// single binary without a database server. The generated sqlboiler code is postgres specific, thus all queries are written by hand
+type SQLiteRepo struct {
+	db              *sql.DB
+	migrationSource migrate.MigrationSource
+}
+
+// OpenSQLiteDB opens (or creates) the SQLite database at path with foreign keys enabled
+func OpenSQLiteDB(path string) (*sql.DB, error) {
+	db, err := sql.Open("sqlite", fmt.Sprintf("file:%s?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)", path))
+	if err != nil {
+		return nil, fmt.Errorf("sql.Open : %v", err)
+	}
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to ping db : %v", err)
+	}
+	return db, nil
+}
+
+func NewSQLiteRepo(db *sql.DB, migrationSource migrate.MigrationSource) (*SQLiteRepo, error) {
+	//SQLite only supports a single writer. Using a single connection serializes all transactions instead of
+	//failing them with "database is locked"
+	db.SetMaxOpenConns(1)
+
+	appliedMigrations, err := migrate.Exec(db, "sqlite3", migrationSource, migrate.Up)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply db migrations : %v", err)
+	}
+	if appliedMigrations != 0 {
+		slog.Info("applied migrations", "count", appliedMigrations)
+	}
+
+	return &SQLiteRepo{db: db, migrationSource: migrationSource}, nil
+}
+
+// timeToSQLite converts t to the unix nanoseconds representation used in the SQLite schema
+func timeToSQLite(t time.Time) int64 {
+	return t.UnixNano()
+}
+
+// timeFromSQLite is the inverse of timeToSQLite. The result is in local time
+func timeFromSQLite(t int64) time.Time {
+	return time.Unix(0, t)
+}
+
+// insertAndGetID is a helper that executes the insert query and returns the id of the new row
+func insertAndGetID(ctx context.Context, exec boil.ContextExecutor, query string, args ...interface{}) (int64, error) {
+	res, err := exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get id of inserted row : %v", err)
+	}
+	return id, nil
+}
+
+// execAndExpectRows is a helper that executes the query and fails if it did not affect exactly want rows
+func execAndExpectRows(ctx context.Context, exec boil.ContextExecutor, want int64, query string, args ...interface{}) error {
+	res, err := exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	got, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows : %v", err)
+	}
+	if got != want {
+		return fmt.Errorf("expected %v affected rows but got %v : %w", want, got, domain.ErrNotFound)
+	}
+	return nil
+}
+
+//
+// Dishes
+//
+
+func (s *SQLiteRepo) GetOrCreateDish(ctx context.Context, dishName string, servedAt string) (*domain.Dish, bool, bool, int64, error) {
+	return s.getOrCreateDish(ctx, dishName, servedAt)
+}
+
+func (s *SQLiteRepo) getOrCreateDish(ctx context.Context, dishName string, servedAt string) (resultDish *domain.Dish,
+	isNewDish bool, isNewLocation bool, dishID int64, err error) {
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		err = fmt.Errorf("BeginTX : %w", err)
+		return
+	}
+	defer func() {
+		err = finishTransaction(err, tx)
+	}()
+
+	resultDish, dishID, err = s.getDishByName(ctx, tx, dishName, servedAt)
+	//resultDish already exists, return it
+	if err == nil {
+		return
+	}
+	if !errors.Is(err, domain.ErrNotFound) {
+		err = fmt.Errorf("getOrCreateDish failed to check if resultDish exists: %v", err)
+		return
+	}
+
+	//create location if it does not exist
+	var locationID int64
+	err = tx.QueryRowContext(ctx, "SELECT id FROM locations WHERE name = ?", servedAt).Scan(&locationID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			err = fmt.Errorf("getOrCreateDish failed to check if location exists : %v", err)
+			return
+		}
+		locationID, err = insertAndGetID(ctx, tx, "INSERT INTO locations (name, created) VALUES (?, ?)",
+			servedAt, timeToSQLite(time.Now()))
+		if err != nil {
+			err = fmt.Errorf("getOrCreateDish failed to insert new location : %v", err)
+			return
+		}
+		isNewLocation = true
+	}
+
+	newDomainDish := domain.NewDishToday(dishName, servedAt)
+	dishID, err = insertAndGetID(ctx, tx, "INSERT INTO dishes (location_id, name) VALUES (?, ?)",
+		locationID, newDomainDish.Name)
+	if err != nil {
+		err = fmt.Errorf("getOrCreateDish failed to insert new resultDish : %v", err)
+		return
+	}
+	isNewDish = true
+
+	_, err = tx.ExecContext(ctx, "INSERT INTO dish_occurrences (dish_id, date) VALUES (?, ?)",
+		dishID, timeToSQLite(newDomainDish.Occurrences()[0]))
+	if err != nil {
+		err = fmt.Errorf("getOrCreateDish failed to insert resultDish occurence for newly created resultDish : %v", err)
+		return
+	}
+
+	resultDish = domain.NewDishFromDB(dishName, servedAt, []time.Time{newDomainDish.Occurrences()[0]})
+	return
+}
+
+func (s *SQLiteRepo) GetOrCreateDishWithOccurrences(ctx context.Context, dishName string, servedAt string,
+	occurrences []time.Time) (dishID int64, err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		err = fmt.Errorf("BeginTX : %w", err)
+		return
+	}
+	defer func() {
+		err = finishTransaction(err, tx)
+	}()
+
+	_, err = tx.ExecContext(ctx, "INSERT INTO locations (name, created) VALUES (?, ?) ON CONFLICT (name) DO NOTHING",
+		servedAt, timeToSQLite(time.Now()))
+	if err != nil {
+		err = fmt.Errorf("failed to get or create location : %v", err)
+		return
+	}
+	_, err = tx.ExecContext(ctx, "INSERT INTO dishes (location_id, name) SELECT id, ? FROM locations WHERE name = ? "+
+		"ON CONFLICT (name, location_id) DO NOTHING", dishName, servedAt)
+	if err != nil {
+		err = fmt.Errorf("failed to get or create dish : %v", err)
+		return
+	}
+	err = tx.QueryRowContext(ctx,
+		"SELECT d.id FROM dishes d INNER JOIN locations l ON d.location_id = l.id WHERE d.name = ? AND l.name = ?",
+		dishName, servedAt).Scan(&dishID)
+	if err != nil {
+		err = fmt.Errorf("failed to fetch dish id : %v", err)
+		return
+	}
+
+	for _, v := range occurrences {
+		_, err = tx.ExecContext(ctx, "INSERT INTO dish_occurrences (dish_id, date) SELECT ?, ? WHERE NOT EXISTS "+
+			"(SELECT 1 FROM dish_occurrences WHERE dish_id = ? AND date = ?)",
+			dishID, timeToSQLite(v), dishID, timeToSQLite(v))
+		if err != nil {
+			err = fmt.Errorf("failed to insert occurrence : %v", err)
+			return
+		}
+	}
+	return
+}
+
+// getDishByName returns domain.ErrNotFound if either the location or the dish does not exist
+func (s *SQLiteRepo) getDishByName(ctx context.Context, exec boil.ContextExecutor, dishName, servedAt string) (*domain.Dish, int64, error) {
+	var dishID int64
+	err := exec.QueryRowContext(ctx,
+		"SELECT d.id FROM dishes d INNER JOIN locations l ON d.location_id = l.id WHERE d.name = ? AND l.name = ?",
+		dishName, servedAt).Scan(&dishID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, 0, domain.ErrNotFound
+		}
+		return nil, 0, err
+	}
+
+	dish, err := s.getDishByID(ctx, exec, dishID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return dish, dishID, nil
+}
+
+// getDishByID returns domain.ErrNotFound if the dish does not exist
+func (s *SQLiteRepo) getDishByID(ctx context.Context, exec boil.ContextExecutor, dishID int64) (*domain.Dish, error) {
+	var dishName, locationName string
+	err := exec.QueryRowContext(ctx,
+		"SELECT d.name, l.name FROM dishes d INNER JOIN locations l ON d.location_id = l.id WHERE d.id = ?",
+		dishID).Scan(&dishName, &locationName)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	rows, err := exec.QueryContext(ctx, "SELECT date FROM dish_occurrences WHERE dish_id = ?", dishID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query occurrences : %v", err)
+	}
+	defer rows.Close()
+	occurrences := make([]time.Time, 0)
+	for rows.Next() {
+		var date int64
+		if err := rows.Scan(&date); err != nil {
+			return nil, fmt.Errorf("failed to scan occurrence : %v", err)
+		}
+		occurrences = append(occurrences, timeFromSQLite(date))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate occurrences : %v", err)
+	}
+
+	return domain.NewDishFromDB(dishName, locationName, occurrences), nil
+}
+
+func (s *SQLiteRepo) GetDishByName(ctx context.Context, dishName, servedAt string) (*domain.Dish, int64, error) {
+	return s.getDishByName(ctx, s.db, dishName, servedAt)
+}
+
+func (s *SQLiteRepo) GetDishByID(ctx context.Context, dishID int64) (*domain.Dish, error) {
+	return s.getDishByID(ctx, s.db, dishID)
+}
+
+// queryIDs is a helper that returns the int64 values of the first column of all rows
+func queryIDs(ctx context.Context, exec boil.ContextExecutor, query string, args ...interface{}) ([]int64, error) {
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]int64, 0)
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (s *SQLiteRepo) GetDishByDate(ctx context.Context, when time.Time, optionalLocation *string) ([]int64, error) {
+	query := "SELECT DISTINCT d.id FROM dishes d INNER JOIN dish_occurrences o ON o.dish_id = d.id " +
+		"INNER JOIN locations l ON d.location_id = l.id WHERE o.date = ?"
+	args := []interface{}{timeToSQLite(when)}
+	if optionalLocation != nil {
+		query += " AND l.name = ?"
+		args = append(args, *optionalLocation)
+	}
+
+	ids, err := queryIDs(ctx, s.db, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch occurences for given timepoint : %v", err)
+	}
+	return ids, nil
+}
+
+func (s *SQLiteRepo) UpdateMostRecentServing(ctx context.Context, dishID int64,
+	updateFN func(currenMostRecent *time.Time) (*time.Time, error)) (err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		err = fmt.Errorf("BeginTX : %w", err)
+		return
+	}
+	defer func() {
+		err = finishTransaction(err, tx)
+	}()
+
+	var oldMostRecent *time.Time
+	var date int64
+	err = tx.QueryRowContext(ctx, "SELECT date FROM dish_occurrences WHERE dish_id = ? ORDER BY date DESC LIMIT 1",
+		dishID).Scan(&date)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			err = fmt.Errorf("failed to fetch most recent occurence : %v", err)
+			return
+		}
+	} else {
+		t := timeFromSQLite(date)
+		oldMostRecent = &t
+	}
+
+	newMostRecent, err := updateFN(oldMostRecent)
+	if err != nil {
+		err = fmt.Errorf("updateFN failed : %v", err)
+		return
+	}
+	//updateFN does not want to add new value
+	if newMostRecent == nil {
+		return
+	}
+
+	_, err = tx.ExecContext(ctx, "INSERT INTO dish_occurrences (dish_id, date) VALUES (?, ?)",
+		dishID, timeToSQLite(*newMostRecent))
+	if err != nil {
+		err = fmt.Errorf("failed to insert new occurence : %v", err)
+		return
+	}
+	return
+}
+
+func (s *SQLiteRepo) GetAllDishesSimple(ctx context.Context) ([]domain.SimpleDishView, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT d.id, d.merged_dish_id, d.name, l.name FROM dishes d "+
+		"INNER JOIN locations l ON d.location_id = l.id ORDER BY d.id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dishes : %v", err)
+	}
+	defer rows.Close()
+
+	result := make([]domain.SimpleDishView, 0)
+	for rows.Next() {
+		var v domain.SimpleDishView
+		var mergedDishID sql.NullInt64
+		if err := rows.Scan(&v.Id, &mergedDishID, &v.Name, &v.ServedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan dish : %v", err)
+		}
+		if mergedDishID.Valid {
+			v.MergedDishID = &mergedDishID.Int64
+		}
+		result = append(result, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate dishes : %v", err)
+	}
+	return result, nil
+}
+
+func (s *SQLiteRepo) RenameDish(ctx context.Context, dishID int64, newName string) (err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("BeginTX : %w", err)
+	}
+	defer func() {
+		err = finishTransaction(err, tx)
+	}()
+
+	var currentName string
+	var locationID int64
+	err = tx.QueryRowContext(ctx, "SELECT name, location_id FROM dishes WHERE id = ?", dishID).
+		Scan(&currentName, &locationID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to fetch dish %v : %w", dishID, domain.ErrNotFound)
+		}
+		return fmt.Errorf("failed to fetch dish %v : %v", dishID, err)
+	}
+	if currentName == newName {
+		return nil
+	}
+
+	var conflicts int
+	err = tx.QueryRowContext(ctx, "SELECT count(*) FROM dishes WHERE name = ? AND location_id = ?",
+		newName, locationID).Scan(&conflicts)
+	if err != nil {
+		return fmt.Errorf("failed to check for existing dish : %v", err)
+	}
+	if conflicts > 0 {
+		return fmt.Errorf("%w : \"%v\"", domain.ErrDishAlreadyExists, newName)
+	}
+
+	if err := execAndExpectRows(ctx, tx, 1, "UPDATE dishes SET name = ? WHERE id = ?", newName, dishID); err != nil {
+		return fmt.Errorf("failed to update dish : %w", err)
+	}
+	return nil
+}
+
+// dishExists returns domain.ErrNotFound if the dish does not exist
+func (s *SQLiteRepo) dishExists(ctx context.Context, exec boil.ContextExecutor, dishID int64) error {
+	var count int
+	if err := exec.QueryRowContext(ctx, "SELECT count(*) FROM dishes WHERE id = ?", dishID).Scan(&count); err != nil {
+		return fmt.Errorf("failed to fetch dish %v : %v", dishID, err)
+	}
+	if count == 0 {
+		return fmt.Errorf("failed to fetch dish %v : %w", dishID, domain.ErrNotFound)
+	}
+	return nil
+}
+
+func (s *SQLiteRepo) AddDishTags(ctx context.Context, dishID int64, tags []string) (err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("BeginTX : %w", err)
+	}
+	defer func() {
+		err = finishTransaction(err, tx)
+	}()
+
+	if err := s.dishExists(ctx, tx, dishID); err != nil {
+		return err
+	}
+	for _, v := range tags {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO dish_tags (dish_id, tag) VALUES (?, ?) ON CONFLICT DO NOTHING",
+			dishID, v); err != nil {
+			return fmt.Errorf("failed to add tag %v : %v", v, err)
+		}
+	}
+	return nil
+}
+
+func (s *SQLiteRepo) GetDishTags(ctx context.Context, dishID int64) ([]string, error) {
+	if err := s.dishExists(ctx, s.db, dishID); err != nil {
+		return nil, err
+	}
+	rows, err := s.db.QueryContext(ctx, "SELECT tag FROM dish_tags WHERE dish_id = ? ORDER BY tag", dishID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tags : %v", err)
+	}
+	defer rows.Close()
+	tags := make([]string, 0)
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, fmt.Errorf("failed to scan tag : %v", err)
+		}
+		tags = append(tags, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate tags : %v", err)
+	}
+	return tags, nil
+}
+
+func (s *SQLiteRepo) DedupeDish(ctx context.Context, canonicalDishID, duplicateDishID int64, performedBy string) (dedupe domain.DishDedupe, err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.DishDedupe{}, fmt.Errorf("BeginTX : %w", err)
+	}
+	defer func() {
+		err = finishTransaction(err, tx)
+	}()
+	return sqliteDialect.dedupeDish(ctx, tx, canonicalDishID, duplicateDishID, performedBy, time.Now())
+}
+
+func (s *SQLiteRepo) GetDishDedupes(ctx context.Context, canonicalDishID int64) ([]domain.DishDedupe, error) {
+	return sqliteDialect.getDishDedupes(ctx, s.db, canonicalDishID)
+}
+
+//
+// Merged Dishes
+//
+
+func (s *SQLiteRepo) CreateMergedDish(ctx context.Context, mergedDish *domain.MergedDish) (id int64, err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		err = fmt.Errorf("BeginTX : %w", err)
+		return
+	}
+	defer func() {
+		err = finishTransaction(err, tx)
+	}()
+
+	var locationID int64
+	err = tx.QueryRowContext(ctx, "SELECT id FROM locations WHERE name = ?", mergedDish.ServedAt).Scan(&locationID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = domain.ErrNotFound
+		}
+		err = fmt.Errorf("failed to fetch location with name \"%v\" : %w", mergedDish.ServedAt, err)
+		return
+	}
+
+	mergeTime := timeToSQLite(time.Now())
+	id, err = insertAndGetID(ctx, tx, "INSERT INTO merged_dishes (name, location_id, created_at) VALUES (?, ?, ?)",
+		mergedDish.Name, locationID, mergeTime)
+	if err != nil {
+		err = fmt.Errorf("failed to insert merged dish : %v", err)
+		return
+	}
+
+	for _, v := range mergedDish.GetCondensedDishNames() {
+		err = execAndExpectRows(ctx, tx, 1,
+			"UPDATE dishes SET merged_dish_id = ?, merged_at = ? WHERE name = ? AND location_id = ?",
+			id, mergeTime, v, locationID)
+		if err != nil {
+			err = fmt.Errorf("failed to add dish %v to merged dish : %w", v, err)
+			return
+		}
+	}
+
+	return
+}
+
+// getMergedDishByID returns domain.ErrNotFound if the merged dish does not exist
+func (s *SQLiteRepo) getMergedDishByID(ctx context.Context, exec boil.ContextExecutor, id int64) (*domain.MergedDish, error) {
+	var name, locationName string
+	err := exec.QueryRowContext(ctx,
+		"SELECT m.name, l.name FROM merged_dishes m INNER JOIN locations l ON m.location_id = l.id WHERE m.id = ?",
+		id).Scan(&name, &locationName)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to query merged dish : %w", err)
+	}
+
+	rows, err := exec.QueryContext(ctx, "SELECT name FROM dishes WHERE merged_dish_id = ?", id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query condensed dishes : %v", err)
+	}
+	defer rows.Close()
+	condensedDishNames := make(map[string]interface{})
+	for rows.Next() {
+		var dishName string
+		if err := rows.Scan(&dishName); err != nil {
+			return nil, fmt.Errorf("failed to scan condensed dish : %v", err)
+		}
+		condensedDishNames[dishName] = nil
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate condensed dishes : %v", err)
+	}
+
+	return domain.NewMergedDishFomDB(name, locationName, condensedDishNames), nil
+}
+
+// getMergedDishID returns domain.ErrNotFound if the merged dish does not exist
+func (s *SQLiteRepo) getMergedDishID(ctx context.Context, exec boil.ContextExecutor, name, servedAt string) (int64, error) {
+	var id int64
+	err := exec.QueryRowContext(ctx,
+		"SELECT m.id FROM merged_dishes m INNER JOIN locations l ON m.location_id = l.id WHERE m.name = ? AND l.name = ?",
+		name, servedAt).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, domain.ErrNotFound
+		}
+		return 0, fmt.Errorf("failed to query merged dish : %w", err)
+	}
+	return id, nil
+}
+
+func (s *SQLiteRepo) GetMergedDish(ctx context.Context, name, servedAt string) (*domain.MergedDish, int64, error) {
+	id, err := s.getMergedDishID(ctx, s.db, name, servedAt)
+	if err != nil {
+		return nil, 0, fmt.Errorf("getMergedDish failed (name: %v, servedAt: %v) : %w", name, servedAt, err)
+	}
+	mergedDish, err := s.getMergedDishByID(ctx, s.db, id)
+	if err != nil {
+		return nil, 0, fmt.Errorf("getMergedDish failed (name: %v, servedAt: %v) : %w", name, servedAt, err)
+	}
+	return mergedDish, id, nil
+}
+
+func (s *SQLiteRepo) GetMergedDishByID(ctx context.Context, id int64) (*domain.MergedDish, error) {
+	mergedDish, err := s.getMergedDishByID(ctx, s.db, id)
+	if err != nil {
+		return nil, fmt.Errorf("getMergedDishByID failed (id: %v) : %w", id, err)
+	}
+	return mergedDish, nil
+}
+
+func (s *SQLiteRepo) GetMostRecentDishForMergedDish(ctx context.Context, mergedDishID int64) (*domain.Dish, int64, error) {
+	var dishID int64
+	err := s.db.QueryRowContext(ctx, "SELECT d.id FROM dishes d INNER JOIN dish_occurrences o ON o.dish_id = d.id "+
+		"WHERE d.merged_dish_id = ? ORDER BY o.date DESC LIMIT 1", mergedDishID).Scan(&dishID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, 0, domain.ErrNotFound
+		}
+		return nil, 0, fmt.Errorf("failed get determine most recent dish dish: %w", err)
+	}
+
+	dish, err := s.getDishByID(ctx, s.db, dishID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get dish: %v", err)
+	}
+	return dish, dishID, nil
+}
+
+func (s *SQLiteRepo) DeleteMergedDish(ctx context.Context, mergedDishName, servedAt string) (err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		err = fmt.Errorf("BeginTX : %w", err)
+		return
+	}
+	defer func() {
+		err = finishTransaction(err, tx)
+	}()
+
+	id, err := s.getMergedDishID(ctx, tx, mergedDishName, servedAt)
+	if err != nil {
+		err = fmt.Errorf("failed to fetch merged dish (name: %v, servedAt: %v) : %w", mergedDishName, servedAt, err)
+		return
+	}
+	err = s.deleteMergedDish(ctx, tx, id)
+	return
+}
+
+func (s *SQLiteRepo) DeleteMergedDishByID(ctx context.Context, mergedDishID int64) (err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		err = fmt.Errorf("BeginTX : %w", err)
+		return
+	}
+	defer func() {
+		err = finishTransaction(err, tx)
+	}()
+
+	err = s.deleteMergedDish(ctx, tx, mergedDishID)
+	return
+}
+
+// deleteMergedDish releases all dishes of the merged dish and deletes it.
+// Returns domain.ErrNotFound if the merged dish does not exist
+func (s *SQLiteRepo) deleteMergedDish(ctx context.Context, exec boil.ContextExecutor, id int64) error {
+	_, err := exec.ExecContext(ctx, "UPDATE dishes SET merged_dish_id = NULL, merged_at = NULL WHERE merged_dish_id = ?", id)
+	if err != nil {
+		return fmt.Errorf("failed to remove dishes from merged dish %v : %v", id, err)
+	}
+	if err := execAndExpectRows(ctx, exec, 1, "DELETE FROM merged_dishes WHERE id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete merged dish %v : %w", id, err)
+	}
+	return nil
+}
+
+func (s *SQLiteRepo) UpdateMergedDishByID(ctx context.Context, id int64,
+	updateFN func(current *domain.MergedDish) (*domain.MergedDish, error)) (err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		err = fmt.Errorf("BeginTX : %w", err)
+		return
+	}
+	defer func() {
+		err = finishTransaction(err, tx)
+	}()
+
+	oldValue, err := s.getMergedDishByID(ctx, tx, id)
+	if err != nil {
+		err = fmt.Errorf("getMergedDishByID failed : %w", err)
+		return
+	}
+
+	updatedValue, err := updateFN(oldValue.DeepCopy())
+	if err != nil {
+		err = fmt.Errorf("updateFN failed : %w", err)
+		return
+	}
+
+	var locationID int64
+	if err = tx.QueryRowContext(ctx, "SELECT location_id FROM merged_dishes WHERE id = ?", id).Scan(&locationID); err != nil {
+		err = fmt.Errorf("failed to query location of merged dish : %v", err)
+		return
+	}
+
+	now := timeToSQLite(time.Now())
+	removedDishNames, addedDishNames := arrayDiff(oldValue.GetCondensedDishNames(), updatedValue.GetCondensedDishNames())
+	for _, v := range removedDishNames {
+		err = execAndExpectRows(ctx, tx, 1,
+			"UPDATE dishes SET merged_dish_id = NULL, merged_at = NULL WHERE merged_dish_id = ? AND name = ?", id, v)
+		if err != nil {
+			err = fmt.Errorf("failed to remove dish %v from merged dish : %w", v, err)
+			return
+		}
+	}
+	for _, v := range addedDishNames {
+		err = execAndExpectRows(ctx, tx, 1,
+			"UPDATE dishes SET merged_dish_id = ?, merged_at = ? WHERE location_id = ? AND name = ?",
+			id, now, locationID, v)
+		if err != nil {
+			err = fmt.Errorf("failed to add dish %v to merged dish : %w", v, err)
+			return
+		}
+	}
+
+	if oldValue.Name != updatedValue.Name {
+		err = execAndExpectRows(ctx, tx, 1, "UPDATE merged_dishes SET name = ?, updated_at = ? WHERE id = ?",
+			updatedValue.Name, now, id)
+		if err != nil {
+			err = fmt.Errorf("failed to update merged dish name : %w", err)
+			return
+		}
+	}
+
+	return
+}
+
+func (s *SQLiteRepo) IsDishPartOfMergedDish(ctx context.Context, dishName string, servedAt string) (bool, int64, error) {
+	var mergedDishID sql.NullInt64
+	err := s.db.QueryRowContext(ctx, "SELECT d.merged_dish_id FROM dishes d INNER JOIN locations l "+
+		"ON d.location_id = l.id WHERE d.name = ? AND l.name = ?", dishName, servedAt).Scan(&mergedDishID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, 0, domain.ErrNotFound
+		}
+		return false, 0, fmt.Errorf("failed to fetch dish : %w", err)
+	}
+	return mergedDishID.Valid, mergedDishID.Int64, nil
+}
+
+func (s *SQLiteRepo) IsDishPartOfMergedDisByID(ctx context.Context, dishID int64) (bool, int64, error) {
+	var mergedDishID sql.NullInt64
+	err := s.db.QueryRowContext(ctx, "SELECT merged_dish_id FROM dishes WHERE id = ?", dishID).Scan(&mergedDishID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, 0, domain.ErrNotFound
+		}
+		return false, 0, fmt.Errorf("failed to fetch dish : %w", err)
+	}
+	return mergedDishID.Valid, mergedDishID.Int64, nil
+}
+
+//
+// Ratings
+//
+
+// queryRatings is a helper that expects query to return the columns (email, rating, date)
+func queryRatings(ctx context.Context, exec boil.ContextExecutor, query string, args ...interface{}) ([]domain.DishRating, error) {
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]domain.DishRating, 0)
+	for rows.Next() {
+		var email string
+		var rating int
+		var date int64
+		if err := rows.Scan(&email, &rating, &date); err != nil {
+			return nil, err
+		}
+		domainRating, err := domain.NewDishRatingFromDB(email, rating, timeFromSQLite(date))
+		if err != nil {
+			return nil, fmt.Errorf("failed to construct domain object from db data : %w", err)
+		}
+		result = append(result, domainRating)
+	}
+	return result, rows.Err()
+}
+
+func (s *SQLiteRepo) GetRatings(ctx context.Context, userEmail string, dishID int64, onlyMostRecent bool) ([]domain.DishRating, error) {
+	query := "SELECT u.email, r.rating, r.date FROM dish_ratings r INNER JOIN users u ON r.user_id = u.id " +
+		"WHERE u.email = ? AND r.dish_id = ?"
+	if onlyMostRecent {
+		query += " ORDER BY r.date DESC LIMIT 1"
+	} else {
+		query += " ORDER BY r.id"
+	}
+
+	ratings, err := queryRatings(ctx, s.db, query, userEmail, dishID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dish rating : %v", err)
+	}
+	if len(ratings) == 0 {
+		return nil, domain.ErrNotFound
+	}
+	return ratings, nil
+}
+
+func (s *SQLiteRepo) CreateOrUpdateRating(ctx context.Context, userEmail string, dishID int64,
+	updateFN func(currentRating *domain.DishRating) (updatedRating *domain.DishRating, createNew bool, err error)) (err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		err = fmt.Errorf("BeginTX : %w", err)
+		return
+	}
+	defer func() {
+		err = finishTransaction(err, tx)
+	}()
+
+	var ratingID int64
+	var rating int
+	var date int64
+	haveEntry := true
+	err = tx.QueryRowContext(ctx, "SELECT r.id, r.rating, r.date FROM dish_ratings r INNER JOIN users u "+
+		"ON r.user_id = u.id WHERE u.email = ? AND r.dish_id = ? ORDER BY r.date DESC LIMIT 1",
+		userEmail, dishID).Scan(&ratingID, &rating, &date)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			err = fmt.Errorf("failed to fetch most recent rating : %v", err)
+			return
+		}
+		haveEntry = false
+	}
+
+	var oldRating *domain.DishRating
+	if haveEntry {
+		dr, newRatingErr := domain.NewDishRatingFromDB(userEmail, rating, timeFromSQLite(date))
+		if newRatingErr != nil {
+			err = fmt.Errorf("failed to create domain rating from db data : %w", newRatingErr)
+			return
+		}
+		oldRating = &dr
+	}
+	newRating, createNewRating, err := updateFN(oldRating)
+	if err != nil {
+		err = fmt.Errorf("updateFN failed : %w", err)
+		return
+	}
+	//updateFN does not want to change anything
+	if newRating == nil {
+		return
+	}
+
+	if createNewRating {
+		_, err = tx.ExecContext(ctx, "INSERT INTO users (email, created) VALUES (?, ?) ON CONFLICT (email) DO NOTHING",
+			userEmail, timeToSQLite(time.Now()))
+		if err != nil {
+			err = fmt.Errorf("failed to get or create user : %v", err)
+			return
+		}
+		_, err = tx.ExecContext(ctx, "INSERT INTO dish_ratings (dish_id, user_id, date, rating) "+
+			"SELECT ?, id, ?, ? FROM users WHERE email = ?",
+			dishID, timeToSQLite(newRating.RatingWhen), int(newRating.Value), userEmail)
+		if err != nil {
+			err = fmt.Errorf("failed to to create new rating %v : %w", *newRating, err)
+			return
+		}
+		return
+	}
+
+	//if we get here, we want to update the rating
+	if !haveEntry {
+		err = fmt.Errorf("updateFN requested to update entry but there is none")
+		return
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE dish_ratings SET rating = ?, date = ? WHERE id = ?",
+		int(newRating.Value), timeToSQLite(newRating.RatingWhen), ratingID)
+	if err != nil {
+		err = fmt.Errorf("failed to to update to new rating %v : %w", *newRating, err)
+		return
+	}
+	return
+}
+
+func (s *SQLiteRepo) GetAllRatingsForDish(ctx context.Context, dishID int64) ([]domain.DishRating, error) {
+	ratings, err := queryRatings(ctx, s.db, "SELECT u.email, r.rating, r.date FROM dish_ratings r "+
+		"INNER JOIN users u ON r.user_id = u.id WHERE r.dish_id = ? ORDER BY r.id", dishID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch dish ratings : %v", err)
+	}
+	return ratings, nil
+}
+
+func (s *SQLiteRepo) DropRepo(_ context.Context) error {
+	_, err := migrate.Exec(s.db, "sqlite3", s.migrationSource, migrate.Down)
+	if err != nil {
+		return fmt.Errorf("failed to apply db migrations : %v", err)
+	}
+	return nil
+}
+
+func (s *SQLiteRepo) Close() error {
+	return s.db.Close()
+}
+
+//
+// Statistics
+//
+
+func (s *SQLiteRepo) GetAllUsers(ctx context.Context) ([]domain.User, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT email FROM users ORDER BY id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query users : %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]domain.User, 0)
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, fmt.Errorf("failed to scan user : %w", err)
+		}
+		result = append(result, domain.User{Email: email})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate users : %w", err)
+	}
+	return result, nil
+}
+
+func (s *SQLiteRepo) GetAllRatingsForDate(ctx context.Context, date domain.DayPrecisionTime) ([]domain.DishRating, error) {
+	ratings, err := queryRatings(ctx, s.db, "SELECT u.email, r.rating, r.date FROM dish_ratings r "+
+		"INNER JOIN users u ON r.user_id = u.id WHERE r.date >= ? AND r.date < ?",
+		timeToSQLite(date.Time), timeToSQLite(date.NextDay().Time))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ratings : %w", err)
+	}
+	return ratings, nil
+}
+
+func (s *SQLiteRepo) GetAllRatingsOfUser(ctx context.Context, userEmail string) ([]domain.RatingOfDish, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT r.dish_id, r.rating, r.date FROM dish_ratings r "+
+		"INNER JOIN users u ON r.user_id = u.id WHERE u.email = ? ORDER BY r.date ASC, r.id ASC", userEmail)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ratings : %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]domain.RatingOfDish, 0)
+	for rows.Next() {
+		var dishID int64
+		var rating int
+		var date int64
+		if err := rows.Scan(&dishID, &rating, &date); err != nil {
+			return nil, fmt.Errorf("failed to scan rating : %w", err)
+		}
+		domainRating, err := domain.NewDishRatingFromDB(userEmail, rating, timeFromSQLite(date))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create domain rating : %w", err)
+		}
+		result = append(result, domain.RatingOfDish{DishID: dishID, Rating: domainRating})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate ratings : %w", err)
+	}
+	return result, nil
+}
+
+func (s *SQLiteRepo) GetRatingSummaryPerUser(ctx context.Context) ([]domain.UserRatingSummary, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT u.email, COUNT(*), SUM(r.rating) FROM dish_ratings r "+
+		"INNER JOIN users u ON r.user_id = u.id GROUP BY u.email")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rating summaries : %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]domain.UserRatingSummary, 0)
+	for rows.Next() {
+		var v domain.UserRatingSummary
+		if err := rows.Scan(&v.User.Email, &v.RatingCount, &v.RatingSum); err != nil {
+			return nil, fmt.Errorf("failed to scan rating summary : %w", err)
+		}
+		result = append(result, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate rating summaries : %w", err)
+	}
+	return result, nil
+}
+
+//
+// Rating Streaks
+//
+
+// queryStreak is a helper that expects query to return the columns (id, start_date, end_date) and only uses the
+// first row. Returns domain.ErrNotFound if there are no rows
+func queryStreak(ctx context.Context, exec boil.ContextExecutor, query string, args ...interface{}) (domain.RatingStreak, int, error) {
+	var id int
+	var startDate, endDate int64
+	if err := exec.QueryRowContext(ctx, query, args...).Scan(&id, &startDate, &endDate); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.RatingStreak{}, 0, domain.ErrNotFound
+		}
+		return domain.RatingStreak{}, 0, err
+	}
+	return domain.NewRatingStreakFromDB(
+		domain.NewDayPrecisionTime(timeFromSQLite(startDate)),
+		domain.NewDayPrecisionTime(timeFromSQLite(endDate)),
+	), id, nil
+}
+
+const sqliteMostRecentStreakQuery = "SELECT id, start_date, end_date FROM rating_streaks WHERE name = ? " +
+	"ORDER BY end_date DESC LIMIT 1"
+
+func (s *SQLiteRepo) UpdateMostRecentRatingStreak(ctx context.Context, name string, updateFN domain.StreakUpdateFN) (err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		err = fmt.Errorf("BeginTX : %w", err)
+		return
+	}
+	defer func() {
+		err = finishTransaction(err, tx)
+	}()
+
+	current, id, err := queryStreak(ctx, tx, sqliteMostRecentStreakQuery, name)
+	if err != nil {
+		err = fmt.Errorf("failed to query most recent streak : %w", err)
+		return
+	}
+
+	updated, err := updateFN(current)
+	if err != nil {
+		err = fmt.Errorf("update function failed : %w", err)
+		return
+	}
+	//no update requested
+	if updated == nil {
+		return
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE rating_streaks SET start_date = ?, end_date = ? WHERE id = ?",
+		timeToSQLite(updated.Begin.Time), timeToSQLite(updated.End.Time), id)
+	if err != nil {
+		err = fmt.Errorf("failed to update streak data : %w", err)
+		return
+	}
+	return
+}
+
+func (s *SQLiteRepo) CreateRatingStreak(ctx context.Context, name string, data domain.RatingStreak) (int, error) {
+	id, err := insertAndGetID(ctx, s.db, "INSERT INTO rating_streaks (name, start_date, end_date) VALUES (?, ?, ?)",
+		name, timeToSQLite(data.Begin.Time), timeToSQLite(data.End.Time))
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert rating streak: %w", err)
+	}
+	return int(id), nil
+}
+
+func (s *SQLiteRepo) GetMostRecentStreak(ctx context.Context, name string) (domain.RatingStreak, int, error) {
+	streak, id, err := queryStreak(ctx, s.db, sqliteMostRecentStreakQuery, name)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return domain.RatingStreak{}, 0, fmt.Errorf("failed to query most recent rating streak : %w", err)
+	}
+	return streak, id, err
+}
+
+func (s *SQLiteRepo) GetLongestStreak(ctx context.Context, name string) (domain.RatingStreak, int, error) {
+	streak, id, err := queryStreak(ctx, s.db, "SELECT id, start_date, end_date FROM rating_streaks WHERE name = ? "+
+		"ORDER BY end_date - start_date DESC LIMIT 1", name)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return domain.RatingStreak{}, 0, fmt.Errorf("failed to query longest streak for %v : %w", name, err)
+	}
+	return streak, id, err
+}
+
+func (s *SQLiteRepo) GetLongestIndividualStreak(ctx context.Context) ([]string, domain.RatingStreak, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT s.name, s.start_date, s.end_date FROM rating_streaks s "+
+		"INNER JOIN users u ON s.name = u.email WHERE s.end_date - s.start_date = "+
+		"(SELECT MAX(s2.end_date - s2.start_date) FROM rating_streaks s2 INNER JOIN users u2 ON s2.name = u2.email)")
+	if err != nil {
+		return nil, domain.RatingStreak{}, fmt.Errorf("failed to query users with max streak length: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]string, 0)
+	var streak domain.RatingStreak
+	for rows.Next() {
+		var name string
+		var startDate, endDate int64
+		if err := rows.Scan(&name, &startDate, &endDate); err != nil {
+			return nil, domain.RatingStreak{}, fmt.Errorf("failed to scan streak : %w", err)
+		}
+		users = append(users, name)
+		streak = domain.NewRatingStreakFromDB(
+			domain.NewDayPrecisionTime(timeFromSQLite(startDate)),
+			domain.NewDayPrecisionTime(timeFromSQLite(endDate)),
+		)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.RatingStreak{}, fmt.Errorf("failed to iterate streaks : %w", err)
+	}
+	if len(users) == 0 {
+		return nil, domain.RatingStreak{}, domain.ErrNotFound
+	}
+	return users, streak, nil
+}
+
+func (s *SQLiteRepo) GetAllStreaks(ctx context.Context) (map[string][]domain.RatingStreak, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT name, start_date, end_date FROM rating_streaks ORDER BY name, start_date")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query streaks : %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[string][]domain.RatingStreak)
+	for rows.Next() {
+		var name string
+		var startDate, endDate int64
+		if err := rows.Scan(&name, &startDate, &endDate); err != nil {
+			return nil, fmt.Errorf("failed to scan streak : %w", err)
+		}
+		result[name] = append(result[name], domain.NewRatingStreakFromDB(
+			domain.NewDayPrecisionTime(timeFromSQLite(startDate)),
+			domain.NewDayPrecisionTime(timeFromSQLite(endDate)),
+		))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate streaks : %w", err)
+	}
+	return result, nil
+}
+
+//
+// Webhooks
+//
+
+func (s *SQLiteRepo) CreateWebhookEndpoint(ctx context.Context, endpoint domain.WebhookEndpoint) (int64, error) {
+	id, err := insertAndGetID(ctx, s.db,
+		"INSERT INTO webhook_endpoints (url, secret, event_types, created_at) VALUES (?, ?, ?, ?)",
+		endpoint.URL, endpoint.Secret, eventTypesToDB(endpoint.EventTypes), timeToSQLite(endpoint.CreatedAt))
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert webhook endpoint : %w", err)
+	}
+	return id, nil
+}
+
+func (s *SQLiteRepo) GetAllWebhookEndpoints(ctx context.Context) (map[int64]domain.WebhookEndpoint, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT id, url, secret, event_types, created_at FROM webhook_endpoints")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhook endpoints : %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[int64]domain.WebhookEndpoint)
+	for rows.Next() {
+		var id, createdAt int64
+		var eventTypes string
+		var endpoint domain.WebhookEndpoint
+		if err := rows.Scan(&id, &endpoint.URL, &endpoint.Secret, &eventTypes, &createdAt); err != nil {
+			return nil, fmt.Errorf("failed to scan webhook endpoint : %w", err)
+		}
+		endpoint.EventTypes = eventTypesFromDB(eventTypes)
+		endpoint.CreatedAt = timeFromSQLite(createdAt)
+		result[id] = endpoint
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate webhook endpoints : %w", err)
+	}
+	return result, nil
+}
+
+func (s *SQLiteRepo) DeleteWebhookEndpoint(ctx context.Context, id int64) (err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		err = fmt.Errorf("BeginTX : %w", err)
+		return
+	}
+	defer func() {
+		err = finishTransaction(err, tx)
+	}()
+
+	if _, err = tx.ExecContext(ctx, "DELETE FROM webhook_deliveries WHERE endpoint_id = ?", id); err != nil {
+		err = fmt.Errorf("failed to delete webhook deliveries : %w", err)
+		return
+	}
+	var res sql.Result
+	res, err = tx.ExecContext(ctx, "DELETE FROM webhook_endpoints WHERE id = ?", id)
+	if err != nil {
+		err = fmt.Errorf("failed to delete webhook endpoint : %w", err)
+		return
+	}
+	var affected int64
+	if affected, err = res.RowsAffected(); err != nil {
+		err = fmt.Errorf("failed to get affected rows : %w", err)
+		return
+	}
+	if affected == 0 {
+		err = domain.ErrNotFound
+	}
+	return
+}
+
+func (s *SQLiteRepo) CreateWebhookDeliveries(ctx context.Context, deliveries []domain.WebhookDelivery) (err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		err = fmt.Errorf("BeginTX : %w", err)
+		return
+	}
+	defer func() {
+		err = finishTransaction(err, tx)
+	}()
+
+	for _, v := range deliveries {
+		_, err = tx.ExecContext(ctx,
+			"INSERT INTO webhook_deliveries (endpoint_id, event_type, payload, state, attempts, next_attempt_at, "+
+				"last_error, created_at, delivered_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+			v.EndpointID, string(v.EventType), v.Payload, string(v.State), v.Attempts, timeToSQLite(v.NextAttemptAt),
+			v.LastError, timeToSQLite(v.CreatedAt), nullTimeToSQLite(v.DeliveredAt),
+		)
+		if err != nil {
+			err = fmt.Errorf("failed to insert webhook delivery : %w", err)
+			return
+		}
+	}
+	return
+}
+
+// nullTimeToSQLite is like timeToSQLite but maps nil to NULL
+func nullTimeToSQLite(t *time.Time) sql.NullInt64 {
+	if t == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: timeToSQLite(*t), Valid: true}
+}
+
+const sqliteWebhookDeliveryColumns = "id, endpoint_id, event_type, payload, state, attempts, next_attempt_at, " +
+	"last_error, created_at, delivered_at"
+
+// scanWebhookDelivery expects the columns in sqliteWebhookDeliveryColumns
+func scanWebhookDelivery(row interface{ Scan(dest ...any) error }) (int64, domain.WebhookDelivery, error) {
+	var id, nextAttemptAt, createdAt int64
+	var eventType, state string
+	var deliveredAt sql.NullInt64
+	var v domain.WebhookDelivery
+	err := row.Scan(&id, &v.EndpointID, &eventType, &v.Payload, &state, &v.Attempts, &nextAttemptAt,
+		&v.LastError, &createdAt, &deliveredAt)
+	if err != nil {
+		return 0, domain.WebhookDelivery{}, err
+	}
+	v.EventType = domain.EventType(eventType)
+	v.State = domain.WebhookDeliveryState(state)
+	v.NextAttemptAt = timeFromSQLite(nextAttemptAt)
+	v.CreatedAt = timeFromSQLite(createdAt)
+	if deliveredAt.Valid {
+		t := timeFromSQLite(deliveredAt.Int64)
+		v.DeliveredAt = &t
+	}
+	return id, v, nil
+}
+
+func (s *SQLiteRepo) GetDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) (map[int64]domain.WebhookDelivery, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT "+sqliteWebhookDeliveryColumns+" FROM webhook_deliveries "+
+		"WHERE state = ? AND next_attempt_at <= ? ORDER BY next_attempt_at LIMIT ?",
+		string(domain.WebhookDeliveryPending), timeToSQLite(now), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query due webhook deliveries : %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[int64]domain.WebhookDelivery)
+	for rows.Next() {
+		id, delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery : %w", err)
+		}
+		result[id] = delivery
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate webhook deliveries : %w", err)
+	}
+	return result, nil
+}
+
+func (s *SQLiteRepo) UpdateWebhookDelivery(ctx context.Context, id int64, updateFN domain.WebhookDeliveryUpdateFN) (err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		err = fmt.Errorf("BeginTX : %w", err)
+		return
+	}
+	defer func() {
+		err = finishTransaction(err, tx)
+	}()
+
+	_, current, err := scanWebhookDelivery(tx.QueryRowContext(ctx,
+		"SELECT "+sqliteWebhookDeliveryColumns+" FROM webhook_deliveries WHERE id = ?", id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = domain.ErrNotFound
+			return
+		}
+		err = fmt.Errorf("failed to query webhook delivery : %w", err)
+		return
+	}
+
+	updated, err := updateFN(current)
+	if err != nil {
+		err = fmt.Errorf("update function failed : %w", err)
+		return
+	}
+	//no update requested
+	if updated == nil {
+		return
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE webhook_deliveries SET state = ?, attempts = ?, next_attempt_at = ?, last_error = ?, "+
+			"delivered_at = ? WHERE id = ?",
+		string(updated.State), updated.Attempts, timeToSQLite(updated.NextAttemptAt), updated.LastError,
+		nullTimeToSQLite(updated.DeliveredAt), id,
+	)
+	if err != nil {
+		err = fmt.Errorf("failed to update webhook delivery : %w", err)
+		return
+	}
+	return
+}
+
+//
+// Locations
+//
+
+// locationColumns are the columns of the locations table that queryLocations expects. They are the same for all
+// sql based repos
+const locationColumns = "id, name, display_name, address, opening_hours, archived"
+
+// queryLocations is a helper that expects query to return the locationColumns. The aliases are fetched separately.
+// It does not use any placeholders on its own, thus it is shared by all sql based repos
+func queryLocations(ctx context.Context, exec boil.ContextExecutor, query string, args ...interface{}) (map[int64]domain.Location, error) {
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query locations : %v", err)
+	}
+	defer rows.Close()
+
+	result := make(map[int64]domain.Location)
+	for rows.Next() {
+		var id int64
+		location := domain.NewLocation("")
+		err := rows.Scan(&id, &location.Name, &location.DisplayName, &location.Address, &location.OpeningHours,
+			&location.Archived)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan location : %v", err)
+		}
+		result[id] = location
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate locations : %v", err)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close rows : %v", err)
+	}
+
+	aliasRows, err := exec.QueryContext(ctx, "SELECT location_id, alias FROM location_aliases ORDER BY alias")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query location aliases : %v", err)
+	}
+	defer aliasRows.Close()
+	for aliasRows.Next() {
+		var locationID int64
+		var alias string
+		if err := aliasRows.Scan(&locationID, &alias); err != nil {
+			return nil, fmt.Errorf("failed to scan location alias : %v", err)
+		}
+		if location, ok := result[locationID]; ok {
+			location.Aliases = append(location.Aliases, alias)
+			result[locationID] = location
+		}
+	}
+	if err := aliasRows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate location aliases : %v", err)
+	}
+	return result, nil
+}
+
+func (s *SQLiteRepo) GetAllLocations(ctx context.Context) (map[int64]domain.Location, error) {
+	return queryLocations(ctx, s.db, "SELECT "+locationColumns+" FROM locations")
+}
+
+func (s *SQLiteRepo) GetLocationByID(ctx context.Context, id int64) (domain.Location, error) {
+	locations, err := queryLocations(ctx, s.db, "SELECT "+locationColumns+" FROM locations WHERE id = ?", id)
+	if err != nil {
+		return domain.Location{}, err
+	}
+	location, ok := locations[id]
+	if !ok {
+		return domain.Location{}, domain.ErrNotFound
+	}
+	return location, nil
+}
+
+func (s *SQLiteRepo) UpdateLocation(ctx context.Context, id int64, updateFN domain.LocationUpdateFN) (err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("BeginTX : %w", err)
+	}
+	defer func() {
+		err = finishTransaction(err, tx)
+	}()
+
+	locations, err := queryLocations(ctx, tx, "SELECT "+locationColumns+" FROM locations WHERE id = ?", id)
+	if err != nil {
+		return err
+	}
+	current, ok := locations[id]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	updated, err := updateFN(current)
+	if err != nil {
+		return fmt.Errorf("update function failed : %w", err)
+	}
+	//no update requested
+	if updated == nil {
+		return nil
+	}
+	if updated.Name != current.Name {
+		return fmt.Errorf("%w : name cannot be changed", domain.ErrInvalidLocation)
+	}
+	if err := updated.Validate(); err != nil {
+		return err
+	}
+
+	for _, alias := range updated.Aliases {
+		var conflicts int
+		err := tx.QueryRowContext(ctx, "SELECT count(*) FROM locations WHERE name = ? AND id != ?", alias, id).
+			Scan(&conflicts)
+		if err != nil {
+			return fmt.Errorf("failed to check for conflicting location : %v", err)
+		}
+		if conflicts == 0 {
+			err = tx.QueryRowContext(ctx, "SELECT count(*) FROM location_aliases WHERE alias = ? AND location_id != ?",
+				alias, id).Scan(&conflicts)
+			if err != nil {
+				return fmt.Errorf("failed to check for conflicting alias : %v", err)
+			}
+		}
+		if conflicts > 0 {
+			return fmt.Errorf("%w : \"%v\"", domain.ErrLocationAliasConflict, alias)
+		}
+	}
+
+	err = execAndExpectRows(ctx, tx, 1,
+		"UPDATE locations SET display_name = ?, address = ?, opening_hours = ?, archived = ? WHERE id = ?",
+		updated.DisplayName, updated.Address, updated.OpeningHours, updated.Archived, id)
+	if err != nil {
+		return fmt.Errorf("failed to update location : %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM location_aliases WHERE location_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete old aliases : %v", err)
+	}
+	for _, alias := range updated.Aliases {
+		_, err := tx.ExecContext(ctx, "INSERT INTO location_aliases (alias, location_id) VALUES (?, ?)", alias, id)
+		if err != nil {
+			return fmt.Errorf("failed to insert alias %v : %v", alias, err)
+		}
+	}
+	return nil
+}
+
+func (s *SQLiteRepo) ResolveLocationName(ctx context.Context, name string) (string, error) {
+	var resolved string
+	err := s.db.QueryRowContext(ctx,
+		"SELECT l.name FROM location_aliases a INNER JOIN locations l ON a.location_id = l.id WHERE a.alias = ?",
+		name).Scan(&resolved)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return name, nil
+		}
+		return "", fmt.Errorf("failed to query location alias : %v", err)
+	}
+	return resolved, nil
+}
+
+func (s *SQLiteRepo) MergeLocations(ctx context.Context, sourceID, targetID int64, dryRun bool) (report domain.LocationMergeReport, err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.LocationMergeReport{}, fmt.Errorf("BeginTX : %w", err)
+	}
+	defer func() {
+		err = finishTransaction(err, tx)
+	}()
+	return sqliteDialect.mergeLocations(ctx, tx, sourceID, targetID, dryRun, time.Now())
+}
+
+//
+// Dish families
+//
+
+func (s *SQLiteRepo) CreateDishFamily(ctx context.Context, family domain.DishFamily) (id int64, err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("BeginTX : %w", err)
+	}
+	defer func() {
+		err = finishTransaction(err, tx)
+	}()
+	return sqliteDialect.createDishFamily(ctx, tx, family)
+}
+
+func (s *SQLiteRepo) GetAllDishFamilies(ctx context.Context) (map[int64]domain.DishFamily, error) {
+	return sqliteDialect.queryDishFamilies(ctx, s.db, "")
+}
+
+func (s *SQLiteRepo) GetDishFamilyByID(ctx context.Context, id int64) (domain.DishFamily, error) {
+	return sqliteDialect.getDishFamilyByID(ctx, s.db, id)
+}
+
+func (s *SQLiteRepo) GetDishFamilyOfDish(ctx context.Context, dishID int64) (int64, error) {
+	return sqliteDialect.getDishFamilyOfDish(ctx, s.db, dishID)
+}
+
+func (s *SQLiteRepo) UpdateDishFamily(ctx context.Context, id int64, updateFN domain.DishFamilyUpdateFN) (err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("BeginTX : %w", err)
+	}
+	defer func() {
+		err = finishTransaction(err, tx)
+	}()
+	return sqliteDialect.updateDishFamily(ctx, tx, id, updateFN)
+}
+
+func (s *SQLiteRepo) DeleteDishFamily(ctx context.Context, id int64) error {
+	return sqliteDialect.deleteDishFamily(ctx, s.db, id)
+}
+
+//
+// Access Tokens
+//
+
+const sqliteAccessTokenColumns = "id, user_email, name, read_only, secret_hash, created_at, last_used_at"
+
+// scanAccessToken expects the columns in sqliteAccessTokenColumns
+func scanAccessToken(row interface{ Scan(dest ...any) error }) (int64, domain.AccessToken, error) {
+	var id int64
+	var token domain.AccessToken
+	var createdAt int64
+	var lastUsedAt sql.NullInt64
+	if err := row.Scan(&id, &token.UserEmail, &token.Name, &token.ReadOnly, &token.SecretHash, &createdAt,
+		&lastUsedAt); err != nil {
+		return 0, domain.AccessToken{}, err
+	}
+	token.CreatedAt = timeFromSQLite(createdAt)
+	if lastUsedAt.Valid {
+		lastUsed := timeFromSQLite(lastUsedAt.Int64)
+		token.LastUsedAt = &lastUsed
+	}
+	return id, token, nil
+}
+
+func (s *SQLiteRepo) CreateAccessToken(ctx context.Context, token domain.AccessToken) (int64, error) {
+	id, err := insertAndGetID(ctx, s.db,
+		"INSERT INTO access_tokens (user_email, name, read_only, secret_hash, created_at, last_used_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?)",
+		token.UserEmail, token.Name, token.ReadOnly, token.SecretHash, timeToSQLite(token.CreatedAt),
+		nullTimeToSQLite(token.LastUsedAt))
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert access token : %w", err)
+	}
+	return id, nil
+}
+
+func (s *SQLiteRepo) GetAccessTokensOfUser(ctx context.Context, userEmail string) (map[int64]domain.AccessToken, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT "+sqliteAccessTokenColumns+" FROM access_tokens WHERE user_email = ?",
+		userEmail)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query access tokens : %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[int64]domain.AccessToken)
+	for rows.Next() {
+		id, token, err := scanAccessToken(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan access token : %w", err)
+		}
+		result[id] = token
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate access tokens : %w", err)
+	}
+	return result, nil
+}
+
+func (s *SQLiteRepo) GetAccessTokenBySecretHash(ctx context.Context, secretHash string) (int64, domain.AccessToken, error) {
+	id, token, err := scanAccessToken(s.db.QueryRowContext(ctx,
+		"SELECT "+sqliteAccessTokenColumns+" FROM access_tokens WHERE secret_hash = ?", secretHash))
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, domain.AccessToken{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return 0, domain.AccessToken{}, fmt.Errorf("failed to query access token : %w", err)
+	}
+	return id, token, nil
+}
+
+func (s *SQLiteRepo) SetAccessTokenLastUsed(ctx context.Context, id int64, lastUsed time.Time) error {
+	err := execAndExpectRows(ctx, s.db, 1, "UPDATE access_tokens SET last_used_at = ? WHERE id = ?",
+		timeToSQLite(lastUsed), id)
+	if err != nil {
+		return fmt.Errorf("failed to update access token %v : %w", id, err)
+	}
+	return nil
+}
+
+func (s *SQLiteRepo) DeleteAccessToken(ctx context.Context, userEmail string, id int64) error {
+	err := execAndExpectRows(ctx, s.db, 1, "DELETE FROM access_tokens WHERE id = ? AND user_email = ?", id, userEmail)
+	if err != nil {
+		return fmt.Errorf("failed to delete access token %v : %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package dishRepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	migrate "github.com/rubenv/sql-migrate"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SQLite_RunCommon(t *testing.T) {
+
+	commonFactory := func() (commonTestRepo, factoryCleanupFunc, error) {
+		db, err := OpenSQLiteDB(filepath.Join(t.TempDir(), "itsTasty.db"))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to open db : %v", err)
+		}
+		migrationSource := &migrate.FileMigrationSource{Dir: "../../../../migrations/sqlite"}
+		repo, err := NewSQLiteRepo(db, migrationSource)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create repo : %v", err)
+		}
+
+		cleanupFunc := func() error {
+			err1 := repo.DropRepo(context.Background())
+			err2 := repo.Close()
+			err := errors.Join(err1, err2)
+			if err != nil {
+				return fmt.Errorf("cleanupFunc failed : %v", err)
+			}
+			return nil
+		}
+		return repo, cleanupFunc, nil
+	}
+
+	runCommonDbTests(t, commonFactory)
+}
+
+func TestSQLiteRepo_CheckHealth(t *testing.T) {
+	db, err := OpenSQLiteDB(filepath.Join(t.TempDir(), "itsTasty.db"))
+	require.NoError(t, err)
+	repo, err := NewSQLiteRepo(db, &migrate.FileMigrationSource{Dir: "../../../../migrations/sqlite"})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.CheckHealth(context.Background()))
+
+	//rolling back the migrations leaves them pending
+	require.NoError(t, repo.DropRepo(context.Background()))
+	require.ErrorContains(t, repo.CheckHealth(context.Background()), "pending")
+
+	require.NoError(t, repo.Close())
+	require.Error(t, repo.CheckHealth(context.Background()))
+}
@@ -0,0 +1,33 @@
+package notifier
+
+import (
+	"context"
+	"itsTasty/pkg/api/domain"
+	"sync"
+)
+
+// FakeNotifier only records the reminders. Intended for tests and local development
+type FakeNotifier struct {
+	lock      sync.Mutex
+	reminders []domain.RatingReminder
+}
+
+func NewFakeNotifier() *FakeNotifier {
+	return &FakeNotifier{reminders: make([]domain.RatingReminder, 0)}
+}
+
+func (f *FakeNotifier) SendRatingReminder(_ context.Context, reminder domain.RatingReminder) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.reminders = append(f.reminders, reminder)
+	return nil
+}
+
+// Reminders returns a copy of all reminders sent so far
+func (f *FakeNotifier) Reminders() []domain.RatingReminder {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	result := make([]domain.RatingReminder, len(f.reminders))
+	copy(result, f.reminders)
+	return result
+}
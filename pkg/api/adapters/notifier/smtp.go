@@ -0,0 +1,79 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"itsTasty/pkg/api/domain"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+type SMTPConfig struct {
+	Host string
+	Port int
+	//Username and Password are optional. If Username is empty, no authentication is performed
+	Username string
+	Password string
+	//From is the sender address
+	From string
+	//RatingURL is included in the mail, if set
+	RatingURL string
+}
+
+// SMTPNotifier sends reminders via email. The user's email is used as recipient
+type SMTPNotifier struct {
+	cfg SMTPConfig
+}
+
+func NewSMTPNotifier(cfg SMTPConfig) (*SMTPNotifier, error) {
+	if cfg.Host == "" {
+		return nil, fmt.Errorf("smtp host must not be empty")
+	}
+	if cfg.Port <= 0 {
+		return nil, fmt.Errorf("invalid smtp port %v", cfg.Port)
+	}
+	if cfg.From == "" {
+		return nil, fmt.Errorf("smtp sender address must not be empty")
+	}
+	return &SMTPNotifier{cfg: cfg}, nil
+}
+
+func (s *SMTPNotifier) SendRatingReminder(ctx context.Context, reminder domain.RatingReminder) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if s.cfg.Username != "" {
+		auth = smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)
+	}
+
+	addr := net.JoinHostPort(s.cfg.Host, strconv.Itoa(s.cfg.Port))
+	msg := s.buildMessage(reminder)
+	if err := smtp.SendMail(addr, auth, s.cfg.From, []string{reminder.User.Email}, msg); err != nil {
+		return fmt.Errorf("failed to send mail to %v : %w", reminder.User.Email, err)
+	}
+	return nil
+}
+
+func (s *SMTPNotifier) buildMessage(reminder domain.RatingReminder) []byte {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "From: %s\r\n", s.cfg.From)
+	fmt.Fprintf(buf, "To: %s\r\n", reminder.User.Email)
+	fmt.Fprintf(buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", "Don't break your rating streak!"))
+	fmt.Fprintf(buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("\r\n")
+	fmt.Fprintf(buf, "Hi,\r\n\r\nyou have rated dishes for %d days in a row, but you have not rated today's dish yet.\r\n",
+		reminder.Streak.LengthInDays())
+	buf.WriteString("Rate it today to keep your streak alive!\r\n")
+	if s.cfg.RatingURL != "" {
+		fmt.Fprintf(buf, "\r\n%s\r\n", s.cfg.RatingURL)
+	}
+	return buf.Bytes()
+}
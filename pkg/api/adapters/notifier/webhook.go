@@ -0,0 +1,62 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"itsTasty/pkg/api/domain"
+	"net/http"
+	"time"
+)
+
+// WebhookNotifier posts reminders as JSON to a fixed URL, e.g. a chat bot that forwards them to the users
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type webhookReminder struct {
+	Email        string `json:"email"`
+	StreakLength int    `json:"streakLength"`
+	StreakStart  string `json:"streakStart"`
+	Date         string `json:"date"`
+}
+
+func (w *WebhookNotifier) SendRatingReminder(ctx context.Context, reminder domain.RatingReminder) error {
+	payload, err := json.Marshal(webhookReminder{
+		Email:        reminder.User.Email,
+		StreakLength: reminder.Streak.LengthInDays(),
+		StreakStart:  reminder.Streak.Begin.Format("2006-01-02"),
+		Date:         reminder.Date.Format("2006-01-02"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal reminder : %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to build request : %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed : %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status code %v", resp.StatusCode)
+	}
+	return nil
+}
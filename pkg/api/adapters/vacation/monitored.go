@@ -0,0 +1,63 @@
+package vacation
+
+import (
+	"context"
+	"fmt"
+	"itsTasty/pkg/api/domain"
+	"sync"
+	"time"
+)
+
+// MonitoredClient wraps a domain.VacationDataSource and remembers when it last answered successfully
+type MonitoredClient struct {
+	source  domain.VacationDataSource
+	created time.Time
+
+	lock        sync.Mutex
+	lastSuccess time.Time
+	lastErr     error
+}
+
+func NewMonitoredClient(source domain.VacationDataSource) *MonitoredClient {
+	return &MonitoredClient{source: source, created: time.Now()}
+}
+
+func (m *MonitoredClient) Vacations(ctx context.Context, day domain.DayPrecisionTime) (domain.UsersOnVacation, error) {
+	vacations, err := m.source.Vacations(ctx, day)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if err != nil {
+		m.lastErr = err
+	} else {
+		m.lastSuccess = time.Now()
+		m.lastErr = nil
+	}
+	return vacations, err
+}
+
+// LastSuccess returns the time of the last successful request. The zero value means that no request succeeded yet
+func (m *MonitoredClient) LastSuccess() time.Time {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.lastSuccess
+}
+
+// CheckFreshness returns an error if the last successful request is older than maxAge. Until the first success,
+// the age is measured from the creation of the client, so that a freshly started instance is not reported as broken
+func (m *MonitoredClient) CheckFreshness(maxAge time.Duration) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	reference := m.lastSuccess
+	if reference.IsZero() {
+		reference = m.created
+	}
+	if age := time.Since(reference); age > maxAge {
+		if m.lastErr != nil {
+			return fmt.Errorf("no successful request for %v, last error : %w", age.Round(time.Second), m.lastErr)
+		}
+		return fmt.Errorf("no successful request for %v", age.Round(time.Second))
+	}
+	return nil
+}
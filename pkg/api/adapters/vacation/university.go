@@ -7,11 +7,16 @@ import (
 	"fmt"
 	"io"
 	"itsTasty/pkg/api/domain"
-	"log"
+	"itsTasty/pkg/logging"
+	"itsTasty/pkg/telemetry"
 	"net/http"
 	"net/url"
 	"path"
 	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // status describes the state of the vacation request in the booking system
@@ -57,7 +62,11 @@ func NewUniversityVacationClient(baseURL, apiKey string) (*UniversityVacationCli
 }
 
 // Vacations returns the vacations for the given da which may not be in the past
-func (u *UniversityVacationClient) Vacations(ctx context.Context, day domain.DayPrecisionTime) (domain.UsersOnVacation, error) {
+func (u *UniversityVacationClient) Vacations(ctx context.Context, day domain.DayPrecisionTime) (_ domain.UsersOnVacation, err error) {
+	//the vacation backend is known to be unreliable, the span helps to tell it apart from our own errors
+	ctx, span := telemetry.Tracer().Start(ctx, "UniversityVacationClient.Vacations", trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("day", day.Format("2006-01-02"))))
+	defer func() { telemetry.EndSpan(span, err) }()
 
 	args := getVacationArgs{
 		StartTime: day.Time,
@@ -81,12 +90,13 @@ func (u *UniversityVacationClient) Vacations(ctx context.Context, day domain.Day
 	if err != nil {
 		return domain.UsersOnVacation{}, fmt.Errorf("request failed : %w", err)
 	}
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
 
 	rawResp, err := io.ReadAll(resp.Body)
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
 		if err != nil {
-			log.Printf("Failed to close resp body : %v", err)
+			logging.FromContext(ctx).Warn("failed to close resp body", "err", err)
 		}
 	}(resp.Body)
 	if err != nil {
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var ErrInvalidAccessToken = errors.New("invalid access token")
+
+// AccessTokenPrefix starts the secret of every personal access token. It distinguishes them from other bearer
+// tokens and makes leaked tokens easy to recognize
+const AccessTokenPrefix = "itt_"
+
+// maxAccessTokenNameLength is the maximum length of AccessToken.Name in runes
+const maxAccessTokenNameLength = 100
+
+// AccessToken is a personal access token that authenticates a user against the user api without a browser
+// session. Only the hash of its secret is stored
+type AccessToken struct {
+	UserEmail string
+	Name      string
+	//ReadOnly tokens may only be used for requests that do not change data
+	ReadOnly bool
+	//SecretHash is the result of HashAccessTokenSecret
+	SecretHash string
+	CreatedAt  time.Time
+	//LastUsedAt is nil if the token has never been used
+	LastUsedAt *time.Time
+}
+
+// NewAccessToken validates the given data and creates a new token for the user. Returns the token and its secret,
+// which is not stored anywhere and thus must be handed to the user right away.
+// Marker errors: ErrInvalidAccessToken
+func NewAccessToken(userEmail, name string, readOnly bool, now time.Time) (AccessToken, string, error) {
+	name = strings.TrimSpace(name)
+	if userEmail == "" {
+		return AccessToken{}, "", fmt.Errorf("%w : user email is empty", ErrInvalidAccessToken)
+	}
+	if name == "" {
+		return AccessToken{}, "", fmt.Errorf("%w : name is empty", ErrInvalidAccessToken)
+	}
+	if utf8.RuneCountInString(name) > maxAccessTokenNameLength {
+		return AccessToken{}, "", fmt.Errorf("%w : name is longer than %v characters", ErrInvalidAccessToken,
+			maxAccessTokenNameLength)
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return AccessToken{}, "", fmt.Errorf("failed to generate secret : %v", err)
+	}
+	secret := AccessTokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+
+	return AccessToken{
+		UserEmail:  userEmail,
+		Name:       name,
+		ReadOnly:   readOnly,
+		SecretHash: HashAccessTokenSecret(secret),
+		CreatedAt:  now,
+	}, secret, nil
+}
+
+// HashAccessTokenSecret returns the hash under which the token with the given secret is stored. The secrets are
+// random, thus a fast hash is sufficient
+func HashAccessTokenSecret(secret string) string {
+	h := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(h[:])
+}
+
+// IsAccessTokenSecret returns true if s looks like the secret of a personal access token
+func IsAccessTokenSecret(s string) bool {
+	return strings.HasPrefix(s, AccessTokenPrefix)
+}
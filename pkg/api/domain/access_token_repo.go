@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type AccessTokenRepo interface {
+	//CreateAccessToken stores the token and returns its id
+	CreateAccessToken(ctx context.Context, token AccessToken) (int64, error)
+	//GetAccessTokensOfUser returns all tokens of the user indexed by their id. The map may be empty
+	GetAccessTokensOfUser(ctx context.Context, userEmail string) (map[int64]AccessToken, error)
+	//GetAccessTokenBySecretHash returns the id and the token with the given AccessToken.SecretHash
+	//Marker errors: ErrNotFound
+	GetAccessTokenBySecretHash(ctx context.Context, secretHash string) (int64, AccessToken, error)
+	//SetAccessTokenLastUsed sets AccessToken.LastUsedAt of the token
+	//Marker errors: ErrNotFound
+	SetAccessTokenLastUsed(ctx context.Context, id int64, lastUsed time.Time) error
+	//DeleteAccessToken deletes the token if it belongs to the user
+	//Marker errors: ErrNotFound, also if the token belongs to another user
+	DeleteAccessToken(ctx context.Context, userEmail string, id int64) error
+}
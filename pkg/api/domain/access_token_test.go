@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAccessToken(t *testing.T) {
+	now := time.Now()
+	token, secret, err := NewAccessToken("user@test.mail", "  cli  ", true, now)
+	require.NoError(t, err)
+	require.Equal(t, "cli", token.Name)
+	require.True(t, token.ReadOnly)
+	require.True(t, IsAccessTokenSecret(secret))
+	require.Equal(t, HashAccessTokenSecret(secret), token.SecretHash)
+	require.NotContains(t, token.SecretHash, secret)
+
+	_, otherSecret, err := NewAccessToken("user@test.mail", "cli", true, now)
+	require.NoError(t, err)
+	require.NotEqual(t, secret, otherSecret)
+
+	for _, name := range []string{"", "   ", strings.Repeat("a", maxAccessTokenNameLength+1)} {
+		_, _, err = NewAccessToken("user@test.mail", name, false, now)
+		require.True(t, errors.Is(err, ErrInvalidAccessToken), name)
+	}
+	_, _, err = NewAccessToken("", "cli", false, now)
+	require.True(t, errors.Is(err, ErrInvalidAccessToken))
+}
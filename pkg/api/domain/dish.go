@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"errors"
 	"sort"
 	"time"
 )
@@ -21,6 +22,9 @@ type Dish struct {
 	occurences []time.Time
 }
 
+// ErrDishAlreadyExists is returned when a dish would end up with the same name as another dish on the same location
+var ErrDishAlreadyExists = errors.New("dish already exists")
+
 // NewDishToday creates a new dish that was first served today
 func NewDishToday(name string, servedAt string) *Dish {
 	return &Dish{
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+var ErrInvalidDishDedupe = errors.New("invalid dish dedupe")
+
+// DishDedupe records that a duplicate dish was folded into a canonical dish, e.g. because a scraper bug
+// reported the same dish with different whitespace or casing
+type DishDedupe struct {
+	CanonicalDishID int64
+	//DuplicateDishID is the id of the deleted dish
+	DuplicateDishID int64
+	//DuplicateDishName is the name of the deleted dish
+	DuplicateDishName string
+	//PerformedBy identifies who triggered the dedupe
+	PerformedBy string
+	PerformedAt time.Time
+
+	MovedOccurrences   int
+	DroppedOccurrences int
+	MovedRatings       int
+	DroppedRatings     int
+
+	//DishFamilyID is the family of the duplicate dish, nil if it was not part of a family
+	DishFamilyID *int64
+	//CanonicalJoinedDishFamily is true if the canonical dish took the place of the duplicate dish in its family
+	CanonicalJoinedDishFamily bool
+	//DishFamilyDissolved is true if the family was deleted, because less than two dishes remained
+	DishFamilyDissolved bool
+}
+
+// NewDishDedupe returns the record for folding the duplicate dish into the canonical dish according to plan and
+// families, see PlanDishFamilyChanges
+func NewDishDedupe(canonicalDishID, duplicateDishID int64, duplicateDishName, performedBy string,
+	performedAt time.Time, plan DishFoldPlan, families DishFamilyPlan) DishDedupe {
+	dedupe := DishDedupe{
+		CanonicalDishID:    canonicalDishID,
+		DuplicateDishID:    duplicateDishID,
+		DuplicateDishName:  duplicateDishName,
+		PerformedBy:        performedBy,
+		PerformedAt:        performedAt,
+		MovedOccurrences:   len(plan.MoveOccurrences),
+		DroppedOccurrences: len(plan.DropOccurrences),
+		MovedRatings:       len(plan.MoveRatings),
+		DroppedRatings:     len(plan.DropRatings),
+	}
+	for _, v := range families.JoiningDishes {
+		if v.DishID == canonicalDishID {
+			familyID := v.DishFamilyID
+			dedupe.DishFamilyID = &familyID
+			dedupe.CanonicalJoinedDishFamily = true
+		}
+	}
+	for _, v := range families.LeavingDishes {
+		if v.DishID == duplicateDishID {
+			familyID := v.DishFamilyID
+			dedupe.DishFamilyID = &familyID
+		}
+	}
+	if len(families.DissolvedFamilies) > 0 {
+		familyID := families.DissolvedFamilies[0]
+		dedupe.DishFamilyID = &familyID
+		dedupe.DishFamilyDissolved = true
+	}
+	return dedupe
+}
+
+// DedupeMembershipPlan describes how merged dish memberships change when a duplicate dish is folded into a
+// canonical dish
+type DedupeMembershipPlan struct {
+	//JoiningDishes are added to JoinMergedDishID. Sorted ascending and never nil
+	JoiningDishes []int64
+	//JoinMergedDishID is only valid if JoiningDishes is not empty
+	JoinMergedDishID int64
+	//DeleteMergedDishID is a merged dish that has to be deleted after JoiningDishes have been added, nil if none
+	DeleteMergedDishID *int64
+}
+
+// PlanDedupeMembership computes the merged dish memberships after folding the duplicate dish into the canonical dish.
+// mergedDishMembers contains the ids of all dishes of the merged dishes of both dishes.
+// If only the duplicate dish is part of a merged dish, the canonical dish takes its place. If both dishes are part of
+// different merged dishes, the merged dish of the duplicate dish is combined with the one of the canonical dish.
+// Merged dishes that would be left with less than two dishes are deleted
+func PlanDedupeMembership(canonicalDishID, duplicateDishID int64, canonicalMergedDishID, duplicateMergedDishID *int64,
+	mergedDishMembers map[int64][]int64) DedupeMembershipPlan {
+
+	plan := DedupeMembershipPlan{JoiningDishes: make([]int64, 0)}
+	if duplicateMergedDishID == nil {
+		return plan
+	}
+	mergedDishID := *duplicateMergedDishID
+	remaining := make([]int64, 0, len(mergedDishMembers[mergedDishID]))
+	for _, v := range mergedDishMembers[mergedDishID] {
+		if v != duplicateDishID {
+			remaining = append(remaining, v)
+		}
+	}
+	sort.Slice(remaining, func(i, j int) bool { return remaining[i] < remaining[j] })
+
+	switch {
+	case canonicalMergedDishID == nil:
+		plan.JoiningDishes = append(plan.JoiningDishes, canonicalDishID)
+		plan.JoinMergedDishID = mergedDishID
+	case *canonicalMergedDishID == mergedDishID:
+		if len(remaining) < 2 {
+			plan.DeleteMergedDishID = &mergedDishID
+		}
+	default:
+		plan.JoiningDishes = remaining
+		plan.JoinMergedDishID = *canonicalMergedDishID
+		plan.DeleteMergedDishID = &mergedDishID
+	}
+	return plan
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanDedupeMembership(t *testing.T) {
+	ptr := func(v int64) *int64 { return &v }
+	const canonical, duplicate = int64(1), int64(2)
+
+	tests := []struct {
+		name                  string
+		canonicalMergedDishID *int64
+		duplicateMergedDishID *int64
+		mergedDishMembers     map[int64][]int64
+		want                  DedupeMembershipPlan
+	}{
+		{
+			name:                  "Duplicate not merged",
+			canonicalMergedDishID: ptr(10),
+			mergedDishMembers:     map[int64][]int64{10: {canonical, 3}},
+			want:                  DedupeMembershipPlan{JoiningDishes: []int64{}},
+		},
+		{
+			name:                  "Canonical dish replaces duplicate",
+			duplicateMergedDishID: ptr(20),
+			mergedDishMembers:     map[int64][]int64{20: {duplicate, 3}},
+			want:                  DedupeMembershipPlan{JoiningDishes: []int64{canonical}, JoinMergedDishID: 20},
+		},
+		{
+			name:                  "Same merged dish keeps enough dishes",
+			canonicalMergedDishID: ptr(10),
+			duplicateMergedDishID: ptr(10),
+			mergedDishMembers:     map[int64][]int64{10: {canonical, duplicate, 3}},
+			want:                  DedupeMembershipPlan{JoiningDishes: []int64{}},
+		},
+		{
+			name:                  "Same merged dish with a single remaining dish",
+			canonicalMergedDishID: ptr(10),
+			duplicateMergedDishID: ptr(10),
+			mergedDishMembers:     map[int64][]int64{10: {canonical, duplicate}},
+			want:                  DedupeMembershipPlan{JoiningDishes: []int64{}, DeleteMergedDishID: ptr(10)},
+		},
+		{
+			name:                  "Different merged dishes are combined",
+			canonicalMergedDishID: ptr(10),
+			duplicateMergedDishID: ptr(20),
+			mergedDishMembers:     map[int64][]int64{10: {canonical, 3}, 20: {5, duplicate, 4}},
+			want: DedupeMembershipPlan{
+				JoiningDishes:      []int64{4, 5},
+				JoinMergedDishID:   10,
+				DeleteMergedDishID: ptr(20),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PlanDedupeMembership(canonical, duplicate, tt.canonicalMergedDishID, tt.duplicateMergedDishID,
+				tt.mergedDishMembers)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
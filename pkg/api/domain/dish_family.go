@@ -0,0 +1,227 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var ErrInvalidDishFamily = errors.New("invalid dish family")
+var ErrDishAlreadyInFamily = errors.New("dish already part of a dish family")
+var ErrDishNotPartOfDishFamily = errors.New("dish not part of dish family")
+
+// DishFamilyMember is a dish that belongs to a DishFamily
+type DishFamilyMember struct {
+	DishID int64
+	//ServedAt is the location of the dish
+	ServedAt string
+}
+
+// DishFamily groups dishes of different locations that are the same recipe, e.g. because two canteens are run by the
+// same caterer. Unlike MergedDish, a family spans locations. To keep merged dishes the only grouping within a location,
+// a family contains at most one dish per location. If that dish is part of a merged dish, the family covers the whole
+// merged dish
+type DishFamily struct {
+	Name string
+	//Members are sorted by DishID
+	Members []DishFamilyMember
+}
+
+// NewDishFamily creates a new family with the given members
+// Marker errors: ErrInvalidDishFamily, ErrDishAlreadyInFamily
+func NewDishFamily(name string, members []DishFamilyMember) (*DishFamily, error) {
+	f := &DishFamily{
+		Name:    name,
+		Members: make([]DishFamilyMember, 0, len(members)),
+	}
+	for _, v := range members {
+		if err := f.AddDish(v.DishID, v.ServedAt); err != nil {
+			return nil, err
+		}
+	}
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// DishIDs returns the ids of all dishes in the family in ascending order
+func (f *DishFamily) DishIDs() []int64 {
+	result := make([]int64, 0, len(f.Members))
+	for _, v := range f.Members {
+		result = append(result, v.DishID)
+	}
+	return result
+}
+
+// AddDish adds the dish served at servedAt to the family
+// Marker errors: ErrDishAlreadyInFamily, ErrInvalidDishFamily if the family already has a dish at servedAt
+func (f *DishFamily) AddDish(dishID int64, servedAt string) error {
+	for _, v := range f.Members {
+		if v.DishID == dishID {
+			return fmt.Errorf("%w : dish %v", ErrDishAlreadyInFamily, dishID)
+		}
+		if v.ServedAt == servedAt {
+			return fmt.Errorf("%w : dish %v is already served at %v, use a merged dish instead",
+				ErrInvalidDishFamily, v.DishID, servedAt)
+		}
+	}
+	f.Members = append(f.Members, DishFamilyMember{DishID: dishID, ServedAt: servedAt})
+	sort.Slice(f.Members, func(i, j int) bool { return f.Members[i].DishID < f.Members[j].DishID })
+	return nil
+}
+
+// RemoveDish removes the dish from the family
+// Marker errors: ErrDishNotPartOfDishFamily, ErrInvalidDishFamily if less than two dishes would remain
+func (f *DishFamily) RemoveDish(dishID int64) error {
+	for i, v := range f.Members {
+		if v.DishID != dishID {
+			continue
+		}
+		if len(f.Members) <= 2 {
+			return fmt.Errorf("%w : a dish family needs at least two dishes", ErrInvalidDishFamily)
+		}
+		f.Members = append(f.Members[:i], f.Members[i+1:]...)
+		return nil
+	}
+	return fmt.Errorf("%w : dish %v", ErrDishNotPartOfDishFamily, dishID)
+}
+
+// Validate trims the name and checks that the family has a name and at least two dishes at different locations
+// Marker errors: ErrInvalidDishFamily
+func (f *DishFamily) Validate() error {
+	f.Name = strings.TrimSpace(f.Name)
+	if f.Name == "" || len(f.Name) > 1000 {
+		return fmt.Errorf("%w : name must have between 1 and 1000 characters", ErrInvalidDishFamily)
+	}
+	if len(f.Members) < 2 {
+		return fmt.Errorf("%w : a dish family needs at least two dishes", ErrInvalidDishFamily)
+	}
+	locations := make(map[string]interface{}, len(f.Members))
+	for _, v := range f.Members {
+		if _, ok := locations[v.ServedAt]; ok {
+			return fmt.Errorf("%w : more than one dish served at %v", ErrInvalidDishFamily, v.ServedAt)
+		}
+		locations[v.ServedAt] = nil
+	}
+	sort.Slice(f.Members, func(i, j int) bool { return f.Members[i].DishID < f.Members[j].DishID })
+	return nil
+}
+
+// DishFamilyMembership assigns a dish to a dish family
+type DishFamilyMembership struct {
+	DishID       int64
+	DishFamilyID int64
+}
+
+// DishFamilyPlan describes how dish families change when dishes are folded into other dishes or moved to another
+// location. All slices are sorted and never nil
+type DishFamilyPlan struct {
+	//JoiningDishes take the place of a folded dish in its family
+	JoiningDishes []DishFamilyMembership
+	//LeavingDishes are removed from their family. Either they are folded into a dish that already belongs to a
+	//family, or the family already has a dish at their new location. Dishes of dissolved families are not listed
+	LeavingDishes []DishFamilyMembership
+	//DissolvedFamilies are deleted, because less than two of their dishes remain
+	DissolvedFamilies []int64
+}
+
+// PlanDishFamilyChanges computes the changes to the given families after the dishes in folds have been folded into
+// the given target dishes and the dishes in moves have been moved to the given location.
+// If the target of a folded dish does not belong to a family yet, it takes the place of the folded dish. Members
+// that keep their place win over joining or moved dishes of the same location. Families that would be left with less
+// than two dishes are dissolved
+func PlanDishFamilyChanges(families map[int64]DishFamily, folds map[int64]DishFamilyMember,
+	moves map[int64]string) DishFamilyPlan {
+
+	plan := DishFamilyPlan{
+		JoiningDishes:     make([]DishFamilyMembership, 0),
+		LeavingDishes:     make([]DishFamilyMembership, 0),
+		DissolvedFamilies: make([]int64, 0),
+	}
+
+	familyIDs := make([]int64, 0, len(families))
+	familyOfDish := make(map[int64]int64)
+	for id, f := range families {
+		familyIDs = append(familyIDs, id)
+		for _, v := range f.Members {
+			familyOfDish[v.DishID] = id
+		}
+	}
+	sort.Slice(familyIDs, func(i, j int) bool { return familyIDs[i] < familyIDs[j] })
+
+	for _, id := range familyIDs {
+		kept := make([]DishFamilyMember, 0, len(families[id].Members))
+		changed := make([]DishFamilyMember, 0)
+		joining := make([]int64, 0)
+		leaving := make([]int64, 0)
+		for _, v := range families[id].Members {
+			if target, ok := folds[v.DishID]; ok {
+				leaving = append(leaving, v.DishID)
+				if _, taken := familyOfDish[target.DishID]; !taken {
+					familyOfDish[target.DishID] = id
+					joining = append(joining, target.DishID)
+					changed = append(changed, target)
+				}
+				continue
+			}
+			if servedAt, ok := moves[v.DishID]; ok {
+				changed = append(changed, DishFamilyMember{DishID: v.DishID, ServedAt: servedAt})
+				continue
+			}
+			kept = append(kept, v)
+		}
+
+		locations := make(map[string]interface{}, len(kept)+len(changed))
+		for _, v := range kept {
+			locations[v.ServedAt] = nil
+		}
+		sort.Slice(changed, func(i, j int) bool { return changed[i].DishID < changed[j].DishID })
+		remaining := len(kept)
+		for _, v := range changed {
+			if _, ok := locations[v.ServedAt]; !ok {
+				locations[v.ServedAt] = nil
+				remaining += 1
+				continue
+			}
+			if i := indexOfInt64(joining, v.DishID); i >= 0 {
+				joining = append(joining[:i], joining[i+1:]...)
+				delete(familyOfDish, v.DishID)
+			} else {
+				leaving = append(leaving, v.DishID)
+			}
+		}
+
+		if remaining < 2 {
+			for _, v := range joining {
+				delete(familyOfDish, v)
+			}
+			plan.DissolvedFamilies = append(plan.DissolvedFamilies, id)
+			continue
+		}
+		for _, v := range joining {
+			plan.JoiningDishes = append(plan.JoiningDishes, DishFamilyMembership{DishID: v, DishFamilyID: id})
+		}
+		for _, v := range leaving {
+			plan.LeavingDishes = append(plan.LeavingDishes, DishFamilyMembership{DishID: v, DishFamilyID: id})
+		}
+	}
+
+	sort.Slice(plan.JoiningDishes, func(i, j int) bool {
+		return plan.JoiningDishes[i].DishID < plan.JoiningDishes[j].DishID
+	})
+	sort.Slice(plan.LeavingDishes, func(i, j int) bool {
+		return plan.LeavingDishes[i].DishID < plan.LeavingDishes[j].DishID
+	})
+	return plan
+}
+
+func indexOfInt64(values []int64, v int64) int {
+	for i, x := range values {
+		if x == v {
+			return i
+		}
+	}
+	return -1
+}
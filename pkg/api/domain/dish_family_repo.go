@@ -0,0 +1,26 @@
+package domain
+
+import "context"
+
+type DishFamilyUpdateFN = func(current DishFamily) (*DishFamily, error)
+
+type DishFamilyRepo interface {
+	//CreateDishFamily validates and stores the family. The members must match the locations of the dishes
+	//Marker errors: ErrNotFound, ErrInvalidDishFamily, ErrDishAlreadyInFamily
+	CreateDishFamily(ctx context.Context, family DishFamily) (int64, error)
+	//GetAllDishFamilies returns all families indexed by their id. The map may be empty
+	GetAllDishFamilies(ctx context.Context) (map[int64]DishFamily, error)
+	//GetDishFamilyByID returns the family with the given id
+	//Marker errors: ErrNotFound
+	GetDishFamilyByID(ctx context.Context, id int64) (DishFamily, error)
+	//GetDishFamilyOfDish returns the id of the family that contains the dish
+	//Marker errors: ErrNotFound if the dish is not part of a family
+	GetDishFamilyOfDish(ctx context.Context, dishID int64) (int64, error)
+	//UpdateDishFamily calls updateFN with the current value of the family. If updateFN returns (nil,nil) nothing is
+	//updated. Otherwise, the returned value is validated and replaces the current one
+	//Marker errors: ErrNotFound, ErrInvalidDishFamily, ErrDishAlreadyInFamily
+	UpdateDishFamily(ctx context.Context, id int64, updateFN DishFamilyUpdateFN) error
+	//DeleteDishFamily deletes the family but not its dishes
+	//Marker errors: ErrNotFound
+	DeleteDishFamily(ctx context.Context, id int64) error
+}
@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDishFamily(t *testing.T) {
+	tests := []struct {
+		name    string
+		family  string
+		members []DishFamilyMember
+		wantErr error
+	}{
+		{
+			name:    "Valid",
+			family:  "Curry",
+			members: []DishFamilyMember{{DishID: 2, ServedAt: "b"}, {DishID: 1, ServedAt: "a"}},
+		},
+		{
+			name:    "Empty name",
+			family:  "  ",
+			members: []DishFamilyMember{{DishID: 1, ServedAt: "a"}, {DishID: 2, ServedAt: "b"}},
+			wantErr: ErrInvalidDishFamily,
+		},
+		{
+			name:    "Single dish",
+			family:  "Curry",
+			members: []DishFamilyMember{{DishID: 1, ServedAt: "a"}},
+			wantErr: ErrInvalidDishFamily,
+		},
+		{
+			name:    "Same location",
+			family:  "Curry",
+			members: []DishFamilyMember{{DishID: 1, ServedAt: "a"}, {DishID: 2, ServedAt: "a"}},
+			wantErr: ErrInvalidDishFamily,
+		},
+		{
+			name:    "Same dish twice",
+			family:  "Curry",
+			members: []DishFamilyMember{{DishID: 1, ServedAt: "a"}, {DishID: 1, ServedAt: "a"}},
+			wantErr: ErrDishAlreadyInFamily,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewDishFamily(tt.family, tt.members)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []int64{1, 2}, got.DishIDs())
+		})
+	}
+}
+
+func TestDishFamily_RemoveDish(t *testing.T) {
+	family, err := NewDishFamily("Curry", []DishFamilyMember{
+		{DishID: 1, ServedAt: "a"}, {DishID: 2, ServedAt: "b"}, {DishID: 3, ServedAt: "c"},
+	})
+	require.NoError(t, err)
+
+	require.ErrorIs(t, family.RemoveDish(4), ErrDishNotPartOfDishFamily)
+	require.NoError(t, family.RemoveDish(2))
+	require.Equal(t, []int64{1, 3}, family.DishIDs())
+	require.ErrorIs(t, family.RemoveDish(1), ErrInvalidDishFamily)
+}
+
+func TestPlanDishFamilyChanges(t *testing.T) {
+	families := map[int64]DishFamily{
+		10: {Name: "Curry", Members: []DishFamilyMember{
+			{DishID: 1, ServedAt: "a"}, {DishID: 2, ServedAt: "b"}, {DishID: 3, ServedAt: "c"},
+		}},
+		20: {Name: "Pasta", Members: []DishFamilyMember{{DishID: 4, ServedAt: "a"}, {DishID: 5, ServedAt: "b"}}},
+	}
+	tests := []struct {
+		name  string
+		folds map[int64]DishFamilyMember
+		moves map[int64]string
+		want  DishFamilyPlan
+	}{
+		{
+			name: "Unrelated changes",
+			folds: map[int64]DishFamilyMember{
+				6: {DishID: 7, ServedAt: "a"},
+			},
+			moves: map[int64]string{8: "a"},
+			want:  DishFamilyPlan{},
+		},
+		{
+			name:  "Target takes the place of the folded dish",
+			folds: map[int64]DishFamilyMember{1: {DishID: 6, ServedAt: "a"}},
+			want: DishFamilyPlan{
+				JoiningDishes: []DishFamilyMembership{{DishID: 6, DishFamilyID: 10}},
+				LeavingDishes: []DishFamilyMembership{{DishID: 1, DishFamilyID: 10}},
+			},
+		},
+		{
+			name:  "Target already belongs to a family",
+			folds: map[int64]DishFamilyMember{1: {DishID: 5, ServedAt: "b"}},
+			want: DishFamilyPlan{
+				LeavingDishes: []DishFamilyMembership{{DishID: 1, DishFamilyID: 10}},
+			},
+		},
+		{
+			name:  "Family with a single remaining dish is dissolved",
+			folds: map[int64]DishFamilyMember{4: {DishID: 2, ServedAt: "b"}},
+			want:  DishFamilyPlan{DissolvedFamilies: []int64{20}},
+		},
+		{
+			name:  "Moved dish conflicts with a dish of the new location",
+			moves: map[int64]string{1: "b", 4: "b"},
+			want: DishFamilyPlan{
+				LeavingDishes:     []DishFamilyMembership{{DishID: 1, DishFamilyID: 10}},
+				DissolvedFamilies: []int64{20},
+			},
+		},
+		{
+			name:  "Joining dish conflicts with a dish of its location",
+			folds: map[int64]DishFamilyMember{1: {DishID: 6, ServedAt: "b"}},
+			want: DishFamilyPlan{
+				LeavingDishes: []DishFamilyMembership{{DishID: 1, DishFamilyID: 10}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PlanDishFamilyChanges(families, tt.folds, tt.moves)
+			for _, v := range []*[]DishFamilyMembership{&tt.want.JoiningDishes, &tt.want.LeavingDishes} {
+				if *v == nil {
+					*v = []DishFamilyMembership{}
+				}
+			}
+			if tt.want.DissolvedFamilies == nil {
+				tt.want.DissolvedFamilies = []int64{}
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
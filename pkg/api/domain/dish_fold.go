@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// FoldOccurrence is a stored occurrence of a dish that takes part in folding one dish into another.
+// ID is the id of the storage backend and only has to be unique per dish fold
+type FoldOccurrence struct {
+	ID   int64
+	Date time.Time
+}
+
+// FoldRating is a stored rating of a dish that takes part in folding one dish into another.
+// ID is the id of the storage backend and only has to be unique per dish fold
+type FoldRating struct {
+	ID        int64
+	UserEmail string
+	Date      time.Time
+}
+
+// DishFoldPlan describes how the occurrences and ratings of a source dish are folded into a target dish.
+// All slices are sorted ascending and never nil
+type DishFoldPlan struct {
+	//MoveOccurrences are occurrences of the source dish that have to be re-pointed to the target dish
+	MoveOccurrences []int64
+	//DropOccurrences are occurrences of the source dish on days on which the target dish was served as well
+	DropOccurrences []int64
+	//MoveRatings are ratings of the source dish that have to be re-pointed to the target dish
+	MoveRatings []int64
+	//DropRatings are ratings of either dish that have to be deleted, because the same user rated the same serving
+	//again later
+	DropRatings []int64
+}
+
+// PlanDishFold computes how the occurrences and ratings of a source dish are combined with those of a target dish.
+// Occurrences are de-duplicated per day. A user may only rate each serving once, thus if a user rated both dishes
+// on the same day, only the newest rating is kept
+func PlanDishFold(sourceOccurrences, targetOccurrences []FoldOccurrence,
+	sourceRatings, targetRatings []FoldRating) DishFoldPlan {
+
+	plan := DishFoldPlan{
+		MoveOccurrences: make([]int64, 0),
+		DropOccurrences: make([]int64, 0),
+		MoveRatings:     make([]int64, 0),
+		DropRatings:     make([]int64, 0),
+	}
+
+	servedOn := make(map[time.Time]interface{}, len(targetOccurrences))
+	for _, v := range targetOccurrences {
+		servedOn[TruncateToDayPrecision(v.Date.Local())] = nil
+	}
+	sortedSourceOccurrences := make([]FoldOccurrence, len(sourceOccurrences))
+	copy(sortedSourceOccurrences, sourceOccurrences)
+	sort.Slice(sortedSourceOccurrences, func(i, j int) bool {
+		return sortedSourceOccurrences[i].ID < sortedSourceOccurrences[j].ID
+	})
+	for _, v := range sortedSourceOccurrences {
+		day := TruncateToDayPrecision(v.Date.Local())
+		if _, ok := servedOn[day]; ok {
+			plan.DropOccurrences = append(plan.DropOccurrences, v.ID)
+			continue
+		}
+		servedOn[day] = nil
+		plan.MoveOccurrences = append(plan.MoveOccurrences, v.ID)
+	}
+
+	type userAndDay struct {
+		user string
+		day  time.Time
+	}
+	type ratingOfDish struct {
+		FoldRating
+		isSource bool
+	}
+	servings := make(map[userAndDay][]ratingOfDish)
+	for _, v := range targetRatings {
+		key := userAndDay{v.UserEmail, TruncateToDayPrecision(v.Date.Local())}
+		servings[key] = append(servings[key], ratingOfDish{v, false})
+	}
+	for _, v := range sourceRatings {
+		key := userAndDay{v.UserEmail, TruncateToDayPrecision(v.Date.Local())}
+		servings[key] = append(servings[key], ratingOfDish{v, true})
+	}
+	for _, ratings := range servings {
+		hasSource := false
+		newest := 0
+		for i, v := range ratings {
+			hasSource = hasSource || v.isSource
+			//on ties, prefer the rating of the target dish
+			if v.Date.After(ratings[newest].Date) || (v.Date.Equal(ratings[newest].Date) && !v.isSource && ratings[newest].isSource) {
+				newest = i
+			}
+		}
+		//ratings of the target dish alone are not affected by the fold
+		if !hasSource {
+			continue
+		}
+		for i, v := range ratings {
+			switch {
+			case i != newest:
+				plan.DropRatings = append(plan.DropRatings, v.ID)
+			case v.isSource:
+				plan.MoveRatings = append(plan.MoveRatings, v.ID)
+			}
+		}
+	}
+	sort.Slice(plan.MoveRatings, func(i, j int) bool { return plan.MoveRatings[i] < plan.MoveRatings[j] })
+	sort.Slice(plan.DropRatings, func(i, j int) bool { return plan.DropRatings[i] < plan.DropRatings[j] })
+
+	return plan
+}
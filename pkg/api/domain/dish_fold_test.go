@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanDishFold(t *testing.T) {
+	day1 := time.Date(2023, time.March, 1, 12, 0, 0, 0, time.Local)
+	day2 := day1.Add(24 * time.Hour)
+	day3 := day2.Add(24 * time.Hour)
+
+	tests := []struct {
+		name              string
+		sourceOccurrences []FoldOccurrence
+		targetOccurrences []FoldOccurrence
+		sourceRatings     []FoldRating
+		targetRatings     []FoldRating
+		want              DishFoldPlan
+	}{
+		{
+			name: "Nothing to fold",
+			want: DishFoldPlan{
+				MoveOccurrences: []int64{},
+				DropOccurrences: []int64{},
+				MoveRatings:     []int64{},
+				DropRatings:     []int64{},
+			},
+		},
+		{
+			name:              "Occurrences are de-duplicated per day",
+			sourceOccurrences: []FoldOccurrence{{ID: 3, Date: day3}, {ID: 1, Date: day1}, {ID: 2, Date: day2.Add(time.Hour)}},
+			targetOccurrences: []FoldOccurrence{{ID: 10, Date: day2}},
+			want: DishFoldPlan{
+				MoveOccurrences: []int64{1, 3},
+				DropOccurrences: []int64{2},
+				MoveRatings:     []int64{},
+				DropRatings:     []int64{},
+			},
+		},
+		{
+			name: "Newest rating of the same serving is kept",
+			sourceRatings: []FoldRating{
+				{ID: 1, UserEmail: "a", Date: day2.Add(time.Hour)},
+				{ID: 2, UserEmail: "b", Date: day2},
+				{ID: 3, UserEmail: "c", Date: day1},
+			},
+			targetRatings: []FoldRating{
+				{ID: 10, UserEmail: "a", Date: day2},
+				{ID: 11, UserEmail: "b", Date: day2.Add(time.Hour)},
+				{ID: 12, UserEmail: "c", Date: day3},
+			},
+			want: DishFoldPlan{
+				MoveOccurrences: []int64{},
+				DropOccurrences: []int64{},
+				MoveRatings:     []int64{1, 3},
+				DropRatings:     []int64{2, 10},
+			},
+		},
+		{
+			name:          "Target rating wins ties",
+			sourceRatings: []FoldRating{{ID: 1, UserEmail: "a", Date: day1}},
+			targetRatings: []FoldRating{{ID: 10, UserEmail: "a", Date: day1}},
+			want: DishFoldPlan{
+				MoveOccurrences: []int64{},
+				DropOccurrences: []int64{},
+				MoveRatings:     []int64{},
+				DropRatings:     []int64{1},
+			},
+		},
+		{
+			name: "Ratings of the target dish alone are not touched",
+			targetRatings: []FoldRating{
+				{ID: 10, UserEmail: "a", Date: day1},
+				{ID: 11, UserEmail: "a", Date: day1.Add(time.Hour)},
+			},
+			want: DishFoldPlan{
+				MoveOccurrences: []int64{},
+				DropOccurrences: []int64{},
+				MoveRatings:     []int64{},
+				DropRatings:     []int64{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PlanDishFold(tt.sourceOccurrences, tt.targetOccurrences, tt.sourceRatings, tt.targetRatings)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -16,6 +16,10 @@ type DishRepo interface {
 	//The two bool results indicate whether a new dish and/or a new location was created.
 	//The int64 result is the id of the dish
 	GetOrCreateDish(ctx context.Context, dishName string, servedAt string) (*Dish, bool, bool, int64, error)
+	//GetOrCreateDishWithOccurrences is like GetOrCreateDish, but instead of adding a serving for today, it adds
+	//all given occurrences that are not yet stored for the dish. Intended for importing existing data.
+	//The int64 result is the id of the dish
+	GetOrCreateDishWithOccurrences(ctx context.Context, dishName string, servedAt string, occurrences []time.Time) (int64, error)
 	//GetDishByName fetches the dish. The second result is the id of the dish
 	GetDishByName(ctx context.Context, dishName, servedAt string) (dish *Dish, dishID int64, err error)
 	//GetDishByID fetches the dish. Returns domain.ErrNotFound if dish could not be found
@@ -32,6 +36,27 @@ type DishRepo interface {
 	//GetAllDishesSimple a slice with basic data for all dishes
 	GetAllDishesSimple(ctx context.Context) ([]SimpleDishView, error)
 
+	//RenameDish changes the name of the dish. Occurrences, ratings and merged dish membership are kept.
+	//Fails with domain.ErrNotFound if the dish does not exist and with domain.ErrDishAlreadyExists if
+	//another dish on the same location is already called newName
+	RenameDish(ctx context.Context, dishID int64, newName string) error
+
+	//AddDishTags adds tags (e.g. "vegan") to the dish. Tags that the dish already has are ignored.
+	//Fails with domain.ErrNotFound if the dish does not exist
+	AddDishTags(ctx context.Context, dishID int64, tags []string) error
+	//GetDishTags returns the tags of the dish in ascending order
+	//Fails with domain.ErrNotFound if the dish does not exist
+	GetDishTags(ctx context.Context, dishID int64) ([]string, error)
+
+	//DedupeDish folds the duplicate dish into the canonical dish and deletes the duplicate dish afterwards.
+	//Occurrences, ratings and tags are combined as described by PlanDishFold, merged dish memberships as described by
+	//PlanDedupeMembership. The dedupe is recorded together with performedBy. All changes are applied atomically
+	//Marker errors: ErrNotFound, ErrNotOnSameLocation, ErrInvalidDishDedupe
+	DedupeDish(ctx context.Context, canonicalDishID, duplicateDishID int64, performedBy string) (DishDedupe, error)
+	//GetDishDedupes returns all dedupes into the canonical dish, oldest first
+	//Fails with domain.ErrNotFound if the dish does not exist
+	GetDishDedupes(ctx context.Context, canonicalDishID int64) ([]DishDedupe, error)
+
 	//CRUD for merged dishes
 
 	//CreateMergedDish creates a new merged dish with name mergedDishName that consists of/merges dish1Name and dish2Name
@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type EventType string
+
+const (
+	EventDishCreated       EventType = "dish.created"
+	EventRatingCreated     EventType = "rating.created"
+	EventMergedDishChanged EventType = "mergedDish.changed"
+	EventStreakMilestone   EventType = "streak.milestone"
+)
+
+// AllEventTypes contains all known event types
+var AllEventTypes = []EventType{EventDishCreated, EventRatingCreated, EventMergedDishChanged, EventStreakMilestone}
+
+// IsValid returns true if e is one of AllEventTypes
+func (e EventType) IsValid() bool {
+	for _, v := range AllEventTypes {
+		if v == e {
+			return true
+		}
+	}
+	return false
+}
+
+// Event notifies external systems about changes in the domain
+type Event struct {
+	Type       EventType
+	OccurredAt time.Time
+	//Data is of type DishCreatedEventData, RatingCreatedEventData, MergedDishChangedEventData or
+	//StreakMilestoneEventData depending on Type
+	Data interface{}
+}
+
+type DishCreatedEventData struct {
+	DishID   int64
+	Name     string
+	ServedAt string
+}
+
+func NewDishCreatedEvent(now time.Time, dishID int64, name, servedAt string) Event {
+	return Event{
+		Type:       EventDishCreated,
+		OccurredAt: now,
+		Data: DishCreatedEventData{
+			DishID:   dishID,
+			Name:     name,
+			ServedAt: servedAt,
+		},
+	}
+}
+
+// RatingCreatedEventData intentionally does not contain the user who gave the rating
+type RatingCreatedEventData struct {
+	DishID int64
+	Rating Rating
+}
+
+func NewRatingCreatedEvent(now time.Time, dishID int64, rating Rating) Event {
+	return Event{
+		Type:       EventRatingCreated,
+		OccurredAt: now,
+		Data: RatingCreatedEventData{
+			DishID: dishID,
+			Rating: rating,
+		},
+	}
+}
+
+type MergedDishChange string
+
+const (
+	MergedDishCreated MergedDishChange = "created"
+	MergedDishUpdated MergedDishChange = "updated"
+	MergedDishDeleted MergedDishChange = "deleted"
+)
+
+type MergedDishChangedEventData struct {
+	MergedDishID int64
+	Change       MergedDishChange
+	//Name and ServedAt are empty if the merged dish has been deleted
+	Name     string
+	ServedAt string
+}
+
+func NewMergedDishChangedEvent(now time.Time, mergedDishID int64, change MergedDishChange, mergedDish *MergedDish) Event {
+	data := MergedDishChangedEventData{
+		MergedDishID: mergedDishID,
+		Change:       change,
+	}
+	if mergedDish != nil {
+		data.Name = mergedDish.Name
+		data.ServedAt = mergedDish.ServedAt
+	}
+	return Event{
+		Type:       EventMergedDishChanged,
+		OccurredAt: now,
+		Data:       data,
+	}
+}
+
+type StreakMilestoneEventData struct {
+	//Name is either the email of a user or the name of a user group
+	Name   string
+	Streak RatingStreak
+}
+
+func NewStreakMilestoneEvent(now time.Time, name string, streak RatingStreak) Event {
+	return Event{
+		Type:       EventStreakMilestone,
+		OccurredAt: now,
+		Data: StreakMilestoneEventData{
+			Name:   name,
+			Streak: streak,
+		},
+	}
+}
+
+// IsStreakMilestone returns true if a streak of the given length should be celebrated
+func IsStreakMilestone(lengthInDays int) bool {
+	switch lengthInDays {
+	case 5, 10, 25:
+		return true
+	}
+	return lengthInDays > 0 && lengthInDays%50 == 0
+}
+
+type EventPublisher interface {
+	//Publish hands the event to all interested subscribers. Implementations should not block
+	//until the event has been delivered
+	Publish(ctx context.Context, event Event) error
+}
+
+// NoopEventPublisher discards all events
+type NoopEventPublisher struct {
+}
+
+func (n NoopEventPublisher) Publish(_ context.Context, _ Event) error {
+	return nil
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var ErrInvalidLocation = errors.New("invalid location")
+var ErrLocationAliasConflict = errors.New("alias conflicts with another location")
+
+// maxLocationNameLength is the maximal length of location names and aliases supported by the db
+const maxLocationNameLength = 200
+
+// Location is a place where dishes are served. Locations are created implicitly once the first dish is served there,
+// thus all fields but Name are optional metadata
+type Location struct {
+	//Name is the unique name that the scrapers use for the location. It cannot be changed
+	Name string
+	//DisplayName is shown to users instead of Name if it is not empty
+	DisplayName  string
+	Address      string
+	OpeningHours string
+	//Aliases are alternative spellings of Name. Dishes that are reported for an alias are stored at this location.
+	//Sorted in ascending order, never nil
+	Aliases []string
+	//Archived locations are no longer served but are kept for their history
+	Archived bool
+}
+
+// NewLocation returns a Location without metadata
+func NewLocation(name string) Location {
+	return Location{
+		Name:    name,
+		Aliases: make([]string, 0),
+	}
+}
+
+// Validate checks the aliases and normalizes them to a sorted list without surrounding whitespace
+// Marker errors: ErrInvalidLocation
+func (l *Location) Validate() error {
+	if l.Name == "" {
+		return fmt.Errorf("%w : name may not be empty", ErrInvalidLocation)
+	}
+	aliases := make([]string, 0, len(l.Aliases))
+	seen := make(map[string]interface{}, len(l.Aliases))
+	for _, v := range l.Aliases {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			return fmt.Errorf("%w : alias may not be empty", ErrInvalidLocation)
+		}
+		if len(v) > maxLocationNameLength {
+			return fmt.Errorf("%w : alias \"%v\" is longer than %v bytes", ErrInvalidLocation, v, maxLocationNameLength)
+		}
+		if v == l.Name {
+			return fmt.Errorf("%w : alias \"%v\" is equal to the name", ErrInvalidLocation, v)
+		}
+		if _, ok := seen[v]; ok {
+			return fmt.Errorf("%w : duplicate alias \"%v\"", ErrInvalidLocation, v)
+		}
+		seen[v] = nil
+		aliases = append(aliases, v)
+	}
+	sort.Strings(aliases)
+	l.Aliases = aliases
+	return nil
+}
@@ -0,0 +1,218 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+)
+
+// LocationMergeDish is a dish that takes part in a location merge
+type LocationMergeDish struct {
+	ID           int64
+	Name         string
+	MergedDishID *int64
+}
+
+// LocationMergeMergedDish is a merged dish that takes part in a location merge
+type LocationMergeMergedDish struct {
+	ID   int64
+	Name string
+}
+
+// DishCombination is a dish of the source location that is folded into the dish with the same name at the
+// target location
+type DishCombination struct {
+	SourceDishID int64
+	TargetDishID int64
+	Name         string
+}
+
+// MergedDishCombination is a merged dish whose dishes are added to another merged dish before it is deleted
+type MergedDishCombination struct {
+	SourceMergedDishID int64
+	TargetMergedDishID int64
+}
+
+// MembershipChange means that a dish belongs to another merged dish after the location merge
+type MembershipChange struct {
+	DishID       int64
+	MergedDishID int64
+}
+
+// LocationMergeReport describes the effects of merging the source location into the target location.
+// All slices are sorted by id and never nil
+type LocationMergeReport struct {
+	SourceLocation string
+	TargetLocation string
+	//MovedDishes are dishes of the source location whose name is not yet taken at the target location
+	MovedDishes []int64
+	//CombinedDishes are dishes of the source location that are folded into a dish of the target location.
+	//Their occurrences and ratings are moved to the target dish, see PlanDishFold. Afterwards, they are deleted
+	CombinedDishes []DishCombination
+	//MovedMergedDishes are merged dishes of the source location that are moved to the target location
+	MovedMergedDishes []int64
+	//CombinedMergedDishes are merged dishes that are deleted, because they have the same name as a merged dish
+	//of the target location or because they contain a dish that is combined with a dish of another merged dish
+	CombinedMergedDishes []MergedDishCombination
+	//MembershipChanges are all remaining dishes that belong to another merged dish after the merge
+	MembershipChanges []MembershipChange
+	//DishFamilyChanges keep the dish families valid, as the dishes of the source location are now served at the
+	//target location. See PlanDishFamilyChanges
+	DishFamilyChanges DishFamilyPlan
+	//DryRun is true if the report was created without changing anything
+	DryRun bool
+}
+
+// PlanLocationMerge computes the changes required to merge the source location into the target location.
+// Dishes and merged dishes of the source location are moved to the target location. If the target location already
+// has a (merged) dish with the same name, both are combined. Combining dishes may require combining the merged
+// dishes they belong to. In this case the merged dish of the target location is kept. families are all dish families,
+// which are kept valid, see PlanDishFamilyChanges
+// Marker errors: ErrInvalidLocation
+func PlanLocationMerge(sourceName, targetName string, sourceDishes, targetDishes []LocationMergeDish,
+	sourceMergedDishes, targetMergedDishes []LocationMergeMergedDish, families map[int64]DishFamily) (LocationMergeReport, error) {
+
+	if sourceName == targetName {
+		return LocationMergeReport{}, fmt.Errorf("%w : cannot merge location %v into itself", ErrInvalidLocation, sourceName)
+	}
+
+	report := LocationMergeReport{
+		SourceLocation:       sourceName,
+		TargetLocation:       targetName,
+		MovedDishes:          make([]int64, 0),
+		CombinedDishes:       make([]DishCombination, 0),
+		MovedMergedDishes:    make([]int64, 0),
+		CombinedMergedDishes: make([]MergedDishCombination, 0),
+		MembershipChanges:    make([]MembershipChange, 0),
+	}
+
+	//union find over merged dish ids. The representative of each set is the merged dish that is kept
+	isTargetMergedDish := make(map[int64]bool)
+	parent := make(map[int64]int64)
+	for _, v := range targetMergedDishes {
+		isTargetMergedDish[v.ID] = true
+		parent[v.ID] = v.ID
+	}
+	for _, v := range sourceMergedDishes {
+		parent[v.ID] = v.ID
+	}
+	var find func(id int64) int64
+	find = func(id int64) int64 {
+		if parent[id] != id {
+			parent[id] = find(parent[id])
+		}
+		return parent[id]
+	}
+	union := func(a, b int64) {
+		a, b = find(a), find(b)
+		if a == b {
+			return
+		}
+		//prefer merged dishes of the target location, then lower ids
+		if isTargetMergedDish[b] && !isTargetMergedDish[a] || isTargetMergedDish[a] == isTargetMergedDish[b] && b < a {
+			a, b = b, a
+		}
+		parent[b] = a
+	}
+
+	targetMergedDishByName := make(map[string]int64, len(targetMergedDishes))
+	for _, v := range targetMergedDishes {
+		targetMergedDishByName[v.Name] = v.ID
+	}
+	for _, v := range sourceMergedDishes {
+		if targetID, ok := targetMergedDishByName[v.Name]; ok {
+			union(targetID, v.ID)
+		}
+	}
+
+	targetDishByName := make(map[string]LocationMergeDish, len(targetDishes))
+	for _, v := range targetDishes {
+		targetDishByName[v.Name] = v
+	}
+	//finalMergedDish tracks the merged dish of all dishes that remain after the merge, before applying find
+	finalMergedDish := make(map[int64]*int64)
+	currentMergedDish := make(map[int64]*int64)
+	for _, v := range targetDishes {
+		finalMergedDish[v.ID] = v.MergedDishID
+		currentMergedDish[v.ID] = v.MergedDishID
+	}
+	for _, v := range sourceDishes {
+		target, ok := targetDishByName[v.Name]
+		if !ok {
+			report.MovedDishes = append(report.MovedDishes, v.ID)
+			finalMergedDish[v.ID] = v.MergedDishID
+			currentMergedDish[v.ID] = v.MergedDishID
+			continue
+		}
+		report.CombinedDishes = append(report.CombinedDishes, DishCombination{
+			SourceDishID: v.ID,
+			TargetDishID: target.ID,
+			Name:         v.Name,
+		})
+		if v.MergedDishID == nil {
+			continue
+		}
+		if current := finalMergedDish[target.ID]; current != nil {
+			union(*current, *v.MergedDishID)
+		} else {
+			finalMergedDish[target.ID] = v.MergedDishID
+		}
+	}
+
+	for _, v := range sourceMergedDishes {
+		if representative := find(v.ID); representative != v.ID {
+			report.CombinedMergedDishes = append(report.CombinedMergedDishes, MergedDishCombination{
+				SourceMergedDishID: v.ID,
+				TargetMergedDishID: representative,
+			})
+		} else {
+			report.MovedMergedDishes = append(report.MovedMergedDishes, v.ID)
+		}
+	}
+	for _, v := range targetMergedDishes {
+		if representative := find(v.ID); representative != v.ID {
+			report.CombinedMergedDishes = append(report.CombinedMergedDishes, MergedDishCombination{
+				SourceMergedDishID: v.ID,
+				TargetMergedDishID: representative,
+			})
+		}
+	}
+
+	for dishID, mergedDishID := range finalMergedDish {
+		if mergedDishID == nil {
+			continue
+		}
+		representative := find(*mergedDishID)
+		if current := currentMergedDish[dishID]; current == nil || *current != representative {
+			report.MembershipChanges = append(report.MembershipChanges, MembershipChange{
+				DishID:       dishID,
+				MergedDishID: representative,
+			})
+		}
+	}
+
+	sort.Slice(report.MovedDishes, func(i, j int) bool { return report.MovedDishes[i] < report.MovedDishes[j] })
+	sort.Slice(report.CombinedDishes, func(i, j int) bool {
+		return report.CombinedDishes[i].SourceDishID < report.CombinedDishes[j].SourceDishID
+	})
+	sort.Slice(report.MovedMergedDishes, func(i, j int) bool {
+		return report.MovedMergedDishes[i] < report.MovedMergedDishes[j]
+	})
+	sort.Slice(report.CombinedMergedDishes, func(i, j int) bool {
+		return report.CombinedMergedDishes[i].SourceMergedDishID < report.CombinedMergedDishes[j].SourceMergedDishID
+	})
+	sort.Slice(report.MembershipChanges, func(i, j int) bool {
+		return report.MembershipChanges[i].DishID < report.MembershipChanges[j].DishID
+	})
+
+	folds := make(map[int64]DishFamilyMember, len(report.CombinedDishes))
+	for _, v := range report.CombinedDishes {
+		folds[v.SourceDishID] = DishFamilyMember{DishID: v.TargetDishID, ServedAt: targetName}
+	}
+	moves := make(map[int64]string, len(report.MovedDishes))
+	for _, v := range report.MovedDishes {
+		moves[v] = targetName
+	}
+	report.DishFamilyChanges = PlanDishFamilyChanges(families, folds, moves)
+
+	return report, nil
+}
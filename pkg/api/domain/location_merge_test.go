@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanLocationMerge(t *testing.T) {
+	ptr := func(v int64) *int64 { return &v }
+
+	t.Run("Self merge", func(t *testing.T) {
+		_, err := PlanLocationMerge("a", "a", nil, nil, nil, nil, nil)
+		require.ErrorIs(t, err, ErrInvalidLocation)
+	})
+
+	t.Run("Disjoint locations", func(t *testing.T) {
+		got, err := PlanLocationMerge("old", "new",
+			[]LocationMergeDish{{ID: 2, Name: "B", MergedDishID: ptr(20)}, {ID: 1, Name: "A", MergedDishID: ptr(20)}},
+			[]LocationMergeDish{{ID: 3, Name: "C"}},
+			[]LocationMergeMergedDish{{ID: 20, Name: "AB"}},
+			nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, LocationMergeReport{
+			SourceLocation:       "old",
+			TargetLocation:       "new",
+			MovedDishes:          []int64{1, 2},
+			CombinedDishes:       []DishCombination{},
+			MovedMergedDishes:    []int64{20},
+			CombinedMergedDishes: []MergedDishCombination{},
+			MembershipChanges:    []MembershipChange{},
+			DishFamilyChanges: DishFamilyPlan{
+				JoiningDishes:     []DishFamilyMembership{},
+				LeavingDishes:     []DishFamilyMembership{},
+				DissolvedFamilies: []int64{},
+			},
+		}, got)
+	})
+
+	t.Run("Merged dishes with the same name are combined", func(t *testing.T) {
+		got, err := PlanLocationMerge("old", "new",
+			[]LocationMergeDish{{ID: 1, Name: "A", MergedDishID: ptr(20)}, {ID: 2, Name: "B", MergedDishID: ptr(20)}},
+			[]LocationMergeDish{{ID: 3, Name: "C", MergedDishID: ptr(30)}, {ID: 4, Name: "D", MergedDishID: ptr(30)}},
+			[]LocationMergeMergedDish{{ID: 20, Name: "Curry"}},
+			[]LocationMergeMergedDish{{ID: 30, Name: "Curry"}}, nil)
+		require.NoError(t, err)
+		require.Equal(t, []int64{1, 2}, got.MovedDishes)
+		require.Equal(t, []int64{}, got.MovedMergedDishes)
+		require.Equal(t, []MergedDishCombination{{SourceMergedDishID: 20, TargetMergedDishID: 30}},
+			got.CombinedMergedDishes)
+		require.Equal(t, []MembershipChange{{DishID: 1, MergedDishID: 30}, {DishID: 2, MergedDishID: 30}},
+			got.MembershipChanges)
+	})
+
+	t.Run("Combined dish joins the merged dish of the source dish", func(t *testing.T) {
+		got, err := PlanLocationMerge("old", "new",
+			[]LocationMergeDish{{ID: 1, Name: "A", MergedDishID: ptr(20)}, {ID: 2, Name: "B", MergedDishID: ptr(20)}},
+			[]LocationMergeDish{{ID: 3, Name: "A"}},
+			[]LocationMergeMergedDish{{ID: 20, Name: "AB"}},
+			nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, []int64{2}, got.MovedDishes)
+		require.Equal(t, []DishCombination{{SourceDishID: 1, TargetDishID: 3, Name: "A"}}, got.CombinedDishes)
+		require.Equal(t, []int64{20}, got.MovedMergedDishes)
+		require.Equal(t, []MembershipChange{{DishID: 3, MergedDishID: 20}}, got.MembershipChanges)
+	})
+
+	t.Run("Dish families stay valid", func(t *testing.T) {
+		got, err := PlanLocationMerge("old", "new",
+			[]LocationMergeDish{{ID: 1, Name: "A"}, {ID: 2, Name: "B"}},
+			[]LocationMergeDish{{ID: 3, Name: "A"}, {ID: 4, Name: "C"}},
+			nil, nil,
+			map[int64]DishFamily{
+				//the combined dish takes the place of the source dish
+				10: {Name: "A", Members: []DishFamilyMember{{DishID: 1, ServedAt: "old"}, {DishID: 5, ServedAt: "x"}}},
+				//both dishes would be served at the new location
+				20: {Name: "B", Members: []DishFamilyMember{{DishID: 2, ServedAt: "old"}, {DishID: 4, ServedAt: "new"}}},
+			})
+		require.NoError(t, err)
+		require.Equal(t, DishFamilyPlan{
+			JoiningDishes:     []DishFamilyMembership{{DishID: 3, DishFamilyID: 10}},
+			LeavingDishes:     []DishFamilyMembership{{DishID: 1, DishFamilyID: 10}},
+			DissolvedFamilies: []int64{20},
+		}, got.DishFamilyChanges)
+	})
+}
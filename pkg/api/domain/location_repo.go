@@ -0,0 +1,26 @@
+package domain
+
+import "context"
+
+type LocationUpdateFN = func(current Location) (*Location, error)
+
+type LocationRepo interface {
+	//GetAllLocations returns all locations indexed by their id. The map may be empty
+	GetAllLocations(ctx context.Context) (map[int64]Location, error)
+	//GetLocationByID returns the location with the given id
+	//Marker errors: ErrNotFound
+	GetLocationByID(ctx context.Context, id int64) (Location, error)
+	//UpdateLocation calls updateFN with the current value of the location. If updateFN returns (nil,nil)
+	//nothing is updated. Otherwise, the returned value is validated and replaces the current one. The name of a
+	//location cannot be changed. An alias may neither be the name nor an alias of another location
+	//Marker errors: ErrNotFound, ErrInvalidLocation, ErrLocationAliasConflict
+	UpdateLocation(ctx context.Context, id int64, updateFN LocationUpdateFN) error
+	//ResolveLocationName returns the name of the location that has name as alias. If there is no such location,
+	//name is returned unchanged
+	ResolveLocationName(ctx context.Context, name string) (string, error)
+	//MergeLocations merges the location sourceID into the location targetID, see PlanLocationMerge. Afterwards,
+	//the source location is deleted and its name and aliases become aliases of the target location. All changes
+	//are applied atomically. If dryRun is true, nothing is changed but the report is computed the same way
+	//Marker errors: ErrNotFound, ErrInvalidLocation
+	MergeLocations(ctx context.Context, sourceID, targetID int64, dryRun bool) (LocationMergeReport, error)
+}
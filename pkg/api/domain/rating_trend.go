@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// RatingTrendEntry aggregates the ratings given for a single serving of a dish
+type RatingTrendEntry struct {
+	//Occurrence is the date on which the dish was served
+	Occurrence time.Time
+	//AverageRating is nil if there are no ratings for this serving
+	AverageRating *float32
+	RatingCount   int
+	//Ratings maps each rating value to the amount of ratings with that value
+	Ratings map[Rating]int
+}
+
+// NewRatingTrend groups ratings by the serving they belong to. A rating belongs to the most recent occurrence
+// on or before the day the rating was given. Ratings given before the first occurrence are attributed to the first occurrence.
+// The result contains one entry for each unique occurrence, sorted in ascending order. Occurrences without ratings
+// are included with a RatingCount of zero
+func NewRatingTrend(occurrences []time.Time, ratings []DishRating) []RatingTrendEntry {
+	uniqueDays := make(map[time.Time]interface{})
+	for _, v := range occurrences {
+		uniqueDays[TruncateToDayPrecision(v)] = nil
+	}
+	days := make([]time.Time, 0, len(uniqueDays))
+	for k := range uniqueDays {
+		days = append(days, k)
+	}
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].Before(days[j])
+	})
+
+	result := make([]RatingTrendEntry, 0, len(days))
+	if len(days) == 0 {
+		return result
+	}
+
+	ratingsPerServing := make([][]DishRating, len(days))
+	for _, r := range ratings {
+		ratingDay := TruncateToDayPrecision(r.RatingWhen)
+		//index of the first occurrence after ratingDay
+		idx := sort.Search(len(days), func(i int) bool {
+			return days[i].After(ratingDay)
+		})
+		if idx > 0 {
+			idx -= 1
+		}
+		ratingsPerServing[idx] = append(ratingsPerServing[idx], r)
+	}
+
+	for i, day := range days {
+		entry := RatingTrendEntry{
+			Occurrence:  day,
+			RatingCount: len(ratingsPerServing[i]),
+			Ratings:     Ratings(ratingsPerServing[i]),
+		}
+		if avg, err := AverageRating(ratingsPerServing[i]); err == nil {
+			entry.AverageRating = &avg
+		}
+		result = append(result, entry)
+	}
+
+	return result
+}
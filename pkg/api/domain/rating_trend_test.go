@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewRatingTrend(t *testing.T) {
+
+	day1 := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.Local)
+	day2 := day1.AddDate(0, 0, 7)
+	day3 := day1.AddDate(0, 0, 14)
+
+	float32Ptr := func(v float32) *float32 { return &v }
+
+	type args struct {
+		occurrences []time.Time
+		ratings     []DishRating
+	}
+	tests := []struct {
+		name string
+		args args
+		want []RatingTrendEntry
+	}{
+		{
+			name: "No occurrences",
+			args: args{
+				occurrences: nil,
+				ratings:     nil,
+			},
+			want: []RatingTrendEntry{},
+		},
+		{
+			name: "Ratings are assigned to most recent serving",
+			args: args{
+				occurrences: []time.Time{day3, day1, day2},
+				ratings: []DishRating{
+					NewDishRating("user1", FiveStars, day1.Add(12*time.Hour)),
+					NewDishRating("user2", ThreeStars, day1.AddDate(0, 0, 2)),
+					NewDishRating("user1", TwoStars, day3.Add(13*time.Hour)),
+				},
+			},
+			want: []RatingTrendEntry{
+				{
+					Occurrence:    day1,
+					AverageRating: float32Ptr(4),
+					RatingCount:   2,
+					Ratings:       map[Rating]int{FiveStars: 1, ThreeStars: 1},
+				},
+				{
+					Occurrence:    day2,
+					AverageRating: nil,
+					RatingCount:   0,
+					Ratings:       map[Rating]int{},
+				},
+				{
+					Occurrence:    day3,
+					AverageRating: float32Ptr(2),
+					RatingCount:   1,
+					Ratings:       map[Rating]int{TwoStars: 1},
+				},
+			},
+		},
+		{
+			name: "Duplicate occurrences and rating before first occurrence",
+			args: args{
+				occurrences: []time.Time{day2, day2.Add(3 * time.Hour)},
+				ratings: []DishRating{
+					NewDishRating("user1", OneStar, day1),
+				},
+			},
+			want: []RatingTrendEntry{
+				{
+					Occurrence:    day2,
+					AverageRating: float32Ptr(1),
+					RatingCount:   1,
+					Ratings:       map[Rating]int{OneStar: 1},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewRatingTrend(tt.args.occurrences, tt.args.ratings); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewRatingTrend() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"context"
+	"sort"
+)
+
+// RatingReminder asks a user to rate today's dish, so that their ongoing streak does not break
+type RatingReminder struct {
+	User   User
+	Streak RatingStreak
+	Date   DayPrecisionTime
+}
+
+// Notifier delivers reminders to users
+type Notifier interface {
+	SendRatingReminder(ctx context.Context, reminder RatingReminder) error
+}
+
+// NewRatingReminders returns a reminder for each user whose most recent streak is still ongoing but who neither
+// rated today nor is on vacation today. A streak is considered ongoing if it lasts at least until the previous
+// workday. Like in NewRatingStreak, the days in isHolidayOrWeekend do not count, thus the previous workday of a Monday
+// is the Friday before. streaks maps user emails to their most recent streak. The result is sorted by email
+func NewRatingReminders(today DayPrecisionTime, streaks map[string]RatingStreak, ratingsToday []DishRating,
+	vacations UsersOnVacation, isHolidayOrWeekend map[DayPrecisionTime]bool) []RatingReminder {
+
+	prevWorkday := today.PrevDay()
+	for isHolidayOrWeekend[prevWorkday] {
+		prevWorkday = prevWorkday.PrevDay()
+	}
+
+	ratedToday := make(map[string]interface{})
+	for _, v := range ratingsToday {
+		if OnSameDay(v.RatingWhen, today.Time) {
+			ratedToday[v.Who] = nil
+		}
+	}
+
+	reminders := make([]RatingReminder, 0)
+	for email, streak := range streaks {
+		if streak.End.Before(prevWorkday.Time) {
+			continue
+		}
+		if _, ok := ratedToday[email]; ok {
+			continue
+		}
+		if vacations.UserHasVacation(email, today) {
+			continue
+		}
+		reminders = append(reminders, RatingReminder{
+			User:   User{Email: email},
+			Streak: streak,
+			Date:   today,
+		})
+	}
+
+	sort.Slice(reminders, func(i, j int) bool {
+		return reminders[i].User.Email < reminders[j].User.Email
+	})
+
+	return reminders
+}
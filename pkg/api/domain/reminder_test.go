@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewRatingReminders(t *testing.T) {
+	today := NewDayPrecisionTime(time.Date(2023, time.March, 1, 12, 0, 0, 0, time.Local))
+	yesterday := today.PrevDay()
+	twoDaysAgo := yesterday.PrevDay()
+
+	ongoing := RatingStreak{Begin: twoDaysAgo, End: yesterday}
+	broken := RatingStreak{Begin: twoDaysAgo, End: twoDaysAgo}
+
+	type args struct {
+		streaks            map[string]RatingStreak
+		ratingsToday       []DishRating
+		vacations          UsersOnVacation
+		isHolidayOrWeekend map[DayPrecisionTime]bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want []RatingReminder
+	}{
+		{
+			name: "No streaks",
+			args: args{
+				streaks:      map[string]RatingStreak{},
+				ratingsToday: nil,
+				vacations:    NewUsersOnVacation(nil),
+			},
+			want: []RatingReminder{},
+		},
+		{
+			name: "Ongoing streaks without rating are reminded in order",
+			args: args{
+				streaks: map[string]RatingStreak{
+					"b@test": ongoing,
+					"a@test": ongoing,
+				},
+				ratingsToday: nil,
+				vacations:    NewUsersOnVacation(nil),
+			},
+			want: []RatingReminder{
+				{User: User{Email: "a@test"}, Streak: ongoing, Date: today},
+				{User: User{Email: "b@test"}, Streak: ongoing, Date: today},
+			},
+		},
+		{
+			name: "Skip broken streak, rated today and vacation",
+			args: args{
+				streaks: map[string]RatingStreak{
+					"broken@test":   broken,
+					"rated@test":    ongoing,
+					"vacation@test": ongoing,
+					"remind@test":   ongoing,
+				},
+				ratingsToday: []DishRating{
+					{Who: "rated@test", Value: FiveStars, RatingWhen: today.Add(time.Hour)},
+					//rating from yesterday must not count
+					{Who: "remind@test", Value: FiveStars, RatingWhen: yesterday.Time},
+				},
+				vacations: NewUsersOnVacation(map[DayPrecisionTime]map[string]interface{}{
+					today: {"vacation@test": nil},
+				}),
+			},
+			want: []RatingReminder{
+				{User: User{Email: "remind@test"}, Streak: ongoing, Date: today},
+			},
+		},
+		{
+			name: "Streak that ended on the workday before the days off",
+			args: args{
+				streaks: map[string]RatingStreak{
+					"ongoing@test": broken,
+					"broken@test":  {Begin: twoDaysAgo.PrevDay(), End: twoDaysAgo.PrevDay()},
+				},
+				ratingsToday:       nil,
+				vacations:          NewUsersOnVacation(nil),
+				isHolidayOrWeekend: map[DayPrecisionTime]bool{yesterday: true},
+			},
+			want: []RatingReminder{
+				{User: User{Email: "ongoing@test"}, Streak: broken, Date: today},
+			},
+		},
+		{
+			name: "Streak that already includes today",
+			args: args{
+				streaks: map[string]RatingStreak{
+					"a@test": {Begin: twoDaysAgo, End: today},
+				},
+				ratingsToday: nil,
+				vacations:    NewUsersOnVacation(nil),
+			},
+			want: []RatingReminder{
+				{User: User{Email: "a@test"}, Streak: RatingStreak{Begin: twoDaysAgo, End: today}, Date: today},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewRatingReminders(today, tt.args.streaks, tt.args.ratingsToday, tt.args.vacations,
+				tt.args.isHolidayOrWeekend); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewRatingReminders() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -32,9 +32,17 @@ type RatingStreakRepo interface {
 	//GetLongestIndividualStreak returns the longest streak only considering single users
 	//Marker errors ErrNotFound
 	GetLongestIndividualStreak(ctx context.Context) ([]string, RatingStreak, error)
+	//GetAllStreaks returns all streaks grouped by user/user group name. The streaks of each name are sorted by
+	//their begin in ascending order
+	GetAllStreaks(ctx context.Context) (map[string][]RatingStreak, error)
 }
 
 type StatisticsRepo interface {
 	GetAllUsers(ctx context.Context) ([]User, error)
 	GetAllRatingsForDate(ctx context.Context, date DayPrecisionTime) ([]DishRating, error)
+	//GetAllRatingsOfUser returns all ratings given by the user with the given email. If the user does not exist,
+	//an empty slice is returned
+	GetAllRatingsOfUser(ctx context.Context, userEmail string) ([]RatingOfDish, error)
+	//GetRatingSummaryPerUser returns a summary for each user that has given at least one rating
+	GetRatingSummaryPerUser(ctx context.Context) ([]UserRatingSummary, error)
 }
@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// RatingOfDish is a DishRating together with the id of the rated dish
+type RatingOfDish struct {
+	DishID int64
+	Rating DishRating
+}
+
+// UserRatingSummary aggregates all ratings given by a single user
+type UserRatingSummary struct {
+	User User
+	//RatingCount is the total amount of ratings given by User
+	RatingCount int
+	//RatingSum is the sum of the values of all ratings given by User
+	RatingSum int
+}
+
+// MonthlyRatingCount is the amount of ratings given in the month starting at Month
+type MonthlyRatingCount struct {
+	//Month is the first day of the month
+	Month time.Time
+	Count int
+}
+
+// DishPreference describes how a user rated a specific dish
+type DishPreference struct {
+	DishID        int64
+	AverageRating float32
+	RatingCount   int
+}
+
+type UserStatistics struct {
+	TotalRatings int
+	//RatingsPerMonth is sorted in ascending order and only contains months with at least one rating
+	RatingsPerMonth []MonthlyRatingCount
+	//AverageGivenRating is nil if the user has not rated yet
+	AverageGivenRating *float32
+	//TeamAverageRating is the average over the ratings of all users. It is nil if there are no ratings at all
+	TeamAverageRating *float32
+	//FavouriteDish is the dish with the highest average rating given by the user. Nil if user has not rated yet
+	FavouriteDish *DishPreference
+	//MostHatedDish is the dish with the lowest average rating given by the user. Nil if user has not rated yet
+	MostHatedDish *DishPreference
+	//Percentile is the share of the other users, that have given fewer ratings than the user, in the range [0,100].
+	//If there are no other users, the user is in the 100th percentile
+	Percentile float64
+}
+
+// NewUserStatistics calculates the statistics for userEmail. ratings must contain all ratings of the user and
+// summaries must contain one entry for each user that has given at least one rating
+func NewUserStatistics(userEmail string, ratings []RatingOfDish, summaries []UserRatingSummary) UserStatistics {
+	result := UserStatistics{
+		TotalRatings:    len(ratings),
+		RatingsPerMonth: make([]MonthlyRatingCount, 0),
+	}
+
+	//ratings per month and per dish
+
+	countByMonth := make(map[time.Time]int)
+	ratingsByDish := make(map[int64][]DishRating)
+	userRatings := make([]DishRating, 0, len(ratings))
+	for _, v := range ratings {
+		when := v.Rating.RatingWhen
+		month := time.Date(when.Year(), when.Month(), 1, 0, 0, 0, 0, when.Location())
+		countByMonth[month] += 1
+		ratingsByDish[v.DishID] = append(ratingsByDish[v.DishID], v.Rating)
+		userRatings = append(userRatings, v.Rating)
+	}
+	for month, count := range countByMonth {
+		result.RatingsPerMonth = append(result.RatingsPerMonth, MonthlyRatingCount{Month: month, Count: count})
+	}
+	sort.Slice(result.RatingsPerMonth, func(i, j int) bool {
+		return result.RatingsPerMonth[i].Month.Before(result.RatingsPerMonth[j].Month)
+	})
+
+	if avg, err := AverageRating(userRatings); err == nil {
+		result.AverageGivenRating = &avg
+	}
+
+	//favourite and most hated dish
+
+	preferences := make([]DishPreference, 0, len(ratingsByDish))
+	for dishID, dishRatings := range ratingsByDish {
+		//cannot fail, as we only have entries for dishes with at least one rating
+		avg, _ := AverageRating(dishRatings)
+		preferences = append(preferences, DishPreference{
+			DishID:        dishID,
+			AverageRating: avg,
+			RatingCount:   len(dishRatings),
+		})
+	}
+	//sort by descending average. Ties are broken by preferring dishes with more ratings and finally by the dish id
+	//to get a stable result
+	sort.Slice(preferences, func(i, j int) bool {
+		if preferences[i].AverageRating != preferences[j].AverageRating {
+			return preferences[i].AverageRating > preferences[j].AverageRating
+		}
+		if preferences[i].RatingCount != preferences[j].RatingCount {
+			return preferences[i].RatingCount > preferences[j].RatingCount
+		}
+		return preferences[i].DishID < preferences[j].DishID
+	})
+	if len(preferences) > 0 {
+		favourite := preferences[0]
+		result.FavouriteDish = &favourite
+
+		//for the most hated dish, we also want to prefer dishes with more ratings on ties
+		mostHated := preferences[len(preferences)-1]
+		for i := len(preferences) - 2; i >= 0 && preferences[i].AverageRating == mostHated.AverageRating; i-- {
+			mostHated = preferences[i]
+		}
+		result.MostHatedDish = &mostHated
+	}
+
+	//team average and percentile
+
+	teamRatingSum := 0
+	teamRatingCount := 0
+	otherUsers := 0
+	otherUsersWithFewerRatings := 0
+	for _, v := range summaries {
+		teamRatingSum += v.RatingSum
+		teamRatingCount += v.RatingCount
+		if v.User.Email == userEmail {
+			continue
+		}
+		otherUsers += 1
+		if v.RatingCount < result.TotalRatings {
+			otherUsersWithFewerRatings += 1
+		}
+	}
+	if teamRatingCount > 0 {
+		teamAvg := float32(float64(teamRatingSum) / float64(teamRatingCount))
+		result.TeamAverageRating = &teamAvg
+	}
+	result.Percentile = 100
+	if otherUsers > 0 {
+		result.Percentile = 100 * float64(otherUsersWithFewerRatings) / float64(otherUsers)
+	}
+
+	return result
+}
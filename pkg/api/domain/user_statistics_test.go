@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewUserStatistics(t *testing.T) {
+
+	jan := time.Date(2023, time.January, 10, 12, 0, 0, 0, time.Local)
+	feb := time.Date(2023, time.February, 3, 12, 0, 0, 0, time.Local)
+	janStart := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.Local)
+	febStart := time.Date(2023, time.February, 1, 0, 0, 0, 0, time.Local)
+
+	float32Ptr := func(v float32) *float32 { return &v }
+
+	type args struct {
+		userEmail string
+		ratings   []RatingOfDish
+		summaries []UserRatingSummary
+	}
+	tests := []struct {
+		name string
+		args args
+		want UserStatistics
+	}{
+		{
+			name: "No ratings at all",
+			args: args{
+				userEmail: "user1",
+				ratings:   nil,
+				summaries: nil,
+			},
+			want: UserStatistics{
+				TotalRatings:    0,
+				RatingsPerMonth: []MonthlyRatingCount{},
+				Percentile:      100,
+			},
+		},
+		{
+			name: "User without ratings among other users",
+			args: args{
+				userEmail: "user1",
+				ratings:   nil,
+				summaries: []UserRatingSummary{
+					{User: User{Email: "user2"}, RatingCount: 2, RatingSum: 6},
+				},
+			},
+			want: UserStatistics{
+				TotalRatings:      0,
+				RatingsPerMonth:   []MonthlyRatingCount{},
+				TeamAverageRating: float32Ptr(3),
+				Percentile:        0,
+			},
+		},
+		{
+			name: "Multiple dishes, months and users",
+			args: args{
+				userEmail: "user1",
+				ratings: []RatingOfDish{
+					{DishID: 1, Rating: NewDishRating("user1", FiveStars, jan)},
+					{DishID: 1, Rating: NewDishRating("user1", FourStars, feb)},
+					{DishID: 2, Rating: NewDishRating("user1", OneStar, feb)},
+					{DishID: 3, Rating: NewDishRating("user1", ThreeStars, jan)},
+				},
+				summaries: []UserRatingSummary{
+					{User: User{Email: "user1"}, RatingCount: 4, RatingSum: 13},
+					{User: User{Email: "user2"}, RatingCount: 2, RatingSum: 4},
+					{User: User{Email: "user3"}, RatingCount: 5, RatingSum: 23},
+				},
+			},
+			want: UserStatistics{
+				TotalRatings: 4,
+				RatingsPerMonth: []MonthlyRatingCount{
+					{Month: janStart, Count: 2},
+					{Month: febStart, Count: 2},
+				},
+				AverageGivenRating: float32Ptr(3.25),
+				TeamAverageRating:  float32Ptr(40.0 / 11.0),
+				FavouriteDish:      &DishPreference{DishID: 1, AverageRating: 4.5, RatingCount: 2},
+				MostHatedDish:      &DishPreference{DishID: 2, AverageRating: 1, RatingCount: 1},
+				Percentile:         50,
+			},
+		},
+		{
+			name: "Ties prefer dish with more ratings",
+			args: args{
+				userEmail: "user1",
+				ratings: []RatingOfDish{
+					{DishID: 1, Rating: NewDishRating("user1", ThreeStars, jan)},
+					{DishID: 2, Rating: NewDishRating("user1", ThreeStars, jan)},
+					{DishID: 2, Rating: NewDishRating("user1", ThreeStars, feb)},
+				},
+				summaries: []UserRatingSummary{
+					{User: User{Email: "user1"}, RatingCount: 3, RatingSum: 9},
+				},
+			},
+			want: UserStatistics{
+				TotalRatings: 3,
+				RatingsPerMonth: []MonthlyRatingCount{
+					{Month: janStart, Count: 2},
+					{Month: febStart, Count: 1},
+				},
+				AverageGivenRating: float32Ptr(3),
+				TeamAverageRating:  float32Ptr(3),
+				FavouriteDish:      &DishPreference{DishID: 2, AverageRating: 3, RatingCount: 2},
+				MostHatedDish:      &DishPreference{DishID: 2, AverageRating: 3, RatingCount: 2},
+				Percentile:         100,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewUserStatistics(tt.args.userEmail, tt.args.ratings, tt.args.summaries); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewUserStatistics() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+var ErrInvalidWebhookURL = errors.New("invalid webhook url")
+var ErrUnknownEventType = errors.New("unknown event type")
+
+// WebhookEndpoint is an external http endpoint that gets notified about events
+type WebhookEndpoint struct {
+	URL string
+	//Secret is used to sign the payload of each delivery
+	Secret string
+	//EventTypes the endpoint is subscribed to. If empty, the endpoint is subscribed to all events
+	EventTypes []EventType
+	CreatedAt  time.Time
+}
+
+// NewWebhookEndpoint validates the given data and creates a new endpoint.
+// Marker errors: ErrInvalidWebhookURL, ErrUnknownEventType
+func NewWebhookEndpoint(rawURL, secret string, eventTypes []EventType, now time.Time) (WebhookEndpoint, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return WebhookEndpoint{}, fmt.Errorf("%w : %v", ErrInvalidWebhookURL, err)
+	}
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return WebhookEndpoint{}, fmt.Errorf("%w : must be absolute http(s) url", ErrInvalidWebhookURL)
+	}
+	for _, v := range eventTypes {
+		if !v.IsValid() {
+			return WebhookEndpoint{}, fmt.Errorf("%w : %v", ErrUnknownEventType, v)
+		}
+	}
+	if eventTypes == nil {
+		eventTypes = make([]EventType, 0)
+	}
+
+	return WebhookEndpoint{
+		URL:        rawURL,
+		Secret:     secret,
+		EventTypes: eventTypes,
+		CreatedAt:  now,
+	}, nil
+}
+
+// IsSubscribedTo returns true if the endpoint wants to receive events of type t
+func (w *WebhookEndpoint) IsSubscribedTo(t EventType) bool {
+	if len(w.EventTypes) == 0 {
+		return true
+	}
+	for _, v := range w.EventTypes {
+		if v == t {
+			return true
+		}
+	}
+	return false
+}
+
+type WebhookDeliveryState string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryState = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryState = "delivered"
+	//WebhookDeliveryFailed means that we gave up after WebhookMaxAttempts
+	WebhookDeliveryFailed WebhookDeliveryState = "failed"
+)
+
+// WebhookMaxAttempts is the maximal amount of delivery attempts before we give up
+const WebhookMaxAttempts = 8
+
+const webhookInitialBackoff = 30 * time.Second
+const webhookMaxBackoff = 6 * time.Hour
+
+// WebhookDelivery is an entry in the webhook outbox
+type WebhookDelivery struct {
+	EndpointID int64
+	EventType  EventType
+	//Payload is the serialized event that is sent to the endpoint
+	Payload       []byte
+	State         WebhookDeliveryState
+	Attempts      int
+	NextAttemptAt time.Time
+	//LastError describes why the most recent attempt failed. Empty if there was no failed attempt yet
+	LastError   string
+	CreatedAt   time.Time
+	DeliveredAt *time.Time
+}
+
+func NewWebhookDelivery(endpointID int64, eventType EventType, payload []byte, now time.Time) WebhookDelivery {
+	return WebhookDelivery{
+		EndpointID:    endpointID,
+		EventType:     eventType,
+		Payload:       payload,
+		State:         WebhookDeliveryPending,
+		Attempts:      0,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+}
+
+// WebhookRetryBackoff returns the time to wait before the next attempt after attempts failed attempts
+func WebhookRetryBackoff(attempts int) time.Duration {
+	backoff := webhookInitialBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= webhookMaxBackoff {
+			return webhookMaxBackoff
+		}
+	}
+	return backoff
+}
+
+// RecordSuccess marks the delivery as delivered
+func (d *WebhookDelivery) RecordSuccess(now time.Time) {
+	d.Attempts += 1
+	d.State = WebhookDeliveryDelivered
+	d.DeliveredAt = &now
+}
+
+// RecordFailure schedules the next attempt using exponential backoff or marks the delivery as failed
+// once WebhookMaxAttempts is reached
+func (d *WebhookDelivery) RecordFailure(now time.Time, reason error) {
+	d.Attempts += 1
+	d.LastError = reason.Error()
+	if d.Attempts >= WebhookMaxAttempts {
+		d.State = WebhookDeliveryFailed
+		return
+	}
+	d.NextAttemptAt = now.Add(WebhookRetryBackoff(d.Attempts))
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type WebhookDeliveryUpdateFN = func(current WebhookDelivery) (*WebhookDelivery, error)
+
+type WebhookRepo interface {
+	//CreateWebhookEndpoint stores the endpoint and returns its id
+	CreateWebhookEndpoint(ctx context.Context, endpoint WebhookEndpoint) (int64, error)
+	//GetAllWebhookEndpoints returns all endpoints indexed by their id. The map may be empty
+	GetAllWebhookEndpoints(ctx context.Context) (map[int64]WebhookEndpoint, error)
+	//DeleteWebhookEndpoint deletes the endpoint as well as all of its deliveries
+	//Marker errors: ErrNotFound
+	DeleteWebhookEndpoint(ctx context.Context, id int64) error
+
+	//CreateWebhookDeliveries adds the deliveries to the outbox
+	CreateWebhookDeliveries(ctx context.Context, deliveries []WebhookDelivery) error
+	//GetDueWebhookDeliveries returns up to limit pending deliveries whose NextAttemptAt is not after now,
+	//indexed by their id
+	GetDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) (map[int64]WebhookDelivery, error)
+	//UpdateWebhookDelivery calls updateFN with the current value of the delivery. If updateFN returns (nil,nil)
+	//nothing is updated. Otherwise, the returned value replaces the current one
+	//Marker errors: ErrNotFound
+	UpdateWebhookDelivery(ctx context.Context, id int64, updateFN WebhookDeliveryUpdateFN) error
+}
@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWebhookEndpoint(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		url        string
+		eventTypes []EventType
+		wantErr    error
+	}{
+		{
+			name:       "Valid https url, all events",
+			url:        "https://example.com/hook",
+			eventTypes: nil,
+			wantErr:    nil,
+		},
+		{
+			name:       "Valid http url, some events",
+			url:        "http://localhost:8080/hook",
+			eventTypes: []EventType{EventDishCreated, EventStreakMilestone},
+			wantErr:    nil,
+		},
+		{
+			name:       "Relative url",
+			url:        "/hook",
+			eventTypes: nil,
+			wantErr:    ErrInvalidWebhookURL,
+		},
+		{
+			name:       "Unsupported scheme",
+			url:        "ftp://example.com/hook",
+			eventTypes: nil,
+			wantErr:    ErrInvalidWebhookURL,
+		},
+		{
+			name:       "Unknown event type",
+			url:        "https://example.com/hook",
+			eventTypes: []EventType{EventDishCreated, "dish.eaten"},
+			wantErr:    ErrUnknownEventType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewWebhookEndpoint(tt.url, "secret", tt.eventTypes, now)
+			if tt.wantErr != nil {
+				require.True(t, errors.Is(err, tt.wantErr), "want error %v, got %v", tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.url, got.URL)
+			require.NotNil(t, got.EventTypes)
+		})
+	}
+}
+
+func TestWebhookEndpoint_IsSubscribedTo(t *testing.T) {
+	all := WebhookEndpoint{EventTypes: []EventType{}}
+	for _, v := range AllEventTypes {
+		require.True(t, all.IsSubscribedTo(v))
+	}
+
+	some := WebhookEndpoint{EventTypes: []EventType{EventRatingCreated}}
+	require.True(t, some.IsSubscribedTo(EventRatingCreated))
+	require.False(t, some.IsSubscribedTo(EventDishCreated))
+}
+
+func TestWebhookDelivery_RecordFailure(t *testing.T) {
+	now := time.Now()
+	delivery := NewWebhookDelivery(1, EventDishCreated, []byte("{}"), now)
+
+	//backoff doubles with each failed attempt
+	delivery.RecordFailure(now, errors.New("failed"))
+	require.Equal(t, WebhookDeliveryPending, delivery.State)
+	require.Equal(t, 1, delivery.Attempts)
+	require.Equal(t, now.Add(30*time.Second), delivery.NextAttemptAt)
+	require.Equal(t, "failed", delivery.LastError)
+
+	delivery.RecordFailure(now, errors.New("failed again"))
+	require.Equal(t, WebhookDeliveryPending, delivery.State)
+	require.Equal(t, now.Add(60*time.Second), delivery.NextAttemptAt)
+
+	//give up after max attempts
+	for delivery.Attempts < WebhookMaxAttempts {
+		delivery.RecordFailure(now, errors.New("failed"))
+	}
+	require.Equal(t, WebhookDeliveryFailed, delivery.State)
+	require.Nil(t, delivery.DeliveredAt)
+}
+
+func TestWebhookRetryBackoff(t *testing.T) {
+	require.Equal(t, 30*time.Second, WebhookRetryBackoff(1))
+	require.Equal(t, 4*time.Minute, WebhookRetryBackoff(4))
+	require.Equal(t, 6*time.Hour, WebhookRetryBackoff(100))
+}
+
+func TestIsStreakMilestone(t *testing.T) {
+	milestones := map[int]bool{1: false, 4: false, 5: true, 10: true, 11: false, 25: true, 50: true, 75: false, 100: true}
+	for length, want := range milestones {
+		require.Equal(t, want, IsStreakMilestone(length), "length %v", length)
+	}
+}
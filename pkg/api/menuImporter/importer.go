@@ -0,0 +1,162 @@
+package menuImporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"itsTasty/pkg/api/domain"
+	"itsTasty/pkg/api/nameNormalizer"
+	"math"
+	"time"
+)
+
+var ErrUnknownFormat = errors.New("unknown format")
+
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatICal Format = "ical"
+)
+
+func ParseFormat(s string) (Format, error) {
+	switch Format(s) {
+	case FormatCSV, FormatICal:
+		return Format(s), nil
+	default:
+		return "", fmt.Errorf("%w : %v", ErrUnknownFormat, s)
+	}
+}
+
+// Parse parses r with the parser for format.
+// Marker errors: ErrUnknownFormat, ErrInvalidFile
+func Parse(r io.Reader, format Format, defaultLocation string) ([]Entry, []RowError, error) {
+	switch format {
+	case FormatCSV:
+		return ParseCSV(r, defaultLocation)
+	case FormatICal:
+		return ParseICal(r, defaultLocation)
+	default:
+		return nil, nil, fmt.Errorf("%w : %v", ErrUnknownFormat, format)
+	}
+}
+
+type RowStatus string
+
+const (
+	//RowCreated means that a new dish was created
+	RowCreated RowStatus = "created"
+	//RowUpdated means that a new occurrence was added to an existing dish
+	RowUpdated RowStatus = "updated"
+	//RowSkipped means that the row could not be parsed or that the occurrence was already known
+	RowSkipped RowStatus = "skipped"
+)
+
+type RowResult struct {
+	Row    int
+	Status RowStatus
+	//DishID is only set if Status is not RowSkipped
+	DishID int64
+	//Reason is only set if Status is RowSkipped
+	Reason string
+}
+
+type Report struct {
+	Created int
+	Updated int
+	Skipped int
+	//Rows contains the results in order of the rows in the source file
+	Rows []RowResult
+}
+
+func (r *Report) add(result RowResult) {
+	switch result.Status {
+	case RowCreated:
+		r.Created += 1
+	case RowUpdated:
+		r.Updated += 1
+	case RowSkipped:
+		r.Skipped += 1
+	}
+	r.Rows = append(r.Rows, result)
+}
+
+type Importer struct {
+	repo       domain.DishRepo
+	locations  domain.LocationRepo
+	normalizer *nameNormalizer.Normalizer
+}
+
+func NewImporter(repo domain.DishRepo, locations domain.LocationRepo, normalizer *nameNormalizer.Normalizer) *Importer {
+	return &Importer{repo: repo, locations: locations, normalizer: normalizer}
+}
+
+// Import stores all entries as dish occurrences. Location aliases are resolved to the location they belong to.
+// Dish names are normalized with the rules of their location and extracted tags are added to the dish.
+// rowErrors are added to the report as skipped rows. Importing the same entries twice does not change anything.
+// Only unexpected repo errors abort the import
+func (i *Importer) Import(ctx context.Context, entries []Entry, rowErrors []RowError) (*Report, error) {
+	report := &Report{Rows: make([]RowResult, 0, len(entries)+len(rowErrors))}
+
+	//keep rows in source order by merging the two sorted slices
+	nextError := 0
+	addErrorsBefore := func(row int) {
+		for nextError < len(rowErrors) && rowErrors[nextError].Row < row {
+			report.add(RowResult{Row: rowErrors[nextError].Row, Status: RowSkipped, Reason: rowErrors[nextError].Reason})
+			nextError += 1
+		}
+	}
+
+	for _, entry := range entries {
+		addErrorsBefore(entry.Row)
+		result, err := i.importEntry(ctx, entry)
+		if err != nil {
+			return report, fmt.Errorf("failed to import row %v : %w", entry.Row, err)
+		}
+		report.add(result)
+	}
+	addErrorsBefore(math.MaxInt)
+
+	return report, nil
+}
+
+func (i *Importer) importEntry(ctx context.Context, entry Entry) (RowResult, error) {
+	location, err := i.locations.ResolveLocationName(ctx, entry.Location)
+	if err != nil {
+		return RowResult{}, fmt.Errorf("ResolveLocationName failed : %v", err)
+	}
+	entry.Location = location
+
+	normalized := i.normalizer.Normalize(entry.Location, entry.DishName)
+	name := normalized.Name
+	if name == "" {
+		return RowResult{Row: entry.Row, Status: RowSkipped, Reason: "empty dish name"}, nil
+	}
+	date := domain.TruncateToDayPrecision(entry.Date.Local())
+
+	status := RowCreated
+	dish, _, err := i.repo.GetDishByName(ctx, name, entry.Location)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return RowResult{}, fmt.Errorf("GetDishByName failed : %v", err)
+	}
+	if err == nil {
+		status = RowUpdated
+		for _, v := range dish.Occurrences() {
+			if domain.OnSameDay(v, date) {
+				return RowResult{Row: entry.Row, Status: RowSkipped, Reason: "occurrence already known"}, nil
+			}
+		}
+	}
+
+	dishID, err := i.repo.GetOrCreateDishWithOccurrences(ctx, name, entry.Location, []time.Time{date})
+	if err != nil {
+		return RowResult{}, fmt.Errorf("GetOrCreateDishWithOccurrences failed : %v", err)
+	}
+	if len(normalized.Tags) > 0 {
+		if err := i.repo.AddDishTags(ctx, dishID, normalized.Tags); err != nil {
+			return RowResult{}, fmt.Errorf("AddDishTags failed : %v", err)
+		}
+	}
+	return RowResult{Row: entry.Row, Status: status, DishID: dishID}, nil
+}
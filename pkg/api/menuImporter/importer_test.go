@@ -0,0 +1,128 @@
+package menuImporter
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"itsTasty/pkg/api/adapters/dishRepo"
+	"itsTasty/pkg/api/domain"
+	"itsTasty/pkg/api/nameNormalizer"
+	"strings"
+	"testing"
+	"time"
+)
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.Local)
+}
+
+func TestParseCSV(t *testing.T) {
+	input := "\ufeffDate;Dish;Location\n" +
+		"2023-03-01;Pasta;Mensa\n" +
+		"02.03.2023;\"Curry; mild\";\n" +
+		"not a date;Pizza;Mensa\n"
+
+	entries, rowErrors, err := ParseCSV(strings.NewReader(input), "Cafeteria")
+	require.NoError(t, err)
+	require.Equal(t, []Entry{
+		{Row: 2, Date: day(2023, time.March, 1), Location: "Mensa", DishName: "Pasta"},
+		{Row: 3, Date: day(2023, time.March, 2), Location: "Cafeteria", DishName: "Curry; mild"},
+	}, entries)
+	require.Len(t, rowErrors, 1)
+	require.Equal(t, 4, rowErrors[0].Row)
+}
+
+func TestParseCSV_InvalidHeader(t *testing.T) {
+	_, _, err := ParseCSV(strings.NewReader("date,dish\n2023-03-01,Pasta\n"), "")
+	require.ErrorIs(t, err, ErrInvalidFile)
+
+	_, _, err = ParseCSV(strings.NewReader("date,location\n2023-03-01,Mensa\n"), "Mensa")
+	require.ErrorIs(t, err, ErrInvalidFile)
+}
+
+func TestParseICal(t *testing.T) {
+	input := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20230301",
+		"SUMMARY:Pasta with tomato\\, basil and a very long name that gets",
+		"  folded",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART:20230302T110000Z",
+		"SUMMARY:Curry",
+		"LOCATION:Cafeteria",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:No date",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	entries, rowErrors, err := ParseICal(strings.NewReader(input), "Mensa")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, Entry{Row: 1, Date: day(2023, time.March, 1), Location: "Mensa",
+		DishName: "Pasta with tomato, basil and a very long name that gets folded"}, entries[0])
+	require.Equal(t, "Cafeteria", entries[1].Location)
+	require.True(t, entries[1].Date.Equal(time.Date(2023, time.March, 2, 11, 0, 0, 0, time.UTC)))
+	require.Equal(t, []RowError{{Row: 3, Reason: "missing DTSTART"}}, rowErrors)
+
+	_, _, err = ParseICal(strings.NewReader("date,dish\n"), "Mensa")
+	require.ErrorIs(t, err, ErrInvalidFile)
+}
+
+func TestImporter_Import(t *testing.T) {
+	ctx := context.Background()
+	repo := dishRepo.NewMemoryRepo()
+	existingID, err := repo.GetOrCreateDishWithOccurrences(ctx, "Curry", "Mensa", []time.Time{day(2023, time.March, 1)})
+	require.NoError(t, err)
+	locations, err := repo.GetAllLocations(ctx)
+	require.NoError(t, err)
+	for id := range locations {
+		require.NoError(t, repo.UpdateLocation(ctx, id, func(current domain.Location) (*domain.Location, error) {
+			current.Aliases = []string{"MENSA"}
+			return &current, nil
+		}))
+	}
+
+	entries := []Entry{
+		{Row: 2, Date: day(2023, time.March, 1), Location: "Mensa", DishName: "VEGANISSIMO: Tofu"},
+		{Row: 3, Date: day(2023, time.March, 1).Add(12 * time.Hour), Location: "Mensa", DishName: "Curry"},
+		{Row: 5, Date: day(2023, time.March, 2), Location: "MENSA", DishName: "Curry"},
+		{Row: 6, Date: day(2023, time.March, 2), Location: "Mensa", DishName: "   "},
+	}
+	rowErrors := []RowError{{Row: 4, Reason: "invalid date"}}
+
+	importer := NewImporter(repo, repo, nameNormalizer.NewDefaultNormalizer())
+	report, err := importer.Import(ctx, entries, rowErrors)
+	require.NoError(t, err)
+	require.Equal(t, 1, report.Created)
+	require.Equal(t, 1, report.Updated)
+	require.Equal(t, 3, report.Skipped)
+	require.Len(t, report.Rows, 5)
+	for i, want := range []RowStatus{RowCreated, RowSkipped, RowSkipped, RowUpdated, RowSkipped} {
+		require.Equal(t, i+2, report.Rows[i].Row)
+		require.Equal(t, want, report.Rows[i].Status, "row %v", i+2)
+	}
+	require.Equal(t, existingID, report.Rows[3].DishID)
+
+	tofu, tofuID, err := repo.GetDishByName(ctx, "Tofu", "Mensa")
+	require.NoError(t, err)
+	require.Equal(t, []time.Time{day(2023, time.March, 1)}, tofu.Occurrences())
+	tags, err := repo.GetDishTags(ctx, tofuID)
+	require.NoError(t, err)
+	require.Equal(t, []string{"vegan"}, tags)
+	curry, err := repo.GetDishByID(ctx, existingID)
+	require.NoError(t, err)
+	require.Len(t, curry.Occurrences(), 2)
+
+	//second import does not change anything
+	report, err = importer.Import(ctx, entries, nil)
+	require.NoError(t, err)
+	require.Equal(t, 0, report.Created+report.Updated)
+	require.Equal(t, 4, report.Skipped)
+	allDishes, err := repo.GetAllDishesSimple(ctx)
+	require.NoError(t, err)
+	require.Len(t, allDishes, 2)
+}
@@ -0,0 +1,282 @@
+package menuImporter
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+var ErrInvalidFile = errors.New("invalid file")
+
+// Entry states that a dish was served at a location on a given day
+type Entry struct {
+	//Row is the line (CSV) or the event (iCalendar) in the source file. Used for reporting
+	Row      int
+	Date     time.Time
+	Location string
+	DishName string
+}
+
+// RowError describes a row of the source file that could not be parsed
+type RowError struct {
+	Row    int
+	Reason string
+}
+
+//
+// CSV
+//
+
+const (
+	csvColumnDate     = "date"
+	csvColumnLocation = "location"
+	csvColumnDish     = "dish"
+)
+
+// csvDateLayouts are the accepted date formats in the date column
+var csvDateLayouts = []string{"2006-01-02", "02.01.2006"}
+
+// ParseCSV parses a CSV file with a header row containing the columns "date", "location" and "dish" in any order.
+// Both "," and ";" are accepted as separator. The location column may be omitted if defaultLocation is set.
+// Dates are either formatted as YYYY-MM-DD or as DD.MM.YYYY and are interpreted in local time.
+// Rows that cannot be parsed are returned as RowError, the remaining rows are still parsed.
+// Marker errors: ErrInvalidFile if the header is missing or incomplete
+func ParseCSV(r io.Reader, defaultLocation string) ([]Entry, []RowError, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read file : %v", err)
+	}
+
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	firstLine, _, _ := strings.Cut(string(content), "\n")
+	if strings.Count(firstLine, ";") > strings.Count(firstLine, ",") {
+		reader.Comma = ';'
+	}
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w : failed to read header : %v", ErrInvalidFile, err)
+	}
+	columns := make(map[string]int)
+	for i, v := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(v, "\ufeff")))] = i
+	}
+	for _, v := range []string{csvColumnDate, csvColumnDish} {
+		if _, ok := columns[v]; !ok {
+			return nil, nil, fmt.Errorf("%w : header lacks column %v", ErrInvalidFile, v)
+		}
+	}
+	if _, ok := columns[csvColumnLocation]; !ok && defaultLocation == "" {
+		return nil, nil, fmt.Errorf("%w : header lacks column %v and no default location is set", ErrInvalidFile,
+			csvColumnLocation)
+	}
+
+	entries := make([]Entry, 0)
+	rowErrors := make([]RowError, 0)
+	//row numbers are 1-based and include the header
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			//the reader cannot reliably recover from syntax errors like unbalanced quotes
+			rowErrors = append(rowErrors, RowError{Row: row, Reason: err.Error()})
+			break
+		}
+
+		field := func(column string) string {
+			i, ok := columns[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		entry := Entry{Row: row, Location: field(csvColumnLocation), DishName: field(csvColumnDish)}
+		if entry.Location == "" {
+			entry.Location = defaultLocation
+		}
+		if entry.Location == "" {
+			rowErrors = append(rowErrors, RowError{Row: row, Reason: "missing location"})
+			continue
+		}
+		entry.Date, err = parseCSVDate(field(csvColumnDate))
+		if err != nil {
+			rowErrors = append(rowErrors, RowError{Row: row, Reason: err.Error()})
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rowErrors, nil
+}
+
+func parseCSVDate(s string) (time.Time, error) {
+	for _, layout := range csvDateLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date \"%v\", expected YYYY-MM-DD or DD.MM.YYYY", s)
+}
+
+//
+// iCalendar
+//
+
+// icalProperty is a single content line of an iCalendar file (RFC 5545 section 3.1)
+type icalProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// unfoldICalLines splits the content into logical lines, joining lines that were folded by inserting
+// a line break followed by a space or tab
+func unfoldICalLines(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	lines := make([]string, 0)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line == "" {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+func parseICalProperty(line string) (icalProperty, error) {
+	nameAndParams, value, ok := strings.Cut(line, ":")
+	if !ok {
+		return icalProperty{}, fmt.Errorf("invalid content line \"%v\"", line)
+	}
+	parts := strings.Split(nameAndParams, ";")
+	p := icalProperty{
+		name:   strings.ToUpper(parts[0]),
+		params: make(map[string]string),
+		value:  value,
+	}
+	for _, v := range parts[1:] {
+		k, paramValue, _ := strings.Cut(v, "=")
+		p.params[strings.ToUpper(k)] = strings.Trim(paramValue, `"`)
+	}
+	return p, nil
+}
+
+// unescapeICalText reverts the escaping of TEXT values (RFC 5545 section 3.3.11)
+func unescapeICalText(s string) string {
+	return strings.NewReplacer(`\\`, `\`, `\;`, `;`, `\,`, `,`, `\n`, " ", `\N`, " ").Replace(s)
+}
+
+// parseICalDate parses the value of a DTSTART property. Date-times are converted to local time
+func parseICalDate(p icalProperty) (time.Time, error) {
+	if p.params["VALUE"] == "DATE" || len(p.value) == len("20060102") {
+		return time.ParseInLocation("20060102", p.value, time.Local)
+	}
+	if strings.HasSuffix(p.value, "Z") {
+		t, err := time.Parse("20060102T150405Z", p.value)
+		return t.Local(), err
+	}
+	location := time.Local
+	if tzid, ok := p.params["TZID"]; ok {
+		var err error
+		if location, err = time.LoadLocation(tzid); err != nil {
+			return time.Time{}, fmt.Errorf("unknown time zone %v", tzid)
+		}
+	}
+	t, err := time.ParseInLocation("20060102T150405", p.value, location)
+	return t.Local(), err
+}
+
+// ParseICal parses the VEVENT entries of an iCalendar feed. The SUMMARY of an event is used as dish name
+// and DTSTART as serving date. The LOCATION of an event takes precedence over defaultLocation.
+// Events that cannot be parsed are returned as RowError, where Row is the 1-based index of the event.
+// Marker errors: ErrInvalidFile if the content is not an iCalendar file
+func ParseICal(r io.Reader, defaultLocation string) ([]Entry, []RowError, error) {
+	lines, err := unfoldICalLines(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read file : %v", err)
+	}
+	if len(lines) == 0 || !strings.EqualFold(lines[0], "BEGIN:VCALENDAR") {
+		return nil, nil, fmt.Errorf("%w : missing BEGIN:VCALENDAR", ErrInvalidFile)
+	}
+
+	entries := make([]Entry, 0)
+	rowErrors := make([]RowError, 0)
+	eventCount := 0
+	var event map[string]icalProperty
+	for _, line := range lines {
+		p, err := parseICalProperty(line)
+		if err != nil {
+			if event != nil {
+				rowErrors = append(rowErrors, RowError{Row: eventCount, Reason: err.Error()})
+			}
+			continue
+		}
+
+		switch {
+		case p.name == "BEGIN" && strings.EqualFold(p.value, "VEVENT"):
+			eventCount += 1
+			event = make(map[string]icalProperty)
+		case p.name == "END" && strings.EqualFold(p.value, "VEVENT") && event != nil:
+			entry, err := icalEventToEntry(event, defaultLocation)
+			if err != nil {
+				rowErrors = append(rowErrors, RowError{Row: eventCount, Reason: err.Error()})
+			} else {
+				entry.Row = eventCount
+				entries = append(entries, entry)
+			}
+			event = nil
+		case event != nil:
+			//nested components like VALARM may repeat properties. Only the first value belongs to the event
+			if _, ok := event[p.name]; !ok {
+				event[p.name] = p
+			}
+		}
+	}
+
+	return entries, rowErrors, nil
+}
+
+func icalEventToEntry(event map[string]icalProperty, defaultLocation string) (Entry, error) {
+	dtStart, ok := event["DTSTART"]
+	if !ok {
+		return Entry{}, fmt.Errorf("missing DTSTART")
+	}
+	date, err := parseICalDate(dtStart)
+	if err != nil {
+		return Entry{}, fmt.Errorf("invalid DTSTART : %v", err)
+	}
+
+	entry := Entry{
+		Date:     date,
+		Location: defaultLocation,
+		DishName: unescapeICalText(event["SUMMARY"].value),
+	}
+	if location := unescapeICalText(event["LOCATION"].value); location != "" {
+		entry.Location = location
+	}
+	if entry.DishName == "" {
+		return Entry{}, fmt.Errorf("missing SUMMARY")
+	}
+	if entry.Location == "" {
+		return Entry{}, fmt.Errorf("missing location")
+	}
+	return entry, nil
+}
@@ -0,0 +1,251 @@
+package nameNormalizer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var ErrInvalidConfig = errors.New("invalid config")
+
+// Casing selects how the letter case of a dish name is normalized
+type Casing string
+
+const (
+	//CasingKeep does not change the case
+	CasingKeep  Casing = ""
+	CasingLower Casing = "lower"
+	CasingUpper Casing = "upper"
+	//CasingTitle upper cases the first letter of each word and lower cases all other letters
+	CasingTitle Casing = "title"
+)
+
+// Replacement replaces all matches of the regular expression Pattern with With.
+// With may reference capture groups, see regexp.Regexp.ReplaceAllString
+type Replacement struct {
+	Pattern string `json:"pattern"`
+	With    string `json:"with"`
+}
+
+// TagRule adds Tag to the dish if Pattern matches the name. The matched text is removed from the name
+type TagRule struct {
+	Tag     string `json:"tag"`
+	Pattern string `json:"pattern"`
+}
+
+// Rules describe the normalization of the dish names of a location. The steps are applied in the following order:
+// prefix stripping, tag extraction, suffix stripping, replacements, whitespace normalization and casing.
+// Surrounding whitespace is always removed
+type Rules struct {
+	StripPrefixes []string  `json:"stripPrefixes"`
+	Tags          []TagRule `json:"tags"`
+	StripSuffixes []string  `json:"stripSuffixes"`
+	//Replacements are applied in order
+	Replacements []Replacement `json:"replacements"`
+	//CollapseWhitespace replaces each sequence of whitespace inside the name by a single space
+	CollapseWhitespace bool   `json:"collapseWhitespace"`
+	Casing             Casing `json:"casing"`
+}
+
+// Config holds the rules for all locations
+type Config struct {
+	//Default is used for all locations without an entry in Locations
+	Default Rules `json:"default"`
+	//Locations maps location names to their rules. The rules of a location replace the default rules
+	Locations map[string]Rules `json:"locations"`
+}
+
+// DefaultConfig contains the rules that are used if no config file is provided. They remove the marketing
+// prefixes that have been observed in the menus so far
+func DefaultConfig() Config {
+	return Config{
+		Default: Rules{
+			Tags: []TagRule{
+				{Tag: "vegan", Pattern: `^VEGANISSIMO:\s*`},
+			},
+			StripPrefixes: []string{
+				`"""YOUR FAVORITES""`,
+				`"YOUR FAVORITES"`,
+				"Begrenztes Angebot :",
+				"BEGRENZTES ANGEBOT:",
+			},
+		},
+	}
+}
+
+// LoadConfig reads a JSON encoded Config from path. Unknown fields are rejected to catch typos
+// Marker errors: ErrInvalidConfig
+func LoadConfig(path string) (Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to open config : %v", err)
+	}
+	defer f.Close()
+
+	decoder := json.NewDecoder(f)
+	decoder.DisallowUnknownFields()
+	var cfg Config
+	if err := decoder.Decode(&cfg); err != nil {
+		return Config{}, fmt.Errorf("%w : %v", ErrInvalidConfig, err)
+	}
+	return cfg, nil
+}
+
+type compiledTagRule struct {
+	tag     string
+	pattern *regexp.Regexp
+}
+
+type compiledReplacement struct {
+	pattern *regexp.Regexp
+	with    string
+}
+
+type compiledRules struct {
+	tags               []compiledTagRule
+	stripPrefixes      []string
+	stripSuffixes      []string
+	replacements       []compiledReplacement
+	collapseWhitespace bool
+	casing             Casing
+}
+
+func compileRules(r Rules) (compiledRules, error) {
+	result := compiledRules{
+		stripPrefixes:      r.StripPrefixes,
+		stripSuffixes:      r.StripSuffixes,
+		collapseWhitespace: r.CollapseWhitespace,
+		casing:             r.Casing,
+	}
+	switch r.Casing {
+	case CasingKeep, CasingLower, CasingUpper, CasingTitle:
+	default:
+		return compiledRules{}, fmt.Errorf("%w : unknown casing %v", ErrInvalidConfig, r.Casing)
+	}
+	for _, v := range r.Tags {
+		if v.Tag == "" {
+			return compiledRules{}, fmt.Errorf("%w : tag rule with pattern %v has empty tag", ErrInvalidConfig, v.Pattern)
+		}
+		pattern, err := regexp.Compile(v.Pattern)
+		if err != nil {
+			return compiledRules{}, fmt.Errorf("%w : pattern of tag %v : %v", ErrInvalidConfig, v.Tag, err)
+		}
+		result.tags = append(result.tags, compiledTagRule{tag: v.Tag, pattern: pattern})
+	}
+	for _, v := range r.Replacements {
+		pattern, err := regexp.Compile(v.Pattern)
+		if err != nil {
+			return compiledRules{}, fmt.Errorf("%w : replacement pattern %v : %v", ErrInvalidConfig, v.Pattern, err)
+		}
+		result.replacements = append(result.replacements, compiledReplacement{pattern: pattern, with: v.With})
+	}
+	return result, nil
+}
+
+// Result is the outcome of normalizing a dish name
+type Result struct {
+	Name string
+	//Tags are the extracted tags in ascending order. Never nil
+	Tags []string
+}
+
+// Normalizer applies the configured Rules to dish names. It is safe for concurrent use
+type Normalizer struct {
+	defaultRules compiledRules
+	locations    map[string]compiledRules
+}
+
+// NewNormalizer validates cfg and compiles all patterns
+// Marker errors: ErrInvalidConfig
+func NewNormalizer(cfg Config) (*Normalizer, error) {
+	defaultRules, err := compileRules(cfg.Default)
+	if err != nil {
+		return nil, fmt.Errorf("default rules : %w", err)
+	}
+	n := &Normalizer{
+		defaultRules: defaultRules,
+		locations:    make(map[string]compiledRules),
+	}
+	for location, rules := range cfg.Locations {
+		if n.locations[location], err = compileRules(rules); err != nil {
+			return nil, fmt.Errorf("rules for location %v : %w", location, err)
+		}
+	}
+	return n, nil
+}
+
+// NewDefaultNormalizer returns a Normalizer for DefaultConfig
+func NewDefaultNormalizer() *Normalizer {
+	n, err := NewNormalizer(DefaultConfig())
+	if err != nil {
+		panic(fmt.Sprintf("default config is invalid : %v", err))
+	}
+	return n
+}
+
+// Normalize applies the rules of location to name
+func (n *Normalizer) Normalize(location, name string) Result {
+	rules, ok := n.locations[location]
+	if !ok {
+		rules = n.defaultRules
+	}
+
+	name = strings.TrimSpace(name)
+	for _, v := range rules.stripPrefixes {
+		name = strings.TrimPrefix(name, v)
+	}
+	//tags come after the prefixes, so that anchored patterns like the one of DefaultConfig match behind a prefix
+	tags := make(map[string]interface{})
+	for _, v := range rules.tags {
+		if v.pattern.MatchString(name) {
+			tags[v.tag] = nil
+			name = v.pattern.ReplaceAllString(name, "")
+		}
+	}
+	name = strings.TrimSpace(name)
+	for _, v := range rules.stripSuffixes {
+		name = strings.TrimSuffix(name, v)
+	}
+	for _, v := range rules.replacements {
+		name = v.pattern.ReplaceAllString(name, v.with)
+	}
+	if rules.collapseWhitespace {
+		name = strings.Join(strings.Fields(name), " ")
+	}
+	name = strings.TrimSpace(name)
+
+	switch rules.casing {
+	case CasingLower:
+		name = strings.ToLower(name)
+	case CasingUpper:
+		name = strings.ToUpper(name)
+	case CasingTitle:
+		name = toTitleCase(name)
+	}
+
+	result := Result{Name: name, Tags: make([]string, 0, len(tags))}
+	for v := range tags {
+		result.Tags = append(result.Tags, v)
+	}
+	sort.Strings(result.Tags)
+	return result
+}
+
+// toTitleCase upper cases the first letter of each space separated word and lower cases all other letters
+func toTitleCase(s string) string {
+	words := strings.Split(s, " ")
+	for i, w := range words {
+		first, size := utf8.DecodeRuneInString(w)
+		if first == utf8.RuneError {
+			continue
+		}
+		words[i] = string(unicode.ToUpper(first)) + strings.ToLower(w[size:])
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,121 @@
+package nameNormalizer
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNormalizer_DefaultConfig(t *testing.T) {
+	n := NewDefaultNormalizer()
+	tests := []struct {
+		in       string
+		wantName string
+		wantTags []string
+	}{
+		{"  Pasta  ", "Pasta", []string{}},
+		{`"YOUR FAVORITES" Pasta`, "Pasta", []string{}},
+		{"BEGRENZTES ANGEBOT: Pasta", "Pasta", []string{}},
+		{"VEGANISSIMO: Tofu", "Tofu", []string{"vegan"}},
+		{"Pasta VEGANISSIMO: ", "Pasta VEGANISSIMO:", []string{}},
+	}
+	for _, tt := range tests {
+		got := n.Normalize("anyLocation", tt.in)
+		assert.Equal(t, tt.wantName, got.Name, tt.in)
+		assert.Equal(t, tt.wantTags, got.Tags, tt.in)
+	}
+}
+
+// TestNormalizer_DefaultConfig_Legacy checks that the default rules produce the same names as the prefix list that
+// the bot api used before the rules were configurable. Otherwise, known dishes would be created again
+func TestNormalizer_DefaultConfig_Legacy(t *testing.T) {
+	n := NewDefaultNormalizer()
+	tests := []struct {
+		in       string
+		wantName string
+		wantTags []string
+	}{
+		{`"""YOUR FAVORITES""Pasta`, "Pasta", []string{}},
+		{`"YOUR FAVORITES"Pasta`, "Pasta", []string{}},
+		{"Begrenztes Angebot : Pasta", "Pasta", []string{}},
+		{"BEGRENZTES ANGEBOT:Pasta", "Pasta", []string{}},
+		{"VEGANISSIMO: Tofu", "Tofu", []string{"vegan"}},
+		{`"YOUR FAVORITES"VEGANISSIMO: Tofu`, "Tofu", []string{"vegan"}},
+		{`"""YOUR FAVORITES""VEGANISSIMO: Tofu`, "Tofu", []string{"vegan"}},
+		{"BEGRENZTES ANGEBOT:VEGANISSIMO: Tofu", "Tofu", []string{"vegan"}},
+		{`"""YOUR FAVORITES""BEGRENZTES ANGEBOT:VEGANISSIMO: Tofu`, "Tofu", []string{"vegan"}},
+		//the prefixes are stripped once in list order, thus later prefixes are kept in front of earlier ones
+		{`BEGRENZTES ANGEBOT:"YOUR FAVORITES"Pasta`, `"YOUR FAVORITES"Pasta`, []string{}},
+		{`VEGANISSIMO: "YOUR FAVORITES"Tofu`, `"YOUR FAVORITES"Tofu`, []string{"vegan"}},
+		//prefixes separated by a space were not stripped
+		{`"YOUR FAVORITES" VEGANISSIMO: Tofu`, "VEGANISSIMO: Tofu", []string{}},
+	}
+	for _, tt := range tests {
+		got := n.Normalize("anyLocation", tt.in)
+		assert.Equal(t, tt.wantName, got.Name, tt.in)
+		assert.Equal(t, tt.wantTags, got.Tags, tt.in)
+	}
+}
+
+func TestNormalizer_Rules(t *testing.T) {
+	n, err := NewNormalizer(Config{
+		Default: Rules{StripPrefixes: []string{"DEFAULT:"}},
+		Locations: map[string]Rules{
+			"Mensa": {
+				Tags: []TagRule{
+					{Tag: "vegan", Pattern: `(?i)\(vegan\)`},
+					{Tag: "spicy", Pattern: `🌶+`},
+				},
+				StripPrefixes:      []string{"Tagesgericht:"},
+				StripSuffixes:      []string{"*"},
+				Replacements:       []Replacement{{Pattern: `\bm\.\s*`, With: "mit "}},
+				CollapseWhitespace: true,
+				Casing:             CasingTitle,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	got := n.Normalize("Mensa", "Tagesgericht:  NUDELN m. TOMATENSOSSE (Vegan) 🌶🌶 *")
+	require.Equal(t, "Nudeln Mit Tomatensosse", got.Name)
+	require.Equal(t, []string{"spicy", "vegan"}, got.Tags)
+
+	//location rules replace the default rules
+	require.Equal(t, "Default: Pasta", n.Normalize("Mensa", "DEFAULT: Pasta").Name)
+	require.Equal(t, "Pasta", n.Normalize("Cafeteria", "DEFAULT: Pasta").Name)
+}
+
+func TestNewNormalizer_InvalidConfig(t *testing.T) {
+	for name, cfg := range map[string]Config{
+		"invalid tag pattern":         {Default: Rules{Tags: []TagRule{{Tag: "vegan", Pattern: "("}}}},
+		"empty tag":                   {Default: Rules{Tags: []TagRule{{Pattern: "vegan"}}}},
+		"invalid replacement pattern": {Locations: map[string]Rules{"Mensa": {Replacements: []Replacement{{Pattern: "["}}}}},
+		"unknown casing":              {Default: Rules{Casing: "camel"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewNormalizer(cfg)
+			require.ErrorIs(t, err, ErrInvalidConfig)
+		})
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "valid.json")
+	require.NoError(t, os.WriteFile(valid, []byte(`{
+		"default": {"stripPrefixes": ["A:"]},
+		"locations": {"Mensa": {"casing": "lower", "tags": [{"tag": "vegan", "pattern": "VEGAN"}]}}
+	}`), 0600))
+	cfg, err := LoadConfig(valid)
+	require.NoError(t, err)
+	require.Equal(t, []string{"A:"}, cfg.Default.StripPrefixes)
+	require.Equal(t, CasingLower, cfg.Locations["Mensa"].Casing)
+	require.Equal(t, []TagRule{{Tag: "vegan", Pattern: "VEGAN"}}, cfg.Locations["Mensa"].Tags)
+
+	typo := filepath.Join(dir, "typo.json")
+	require.NoError(t, os.WriteFile(typo, []byte(`{"default": {"stripPrefix": ["A:"]}}`), 0600))
+	_, err = LoadConfig(typo)
+	require.ErrorIs(t, err, ErrInvalidConfig)
+}
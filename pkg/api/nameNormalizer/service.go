@@ -0,0 +1,248 @@
+package nameNormalizer
+
+import (
+	"context"
+	"fmt"
+	"itsTasty/pkg/api/domain"
+	"sort"
+)
+
+// Action describes what is required to bring an existing dish in line with the rules
+type Action string
+
+const (
+	//ActionRename means that the dish is renamed to its normalized name
+	ActionRename Action = "rename"
+	//ActionMerge means that another dish already has (or will get) the normalized name. Both dishes are combined
+	//in a merged dish
+	ActionMerge Action = "merge"
+	//ActionTag means that the name is already normalized but the dish lacks some of the extracted tags
+	ActionTag Action = "tag"
+)
+
+// Change describes the effect of the rules on an existing dish
+type Change struct {
+	DishID         int64
+	Location       string
+	Name           string
+	NormalizedName string
+	//Tags that the rules extract from Name, including those the dish already has
+	Tags   []string
+	Action Action
+	//TargetDishID is the dish that Action ActionMerge combines this dish with. Zero for other actions
+	TargetDishID int64
+	//Applied is set by Apply if the change was performed
+	Applied bool
+	//Error is set by Apply if the change failed
+	Error string
+}
+
+type ApplyOptions struct {
+	//Rename performs all changes with ActionRename
+	Rename bool
+	//Merge performs all changes with ActionMerge
+	Merge bool
+}
+
+type NormalizationService interface {
+	//Preview returns the changes that Apply would perform, ordered by dish id. If location is not nil,
+	//only dishes of that location are considered
+	Preview(ctx context.Context, location *string) ([]Change, error)
+	//Apply performs the changes returned by Preview that are enabled in opts. Extracted tags are always stored.
+	//A failing change does not abort the others, instead its Error field is set
+	Apply(ctx context.Context, location *string, opts ApplyOptions) ([]Change, error)
+}
+
+type DefaultNormalizationService struct {
+	repo       domain.DishRepo
+	normalizer *Normalizer
+}
+
+func NewDefaultNormalizationService(repo domain.DishRepo, normalizer *Normalizer) *DefaultNormalizationService {
+	return &DefaultNormalizationService{
+		repo:       repo,
+		normalizer: normalizer,
+	}
+}
+
+func (s *DefaultNormalizationService) Preview(ctx context.Context, location *string) ([]Change, error) {
+	dishes, err := s.repo.GetAllDishesSimple(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetAllDishesSimple failed : %v", err)
+	}
+	sort.Slice(dishes, func(i, j int) bool {
+		return dishes[i].Id < dishes[j].Id
+	})
+
+	type locationAndName struct {
+		location string
+		name     string
+	}
+	//targets maps the normalized name to the dish that has or will get this name
+	targets := make(map[locationAndName]int64)
+	normalized := make(map[int64]Result, len(dishes))
+	mergedDishIDs := make(map[int64]int64)
+	for _, v := range dishes {
+		if v.MergedDishID != nil {
+			mergedDishIDs[v.Id] = *v.MergedDishID
+		}
+		if location != nil && v.ServedAt != *location {
+			continue
+		}
+		normalized[v.Id] = s.normalizer.Normalize(v.ServedAt, v.Name)
+		if normalized[v.Id].Name == v.Name {
+			targets[locationAndName{v.ServedAt, v.Name}] = v.Id
+		}
+	}
+
+	changes := make([]Change, 0)
+	for _, v := range dishes {
+		result, ok := normalized[v.Id]
+		//a rule that removes the whole name is most likely a mistake. Leave such dishes alone
+		if !ok || result.Name == "" {
+			continue
+		}
+		change := Change{
+			DishID:         v.Id,
+			Location:       v.ServedAt,
+			Name:           v.Name,
+			NormalizedName: result.Name,
+			Tags:           result.Tags,
+		}
+
+		key := locationAndName{v.ServedAt, result.Name}
+		target, exists := targets[key]
+		mergedDishID, isMerged := mergedDishIDs[v.Id]
+		alreadyMerged := exists && isMerged && mergedDishIDs[target] == mergedDishID
+		switch {
+		case exists && (target == v.Id || alreadyMerged):
+			missing, err := s.hasMissingTags(ctx, v.Id, result.Tags)
+			if err != nil {
+				return nil, err
+			}
+			if !missing {
+				continue
+			}
+			change.Action = ActionTag
+		case exists:
+			change.Action = ActionMerge
+			change.TargetDishID = target
+		default:
+			change.Action = ActionRename
+			targets[key] = v.Id
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+// hasMissingTags returns true if the dish does not yet have all tags
+func (s *DefaultNormalizationService) hasMissingTags(ctx context.Context, dishID int64, tags []string) (bool, error) {
+	if len(tags) == 0 {
+		return false, nil
+	}
+	current, err := s.repo.GetDishTags(ctx, dishID)
+	if err != nil {
+		return false, fmt.Errorf("GetDishTags failed for dish %v : %v", dishID, err)
+	}
+	currentSet := make(map[string]interface{}, len(current))
+	for _, v := range current {
+		currentSet[v] = nil
+	}
+	for _, v := range tags {
+		if _, ok := currentSet[v]; !ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *DefaultNormalizationService) Apply(ctx context.Context, location *string, opts ApplyOptions) ([]Change, error) {
+	changes, err := s.Preview(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range changes {
+		change := &changes[i]
+		var err error
+		switch change.Action {
+		case ActionTag:
+			err = s.repo.AddDishTags(ctx, change.DishID, change.Tags)
+		case ActionRename:
+			if !opts.Rename {
+				continue
+			}
+			if err = s.repo.AddDishTags(ctx, change.DishID, change.Tags); err == nil {
+				err = s.repo.RenameDish(ctx, change.DishID, change.NormalizedName)
+			}
+		case ActionMerge:
+			if !opts.Merge {
+				continue
+			}
+			if err = s.repo.AddDishTags(ctx, change.DishID, change.Tags); err == nil {
+				err = s.merge(ctx, change.DishID, change.TargetDishID, change.NormalizedName)
+			}
+		}
+		if err != nil {
+			change.Error = err.Error()
+			continue
+		}
+		change.Applied = true
+	}
+
+	return changes, nil
+}
+
+// merge combines dishID and targetDishID in a merged dish. If one of them already is part of a merged dish,
+// the other one is added to it. Otherwise, a new merged dish called name is created
+func (s *DefaultNormalizationService) merge(ctx context.Context, dishID, targetDishID int64, name string) error {
+	dish, err := s.repo.GetDishByID(ctx, dishID)
+	if err != nil {
+		return fmt.Errorf("GetDishByID failed for dish %v : %w", dishID, err)
+	}
+	target, err := s.repo.GetDishByID(ctx, targetDishID)
+	if err != nil {
+		return fmt.Errorf("GetDishByID failed for dish %v : %w", targetDishID, err)
+	}
+
+	dishIsMerged, dishMergedDishID, err := s.repo.IsDishPartOfMergedDisByID(ctx, dishID)
+	if err != nil {
+		return fmt.Errorf("IsDishPartOfMergedDisByID failed for dish %v : %w", dishID, err)
+	}
+	targetIsMerged, targetMergedDishID, err := s.repo.IsDishPartOfMergedDisByID(ctx, targetDishID)
+	if err != nil {
+		return fmt.Errorf("IsDishPartOfMergedDisByID failed for dish %v : %w", targetDishID, err)
+	}
+
+	addToMergedDish := func(mergedDishID int64, d *domain.Dish) error {
+		return s.repo.UpdateMergedDishByID(ctx, mergedDishID, func(current *domain.MergedDish) (*domain.MergedDish, error) {
+			if err := current.AddDish(d); err != nil {
+				return nil, err
+			}
+			return current, nil
+		})
+	}
+
+	switch {
+	case dishIsMerged && targetIsMerged:
+		if dishMergedDishID == targetMergedDishID {
+			return nil
+		}
+		return fmt.Errorf("dishes are part of different merged dishes : %w", domain.ErrDishAlreadyMerged)
+	case targetIsMerged:
+		return addToMergedDish(targetMergedDishID, dish)
+	case dishIsMerged:
+		return addToMergedDish(dishMergedDishID, target)
+	}
+
+	mergedDish, err := domain.NewMergedDish(name, target, dish, nil)
+	if err != nil {
+		return fmt.Errorf("NewMergedDish failed : %w", err)
+	}
+	if _, err := s.repo.CreateMergedDish(ctx, mergedDish); err != nil {
+		return fmt.Errorf("CreateMergedDish failed : %w", err)
+	}
+	return nil
+}
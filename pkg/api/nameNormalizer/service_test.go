@@ -0,0 +1,122 @@
+package nameNormalizer
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"itsTasty/pkg/api/adapters/dishRepo"
+	"itsTasty/pkg/api/domain"
+	"testing"
+)
+
+func TestDefaultNormalizationService_PreviewApply(t *testing.T) {
+	ctx := context.Background()
+	repo := dishRepo.NewMemoryRepo()
+	createDish := func(name, location string) int64 {
+		_, _, _, id, err := repo.GetOrCreateDish(ctx, name, location)
+		require.NoError(t, err)
+		return id
+	}
+	pasta := createDish("Pasta", "Mensa")
+	pastaPrefixed := createDish("BEGRENZTES ANGEBOT: Pasta", "Mensa")
+	tofu := createDish("VEGANISSIMO: Tofu", "Mensa")
+	curry := createDish("BEGRENZTES ANGEBOT: Curry", "Mensa")
+	curryPrefixed := createDish(`"YOUR FAVORITES" Curry`, "Mensa")
+	createDish("Soup", "Mensa")
+	createDish("BEGRENZTES ANGEBOT: Soup", "Cafeteria")
+
+	service := NewDefaultNormalizationService(repo, NewDefaultNormalizer())
+	location := "Mensa"
+	changes, err := service.Preview(ctx, &location)
+	require.NoError(t, err)
+
+	type actionAndTarget struct {
+		action Action
+		target int64
+	}
+	want := map[int64]actionAndTarget{
+		pastaPrefixed: {ActionMerge, pasta},
+		tofu:          {ActionRename, 0},
+		curry:         {ActionRename, 0},
+		curryPrefixed: {ActionMerge, curry},
+	}
+	got := make(map[int64]actionAndTarget)
+	for _, v := range changes {
+		got[v.DishID] = actionAndTarget{v.Action, v.TargetDishID}
+		require.False(t, v.Applied)
+	}
+	require.Equal(t, want, got)
+
+	//preview does not change anything
+	dish, err := repo.GetDishByID(ctx, tofu)
+	require.NoError(t, err)
+	require.Equal(t, "VEGANISSIMO: Tofu", dish.Name)
+
+	//renames only
+	changes, err = service.Apply(ctx, &location, ApplyOptions{Rename: true})
+	require.NoError(t, err)
+	for _, v := range changes {
+		require.Empty(t, v.Error)
+		require.Equal(t, v.Action == ActionRename, v.Applied, "dish %v", v.DishID)
+	}
+	dish, err = repo.GetDishByID(ctx, tofu)
+	require.NoError(t, err)
+	require.Equal(t, "Tofu", dish.Name)
+	tags, err := repo.GetDishTags(ctx, tofu)
+	require.NoError(t, err)
+	require.Equal(t, []string{"vegan"}, tags)
+
+	//merges
+	changes, err = service.Apply(ctx, &location, ApplyOptions{Merge: true})
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	for _, v := range changes {
+		require.Equal(t, ActionMerge, v.Action)
+		require.Empty(t, v.Error)
+		require.True(t, v.Applied)
+	}
+	for _, v := range [][2]int64{{pasta, pastaPrefixed}, {curry, curryPrefixed}} {
+		isMerged, mergedDishID, err := repo.IsDishPartOfMergedDisByID(ctx, v[0])
+		require.NoError(t, err)
+		require.True(t, isMerged)
+		_, otherMergedDishID, err := repo.IsDishPartOfMergedDisByID(ctx, v[1])
+		require.NoError(t, err)
+		require.Equal(t, mergedDishID, otherMergedDishID)
+	}
+	mergedDish, _, err := repo.GetMergedDish(ctx, "Pasta", "Mensa")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"Pasta", "BEGRENZTES ANGEBOT: Pasta"}, mergedDish.GetCondensedDishNames())
+
+	//dishes that are already merged need no further changes
+	changes, err = service.Preview(ctx, &location)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	//other locations were not touched
+	_, _, err = repo.GetDishByName(ctx, "BEGRENZTES ANGEBOT: Soup", "Cafeteria")
+	require.NoError(t, err)
+	changes, err = service.Preview(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, ActionRename, changes[len(changes)-1].Action)
+}
+
+func TestDefaultNormalizationService_Apply_DifferentMergedDishes(t *testing.T) {
+	ctx := context.Background()
+	repo := dishRepo.NewMemoryRepo()
+	for _, v := range []string{"Pasta", "Pasta v2", "BEGRENZTES ANGEBOT: Pasta", "Pasta v3"} {
+		_, _, _, _, err := repo.GetOrCreateDish(ctx, v, "Mensa")
+		require.NoError(t, err)
+	}
+	for name, dishes := range map[string][2]string{"merged1": {"Pasta", "Pasta v2"}, "merged2": {"BEGRENZTES ANGEBOT: Pasta", "Pasta v3"}} {
+		mergedDish, err := domain.NewMergedDish(name, domain.NewDishToday(dishes[0], "Mensa"),
+			domain.NewDishToday(dishes[1], "Mensa"), nil)
+		require.NoError(t, err)
+		_, err = repo.CreateMergedDish(ctx, mergedDish)
+		require.NoError(t, err)
+	}
+
+	changes, err := NewDefaultNormalizationService(repo, NewDefaultNormalizer()).Apply(ctx, nil, ApplyOptions{Merge: true})
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.False(t, changes[0].Applied)
+	require.Contains(t, changes[0].Error, domain.ErrDishAlreadyMerged.Error())
+}
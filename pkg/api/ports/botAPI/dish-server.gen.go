@@ -102,11 +102,36 @@ type ClientInterface interface {
 	// GetDishesDishID request
 	GetDishesDishID(ctx context.Context, dishID int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetMenu request
+	GetMenu(ctx context.Context, params *GetMenuParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostMenuImport request with any body
+	PostMenuImportWithBody(ctx context.Context, params *PostMenuImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostNameNormalizationApply request with any body
+	PostNameNormalizationApplyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostNameNormalizationApply(ctx context.Context, body PostNameNormalizationApplyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNameNormalizationPreview request
+	GetNameNormalizationPreview(ctx context.Context, params *GetNameNormalizationPreviewParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetStatisticsCurrentVotingStreaks request
 	GetStatisticsCurrentVotingStreaks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetStatisticsLongestVotingStreaks request
 	GetStatisticsLongestVotingStreaks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhooks request
+	GetWebhooks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWebhooks request with any body
+	PostWebhooksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostWebhooks(ctx context.Context, body PostWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWebhooksWebhookID request
+	DeleteWebhooksWebhookID(ctx context.Context, webhookID int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) PostCreateOrUpdateDishWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -145,6 +170,66 @@ func (c *Client) GetDishesDishID(ctx context.Context, dishID int64, reqEditors .
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetMenu(ctx context.Context, params *GetMenuParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMenuRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostMenuImportWithBody(ctx context.Context, params *PostMenuImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMenuImportRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostNameNormalizationApplyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostNameNormalizationApplyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostNameNormalizationApply(ctx context.Context, body PostNameNormalizationApplyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostNameNormalizationApplyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetNameNormalizationPreview(ctx context.Context, params *GetNameNormalizationPreviewParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNameNormalizationPreviewRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetStatisticsCurrentVotingStreaks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetStatisticsCurrentVotingStreaksRequest(c.Server)
 	if err != nil {
@@ -169,6 +254,54 @@ func (c *Client) GetStatisticsLongestVotingStreaks(ctx context.Context, reqEdito
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetWebhooks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhooksRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostWebhooksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostWebhooks(ctx context.Context, body PostWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteWebhooksWebhookID(ctx context.Context, webhookID int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWebhooksWebhookIDRequest(c.Server, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewPostCreateOrUpdateDishRequest calls the generic PostCreateOrUpdateDish builder with application/json body
 func NewPostCreateOrUpdateDishRequest(server string, body PostCreateOrUpdateDishJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -243,8 +376,8 @@ func NewGetDishesDishIDRequest(server string, dishID int64) (*http.Request, erro
 	return req, nil
 }
 
-// NewGetStatisticsCurrentVotingStreaksRequest generates requests for GetStatisticsCurrentVotingStreaks
-func NewGetStatisticsCurrentVotingStreaksRequest(server string) (*http.Request, error) {
+// NewGetMenuRequest generates requests for GetMenu
+func NewGetMenuRequest(server string, params *GetMenuParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -252,7 +385,7 @@ func NewGetStatisticsCurrentVotingStreaksRequest(server string) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/statistics/currentVotingStreaks")
+	operationPath := fmt.Sprintf("/menu")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -262,6 +395,38 @@ func NewGetStatisticsCurrentVotingStreaksRequest(server string) (*http.Request,
 		return nil, err
 	}
 
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date", runtime.ParamLocationQuery, params.Date); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if params.Location != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "location", runtime.ParamLocationQuery, *params.Location); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -270,8 +435,8 @@ func NewGetStatisticsCurrentVotingStreaksRequest(server string) (*http.Request,
 	return req, nil
 }
 
-// NewGetStatisticsLongestVotingStreaksRequest generates requests for GetStatisticsLongestVotingStreaks
-func NewGetStatisticsLongestVotingStreaksRequest(server string) (*http.Request, error) {
+// NewPostMenuImportRequestWithBody generates requests for PostMenuImport with any type of body
+func NewPostMenuImportRequestWithBody(server string, params *PostMenuImportParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -279,7 +444,7 @@ func NewGetStatisticsLongestVotingStreaksRequest(server string) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/statistics/longestVotingStreaks")
+	operationPath := fmt.Sprintf("/menu/import")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -289,120 +454,516 @@ func NewGetStatisticsLongestVotingStreaksRequest(server string) (*http.Request,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, params.Format); err != nil {
 		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
 	}
 
-	return req, nil
-}
+	if params.Location != nil {
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "location", runtime.ParamLocationQuery, *params.Location); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
+
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+// NewPostNameNormalizationApplyRequest calls the generic PostNameNormalizationApply builder with application/json body
+func NewPostNameNormalizationApplyRequest(server string, body PostNameNormalizationApplyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewPostNameNormalizationApplyRequestWithBody(server, "application/json", bodyReader)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+// NewPostNameNormalizationApplyRequestWithBody generates requests for PostNameNormalizationApply with any type of body
+func NewPostNameNormalizationApplyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PostCreateOrUpdateDish request with any body
-	PostCreateOrUpdateDishWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCreateOrUpdateDishResponse, error)
+	operationPath := fmt.Sprintf("/nameNormalization/apply")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	PostCreateOrUpdateDishWithResponse(ctx context.Context, body PostCreateOrUpdateDishJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCreateOrUpdateDishResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetDishesDishID request
-	GetDishesDishIDWithResponse(ctx context.Context, dishID int64, reqEditors ...RequestEditorFn) (*GetDishesDishIDResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetStatisticsCurrentVotingStreaks request
-	GetStatisticsCurrentVotingStreaksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatisticsCurrentVotingStreaksResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// GetStatisticsLongestVotingStreaks request
-	GetStatisticsLongestVotingStreaksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatisticsLongestVotingStreaksResponse, error)
+	return req, nil
 }
 
-type PostCreateOrUpdateDishResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CreateOrUpdateDishResp
-	JSON500      *BasicError
-}
+// NewGetNameNormalizationPreviewRequest generates requests for GetNameNormalizationPreview
+func NewGetNameNormalizationPreviewRequest(server string, params *GetNameNormalizationPreviewParams) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r PostCreateOrUpdateDishResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostCreateOrUpdateDishResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/nameNormalization/preview")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type GetDishesDishIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetDishResp
-	JSON400      *BasicError
-	JSON500      *BasicError
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.ServedAt != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "servedAt", runtime.ParamLocationQuery, *params.ServedAt); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-// Status returns HTTPResponse.Status
-func (r GetDishesDishIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDishesDishIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type GetStatisticsCurrentVotingStreaksResponse struct {
+// NewGetStatisticsCurrentVotingStreaksRequest generates requests for GetStatisticsCurrentVotingStreaks
+func NewGetStatisticsCurrentVotingStreaksRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/statistics/currentVotingStreaks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStatisticsLongestVotingStreaksRequest generates requests for GetStatisticsLongestVotingStreaks
+func NewGetStatisticsLongestVotingStreaksRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/statistics/longestVotingStreaks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWebhooksRequest generates requests for GetWebhooks
+func NewGetWebhooksRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostWebhooksRequest calls the generic PostWebhooks builder with application/json body
+func NewPostWebhooksRequest(server string, body PostWebhooksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWebhooksRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostWebhooksRequestWithBody generates requests for PostWebhooks with any type of body
+func NewPostWebhooksRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteWebhooksWebhookIDRequest generates requests for DeleteWebhooksWebhookID
+func NewDeleteWebhooksWebhookIDRequest(server string, webhookID int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhookID", runtime.ParamLocationPath, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// PostCreateOrUpdateDish request with any body
+	PostCreateOrUpdateDishWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCreateOrUpdateDishResponse, error)
+
+	PostCreateOrUpdateDishWithResponse(ctx context.Context, body PostCreateOrUpdateDishJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCreateOrUpdateDishResponse, error)
+
+	// GetDishesDishID request
+	GetDishesDishIDWithResponse(ctx context.Context, dishID int64, reqEditors ...RequestEditorFn) (*GetDishesDishIDResponse, error)
+
+	// GetMenu request
+	GetMenuWithResponse(ctx context.Context, params *GetMenuParams, reqEditors ...RequestEditorFn) (*GetMenuResponse, error)
+
+	// PostMenuImport request with any body
+	PostMenuImportWithBodyWithResponse(ctx context.Context, params *PostMenuImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMenuImportResponse, error)
+
+	// PostNameNormalizationApply request with any body
+	PostNameNormalizationApplyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostNameNormalizationApplyResponse, error)
+
+	PostNameNormalizationApplyWithResponse(ctx context.Context, body PostNameNormalizationApplyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostNameNormalizationApplyResponse, error)
+
+	// GetNameNormalizationPreview request
+	GetNameNormalizationPreviewWithResponse(ctx context.Context, params *GetNameNormalizationPreviewParams, reqEditors ...RequestEditorFn) (*GetNameNormalizationPreviewResponse, error)
+
+	// GetStatisticsCurrentVotingStreaks request
+	GetStatisticsCurrentVotingStreaksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatisticsCurrentVotingStreaksResponse, error)
+
+	// GetStatisticsLongestVotingStreaks request
+	GetStatisticsLongestVotingStreaksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatisticsLongestVotingStreaksResponse, error)
+
+	// GetWebhooks request
+	GetWebhooksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWebhooksResponse, error)
+
+	// PostWebhooks request with any body
+	PostWebhooksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksResponse, error)
+
+	PostWebhooksWithResponse(ctx context.Context, body PostWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksResponse, error)
+
+	// DeleteWebhooksWebhookID request
+	DeleteWebhooksWebhookIDWithResponse(ctx context.Context, webhookID int64, reqEditors ...RequestEditorFn) (*DeleteWebhooksWebhookIDResponse, error)
+}
+
+type PostCreateOrUpdateDishResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CreateOrUpdateDishResp
+	JSON500      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostCreateOrUpdateDishResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostCreateOrUpdateDishResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDishesDishIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetDishResp
+	JSON400      *BasicError
+	JSON500      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDishesDishIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDishesDishIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMenuResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MenuResp
+	JSON400      *BasicError
+	JSON500      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMenuResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMenuResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostMenuImportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImportMenuResp
+	JSON400      *BasicError
+	JSON413      *BasicError
+	JSON500      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostMenuImportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostMenuImportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostNameNormalizationApplyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NameNormalizationResp
+	JSON500      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostNameNormalizationApplyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostNameNormalizationApplyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNameNormalizationPreviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NameNormalizationResp
+	JSON500      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNameNormalizationPreviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNameNormalizationPreviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatisticsCurrentVotingStreaksResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *CurrentVotingStreakResp
@@ -446,6 +1007,75 @@ func (r GetStatisticsLongestVotingStreaksResponse) StatusCode() int {
 	return 0
 }
 
+type GetWebhooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetWebhooksResp
+	JSON500      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWebhooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CreateWebhookResp
+	JSON400      *BasicError
+	JSON500      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWebhooksWebhookIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWebhooksWebhookIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWebhooksWebhookIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 // PostCreateOrUpdateDishWithBodyWithResponse request with arbitrary body returning *PostCreateOrUpdateDishResponse
 func (c *ClientWithResponses) PostCreateOrUpdateDishWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCreateOrUpdateDishResponse, error) {
 	rsp, err := c.PostCreateOrUpdateDishWithBody(ctx, contentType, body, reqEditors...)
@@ -472,6 +1102,50 @@ func (c *ClientWithResponses) GetDishesDishIDWithResponse(ctx context.Context, d
 	return ParseGetDishesDishIDResponse(rsp)
 }
 
+// GetMenuWithResponse request returning *GetMenuResponse
+func (c *ClientWithResponses) GetMenuWithResponse(ctx context.Context, params *GetMenuParams, reqEditors ...RequestEditorFn) (*GetMenuResponse, error) {
+	rsp, err := c.GetMenu(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMenuResponse(rsp)
+}
+
+// PostMenuImportWithBodyWithResponse request with arbitrary body returning *PostMenuImportResponse
+func (c *ClientWithResponses) PostMenuImportWithBodyWithResponse(ctx context.Context, params *PostMenuImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMenuImportResponse, error) {
+	rsp, err := c.PostMenuImportWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMenuImportResponse(rsp)
+}
+
+// PostNameNormalizationApplyWithBodyWithResponse request with arbitrary body returning *PostNameNormalizationApplyResponse
+func (c *ClientWithResponses) PostNameNormalizationApplyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostNameNormalizationApplyResponse, error) {
+	rsp, err := c.PostNameNormalizationApplyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostNameNormalizationApplyResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostNameNormalizationApplyWithResponse(ctx context.Context, body PostNameNormalizationApplyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostNameNormalizationApplyResponse, error) {
+	rsp, err := c.PostNameNormalizationApply(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostNameNormalizationApplyResponse(rsp)
+}
+
+// GetNameNormalizationPreviewWithResponse request returning *GetNameNormalizationPreviewResponse
+func (c *ClientWithResponses) GetNameNormalizationPreviewWithResponse(ctx context.Context, params *GetNameNormalizationPreviewParams, reqEditors ...RequestEditorFn) (*GetNameNormalizationPreviewResponse, error) {
+	rsp, err := c.GetNameNormalizationPreview(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNameNormalizationPreviewResponse(rsp)
+}
+
 // GetStatisticsCurrentVotingStreaksWithResponse request returning *GetStatisticsCurrentVotingStreaksResponse
 func (c *ClientWithResponses) GetStatisticsCurrentVotingStreaksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatisticsCurrentVotingStreaksResponse, error) {
 	rsp, err := c.GetStatisticsCurrentVotingStreaks(ctx, reqEditors...)
@@ -481,36 +1155,198 @@ func (c *ClientWithResponses) GetStatisticsCurrentVotingStreaksWithResponse(ctx
 	return ParseGetStatisticsCurrentVotingStreaksResponse(rsp)
 }
 
-// GetStatisticsLongestVotingStreaksWithResponse request returning *GetStatisticsLongestVotingStreaksResponse
-func (c *ClientWithResponses) GetStatisticsLongestVotingStreaksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatisticsLongestVotingStreaksResponse, error) {
-	rsp, err := c.GetStatisticsLongestVotingStreaks(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+// GetStatisticsLongestVotingStreaksWithResponse request returning *GetStatisticsLongestVotingStreaksResponse
+func (c *ClientWithResponses) GetStatisticsLongestVotingStreaksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatisticsLongestVotingStreaksResponse, error) {
+	rsp, err := c.GetStatisticsLongestVotingStreaks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatisticsLongestVotingStreaksResponse(rsp)
+}
+
+// GetWebhooksWithResponse request returning *GetWebhooksResponse
+func (c *ClientWithResponses) GetWebhooksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWebhooksResponse, error) {
+	rsp, err := c.GetWebhooks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhooksResponse(rsp)
+}
+
+// PostWebhooksWithBodyWithResponse request with arbitrary body returning *PostWebhooksResponse
+func (c *ClientWithResponses) PostWebhooksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksResponse, error) {
+	rsp, err := c.PostWebhooksWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWebhooksWithResponse(ctx context.Context, body PostWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksResponse, error) {
+	rsp, err := c.PostWebhooks(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksResponse(rsp)
+}
+
+// DeleteWebhooksWebhookIDWithResponse request returning *DeleteWebhooksWebhookIDResponse
+func (c *ClientWithResponses) DeleteWebhooksWebhookIDWithResponse(ctx context.Context, webhookID int64, reqEditors ...RequestEditorFn) (*DeleteWebhooksWebhookIDResponse, error) {
+	rsp, err := c.DeleteWebhooksWebhookID(ctx, webhookID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWebhooksWebhookIDResponse(rsp)
+}
+
+// ParsePostCreateOrUpdateDishResponse parses an HTTP response from a PostCreateOrUpdateDishWithResponse call
+func ParsePostCreateOrUpdateDishResponse(rsp *http.Response) (*PostCreateOrUpdateDishResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostCreateOrUpdateDishResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreateOrUpdateDishResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDishesDishIDResponse parses an HTTP response from a GetDishesDishIDWithResponse call
+func ParseGetDishesDishIDResponse(rsp *http.Response) (*GetDishesDishIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDishesDishIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetDishResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMenuResponse parses an HTTP response from a GetMenuWithResponse call
+func ParseGetMenuResponse(rsp *http.Response) (*GetMenuResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMenuResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MenuResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetStatisticsLongestVotingStreaksResponse(rsp)
+
+	return response, nil
 }
 
-// ParsePostCreateOrUpdateDishResponse parses an HTTP response from a PostCreateOrUpdateDishWithResponse call
-func ParsePostCreateOrUpdateDishResponse(rsp *http.Response) (*PostCreateOrUpdateDishResponse, error) {
+// ParsePostMenuImportResponse parses an HTTP response from a PostMenuImportWithResponse call
+func ParsePostMenuImportResponse(rsp *http.Response) (*PostMenuImportResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostCreateOrUpdateDishResponse{
+	response := &PostMenuImportResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CreateOrUpdateDishResp
+		var dest ImportMenuResp
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest BasicError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -523,33 +1359,59 @@ func ParsePostCreateOrUpdateDishResponse(rsp *http.Response) (*PostCreateOrUpdat
 	return response, nil
 }
 
-// ParseGetDishesDishIDResponse parses an HTTP response from a GetDishesDishIDWithResponse call
-func ParseGetDishesDishIDResponse(rsp *http.Response) (*GetDishesDishIDResponse, error) {
+// ParsePostNameNormalizationApplyResponse parses an HTTP response from a PostNameNormalizationApplyWithResponse call
+func ParsePostNameNormalizationApplyResponse(rsp *http.Response) (*PostNameNormalizationApplyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDishesDishIDResponse{
+	response := &PostNameNormalizationApplyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetDishResp
+		var dest NameNormalizationResp
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest BasicError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetNameNormalizationPreviewResponse parses an HTTP response from a GetNameNormalizationPreviewWithResponse call
+func ParseGetNameNormalizationPreviewResponse(rsp *http.Response) (*GetNameNormalizationPreviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNameNormalizationPreviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NameNormalizationResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest BasicError
@@ -615,6 +1477,105 @@ func ParseGetStatisticsLongestVotingStreaksResponse(rsp *http.Response) (*GetSta
 	return response, nil
 }
 
+// ParseGetWebhooksResponse parses an HTTP response from a GetWebhooksWithResponse call
+func ParseGetWebhooksResponse(rsp *http.Response) (*GetWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetWebhooksResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostWebhooksResponse parses an HTTP response from a PostWebhooksWithResponse call
+func ParsePostWebhooksResponse(rsp *http.Response) (*PostWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreateWebhookResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteWebhooksWebhookIDResponse parses an HTTP response from a DeleteWebhooksWebhookIDWithResponse call
+func ParseDeleteWebhooksWebhookIDResponse(rsp *http.Response) (*DeleteWebhooksWebhookIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteWebhooksWebhookIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
@@ -624,11 +1585,32 @@ type ServerInterface interface {
 	// (GET /dishes/{dishID})
 	GetDishesDishID(w http.ResponseWriter, r *http.Request, dishID int64)
 
+	// (GET /menu)
+	GetMenu(w http.ResponseWriter, r *http.Request, params GetMenuParams)
+
+	// (POST /menu/import)
+	PostMenuImport(w http.ResponseWriter, r *http.Request, params PostMenuImportParams)
+
+	// (POST /nameNormalization/apply)
+	PostNameNormalizationApply(w http.ResponseWriter, r *http.Request)
+
+	// (GET /nameNormalization/preview)
+	GetNameNormalizationPreview(w http.ResponseWriter, r *http.Request, params GetNameNormalizationPreviewParams)
+
 	// (GET /statistics/currentVotingStreaks)
 	GetStatisticsCurrentVotingStreaks(w http.ResponseWriter, r *http.Request)
 
 	// (GET /statistics/longestVotingStreaks)
 	GetStatisticsLongestVotingStreaks(w http.ResponseWriter, r *http.Request)
+
+	// (GET /webhooks)
+	GetWebhooks(w http.ResponseWriter, r *http.Request)
+
+	// (POST /webhooks)
+	PostWebhooks(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /webhooks/{webhookID})
+	DeleteWebhooksWebhookID(w http.ResponseWriter, r *http.Request, webhookID int64)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -640,14 +1622,149 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// PostCreateOrUpdateDish operation middleware
-func (siw *ServerInterfaceWrapper) PostCreateOrUpdateDish(w http.ResponseWriter, r *http.Request) {
+// PostCreateOrUpdateDish operation middleware
+func (siw *ServerInterfaceWrapper) PostCreateOrUpdateDish(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostCreateOrUpdateDish(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetDishesDishID operation middleware
+func (siw *ServerInterfaceWrapper) GetDishesDishID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "dishID" -------------
+	var dishID int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "dishID", runtime.ParamLocationPath, chi.URLParam(r, "dishID"), &dishID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dishID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDishesDishID(w, r, dishID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetMenu operation middleware
+func (siw *ServerInterfaceWrapper) GetMenu(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMenuParams
+
+	// ------------- Required query parameter "date" -------------
+
+	if paramValue := r.URL.Query().Get("date"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "date"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "date", r.URL.Query(), &params.Date)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "location" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "location", r.URL.Query(), &params.Location)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "location", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMenu(w, r, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostMenuImport operation middleware
+func (siw *ServerInterfaceWrapper) PostMenuImport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostMenuImportParams
+
+	// ------------- Required query parameter "format" -------------
+
+	if paramValue := r.URL.Query().Get("format"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "location" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "location", r.URL.Query(), &params.Location)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "location", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostMenuImport(w, r, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostNameNormalizationApply operation middleware
+func (siw *ServerInterfaceWrapper) PostNameNormalizationApply(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{""})
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostCreateOrUpdateDish(w, r)
+		siw.Handler.PostNameNormalizationApply(w, r)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -657,25 +1774,27 @@ func (siw *ServerInterfaceWrapper) PostCreateOrUpdateDish(w http.ResponseWriter,
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetDishesDishID operation middleware
-func (siw *ServerInterfaceWrapper) GetDishesDishID(w http.ResponseWriter, r *http.Request) {
+// GetNameNormalizationPreview operation middleware
+func (siw *ServerInterfaceWrapper) GetNameNormalizationPreview(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "dishID" -------------
-	var dishID int64
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{""})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "dishID", runtime.ParamLocationPath, chi.URLParam(r, "dishID"), &dishID)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNameNormalizationPreviewParams
+
+	// ------------- Optional query parameter "servedAt" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "servedAt", r.URL.Query(), &params.ServedAt)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dishID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "servedAt", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{""})
-
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDishesDishID(w, r, dishID)
+		siw.Handler.GetNameNormalizationPreview(w, r, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -719,6 +1838,68 @@ func (siw *ServerInterfaceWrapper) GetStatisticsLongestVotingStreaks(w http.Resp
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// GetWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhooks(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWebhooks(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteWebhooksWebhookID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhooksWebhookID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhookID", runtime.ParamLocationPath, chi.URLParam(r, "webhookID"), &webhookID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhooksWebhookID(w, r, webhookID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -838,149 +2019,452 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/dishes/{dishID}", wrapper.GetDishesDishID)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/menu", wrapper.GetMenu)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/menu/import", wrapper.PostMenuImport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/nameNormalization/apply", wrapper.PostNameNormalizationApply)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/nameNormalization/preview", wrapper.GetNameNormalizationPreview)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/statistics/currentVotingStreaks", wrapper.GetStatisticsCurrentVotingStreaks)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/statistics/longestVotingStreaks", wrapper.GetStatisticsLongestVotingStreaks)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks", wrapper.GetWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks", wrapper.PostWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/webhooks/{webhookID}", wrapper.DeleteWebhooksWebhookID)
+	})
 
 	return r
 }
 
-type PostCreateOrUpdateDishRequestObject struct {
-	Body *PostCreateOrUpdateDishJSONRequestBody
+type PostCreateOrUpdateDishRequestObject struct {
+	Body *PostCreateOrUpdateDishJSONRequestBody
+}
+
+type PostCreateOrUpdateDishResponseObject interface {
+	VisitPostCreateOrUpdateDishResponse(w http.ResponseWriter) error
+}
+
+type PostCreateOrUpdateDish200JSONResponse CreateOrUpdateDishResp
+
+func (response PostCreateOrUpdateDish200JSONResponse) VisitPostCreateOrUpdateDishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCreateOrUpdateDish401Response struct {
+}
+
+func (response PostCreateOrUpdateDish401Response) VisitPostCreateOrUpdateDishResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostCreateOrUpdateDish500JSONResponse BasicError
+
+func (response PostCreateOrUpdateDish500JSONResponse) VisitPostCreateOrUpdateDishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDishesDishIDRequestObject struct {
+	DishID int64 `json:"dishID"`
+}
+
+type GetDishesDishIDResponseObject interface {
+	VisitGetDishesDishIDResponse(w http.ResponseWriter) error
+}
+
+type GetDishesDishID200JSONResponse GetDishResp
+
+func (response GetDishesDishID200JSONResponse) VisitGetDishesDishIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDishesDishID400JSONResponse BasicError
+
+func (response GetDishesDishID400JSONResponse) VisitGetDishesDishIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDishesDishID401Response struct {
+}
+
+func (response GetDishesDishID401Response) VisitGetDishesDishIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetDishesDishID404Response struct {
+}
+
+func (response GetDishesDishID404Response) VisitGetDishesDishIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetDishesDishID500JSONResponse BasicError
+
+func (response GetDishesDishID500JSONResponse) VisitGetDishesDishIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMenuRequestObject struct {
+	Params GetMenuParams
+}
+
+type GetMenuResponseObject interface {
+	VisitGetMenuResponse(w http.ResponseWriter) error
+}
+
+type GetMenu200JSONResponse MenuResp
+
+func (response GetMenu200JSONResponse) VisitGetMenuResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMenu400JSONResponse BasicError
+
+func (response GetMenu400JSONResponse) VisitGetMenuResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMenu401Response struct {
+}
+
+func (response GetMenu401Response) VisitGetMenuResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetMenu500JSONResponse BasicError
+
+func (response GetMenu500JSONResponse) VisitGetMenuResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostMenuImportRequestObject struct {
+	Params      PostMenuImportParams
+	ContentType string
+	Body        io.Reader
+}
+
+type PostMenuImportResponseObject interface {
+	VisitPostMenuImportResponse(w http.ResponseWriter) error
+}
+
+type PostMenuImport200JSONResponse ImportMenuResp
+
+func (response PostMenuImport200JSONResponse) VisitPostMenuImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostMenuImport400JSONResponse BasicError
+
+func (response PostMenuImport400JSONResponse) VisitPostMenuImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostMenuImport401Response struct {
+}
+
+func (response PostMenuImport401Response) VisitPostMenuImportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostMenuImport413JSONResponse BasicError
+
+func (response PostMenuImport413JSONResponse) VisitPostMenuImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostMenuImport500JSONResponse BasicError
+
+func (response PostMenuImport500JSONResponse) VisitPostMenuImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNameNormalizationApplyRequestObject struct {
+	Body *PostNameNormalizationApplyJSONRequestBody
+}
+
+type PostNameNormalizationApplyResponseObject interface {
+	VisitPostNameNormalizationApplyResponse(w http.ResponseWriter) error
+}
+
+type PostNameNormalizationApply200JSONResponse NameNormalizationResp
+
+func (response PostNameNormalizationApply200JSONResponse) VisitPostNameNormalizationApplyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNameNormalizationApply401Response struct {
+}
+
+func (response PostNameNormalizationApply401Response) VisitPostNameNormalizationApplyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostNameNormalizationApply500JSONResponse BasicError
+
+func (response PostNameNormalizationApply500JSONResponse) VisitPostNameNormalizationApplyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNameNormalizationPreviewRequestObject struct {
+	Params GetNameNormalizationPreviewParams
 }
 
-type PostCreateOrUpdateDishResponseObject interface {
-	VisitPostCreateOrUpdateDishResponse(w http.ResponseWriter) error
+type GetNameNormalizationPreviewResponseObject interface {
+	VisitGetNameNormalizationPreviewResponse(w http.ResponseWriter) error
 }
 
-type PostCreateOrUpdateDish200JSONResponse CreateOrUpdateDishResp
+type GetNameNormalizationPreview200JSONResponse NameNormalizationResp
 
-func (response PostCreateOrUpdateDish200JSONResponse) VisitPostCreateOrUpdateDishResponse(w http.ResponseWriter) error {
+func (response GetNameNormalizationPreview200JSONResponse) VisitGetNameNormalizationPreviewResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostCreateOrUpdateDish401Response struct {
+type GetNameNormalizationPreview401Response struct {
 }
 
-func (response PostCreateOrUpdateDish401Response) VisitPostCreateOrUpdateDishResponse(w http.ResponseWriter) error {
+func (response GetNameNormalizationPreview401Response) VisitGetNameNormalizationPreviewResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type PostCreateOrUpdateDish500JSONResponse BasicError
+type GetNameNormalizationPreview500JSONResponse BasicError
 
-func (response PostCreateOrUpdateDish500JSONResponse) VisitPostCreateOrUpdateDishResponse(w http.ResponseWriter) error {
+func (response GetNameNormalizationPreview500JSONResponse) VisitGetNameNormalizationPreviewResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDishesDishIDRequestObject struct {
-	DishID int64 `json:"dishID"`
+type GetStatisticsCurrentVotingStreaksRequestObject struct {
 }
 
-type GetDishesDishIDResponseObject interface {
-	VisitGetDishesDishIDResponse(w http.ResponseWriter) error
+type GetStatisticsCurrentVotingStreaksResponseObject interface {
+	VisitGetStatisticsCurrentVotingStreaksResponse(w http.ResponseWriter) error
 }
 
-type GetDishesDishID200JSONResponse GetDishResp
+type GetStatisticsCurrentVotingStreaks200JSONResponse CurrentVotingStreakResp
 
-func (response GetDishesDishID200JSONResponse) VisitGetDishesDishIDResponse(w http.ResponseWriter) error {
+func (response GetStatisticsCurrentVotingStreaks200JSONResponse) VisitGetStatisticsCurrentVotingStreaksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDishesDishID400JSONResponse BasicError
+type GetStatisticsCurrentVotingStreaks500Response struct {
+}
 
-func (response GetDishesDishID400JSONResponse) VisitGetDishesDishIDResponse(w http.ResponseWriter) error {
+func (response GetStatisticsCurrentVotingStreaks500Response) VisitGetStatisticsCurrentVotingStreaksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetStatisticsLongestVotingStreaksRequestObject struct {
+}
+
+type GetStatisticsLongestVotingStreaksResponseObject interface {
+	VisitGetStatisticsLongestVotingStreaksResponse(w http.ResponseWriter) error
+}
+
+type GetStatisticsLongestVotingStreaks200JSONResponse LongestVotingStreakResp
+
+func (response GetStatisticsLongestVotingStreaks200JSONResponse) VisitGetStatisticsLongestVotingStreaksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDishesDishID401Response struct {
+type GetStatisticsLongestVotingStreaks500Response struct {
 }
 
-func (response GetDishesDishID401Response) VisitGetDishesDishIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
+func (response GetStatisticsLongestVotingStreaks500Response) VisitGetStatisticsLongestVotingStreaksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
 	return nil
 }
 
-type GetDishesDishID404Response struct {
+type GetWebhooksRequestObject struct {
 }
 
-func (response GetDishesDishID404Response) VisitGetDishesDishIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
+type GetWebhooksResponseObject interface {
+	VisitGetWebhooksResponse(w http.ResponseWriter) error
+}
+
+type GetWebhooks200JSONResponse GetWebhooksResp
+
+func (response GetWebhooks200JSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooks401Response struct {
+}
+
+func (response GetWebhooks401Response) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
 	return nil
 }
 
-type GetDishesDishID500JSONResponse BasicError
+type GetWebhooks500JSONResponse BasicError
 
-func (response GetDishesDishID500JSONResponse) VisitGetDishesDishIDResponse(w http.ResponseWriter) error {
+func (response GetWebhooks500JSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetStatisticsCurrentVotingStreaksRequestObject struct {
+type PostWebhooksRequestObject struct {
+	Body *PostWebhooksJSONRequestBody
 }
 
-type GetStatisticsCurrentVotingStreaksResponseObject interface {
-	VisitGetStatisticsCurrentVotingStreaksResponse(w http.ResponseWriter) error
+type PostWebhooksResponseObject interface {
+	VisitPostWebhooksResponse(w http.ResponseWriter) error
 }
 
-type GetStatisticsCurrentVotingStreaks200JSONResponse CurrentVotingStreakResp
+type PostWebhooks200JSONResponse CreateWebhookResp
 
-func (response GetStatisticsCurrentVotingStreaks200JSONResponse) VisitGetStatisticsCurrentVotingStreaksResponse(w http.ResponseWriter) error {
+func (response PostWebhooks200JSONResponse) VisitPostWebhooksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetStatisticsCurrentVotingStreaks500Response struct {
+type PostWebhooks400JSONResponse BasicError
+
+func (response PostWebhooks400JSONResponse) VisitPostWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response GetStatisticsCurrentVotingStreaks500Response) VisitGetStatisticsCurrentVotingStreaksResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
+type PostWebhooks401Response struct {
+}
+
+func (response PostWebhooks401Response) VisitPostWebhooksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
 	return nil
 }
 
-type GetStatisticsLongestVotingStreaksRequestObject struct {
+type PostWebhooks500JSONResponse BasicError
+
+func (response PostWebhooks500JSONResponse) VisitPostWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetStatisticsLongestVotingStreaksResponseObject interface {
-	VisitGetStatisticsLongestVotingStreaksResponse(w http.ResponseWriter) error
+type DeleteWebhooksWebhookIDRequestObject struct {
+	WebhookID int64 `json:"webhookID"`
 }
 
-type GetStatisticsLongestVotingStreaks200JSONResponse LongestVotingStreakResp
+type DeleteWebhooksWebhookIDResponseObject interface {
+	VisitDeleteWebhooksWebhookIDResponse(w http.ResponseWriter) error
+}
 
-func (response GetStatisticsLongestVotingStreaks200JSONResponse) VisitGetStatisticsLongestVotingStreaksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+type DeleteWebhooksWebhookID200Response struct {
+}
+
+func (response DeleteWebhooksWebhookID200Response) VisitDeleteWebhooksWebhookIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
+	return nil
+}
 
-	return json.NewEncoder(w).Encode(response)
+type DeleteWebhooksWebhookID401Response struct {
 }
 
-type GetStatisticsLongestVotingStreaks500Response struct {
+func (response DeleteWebhooksWebhookID401Response) VisitDeleteWebhooksWebhookIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
 }
 
-func (response GetStatisticsLongestVotingStreaks500Response) VisitGetStatisticsLongestVotingStreaksResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
+type DeleteWebhooksWebhookID404Response struct {
+}
+
+func (response DeleteWebhooksWebhookID404Response) VisitDeleteWebhooksWebhookIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
 	return nil
 }
 
+type DeleteWebhooksWebhookID500JSONResponse BasicError
+
+func (response DeleteWebhooksWebhookID500JSONResponse) VisitDeleteWebhooksWebhookIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 
@@ -990,11 +2474,32 @@ type StrictServerInterface interface {
 	// (GET /dishes/{dishID})
 	GetDishesDishID(ctx context.Context, request GetDishesDishIDRequestObject) (GetDishesDishIDResponseObject, error)
 
+	// (GET /menu)
+	GetMenu(ctx context.Context, request GetMenuRequestObject) (GetMenuResponseObject, error)
+
+	// (POST /menu/import)
+	PostMenuImport(ctx context.Context, request PostMenuImportRequestObject) (PostMenuImportResponseObject, error)
+
+	// (POST /nameNormalization/apply)
+	PostNameNormalizationApply(ctx context.Context, request PostNameNormalizationApplyRequestObject) (PostNameNormalizationApplyResponseObject, error)
+
+	// (GET /nameNormalization/preview)
+	GetNameNormalizationPreview(ctx context.Context, request GetNameNormalizationPreviewRequestObject) (GetNameNormalizationPreviewResponseObject, error)
+
 	// (GET /statistics/currentVotingStreaks)
 	GetStatisticsCurrentVotingStreaks(ctx context.Context, request GetStatisticsCurrentVotingStreaksRequestObject) (GetStatisticsCurrentVotingStreaksResponseObject, error)
 
 	// (GET /statistics/longestVotingStreaks)
 	GetStatisticsLongestVotingStreaks(ctx context.Context, request GetStatisticsLongestVotingStreaksRequestObject) (GetStatisticsLongestVotingStreaksResponseObject, error)
+
+	// (GET /webhooks)
+	GetWebhooks(ctx context.Context, request GetWebhooksRequestObject) (GetWebhooksResponseObject, error)
+
+	// (POST /webhooks)
+	PostWebhooks(ctx context.Context, request PostWebhooksRequestObject) (PostWebhooksResponseObject, error)
+
+	// (DELETE /webhooks/{webhookID})
+	DeleteWebhooksWebhookID(ctx context.Context, request DeleteWebhooksWebhookIDRequestObject) (DeleteWebhooksWebhookIDResponseObject, error)
 }
 
 type StrictHandlerFunc func(ctx context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error)
@@ -1084,6 +2589,118 @@ func (sh *strictHandler) GetDishesDishID(w http.ResponseWriter, r *http.Request,
 	}
 }
 
+// GetMenu operation middleware
+func (sh *strictHandler) GetMenu(w http.ResponseWriter, r *http.Request, params GetMenuParams) {
+	var request GetMenuRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMenu(ctx, request.(GetMenuRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMenu")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMenuResponseObject); ok {
+		if err := validResponse.VisitGetMenuResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// PostMenuImport operation middleware
+func (sh *strictHandler) PostMenuImport(w http.ResponseWriter, r *http.Request, params PostMenuImportParams) {
+	var request PostMenuImportRequestObject
+
+	request.Params = params
+	request.ContentType = r.Header.Get("Content-Type")
+
+	request.Body = r.Body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostMenuImport(ctx, request.(PostMenuImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostMenuImport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostMenuImportResponseObject); ok {
+		if err := validResponse.VisitPostMenuImportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// PostNameNormalizationApply operation middleware
+func (sh *strictHandler) PostNameNormalizationApply(w http.ResponseWriter, r *http.Request) {
+	var request PostNameNormalizationApplyRequestObject
+
+	var body PostNameNormalizationApplyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostNameNormalizationApply(ctx, request.(PostNameNormalizationApplyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostNameNormalizationApply")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostNameNormalizationApplyResponseObject); ok {
+		if err := validResponse.VisitPostNameNormalizationApplyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetNameNormalizationPreview operation middleware
+func (sh *strictHandler) GetNameNormalizationPreview(w http.ResponseWriter, r *http.Request, params GetNameNormalizationPreviewParams) {
+	var request GetNameNormalizationPreviewRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNameNormalizationPreview(ctx, request.(GetNameNormalizationPreviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNameNormalizationPreview")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetNameNormalizationPreviewResponseObject); ok {
+		if err := validResponse.VisitGetNameNormalizationPreviewResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
 // GetStatisticsCurrentVotingStreaks operation middleware
 func (sh *strictHandler) GetStatisticsCurrentVotingStreaks(w http.ResponseWriter, r *http.Request) {
 	var request GetStatisticsCurrentVotingStreaksRequestObject
@@ -1132,38 +2749,156 @@ func (sh *strictHandler) GetStatisticsLongestVotingStreaks(w http.ResponseWriter
 	}
 }
 
+// GetWebhooks operation middleware
+func (sh *strictHandler) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+	var request GetWebhooksRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWebhooks(ctx, request.(GetWebhooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWebhooks")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWebhooksResponseObject); ok {
+		if err := validResponse.VisitGetWebhooksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// PostWebhooks operation middleware
+func (sh *strictHandler) PostWebhooks(w http.ResponseWriter, r *http.Request) {
+	var request PostWebhooksRequestObject
+
+	var body PostWebhooksJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostWebhooks(ctx, request.(PostWebhooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostWebhooks")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostWebhooksResponseObject); ok {
+		if err := validResponse.VisitPostWebhooksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// DeleteWebhooksWebhookID operation middleware
+func (sh *strictHandler) DeleteWebhooksWebhookID(w http.ResponseWriter, r *http.Request, webhookID int64) {
+	var request DeleteWebhooksWebhookIDRequestObject
+
+	request.WebhookID = webhookID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteWebhooksWebhookID(ctx, request.(DeleteWebhooksWebhookIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteWebhooksWebhookID")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteWebhooksWebhookIDResponseObject); ok {
+		if err := validResponse.VisitDeleteWebhooksWebhookIDResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8xYX3PbxhH/KjvXzjSZYUilcfugN1nypKztWGM5TTO2H5a4JXHW4Q6+XZBBPfzunbsD",
-	"SVAATWVadfokCuD+/+3ub/lFFb6qvSMnrC6/KC5KqjB9fI5sihch+BD/q4OvKYih9G5TosS/0takLhVL",
-	"MG6lttvJ7olffKJC1HairgOh0Jvwc61R6MZw+ZY+R1lNXARTi/FOXap3AR1XRkCjICx9AG24hCJKx29M",
-	"HngQ3/6EFQ01xafglyAlZR3iYUFZE2mYQZM80Wry0P2JYgpr0lcy1PrKF8kR2JQUCKQ0nLUbhiw11Led",
-	"qECfGxNIq8v3B5d7dj4+MmNcD12au6UPFZhldmSDvA/SB/jUsOxCBXQaAkkTHBhhmN8M8lmUVNy/prCi",
-	"a3TaRDEemrxGB97ZNiZUQkOpUI42yQPiKcyX6fmklyAufWM11MEvcJFFq2hHw8ZICfSbYTFutVfxq292",
-	"MtpwbbFNpWyYAiBY4+5jReOjDS2gxhVNYFOaooQCXXzuYElSlOkryVJ80YUEa4MHdVe38+mhbAvvLaGL",
-	"Jejy+BNtYvrH0NpQTDzug++n/4zKHZTOqbV7yJ1RHe3Pb0YAcrPrg056tsNDlJjC3yNEJOPZQ4X3BNws",
-	"mD435AQKtJaBkA0FNVERa7HplXHy12cHP4wTWlEYoP1BCvdejmZiMo6/0e5oQiAn//ARNHcSCO/H2+OV",
-	"dytigSIL2Ba8W/mItHWSBU7CPGyFLPCOsOpbGbFAbiVlzHEnAkJYHasH40BjyyMJm+ws/cwU+pay3q/Z",
-	"izW1Ob7JuQBh0QI6ME6btdEN2oT9UX/iC/7FSPkafzsV9JXNChg2pYcS1wR41gW/BJud/3rIE2WEqpHB",
-	"86JCY3eRH/t/mN7dAwwB2/Fl9CPJ6Xl6Q4LGxu44PI4mMbXLACa4Xr3FGMFIjtYUcEUQ0vs0JPfzcApv",
-	"KiOxCc2yaz4MBM7HlBFDS3KIzTXVIpfGPWrXjWXFFznnBV37xo2stqsqPk9qTEXcG92dqB4FSw4up0Jr",
-	"E5WhvT1K0VDo2HLOHz9M0EtqGSpCBx8UCwb+oNIGW6NtqHvTWc87REoUwH0YSSbtosqnXY0O/kVhl+GY",
-	"7joQx4bdFxGWhqyGwjtB4zhlFI/K2NsUB0AFKsjJm32KR6D72rNA/h4casH9sk3htVmVAs7LzoO84Epv",
-	"CUrD4kN7ujnyaIa2bdtpVU217o/rOEnPN8sTUx+Xac9DKI7l7wCsMzSpm++PXwS0pnBu9ndD9RGzv6/0",
-	"d479zsijx/5J/3dW0pR60hH/dZefdmwncBZNMNLexfMkV+qqNi+pvWpyxky0VhLqZCIPS/XP765u59+9",
-	"fPHrwSomKbWNSo1b+hEOFuF9dTsHtNZvuGORjhEWXjJPcnEoZLqa5lKmm6klDtgVIzZanL+7g2/+5mta",
-	"Nta238I7ZGnhuZdoRE3UmgJnyxfT76cXaWLX5LA26lL9ML2Y/hBBilKmqGfF4DhI15nnkcbNh8SBovrQ",
-	"HQRg5E8MH5RFlq6FP6g8XuNWigzUBkLdZnbOcfTFLkljYK7Vpbr1LMM7ReWuJ5bnXreJS3knlJcO1rU1",
-	"eZLMPnGmv/ncjJ/+GGipLtUfZod7dNYdo7PxE3J7PGTi2ZEecO0dZ4z8+eLiSZ3gOntxnPa7piiIeQpv",
-	"u4vr1J0AyLAha+Pf3SqY36RtuGykCZRwmHh4hMWzi++HNY4dCY5Ic8Sm9SuTToK//Bcj7/0QMBLt3AkF",
-	"hxYofgMWjYBxdSMp0KVxFIW2EzXLDTP7kqPcRqsrGkHtjySgExVjsOae9qs+tlp/gR6xhgFCO6pHfLO7",
-	"O2oMWJFQYHX5vhsZsbEOA2N/ohzDatJL1Pkz6OMTgrDPX0dqsWewxmU/49bGhW/kwBATkP5X4HiOGuYJ",
-	"DRoFp78Xxc8uno3Q9NwlkSstfeP0/yncWVAMiyl4VgxPVj4J/6uHKz7PhiLiqWjErAnyVRDXalr+mf3m",
-	"XzRK5MRy9RR+Ibonp7mjzjkXDNqn1C2S8mM2NIW0/Mjp2hsn3S9Gnf0zF15uxh1lTYTom/2BY6IT7bcP",
-	"55193IWeb7AU3kDlWNvf7TN/PZb4p9wRJ36bOL0keuD9z3Fmh4z4NM7uiOAcSFNVj2uhaWlcOvZOgGVX",
-	"1BFOfKL8w2IPqGxEJu1gChW2e4KyIPBrCrNF8Pfkvg6HV2P5eUI4nLpQngwOPaacVlyfI7//uP24/XcA",
-	"AAD//4p4AjLvFwAA",
+	"H4sIAAAAAAAC/+w7bXPbNpp/5RnezVyyI0tOm90PmbkPjp3d+ho3mchtNlPnA0Q+FFGDAAuAktmO//vN",
+	"A4AvEkFLvtbdbOdm+iKTBPC8v+PXJFVlpSRKa5JXvyYmLbBk7udZVYnmO1bid0qXTPBfmOVKfsCf6WWl",
+	"VYXacnSflqjXSD8yNKnmFX2YvEreo86VLiEtmFyjgS23BbCU3oJfMktsU2HyKlkpJZDJ5H6WaJSsfORu",
+	"YU1sO4N6g9mZHW/4TooGUiUNz1BDxk2BBlQOtuAGhEodvnM4E6L7ywDPQZXcWsz604zVXK6Tewf8zzXX",
+	"mCWvfkw6oDyun7vv1eonTC0B95oZnr7RWukxUbcFc0CPDxltc66RWXynv68yZvGCmyKwaRfha82kKbmF",
+	"jFkGufJIQ0qr6YvZHgT09rsoL+ipJxX6PayCFfqdMIMF1A6SCI0eYsjbQGXYFqjR88Htzg34VQdp3oE8",
+	"OOfzkRQz1RikS+lkjucekC0zHZJKw0+1sS2qwGQGGm2tJXBr4PJiRM+0wPT2ioThnMmM0zIzPvKcSVAk",
+	"misEq2t0jJK4DRI6h8vcPZ8NCGQKVYsMKq1WbOWXOqHLvJbgHTeWy3W3xSdVt2sybirBGsfK2qAGBoLL",
+	"W+IoPdriCiq2xhlsC54WkDJJzyXkaNPCfeJOgrRDCTac9dudvb+cRxUz0PE73BL5Y9JaIxGedcgPyX9g",
+	"y1aUDm0rOpE7sDWdf3kREZCLVg/C6kUrD7RiDv9DImK9PCso2S2CqVcGf65RWkiZEAaQGY46mSUka6T0",
+	"CZf2by97OLi0uEY9kvY9EnZQRikxi8vftHZ8xFWh1G3U4uMGpb1uqpgAv6F3QJsawpnQTTUnaVZOeIP9",
+	"JAXCsrLNrBU0Og00psg3aIAJAe4YM4cfmOBZ2JJphBuH6TxgeZPM4CbRjER895lXggv3rXMb2U3iFPUm",
+	"MVYju52XXKCxSuJNkswSbrE0EaPb0YhpzRr6u9ZijPnZyihRW4TC2uqZeQ7ff3gLtmC2x4pQ9Vg5NXn/",
+	"bnl90KjRWUewyVRjPvGM/ntQrshaphojNnnpnpMqZ46ZfC291UfBN6g5WZM3LC3aB86jWsalR7VARs71",
+	"nyeX1lwzY5uTJV9LZmuN3jTRRxsmauKp/e+b+vT067SW/A4sL9H9ibPNi/CiwDtAmaoMM/jm6uz8ZPnN",
+	"2Vd//Zv/7CaZw3WB7gWkaoPag9Dt5uVsAMpFC3KAkgSDPiHao7GwUlkzg58Ul5jBqoGbZE6HfPC81KY1",
+	"oRqJIwOSODJhBqVyboxJYJDjFkoua0sivFbusMGKbaEMAncANMCERpY1ZNBTNAYzj5pnEnDjHYT3NqRJ",
+	"MsWDUsSzpGNzVJpqrVHaHxSp0dJpR9wnvlUUf1lI/QLRgJJrRe5l49aCVy0z9n9+wTWycnhK5ASUa1uQ",
+	"YQ1LwCIrd7cHLiFjjYlKc1j2vUE9PMnv+9B5xH7h8ZsdQpBEgkngMuMbntVMOIcXhYdemI/cFlfsbgpp",
+	"ijPddyQLULANAjsIgspBeOAfRnlg2vaMdcm4aDHfhX/K+MUi0H+gnQ6iLtAyLsgl9o/pSOZ85EhM2Gb9",
+	"wdnyCI02qNkawdt6Fxl1QdAc3gXHwvPgcclVSEUkQwMN2h43WZcrzxp5VIAbo4pKPc1TPFe1jNjOs5Ke",
+	"u214iaYHFcLSLCosHjlPiizjtBkT73dINF60e7Knn9kn0LfYGCiRSef/mDbBGzoDHN6E01vrzCywDg23",
+	"xvnw3rL9grqlMJG70mhIYTsmQs5RZLtege2wcZ5EBIocprTvOhJHRPdKGe9YCbj+wyHb5nDF14UFqWwL",
+	"gQ82CiUQCm6s0s20cni/CU3TNPOynGfZMEaj8Omwsvze+c4ssWwdIwZa5tI6wW/Jl25wzeRN4jlIwS3e",
+	"Wc1SUo9cq7LP3EKOemzks+dUwup9VYjxrxfsAU0COp/jJiWENiYe24SI0f3uwP9PjXnyKvmPRV/XWISi",
+	"xiJsdxCpbuMYWJdlpbS9QlnHoWqTiAesgVZb4xkTPh7kOHGLoLbHYzkAUG2jAnnLq+pYCLckmVxuXACu",
+	"dBeZ3Eq1lXFfF1L/o7ZnWdah3wsRWEWedSdtPTobSnoQelwDDQ8wVG3H/JxK/Fz1yKAlb2Mss7UhtZXK",
+	"Qn/mEXG3RmaUPHb7fuuRmmq1He/ylkuEZ+fLH54T716crJjBzOcfwGWGd/CMnzOBMmP6OQTjWFdCMWJL",
+	"zgVGgfYA0XEo6/IQ7T8fLJSpbdJtGmNRiDiPD01xg/pQNBrCvCOi0eGmjwxEwyFHB6KT8LenuLjpSYPO",
+	"h0F+6kCS9DBeD7pwgZMMJSdZ+0JY68a4gYpp68PKUPuiFz7pc54xwDZ4SavaJOo3hKGUa7pyBbk18nD/",
+	"x1D0cI3J+83WHh5hXgQzdukWPRw/BchhhbmP7LosmI5jFvdQ4s4c0Wc516Zbf0x81NdkorjmYNDOoox1",
+	"xw7YdxwJjgvwQel96YhaWcfxg/F+G0SPJOOwF+tKeCG0Gp74eUJhhtXOsffC42OHTvsiYYOYLKkOaSn6",
+	"cuPDRn/wYQByCru4qSfL5Re2WqEkZZTMIqy1qitfsxkcs0cZEs9hbWxKXtsdHkfFjiURSpZM32YUPkWi",
+	"eFmDRpmhprDIwFX41GVylfLhEJfUBLMmBm0lGJfXeGeP2Nx9Cxbv7JHb74uqp1hPoOHxAzRjjB01Fs9d",
+	"fTbiV/IcU7ujqqQZIIeLQdcCjZOAcdi4Z9bTuBD7bl3XXaTD5J6NmLetDlWuuETTvQjJMtNrXwa5vGjb",
+	"JW24XDADz5SGLRcC1mif+wMCDpi5s2ZE+h3vNQfL1r7YZ6zS4cg+lXPJ06yLwvY6ji65ioRfs4RVleCY",
+	"TXdHbNGSwiWPle+9Uh3yTGwpDMmZML41VWnccNyaA22TIyw1to3QvWKGC5FhWzRDsFJXepXKUrOrxSeC",
+	"atz8h2LniMXRHTouPdAMZbklU089cxI92s/J5KEO6JHp/TVbR1P4R2bvs2QopA9kHcTYvU79Y5tTnSMb",
+	"JPsB3D2KBqRnrW728nmU5ZjIw70iH220pwzSoWJBe04M1LbgMFUk8CKw44FOLC+jbuihjtuybbG1uR2t",
+	"psYMtddcWW/YTnuUvBzdQAoNsSN6EPTlDj6zAT3GZPTNqVpz2yyJVximU/i32JzVPoviRAXfxWml7FXy",
+	"z5Oz95cn37751MPL3KrknjblMlcRRaMY8+z9JdHLVylctmEYrJT1LVxJoX8IPZjMQiecHkBf4bLcCjrx",
+	"8noJz75RFea1EM1zcJ0neK0sHZLMEuoj+ZNP5y/mp0RJVaFkFU9eJV/PT+dfkwdjtnBYL9LR3AI9Js8d",
+	"sXHu2757rnSYVQBu/8vAjUsOQvB0k4QuHM/pn9ZtOVdqfPOpd72uztF5rq6NF3zwbhzYZhTcta2cEyMZ",
+	"IGVw7y8zGu1Rxo4nMhIvO2jsa5U1Tm2UtOgjb2ch/BGLn0IFxavyIUWPD8vc74qq1TW6B6ZS0niR++r0",
+	"9EmBMJWHYl+30xSNobajny2ZmogAZmCLQtD/W3d2eeEseV67hiuJtZs4ICl7efpiLDKU9INEzFznXqg1",
+	"d178r78j5oORpwi2l9KilkyAiwVgVVvgsqp9CTvnEmnR/SxZeP1b/OqxvKdT17H+9T/QQub6T8aXxtvU",
+	"jDR32DXYaZWMJDT0t9BctF6tYpqVaFGb5NWPwQKRnvb2p3OAu2I1GxDqsE/9/IRCOGzaRXjRte249HCS",
+	"OrOVqm0fLjlB+qOE4zXL4NJJA9Vz5o+V4penL+NVpcsLF0fmqpbZFyruVPB6UMZZLB0mNq35BuVkWkwp",
+	"RyrqLMSsXIemGE+ZaFVlDl70Q8VeY7zS5t5oNEps/KzIuJwy0ilKSce6tIvd330T7tOnT59Orq5OLkij",
+	"nLb9XKNuBurms9EjlC2e6d/PpmpRLv/aJS6zu2OiAfmulBgDcFCK6IHaB+Ip9b0rpky7mX8rhf5C9XTB",
+	"XUdpOjDzHaehptEyitfdNNL58gfqkC26zkzbsGnjJ/LwTA77ZTuFzH299/rrQjcT6tBd8OZcIpcQCSzn",
+	"4OFs01lDoR+1hMBueYqQKfT9rpCQM9nYgtr5DgH60DieAWvnq7pIMVWiLqVx0M2C8SB3XPlZB9EMxq//",
+	"rjT0lMhJCHx1ePn91dXZh0+hIdKRyE2qMTMIV2nri+vl9dmHa/fCa/84AiX18ChPuPc9fQ725CGT07XH",
+	"zCaZJcTqaDdscjCAghJqXC7CyKCzwJlyZDcVpjxvQEn8zQZnKsr+y+IvuyrU2dAVl8ydtb/fSHeuiyA2",
+	"YV+fTfiNnEyjQFfUWPn6TnjTMYC4XZsgN9K26W3bbXVDrZiN2PCU0fveDMBDUfv5cO5Fo6mFL66gU3Wt",
+	"tv9WFvfli6//IEA7qcG71INB0QS74yUTYPgvzua9OIUr/voLdQVyv6C0cNXBabfgLtwcUeW2ajzQ/2an",
+	"JOysPPOFWu8yZuGKjM97XFQWhkh53nf65nAGOeOCdg5WvbPybKW0D/yVLVCbqAkdFdEcTk+UyE9fUPqD",
+	"k/l4RfIoq0Bhu6e0mYFB33kN1U/HKi9lboauzVGRa1C1TVWJf4KIaawmoaEwme4sC7UF+je4hJyva90m",
+	"IZNas3X9giDVI/3ZyXBC8YtwFs1+fYtUq78BNspoRrLwPmBzIMs56jLahJ8fFNj/NYnFYxXgTyC2xjJL",
+	"MpSaRToenDeTwnu2P9bTRTZU5LZ8g+AHQoj5buDHy6S/TEVtRBohyebwEfEWZWbCAG97PzEEhyu3+c5R",
+	"rozLDaDMKsWlDelqOP/AnHmwPGFw1g1BPetmWzgB0TzfL0CK4+4J+EKCQ2+0ZUzDlh3lz2OEf8qi7cQN",
+	"iYcFPQjvb5czMZ6Cm5azJSIcElLH1V1eZJhz6UbOJ4SlZWpkDm6C/WNmj8bXwu2WAFXJ+gswK3QzNIuV",
+	"VrcoHxaHtzH6PKE4TE0lPqU4DGeeo3x/y40vBmpcc2PdqEe3KEK+j/27p6wz70xy/2kdw2wisv8QeBE6",
+	"N4Efrt4jrQ8pwlUwV7toZFpoJVVtRNNeK9a8v8zrIedMwIqltyrP24mNcMtQ91G752lYyeCru7t2mjhV",
+	"WbwMsiMRT9V+G9wt/Zd03oaXJv+/GvpUQVJreBa/hl+hU5ehQIuxi2L0fHgdeA7vUbruxOCupNMXblKm",
+	"s0gQ7jdppfhje/BRLbvt4Osn6Nr9LjYv2sYKaH7ZfazBJIdjwHCG48fP95/v/3cAxATwwo5EAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -4,6 +4,8 @@
 package botAPI
 
 import (
+	"time"
+
 	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
 )
 
@@ -11,6 +13,38 @@ const (
 	ApiKeyAuthScopes = "ApiKeyAuth.Scopes"
 )
 
+// Defines values for ImportMenuRowStatus.
+const (
+	Created ImportMenuRowStatus = "created"
+	Skipped ImportMenuRowStatus = "skipped"
+	Updated ImportMenuRowStatus = "updated"
+)
+
+// Defines values for NameNormalizationChangeAction.
+const (
+	Merge  NameNormalizationChangeAction = "merge"
+	Rename NameNormalizationChangeAction = "rename"
+	Tag    NameNormalizationChangeAction = "tag"
+)
+
+// Defines values for PostMenuImportParamsFormat.
+const (
+	Csv  PostMenuImportParamsFormat = "csv"
+	Ical PostMenuImportParamsFormat = "ical"
+)
+
+// ApplyNameNormalizationReq defines model for ApplyNameNormalizationReq.
+type ApplyNameNormalizationReq struct {
+	// Merge Perform changes with action merge
+	Merge bool `json:"merge"`
+
+	// Rename Perform changes with action rename
+	Rename bool `json:"rename"`
+
+	// ServedAt Only consider dishes of this location. All locations if omitted
+	ServedAt *string `json:"servedAt,omitempty"`
+}
+
 // BasicError defines model for BasicError.
 type BasicError struct {
 	What *string `json:"what,omitempty"`
@@ -40,6 +74,23 @@ type CreateOrUpdateDishResp struct {
 	DishID int64 `json:"dishID"`
 }
 
+// CreateWebhookReq defines model for CreateWebhookReq.
+type CreateWebhookReq struct {
+	// EventTypes Event types to subscribe to. If omitted or empty, the webhook receives all events. Valid types are "dish.created", "rating.created", "mergedDish.changed" and "streak.milestone"
+	EventTypes *[]string `json:"eventTypes,omitempty"`
+
+	// Url Absolute http(s) URL that receives the events via POST
+	Url string `json:"url"`
+}
+
+// CreateWebhookResp defines model for CreateWebhookResp.
+type CreateWebhookResp struct {
+	Id int64 `json:"id"`
+
+	// Secret Secret used to sign the deliveries. Each delivery contains the header X-ItsTasty-Signature with the value "t=<unix time>,v1=<hex encoded HMAC-SHA256>". The HMAC covers the unix time, the X-ItsTasty-Delivery header and the request body, joined by ".". Receivers should reject deliveries signed more than a few minutes ago and deliveries whose id they already processed. The secret is only returned once
+	Secret string `json:"secret"`
+}
+
 // CurrentVotingStreakResp Longest currently ongoing voting streaks
 type CurrentVotingStreakResp struct {
 	// CurrentTeamVotingStreak Length of current team voting streak in days
@@ -71,8 +122,45 @@ type GetDishResp struct {
 
 	// ServedAt Location where this dish is served
 	ServedAt string `json:"servedAt"`
+
+	// Tags Metadata like "vegan" that was extracted from the dish name
+	Tags []string `json:"tags"`
+}
+
+// GetWebhooksResp defines model for GetWebhooksResp.
+type GetWebhooksResp struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+// ImportMenuResp defines model for ImportMenuResp.
+type ImportMenuResp struct {
+	// Created Amount of rows that created a new dish
+	Created int             `json:"created"`
+	Rows    []ImportMenuRow `json:"rows"`
+
+	// Skipped Amount of rows that were invalid or already known
+	Skipped int `json:"skipped"`
+
+	// Updated Amount of rows that added a new occurrence to an existing dish
+	Updated int `json:"updated"`
 }
 
+// ImportMenuRow defines model for ImportMenuRow.
+type ImportMenuRow struct {
+	// DishID Only set if status is not skipped
+	DishID *int64 `json:"dishID,omitempty"`
+
+	// Reason Only set if status is skipped
+	Reason *string `json:"reason,omitempty"`
+
+	// Row Line (CSV) or 1-based event index (iCalendar) in the uploaded file
+	Row    int                 `json:"row"`
+	Status ImportMenuRowStatus `json:"status"`
+}
+
+// ImportMenuRowStatus defines model for ImportMenuRow.Status.
+type ImportMenuRowStatus string
+
 // LongestVotingStreakResp Longest ever voting streaks
 type LongestVotingStreakResp struct {
 	// LongestTeamVotingStreak Longest ever team voting streak in days
@@ -85,5 +173,122 @@ type LongestVotingStreakResp struct {
 	UsersWithMaxStreak *[]string `json:"usersWithMaxStreak,omitempty"`
 }
 
+// MenuDish Dish on the menu. If the dish is part of a merged dish, the data of the merged dish is returned
+type MenuDish struct {
+	// AvgRating Average rating over all servings. Omitted if there are no votes yet
+	AvgRating *float32 `json:"avgRating,omitempty"`
+
+	// DishID ID of the served dish
+	DishID int64 `json:"dishID"`
+
+	// LastServed Most recent serving before the requested date. Omitted if this is the first serving
+	LastServed *openapi_types.Date `json:"lastServed,omitempty"`
+
+	// MergedDishID If set, the dish is part of this merged dish
+	MergedDishID *int64 `json:"mergedDishID,omitempty"`
+
+	// Name Name of the dish or the merged dish
+	Name string `json:"name"`
+
+	// RatingCount Amount of ratings over all servings
+	RatingCount int `json:"ratingCount"`
+}
+
+// MenuLocation defines model for MenuLocation.
+type MenuLocation struct {
+	Dishes []MenuDish `json:"dishes"`
+
+	// Location Name of the location
+	Location string `json:"location"`
+}
+
+// MenuResp All dishes served on a date grouped by location
+type MenuResp struct {
+	Date      openapi_types.Date `json:"date"`
+	Locations []MenuLocation     `json:"locations"`
+
+	// Markdown Menu rendered as Markdown for posting in chats
+	Markdown string `json:"markdown"`
+
+	// PlainText Menu rendered as plain text for posting in chats
+	PlainText string `json:"plainText"`
+}
+
+// NameNormalizationChange Effect of the dish name normalization rules on an existing dish
+type NameNormalizationChange struct {
+	// Action rename changes the name of the dish. merge combines the dish with targetDishID, which already has (or will get) the normalized name, in a merged dish. tag only stores the extracted tags
+	Action NameNormalizationChangeAction `json:"action"`
+
+	// Applied True if the change was performed. Always false for previews
+	Applied bool  `json:"applied"`
+	DishID  int64 `json:"dishID"`
+
+	// Error Reason why the change could not be applied
+	Error *string `json:"error,omitempty"`
+
+	// Name Current name of the dish
+	Name string `json:"name"`
+
+	// NormalizedName Name after applying the rules
+	NormalizedName string `json:"normalizedName"`
+	ServedAt       string `json:"servedAt"`
+
+	// Tags Tags extracted from the name
+	Tags []string `json:"tags"`
+
+	// TargetDishID Only set for action merge
+	TargetDishID *int64 `json:"targetDishID,omitempty"`
+}
+
+// NameNormalizationChangeAction rename changes the name of the dish. merge combines the dish with targetDishID, which already has (or will get) the normalized name, in a merged dish. tag only stores the extracted tags
+type NameNormalizationChangeAction string
+
+// NameNormalizationResp defines model for NameNormalizationResp.
+type NameNormalizationResp struct {
+	Changes []NameNormalizationChange `json:"changes"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// EventTypes Subscribed event types. Empty means all events
+	EventTypes []string `json:"eventTypes"`
+	Id         int64    `json:"id"`
+	Url        string   `json:"url"`
+}
+
+// GetMenuParams defines parameters for GetMenu.
+type GetMenuParams struct {
+	// Date Format YYYY-MM-DD
+	Date openapi_types.Date `form:"date" json:"date"`
+
+	// Location If set, only dishes served at this location are returned
+	Location *string `form:"location,omitempty" json:"location,omitempty"`
+}
+
+// PostMenuImportParams defines parameters for PostMenuImport.
+type PostMenuImportParams struct {
+	Format PostMenuImportParamsFormat `form:"format" json:"format"`
+
+	// Location Location for rows/events that do not specify one
+	Location *string `form:"location,omitempty" json:"location,omitempty"`
+}
+
+// PostMenuImportParamsFormat defines parameters for PostMenuImport.
+type PostMenuImportParamsFormat string
+
+// GetNameNormalizationPreviewParams defines parameters for GetNameNormalizationPreview.
+type GetNameNormalizationPreviewParams struct {
+	// ServedAt Only consider dishes of this location
+	ServedAt *string `form:"servedAt,omitempty" json:"servedAt,omitempty"`
+}
+
 // PostCreateOrUpdateDishJSONRequestBody defines body for PostCreateOrUpdateDish for application/json ContentType.
 type PostCreateOrUpdateDishJSONRequestBody = CreateOrUpdateDishReq
+
+// PostNameNormalizationApplyJSONRequestBody defines body for PostNameNormalizationApply for application/json ContentType.
+type PostNameNormalizationApplyJSONRequestBody = ApplyNameNormalizationReq
+
+// PostWebhooksJSONRequestBody defines body for PostWebhooks for application/json ContentType.
+type PostWebhooksJSONRequestBody = CreateWebhookReq
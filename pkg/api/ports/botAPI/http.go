@@ -1,15 +1,22 @@
 package botAPI
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"github.com/deepmap/oapi-codegen/pkg/types"
 	"github.com/sourcegraph/conc/pool"
+	"io"
 	"itsTasty/pkg/api/domain"
+	"itsTasty/pkg/api/menuImporter"
+	"itsTasty/pkg/api/nameNormalizer"
 	"itsTasty/pkg/api/ports"
 	"itsTasty/pkg/api/statisticsService"
-	"log"
-	"strings"
+	"itsTasty/pkg/api/webhookService"
+	"itsTasty/pkg/httpSecurity"
+	"itsTasty/pkg/logging"
+	"sort"
 	"time"
 )
 
@@ -18,9 +25,19 @@ import (
 
 const defaultDBTimeout = 5 * time.Second
 
+// menuImportTimeout is the time limit for importing a whole file in PostMenuImport
+const menuImportTimeout = 2 * time.Minute
+
+// MaxMenuImportSize is the maximal accepted file size in PostMenuImport. The body limit of the route has to allow it
+const MaxMenuImportSize = 10 << 20
+
 type Service struct {
 	repo          domain.DishRepo
+	locations     domain.LocationRepo
 	streakService statisticsService.StreakService
+	webhooks      webhookService.WebhookService
+	normalizer    *nameNormalizer.Normalizer
+	normalization nameNormalizer.NormalizationService
 	timeSource    TimeSource
 }
 
@@ -38,20 +55,25 @@ func (d defaultTimeSource) Now() time.Time {
 	return time.Now()
 }
 
-func NewService(repo domain.DishRepo, streak statisticsService.StreakService) *Service {
-	return &Service{
-		repo:          repo,
-		streakService: streak,
-		timeSource:    defaultTimeSource{},
-	}
+func NewService(repo domain.DishRepo, locations domain.LocationRepo, streak statisticsService.StreakService,
+	webhooks webhookService.WebhookService, normalizer *nameNormalizer.Normalizer) *Service {
+	return NewServiceCustomTime(repo, locations, streak, webhooks, normalizer, defaultTimeSource{})
 }
 
-type ServiceFactory func(repo domain.DishRepo, streakService statisticsService.StreakService) *Service
+type ServiceFactory func(repo domain.DishRepo, locations domain.LocationRepo,
+	streakService statisticsService.StreakService, webhooks webhookService.WebhookService,
+	normalizer *nameNormalizer.Normalizer) *Service
 
-func NewServiceCustomTime(repo domain.DishRepo, streakService statisticsService.StreakService, timeSource TimeSource) *Service {
+func NewServiceCustomTime(repo domain.DishRepo, locations domain.LocationRepo,
+	streakService statisticsService.StreakService, webhooks webhookService.WebhookService,
+	normalizer *nameNormalizer.Normalizer, timeSource TimeSource) *Service {
 	return &Service{
 		repo:          repo,
+		locations:     locations,
 		streakService: streakService,
+		webhooks:      webhooks,
+		normalizer:    normalizer,
+		normalization: nameNormalizer.NewDefaultNormalizationService(repo, normalizer),
 		timeSource:    timeSource,
 	}
 }
@@ -62,7 +84,7 @@ func (s *Service) GetStatisticsLongestVotingStreaks(ctx context.Context, request
 	updateCtx, updateCancel := context.WithTimeout(ctx, 10*time.Second)
 	defer updateCancel()
 	if err := s.streakService.UpdateRatingStreaks(updateCtx); err != nil {
-		log.Printf("Failed to update rating streaks : %v", err)
+		logging.FromContext(ctx).Error("failed to update rating streaks", "err", err)
 		if !errors.Is(err, context.DeadlineExceeded) {
 			return GetStatisticsLongestVotingStreaks500Response{}, nil
 		}
@@ -70,7 +92,7 @@ func (s *Service) GetStatisticsLongestVotingStreaks(ctx context.Context, request
 
 	userStreaks, allUsersGroupStreak, err := s.streakService.GetLongestStreaks(ctx)
 	if err != nil {
-		log.Printf("failed to get longest streaks : %v", err)
+		logging.FromContext(ctx).Error("failed to get longest streaks", "err", err)
 		return GetStatisticsLongestVotingStreaks500Response{}, nil
 	}
 
@@ -104,7 +126,7 @@ func (s *Service) GetStatisticsCurrentVotingStreaks(ctx context.Context, request
 	updateCtx, updateCancel := context.WithTimeout(ctx, 10*time.Second)
 	defer updateCancel()
 	if err := s.streakService.UpdateRatingStreaks(updateCtx); err != nil {
-		log.Printf("Failed to update rating streaks : %v", err)
+		logging.FromContext(ctx).Error("failed to update rating streaks", "err", err)
 		if !errors.Is(err, context.DeadlineExceeded) {
 			return GetStatisticsCurrentVotingStreaks500Response{}, nil
 		}
@@ -157,7 +179,7 @@ func (s *Service) GetStatisticsCurrentVotingStreaks(ctx context.Context, request
 	})
 
 	if err := fetchPool.Wait(); err != nil {
-		log.Printf("at least one request in fetchPool failed : %v", err)
+		logging.FromContext(ctx).Error("at least one request in fetchPool failed", "err", err)
 		return GetStatisticsCurrentVotingStreaks500Response{}, nil
 	}
 
@@ -181,31 +203,30 @@ func (s *Service) PostCreateOrUpdateDish(ctx context.Context, request PostCreate
 	dbCtx, dbCancel := context.WithTimeout(ctx, defaultDBTimeout)
 	defer dbCancel()
 
-	sanitizeName := func(s string) string {
-		prefixes := []string{
-			`"""YOUR FAVORITES""`,
-			`"YOUR FAVORITES"`,
-			"Begrenztes Angebot :",
-			"BEGRENZTES ANGEBOT:",
-			"VEGANISSIMO: ",
-		}
-		for _, v := range prefixes {
-			s = strings.TrimPrefix(s, v)
-		}
-
-		s = strings.Trim(s, " ")
-		return s
-
+	//scrapers may use an alias of the location
+	servedAt, err := s.locations.ResolveLocationName(dbCtx, request.Body.ServedAt)
+	if err != nil {
+		logging.FromContext(ctx).Error("ResolveLocationName failed", "location", request.Body.ServedAt, "err", err)
+		return PostCreateOrUpdateDish500JSONResponse{}, nil
 	}
+	request.Body.ServedAt = servedAt
 
-	request.Body.DishName = sanitizeName(request.Body.DishName)
+	normalized := s.normalizer.Normalize(request.Body.ServedAt, request.Body.DishName)
+	request.Body.DishName = normalized.Name
 
 	_, createdDish, createdLocation, dishID, err := s.repo.GetOrCreateDish(dbCtx, request.Body.DishName, request.Body.ServedAt)
 	if err != nil {
-		log.Printf("GetOrCreateDish for dishName %v : %v", request.Body.DishName, err)
+		logging.FromContext(ctx).Error("GetOrCreateDish failed", "dishName", request.Body.DishName, "err", err)
 		return PostCreateOrUpdateDish500JSONResponse{}, nil
 	}
 
+	if len(normalized.Tags) > 0 {
+		if err := s.repo.AddDishTags(dbCtx, dishID, normalized.Tags); err != nil {
+			logging.FromContext(ctx).Error("AddDishTags failed", "dishID", dishID, "err", err)
+			return PostCreateOrUpdateDish500JSONResponse{}, nil
+		}
+	}
+
 	dbCancel()
 	dbCtx, dbCancel = context.WithTimeout(ctx, defaultDBTimeout)
 	defer dbCancel()
@@ -222,7 +243,7 @@ func (s *Service) PostCreateOrUpdateDish(ctx context.Context, request PostCreate
 		return nil, nil
 	})
 	if err != nil {
-		log.Printf("UPdateDish for dishID %v : %v", dishID, err)
+		logging.FromContext(ctx).Error("UpdateDish failed", "dishID", dishID, "err", err)
 		return PostCreateOrUpdateDish500JSONResponse{}, nil
 	}
 
@@ -232,11 +253,18 @@ func (s *Service) PostCreateOrUpdateDish(ctx context.Context, request PostCreate
 		dbCancel()
 		dbCtx, dbCancel = context.WithTimeout(ctx, defaultDBTimeout)
 		defer dbCancel()
+
+		event := domain.NewDishCreatedEvent(s.timeSource.Now(), dishID, request.Body.DishName, request.Body.ServedAt)
+		if err := s.webhooks.Publish(dbCtx, event); err != nil {
+			//dish has already been created, don't fail the request because of the notification
+			logging.FromContext(ctx).Error("failed to publish dish created event", "dishID", dishID, "err", err)
+		}
+
 		mergeCandidates, err := ports.FetchMergeCandidates(dbCtx, dishID, s.repo)
 		if err != nil {
-			log.Printf("ports.FetchMergeCandidates failed with : %v", err)
+			logging.FromContext(ctx).Error("ports.FetchMergeCandidates failed", "dishID", dishID, "err", err)
 			if errors.Is(err, domain.ErrNotFound) {
-				log.Printf("domain.ErrNotFound should never happen here, since we just created the dish")
+				logging.FromContext(ctx).Error("domain.ErrNotFound should never happen here, since we just created the dish")
 			}
 			return PostCreateOrUpdateDish500JSONResponse{}, nil
 		}
@@ -275,7 +303,13 @@ func (s *Service) GetDishesDishID(ctx context.Context, request GetDishesDishIDRe
 
 	basicDishData, err := ports.FetchBasicDishData(dbCtx, s.repo, request.DishID)
 	if err != nil {
-		log.Printf("FetchBasicDishData for dishID %v failed : %v", request.DishID, err)
+		logging.FromContext(ctx).Warn("FetchBasicDishData failed", "dishID", request.DishID, "err", err)
+		return GetDishesDishID500JSONResponse{}, nil
+	}
+
+	tags, err := s.repo.GetDishTags(dbCtx, request.DishID)
+	if err != nil {
+		logging.FromContext(ctx).Error("GetDishTags failed", "dishID", request.DishID, "err", err)
 		return GetDishesDishID500JSONResponse{}, nil
 	}
 
@@ -286,7 +320,248 @@ func (s *Service) GetDishesDishID(ctx context.Context, request GetDishesDishIDRe
 		OccurrenceCount:   basicDishData.OccurrenceCount,
 		Ratings:           basicDishData.Ratings,
 		RecentOccurrences: basicDishData.RecentOccurrences,
+		Tags:              tags,
 	}
 
 	return response, nil
 }
+
+func (s *Service) GetMenu(ctx context.Context, request GetMenuRequestObject) (GetMenuResponseObject, error) {
+	dbCtx, dbCancel := context.WithTimeout(ctx, defaultDBTimeout)
+	defer dbCancel()
+
+	menu, err := ports.FetchMenu(dbCtx, s.repo, request.Params.Date.Time, request.Params.Location)
+	if err != nil {
+		logging.FromContext(ctx).Error("FetchMenu failed", "date", request.Params.Date, "err", err)
+		return GetMenu500JSONResponse{}, nil
+	}
+
+	//
+	// Assemble Response
+	//
+
+	locations := make([]MenuLocation, len(menu.Locations))
+	for i, location := range menu.Locations {
+		dishes := make([]MenuDish, len(location.Dishes))
+		for j, dish := range location.Dishes {
+			dishes[j] = MenuDish{
+				AvgRating:    dish.AvgRating,
+				DishID:       dish.DishID,
+				MergedDishID: dish.MergedDishID,
+				Name:         dish.Name,
+				RatingCount:  dish.RatingCount,
+			}
+			if dish.LastServed != nil {
+				dishes[j].LastServed = &types.Date{Time: *dish.LastServed}
+			}
+		}
+		locations[i] = MenuLocation{
+			Dishes:   dishes,
+			Location: location.Name,
+		}
+	}
+
+	return GetMenu200JSONResponse{
+		Date:      request.Params.Date,
+		Locations: locations,
+		Markdown:  menu.RenderMarkdown(),
+		PlainText: menu.RenderPlain(),
+	}, nil
+}
+
+func (s *Service) PostMenuImport(ctx context.Context, request PostMenuImportRequestObject) (PostMenuImportResponseObject, error) {
+	badRequest := func(what string) PostMenuImportResponseObject {
+		return PostMenuImport400JSONResponse{What: &what}
+	}
+
+	format, err := menuImporter.ParseFormat(string(request.Params.Format))
+	if err != nil {
+		return badRequest(err.Error()), nil
+	}
+	if request.Body == nil {
+		return badRequest("missing file"), nil
+	}
+	tooLarge := func() PostMenuImportResponseObject {
+		what := fmt.Sprintf("file exceeds %v bytes", MaxMenuImportSize)
+		return PostMenuImport413JSONResponse{What: &what}
+	}
+	content, err := io.ReadAll(io.LimitReader(request.Body, MaxMenuImportSize+1))
+	if err != nil {
+		if httpSecurity.IsBodyTooLarge(err) {
+			return tooLarge(), nil
+		}
+		logging.FromContext(ctx).Warn("failed to read uploaded menu", "err", err)
+		return badRequest("failed to read file"), nil
+	}
+	if len(content) > MaxMenuImportSize {
+		return tooLarge(), nil
+	}
+
+	defaultLocation := ""
+	if request.Params.Location != nil {
+		defaultLocation = *request.Params.Location
+	}
+	entries, rowErrors, err := menuImporter.Parse(bytes.NewReader(content), format, defaultLocation)
+	if err != nil {
+		return badRequest(err.Error()), nil
+	}
+
+	importCtx, importCancel := context.WithTimeout(ctx, menuImportTimeout)
+	defer importCancel()
+	report, err := menuImporter.NewImporter(s.repo, s.locations, s.normalizer).Import(importCtx, entries, rowErrors)
+	if err != nil {
+		logging.FromContext(ctx).Warn("menu import failed", "err", err)
+		return PostMenuImport500JSONResponse{}, nil
+	}
+
+	rows := make([]ImportMenuRow, len(report.Rows))
+	for i, v := range report.Rows {
+		rows[i] = ImportMenuRow{
+			Row:    v.Row,
+			Status: ImportMenuRowStatus(v.Status),
+		}
+		if v.Status == menuImporter.RowSkipped {
+			reason := v.Reason
+			rows[i].Reason = &reason
+		} else {
+			dishID := v.DishID
+			rows[i].DishID = &dishID
+		}
+	}
+
+	return PostMenuImport200JSONResponse{
+		Created: report.Created,
+		Updated: report.Updated,
+		Skipped: report.Skipped,
+		Rows:    rows,
+	}, nil
+}
+
+func (s *Service) GetWebhooks(ctx context.Context, request GetWebhooksRequestObject) (GetWebhooksResponseObject, error) {
+	dbCtx, dbCancel := context.WithTimeout(ctx, defaultDBTimeout)
+	defer dbCancel()
+
+	endpoints, err := s.webhooks.GetEndpoints(dbCtx)
+	if err != nil {
+		logging.FromContext(ctx).Error("GetEndpoints failed", "err", err)
+		return GetWebhooks500JSONResponse{}, nil
+	}
+
+	webhooks := make([]Webhook, 0, len(endpoints))
+	for id, endpoint := range endpoints {
+		eventTypes := make([]string, len(endpoint.EventTypes))
+		for i, v := range endpoint.EventTypes {
+			eventTypes[i] = string(v)
+		}
+		webhooks = append(webhooks, Webhook{
+			CreatedAt:  endpoint.CreatedAt,
+			EventTypes: eventTypes,
+			Id:         id,
+			Url:        endpoint.URL,
+		})
+	}
+	sort.Slice(webhooks, func(i, j int) bool {
+		return webhooks[i].Id < webhooks[j].Id
+	})
+
+	return GetWebhooks200JSONResponse{Webhooks: webhooks}, nil
+}
+
+func (s *Service) PostWebhooks(ctx context.Context, request PostWebhooksRequestObject) (PostWebhooksResponseObject, error) {
+	dbCtx, dbCancel := context.WithTimeout(ctx, defaultDBTimeout)
+	defer dbCancel()
+
+	eventTypes := make([]domain.EventType, 0)
+	if request.Body.EventTypes != nil {
+		for _, v := range *request.Body.EventTypes {
+			eventTypes = append(eventTypes, domain.EventType(v))
+		}
+	}
+
+	id, secret, err := s.webhooks.RegisterEndpoint(dbCtx, request.Body.Url, eventTypes)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidWebhookURL) || errors.Is(err, domain.ErrUnknownEventType) {
+			errStr := err.Error()
+			return PostWebhooks400JSONResponse{What: &errStr}, nil
+		}
+		logging.FromContext(ctx).Warn("RegisterEndpoint failed", "url", request.Body.Url, "err", err)
+		return PostWebhooks500JSONResponse{}, nil
+	}
+
+	return PostWebhooks200JSONResponse{
+		Id:     id,
+		Secret: secret,
+	}, nil
+}
+
+func (s *Service) DeleteWebhooksWebhookID(ctx context.Context, request DeleteWebhooksWebhookIDRequestObject) (DeleteWebhooksWebhookIDResponseObject, error) {
+	dbCtx, dbCancel := context.WithTimeout(ctx, defaultDBTimeout)
+	defer dbCancel()
+
+	if err := s.webhooks.DeleteEndpoint(dbCtx, request.WebhookID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return DeleteWebhooksWebhookID404Response{}, nil
+		}
+		logging.FromContext(ctx).Warn("DeleteEndpoint failed", "webhookID", request.WebhookID, "err", err)
+		return DeleteWebhooksWebhookID500JSONResponse{}, nil
+	}
+
+	return DeleteWebhooksWebhookID200Response{}, nil
+}
+
+// nameNormalizationTimeout is the time limit for previewing or applying the name normalization rules to all dishes
+const nameNormalizationTimeout = 2 * time.Minute
+
+func nameNormalizationChangesToAPI(changes []nameNormalizer.Change) NameNormalizationResp {
+	resp := NameNormalizationResp{Changes: make([]NameNormalizationChange, 0, len(changes))}
+	for _, v := range changes {
+		change := NameNormalizationChange{
+			Action:         NameNormalizationChangeAction(v.Action),
+			Applied:        v.Applied,
+			DishID:         v.DishID,
+			Name:           v.Name,
+			NormalizedName: v.NormalizedName,
+			ServedAt:       v.Location,
+			Tags:           v.Tags,
+		}
+		if v.Action == nameNormalizer.ActionMerge {
+			targetDishID := v.TargetDishID
+			change.TargetDishID = &targetDishID
+		}
+		if v.Error != "" {
+			errMsg := v.Error
+			change.Error = &errMsg
+		}
+		resp.Changes = append(resp.Changes, change)
+	}
+	return resp
+}
+
+func (s *Service) GetNameNormalizationPreview(ctx context.Context, request GetNameNormalizationPreviewRequestObject) (GetNameNormalizationPreviewResponseObject, error) {
+	previewCtx, previewCancel := context.WithTimeout(ctx, nameNormalizationTimeout)
+	defer previewCancel()
+
+	changes, err := s.normalization.Preview(previewCtx, request.Params.ServedAt)
+	if err != nil {
+		logging.FromContext(ctx).Error("preview name normalization failed", "err", err)
+		return GetNameNormalizationPreview500JSONResponse{}, nil
+	}
+
+	return GetNameNormalizationPreview200JSONResponse(nameNormalizationChangesToAPI(changes)), nil
+}
+
+func (s *Service) PostNameNormalizationApply(ctx context.Context, request PostNameNormalizationApplyRequestObject) (PostNameNormalizationApplyResponseObject, error) {
+	applyCtx, applyCancel := context.WithTimeout(ctx, nameNormalizationTimeout)
+	defer applyCancel()
+
+	changes, err := s.normalization.Apply(applyCtx, request.Body.ServedAt, nameNormalizer.ApplyOptions{
+		Rename: request.Body.Rename,
+		Merge:  request.Body.Merge,
+	})
+	if err != nil {
+		logging.FromContext(ctx).Error("apply name normalization failed", "err", err)
+		return PostNameNormalizationApply500JSONResponse{}, nil
+	}
+
+	return PostNameNormalizationApply200JSONResponse(nameNormalizationChangesToAPI(changes)), nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/sourcegraph/conc/iter"
 	"github.com/sourcegraph/conc/pool"
 	"itsTasty/pkg/api/domain"
+	"sort"
 	"time"
 )
 
@@ -81,18 +82,58 @@ func FetchDishResourcesByID(ctx context.Context, repo domain.DishRepo, dishID in
 	return result, nil
 }
 
-func FetchBasicDishData(ctx context.Context, repo domain.DishRepo, dishID int64) (*BasicDishReply, error) {
+// dishOrMergedDishData holds the data of a dish or, if the dish is part of a merged dish, the data of all dishes
+// condensed in the merged dish
+type dishOrMergedDishData struct {
+	dishes []*DishWithRatings
+	//mergedDish is nil if the dish is not part of a merged dish
+	mergedDish   *domain.MergedDish
+	mergedDishID int64
+}
+
+// occurrences returns all unique occurrences in ascending order
+func (d *dishOrMergedDishData) occurrences() []time.Time {
+	if d.mergedDish == nil {
+		return d.dishes[0].Dish.Occurrences()
+	}
+	rawOccurrences := make([]time.Time, 0)
+	for _, v := range d.dishes {
+		rawOccurrences = append(rawOccurrences, v.Dish.Occurrences()...)
+	}
+	return d.mergedDish.GetUniqueOccurrences(rawOccurrences)
+}
+
+// nameAndLocation returns the name and location of the dish or the merged dish. The last result is only set
+// for merged dishes
+func (d *dishOrMergedDishData) nameAndLocation() (string, string, *int64) {
+	if d.mergedDish == nil {
+		return d.dishes[0].Dish.Name, d.dishes[0].Dish.ServedAt, nil
+	}
+	mergedDishID := d.mergedDishID
+	return d.mergedDish.Name, d.mergedDish.ServedAt, &mergedDishID
+}
+
+// ratings returns the ratings of all dishes
+func (d *dishOrMergedDishData) ratings() []domain.DishRating {
+	allDishRatings := make([]domain.DishRating, 0)
+	for _, v := range d.dishes {
+		allDishRatings = append(allDishRatings, v.Ratings...)
+	}
+	return allDishRatings
+}
 
+func fetchDishOrMergedDishData(ctx context.Context, repo domain.DishRepo, dishID int64) (*dishOrMergedDishData, error) {
 	isPartOfMergedDish, mergedDishID, err := repo.IsDishPartOfMergedDisByID(ctx, dishID)
 	if err != nil {
-		return nil, fmt.Errorf("IsDishPartOfMergedDisByID failed : %v", err)
+		return nil, fmt.Errorf("IsDishPartOfMergedDisByID failed : %w", err)
 	}
 
-	dataForResponse := make([]*DishWithRatings, 0)
-	var mergedDish *domain.MergedDish
+	result := &dishOrMergedDishData{
+		dishes: make([]*DishWithRatings, 0),
+	}
 
 	if isPartOfMergedDish {
-		mergedDish, err = repo.GetMergedDishByID(ctx, mergedDishID)
+		mergedDish, err := repo.GetMergedDishByID(ctx, mergedDishID)
 		if err != nil {
 
 			return nil, fmt.Errorf("failed to check if dish %v is part of a merged dish : %v",
@@ -105,15 +146,44 @@ func FetchBasicDishData(ctx context.Context, repo domain.DishRepo, dishID int64)
 		if err != nil {
 			return nil, fmt.Errorf("failed to fetch at lesat one dish contained in merged dish (name=%v, id=%v) :  %v", mergedDish.Name, mergedDishID, err)
 		}
-		dataForResponse = append(dataForResponse, dishData...)
+		result.dishes = append(result.dishes, dishData...)
+		result.mergedDish = mergedDish
+		result.mergedDishID = mergedDishID
 	} else {
 		dishData, err := FetchDishResourcesByID(ctx, repo, dishID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to fetch dish %v: %v", dishID, err)
+			return nil, fmt.Errorf("failed to fetch dish %v: %w", dishID, err)
 		}
-		dataForResponse = append(dataForResponse, dishData)
+		result.dishes = append(result.dishes, dishData)
 	}
 
+	return result, nil
+}
+
+// summarizeRatings returns the average rating, which is nil if there are no ratings, and the amount of ratings per
+// rating value
+func summarizeRatings(allRatings []domain.DishRating) (*float32, map[string]int, error) {
+	var avgRating *float32
+	if v, err := domain.AverageRating(allRatings); err != nil {
+		if !errors.Is(err, domain.ErrNoVotes) {
+			return nil, nil, fmt.Errorf("failed to calculate average rating : %v", err)
+		}
+	} else {
+		avgRating = &v
+	}
+	ratings := make(map[string]int)
+	for k, v := range domain.Ratings(allRatings) {
+		ratings[fmt.Sprintf("%v", k)] = v
+	}
+	return avgRating, ratings, nil
+}
+
+func FetchBasicDishData(ctx context.Context, repo domain.DishRepo, dishID int64) (*BasicDishReply, error) {
+
+	data, err := fetchDishOrMergedDishData(ctx, repo, dishID)
+	if err != nil {
+		return nil, err
+	}
 	//
 	//Assemble response
 	//
@@ -122,16 +192,7 @@ func FetchBasicDishData(ctx context.Context, repo domain.DishRepo, dishID int64)
 
 	const maxOccurrencesInAnswer = 10
 
-	occurrences := make([]time.Time, 0)
-	if mergedDish == nil {
-		occurrences = dataForResponse[0].Dish.Occurrences()
-	} else {
-		rawOccurrences := make([]time.Time, 0)
-		for _, v := range dataForResponse {
-			rawOccurrences = append(rawOccurrences, v.Dish.Occurrences()...)
-		}
-		occurrences = mergedDish.GetUniqueOccurrences(rawOccurrences)
-	}
+	occurrences := data.occurrences()
 	occurrenceCountForResponse := maxOccurrencesInAnswer
 	if maxOccurrencesInAnswer > len(occurrences) {
 		occurrenceCountForResponse = len(occurrences)
@@ -143,41 +204,13 @@ func FetchBasicDishData(ctx context.Context, repo domain.DishRepo, dishID int64)
 
 	//rating data
 
-	allDishRatings := make([]domain.DishRating, 0)
-	for _, v := range dataForResponse {
-		allDishRatings = append(allDishRatings, v.Ratings...)
-	}
-	var avgRating *float32
-	if v, err := domain.AverageRating(allDishRatings); err != nil {
-		if !errors.Is(err, domain.ErrNoVotes) {
-			return nil, fmt.Errorf("failed to calculate average rating : %v", err)
-		}
-	} else {
-		avgRating = &v
-	}
-	ratings := make(map[string]int)
-	for k, v := range domain.Ratings(allDishRatings) {
-		ratings[fmt.Sprintf("%v", k)] = v
+	avgRating, ratings, err := summarizeRatings(data.ratings())
+	if err != nil {
+		return nil, err
 	}
 
-	var name string
-	if mergedDish == nil {
-		name = dataForResponse[0].Dish.Name
-	} else {
-		name = mergedDish.Name
-	}
+	name, servedAt, respMergeDishID := data.nameAndLocation()
 
-	var servedAt string
-	if mergedDish == nil {
-		servedAt = dataForResponse[0].Dish.ServedAt
-	} else {
-		servedAt = mergedDish.ServedAt
-	}
-
-	var respMergeDishID *int64 = nil
-	if isPartOfMergedDish {
-		respMergeDishID = &mergedDishID
-	}
 	return &BasicDishReply{
 		AvgRating:         avgRating, //updated below if data is available
 		Name:              name,
@@ -188,3 +221,91 @@ func FetchBasicDishData(ctx context.Context, repo domain.DishRepo, dishID int64)
 		MergedDishID:      respMergeDishID,
 	}, nil
 }
+
+type RatingTrendReply struct {
+	Name     string
+	ServedAt string
+	//MergedDishID is set if the trend covers all dishes condensed in this merged dish
+	MergedDishID *int64
+	Trend        []domain.RatingTrendEntry
+}
+
+// FetchRatingTrend returns the rating trend per serving for the dish. If the dish is part of a merged dish,
+// the trend covers all dishes condensed in the merged dish
+func FetchRatingTrend(ctx context.Context, repo domain.DishRepo, dishID int64) (*RatingTrendReply, error) {
+	data, err := fetchDishOrMergedDishData(ctx, repo, dishID)
+	if err != nil {
+		return nil, err
+	}
+
+	name, servedAt, mergedDishID := data.nameAndLocation()
+	return &RatingTrendReply{
+		Name:         name,
+		ServedAt:     servedAt,
+		MergedDishID: mergedDishID,
+		Trend:        domain.NewRatingTrend(data.occurrences(), data.ratings()),
+	}, nil
+}
+
+// DishFamilyLocationReply contains the ratings of a dish family at a single location
+type DishFamilyLocationReply struct {
+	DishID int64
+	//Name is the name of the dish or, if the dish is part of a merged dish, the name of the merged dish
+	Name     string
+	ServedAt string
+	//MergedDishID is set if the ratings cover all dishes condensed in this merged dish
+	MergedDishID *int64
+	AvgRating    *float32
+	Ratings      map[string]int
+}
+
+type DishFamilyReply struct {
+	Name string
+	//AvgRating and Ratings combine the ratings of all locations
+	AvgRating *float32
+	Ratings   map[string]int
+	//Locations are sorted by location
+	Locations []DishFamilyLocationReply
+}
+
+// FetchDishFamilyData returns the combined and the per location ratings of the family. Dishes that are part of a
+// merged dish contribute the ratings of the whole merged dish
+func FetchDishFamilyData(ctx context.Context, repo domain.DishRepo, family domain.DishFamily) (*DishFamilyReply, error) {
+	mapper := iter.Mapper[domain.DishFamilyMember, *dishOrMergedDishData]{MaxGoroutines: 3}
+	members, err := mapper.MapErr(family.Members, func(v *domain.DishFamilyMember) (*dishOrMergedDishData, error) {
+		return fetchDishOrMergedDishData(ctx, repo, v.DishID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch at least one dish of the dish family : %w", err)
+	}
+
+	result := &DishFamilyReply{
+		Name:      family.Name,
+		Locations: make([]DishFamilyLocationReply, 0, len(members)),
+	}
+	allRatings := make([]domain.DishRating, 0)
+	for i, v := range members {
+		memberRatings := v.ratings()
+		allRatings = append(allRatings, memberRatings...)
+		avgRating, ratings, err := summarizeRatings(memberRatings)
+		if err != nil {
+			return nil, err
+		}
+		name, servedAt, mergedDishID := v.nameAndLocation()
+		result.Locations = append(result.Locations, DishFamilyLocationReply{
+			DishID:       family.Members[i].DishID,
+			Name:         name,
+			ServedAt:     servedAt,
+			MergedDishID: mergedDishID,
+			AvgRating:    avgRating,
+			Ratings:      ratings,
+		})
+	}
+	sort.Slice(result.Locations, func(i, j int) bool {
+		return result.Locations[i].ServedAt < result.Locations[j].ServedAt
+	})
+	if result.AvgRating, result.Ratings, err = summarizeRatings(allRatings); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
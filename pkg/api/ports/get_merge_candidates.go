@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 	"itsTasty/pkg/api/domain"
-	"log"
+	"itsTasty/pkg/logging"
 	"sort"
 	"strings"
 
@@ -32,14 +32,14 @@ func FetchMergeCandidates(ctx context.Context, baseDishID int64, repo domain.Dis
 	//
 	baseDish, err := repo.GetDishByID(ctx, baseDishID)
 	if err != nil {
-		log.Printf("Failed to fetch dish %v : %v", baseDishID, err)
+		logging.FromContext(ctx).Error("failed to fetch dish", "dishID", baseDishID, "err", err)
 
 		return nil, fmt.Errorf("GetDishByID failed : %w", err)
 	}
 
 	allDishesSimple, err := repo.GetAllDishesSimple(ctx)
 	if err != nil {
-		log.Printf("Failed to fetch all dish ids : %v", err)
+		logging.FromContext(ctx).Error("failed to fetch all dish ids", "err", err)
 
 		return nil, fmt.Errorf("GetAllDishesSimple failed : %w", err)
 	}
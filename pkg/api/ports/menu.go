@@ -0,0 +1,177 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsTasty/pkg/api/domain"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/conc/iter"
+)
+
+// MenuEntry is a single dish on the menu. If the dish is part of a merged dish, the entry describes the merged dish
+type MenuEntry struct {
+	//DishID is the id of the dish that was served. If multiple dishes of a merged dish were served, this is
+	//the smallest of their ids
+	DishID       int64
+	Name         string
+	MergedDishID *int64
+	//AvgRating is nil if there are no ratings yet
+	AvgRating   *float32
+	RatingCount int
+	//LastServed is the most recent serving before the menu date. Nil if this is the first serving
+	LastServed *time.Time
+}
+
+type MenuLocation struct {
+	Name   string
+	Dishes []MenuEntry
+}
+
+type Menu struct {
+	Date      time.Time
+	Locations []MenuLocation
+}
+
+// FetchMenu returns all dishes served on date, optionally restricted to the given location. Dishes are grouped by
+// location and resolved to their merged dish. Locations and dishes are sorted by name
+func FetchMenu(ctx context.Context, repo domain.DishRepo, date time.Time, optionalLocation *string) (*Menu, error) {
+	dishIDs, err := repo.GetDishByDate(ctx, date, optionalLocation)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			dishIDs = make([]int64, 0)
+		} else {
+			return nil, fmt.Errorf("failed to get dishes for %v : %w", date, err)
+		}
+	}
+
+	mapper := iter.Mapper[int64, *dishOrMergedDishData]{MaxGoroutines: 3}
+	dishData, err := mapper.MapErr(dishIDs, func(dishID *int64) (*dishOrMergedDishData, error) {
+		return fetchDishOrMergedDishData(ctx, repo, *dishID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch data for at least one dish : %w", err)
+	}
+
+	dishesByLocation := make(map[string][]MenuEntry)
+	//merged dishes might be represented by multiple served dishes. We only want to list them once
+	seenMergedDishes := make(map[int64]int)
+	for i, data := range dishData {
+		name, servedAt, mergedDishID := data.nameAndLocation()
+		if mergedDishID != nil {
+			if idx, ok := seenMergedDishes[*mergedDishID]; ok {
+				if dishIDs[i] < dishesByLocation[servedAt][idx].DishID {
+					dishesByLocation[servedAt][idx].DishID = dishIDs[i]
+				}
+				continue
+			}
+			seenMergedDishes[*mergedDishID] = len(dishesByLocation[servedAt])
+		}
+
+		ratings := data.ratings()
+		entry := MenuEntry{
+			DishID:       dishIDs[i],
+			Name:         name,
+			MergedDishID: mergedDishID,
+			RatingCount:  len(ratings),
+		}
+		if avg, err := domain.AverageRating(ratings); err == nil {
+			entry.AvgRating = &avg
+		}
+		for _, occurrence := range data.occurrences() {
+			if domain.TruncateToDayPrecision(occurrence).Before(domain.TruncateToDayPrecision(date)) {
+				v := occurrence
+				entry.LastServed = &v
+			}
+		}
+
+		dishesByLocation[servedAt] = append(dishesByLocation[servedAt], entry)
+	}
+
+	menu := &Menu{
+		Date:      date,
+		Locations: make([]MenuLocation, 0, len(dishesByLocation)),
+	}
+	for location, dishes := range dishesByLocation {
+		sort.Slice(dishes, func(i, j int) bool {
+			return dishes[i].Name < dishes[j].Name
+		})
+		menu.Locations = append(menu.Locations, MenuLocation{Name: location, Dishes: dishes})
+	}
+	sort.Slice(menu.Locations, func(i, j int) bool {
+		return menu.Locations[i].Name < menu.Locations[j].Name
+	})
+
+	return menu, nil
+}
+
+const menuDateFormat = "2006-01-02"
+
+// describeRatings returns a short, human-readable summary of the rating data of e
+func (e *MenuEntry) describeRatings() string {
+	var b strings.Builder
+	if e.AvgRating == nil {
+		b.WriteString("no ratings yet")
+	} else {
+		suffix := "s"
+		if e.RatingCount == 1 {
+			suffix = ""
+		}
+		b.WriteString(fmt.Sprintf("%.1f/5 (%d rating%s)", *e.AvgRating, e.RatingCount, suffix))
+	}
+	if e.LastServed == nil {
+		b.WriteString(", first serving")
+	} else {
+		b.WriteString(fmt.Sprintf(", last served %s", e.LastServed.Format(menuDateFormat)))
+	}
+	return b.String()
+}
+
+// RenderPlain renders the menu as plain text suitable for chat messages
+func (m *Menu) RenderPlain() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Menu for %s\n", m.Date.Format(menuDateFormat)))
+	if len(m.Locations) == 0 {
+		b.WriteString("\nNo dishes found\n")
+		return b.String()
+	}
+	for _, location := range m.Locations {
+		b.WriteString(fmt.Sprintf("\n%s\n", location.Name))
+		for i := range location.Dishes {
+			dish := &location.Dishes[i]
+			b.WriteString(fmt.Sprintf("- %s: %s\n", dish.Name, dish.describeRatings()))
+		}
+	}
+	return b.String()
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"*", `\*`,
+	"_", `\_`,
+	"`", "\\`",
+	"[", `\[`,
+	"]", `\]`,
+	"#", `\#`,
+)
+
+// RenderMarkdown renders the menu as Markdown suitable for chat messages
+func (m *Menu) RenderMarkdown() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("## Menu for %s\n", m.Date.Format(menuDateFormat)))
+	if len(m.Locations) == 0 {
+		b.WriteString("\n_No dishes found_\n")
+		return b.String()
+	}
+	for _, location := range m.Locations {
+		b.WriteString(fmt.Sprintf("\n### %s\n", markdownEscaper.Replace(location.Name)))
+		for i := range location.Dishes {
+			dish := &location.Dishes[i]
+			b.WriteString(fmt.Sprintf("- **%s**: %s\n", markdownEscaper.Replace(dish.Name), dish.describeRatings()))
+		}
+	}
+	return b.String()
+}
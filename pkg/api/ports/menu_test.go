@@ -0,0 +1,46 @@
+package ports
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMenu_Render(t *testing.T) {
+	date := time.Date(2023, time.March, 8, 0, 0, 0, 0, time.UTC)
+	lastServed := date.AddDate(0, 0, -7)
+	avg := float32(4.5)
+	mergedDishID := int64(3)
+
+	menu := Menu{
+		Date: date,
+		Locations: []MenuLocation{
+			{
+				Name: "Mensa",
+				Dishes: []MenuEntry{
+					{DishID: 1, Name: "Pasta *Arrabiata*", MergedDishID: &mergedDishID, AvgRating: &avg, RatingCount: 2, LastServed: &lastServed},
+					{DishID: 2, Name: "Soup", RatingCount: 0},
+				},
+			},
+		},
+	}
+
+	wantPlain := "Menu for 2023-03-08\n" +
+		"\n" +
+		"Mensa\n" +
+		"- Pasta *Arrabiata*: 4.5/5 (2 ratings), last served 2023-03-01\n" +
+		"- Soup: no ratings yet, first serving\n"
+	require.Equal(t, wantPlain, menu.RenderPlain())
+
+	wantMarkdown := "## Menu for 2023-03-08\n" +
+		"\n" +
+		"### Mensa\n" +
+		"- **Pasta \\*Arrabiata\\***: 4.5/5 (2 ratings), last served 2023-03-01\n" +
+		"- **Soup**: no ratings yet, first serving\n"
+	require.Equal(t, wantMarkdown, menu.RenderMarkdown())
+
+	emptyMenu := Menu{Date: date, Locations: []MenuLocation{}}
+	require.Equal(t, "Menu for 2023-03-08\n\nNo dishes found\n", emptyMenu.RenderPlain())
+	require.Equal(t, "## Menu for 2023-03-08\n\n_No dishes found_\n", emptyMenu.RenderMarkdown())
+}
@@ -94,6 +94,25 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetDishFamilies request
+	GetDishFamilies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostDishFamilies request with any body
+	PostDishFamiliesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostDishFamilies(ctx context.Context, body PostDishFamiliesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteDishFamiliesDishFamilyID request
+	DeleteDishFamiliesDishFamilyID(ctx context.Context, dishFamilyID int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDishFamiliesDishFamilyID request
+	GetDishFamiliesDishFamilyID(ctx context.Context, dishFamilyID int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchDishFamiliesDishFamilyID request with any body
+	PatchDishFamiliesDishFamilyIDWithBody(ctx context.Context, dishFamilyID int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchDishFamiliesDishFamilyID(ctx context.Context, dishFamilyID int64, body PatchDishFamiliesDishFamilyIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetDishesMergeCandidatesDishID request
 	GetDishesMergeCandidatesDishID(ctx context.Context, dishID int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -105,9 +124,23 @@ type ClientInterface interface {
 
 	PostDishesDishID(ctx context.Context, dishID int64, body PostDishesDishIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetDishesDishIDRatingTrend request
+	GetDishesDishIDRatingTrend(ctx context.Context, dishID int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetGetAllDishes request
 	GetGetAllDishes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetLocations request
+	GetLocations(ctx context.Context, params *GetLocationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLocationsLocationID request
+	GetLocationsLocationID(ctx context.Context, locationID int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchLocationsLocationID request with any body
+	PatchLocationsLocationIDWithBody(ctx context.Context, locationID int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchLocationsLocationID(ctx context.Context, locationID int64, body PatchLocationsLocationIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostMergedDishes request with any body
 	PostMergedDishesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -136,6 +169,113 @@ type ClientInterface interface {
 
 	// GetUsersMe request
 	GetUsersMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUsersMeSessions request
+	DeleteUsersMeSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersMeSessions request
+	GetUsersMeSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUsersMeSessionsSessionID request
+	DeleteUsersMeSessionsSessionID(ctx context.Context, sessionID string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersMeStatistics request
+	GetUsersMeStatistics(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersMeTokens request
+	GetUsersMeTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersMeTokens request with any body
+	PostUsersMeTokensWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersMeTokens(ctx context.Context, body PostUsersMeTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUsersMeTokensTokenID request
+	DeleteUsersMeTokensTokenID(ctx context.Context, tokenID int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetDishFamilies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDishFamiliesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostDishFamiliesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostDishFamiliesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostDishFamilies(ctx context.Context, body PostDishFamiliesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostDishFamiliesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteDishFamiliesDishFamilyID(ctx context.Context, dishFamilyID int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDishFamiliesDishFamilyIDRequest(c.Server, dishFamilyID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDishFamiliesDishFamilyID(ctx context.Context, dishFamilyID int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDishFamiliesDishFamilyIDRequest(c.Server, dishFamilyID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchDishFamiliesDishFamilyIDWithBody(ctx context.Context, dishFamilyID int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchDishFamiliesDishFamilyIDRequestWithBody(c.Server, dishFamilyID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchDishFamiliesDishFamilyID(ctx context.Context, dishFamilyID int64, body PatchDishFamiliesDishFamilyIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchDishFamiliesDishFamilyIDRequest(c.Server, dishFamilyID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
 func (c *Client) GetDishesMergeCandidatesDishID(ctx context.Context, dishID int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -186,6 +326,18 @@ func (c *Client) PostDishesDishID(ctx context.Context, dishID int64, body PostDi
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetDishesDishIDRatingTrend(ctx context.Context, dishID int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDishesDishIDRatingTrendRequest(c.Server, dishID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetGetAllDishes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetGetAllDishesRequest(c.Server)
 	if err != nil {
@@ -198,6 +350,54 @@ func (c *Client) GetGetAllDishes(ctx context.Context, reqEditors ...RequestEdito
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetLocations(ctx context.Context, params *GetLocationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLocationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLocationsLocationID(ctx context.Context, locationID int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLocationsLocationIDRequest(c.Server, locationID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchLocationsLocationIDWithBody(ctx context.Context, locationID int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchLocationsLocationIDRequestWithBody(c.Server, locationID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchLocationsLocationID(ctx context.Context, locationID int64, body PatchLocationsLocationIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchLocationsLocationIDRequest(c.Server, locationID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PostMergedDishesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPostMergedDishesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -330,123 +530,104 @@ func (c *Client) GetUsersMe(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-// NewGetDishesMergeCandidatesDishIDRequest generates requests for GetDishesMergeCandidatesDishID
-func NewGetDishesMergeCandidatesDishIDRequest(server string, dishID int64) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dishID", runtime.ParamLocationPath, dishID)
+func (c *Client) DeleteUsersMeSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUsersMeSessionsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/dishes/mergeCandidates/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetUsersMeSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersMeSessionsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetDishesDishIDRequest generates requests for GetDishesDishID
-func NewGetDishesDishIDRequest(server string, dishID int64) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dishID", runtime.ParamLocationPath, dishID)
+func (c *Client) DeleteUsersMeSessionsSessionID(ctx context.Context, sessionID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUsersMeSessionsSessionIDRequest(c.Server, sessionID)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/dishes/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetUsersMeStatistics(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersMeStatisticsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostDishesDishIDRequest calls the generic PostDishesDishID builder with application/json body
-func NewPostDishesDishIDRequest(server string, dishID int64, body PostDishesDishIDJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetUsersMeTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersMeTokensRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostDishesDishIDRequestWithBody(server, dishID, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostDishesDishIDRequestWithBody generates requests for PostDishesDishID with any type of body
-func NewPostDishesDishIDRequestWithBody(server string, dishID int64, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dishID", runtime.ParamLocationPath, dishID)
+func (c *Client) PostUsersMeTokensWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersMeTokensRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostUsersMeTokens(ctx context.Context, body PostUsersMeTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersMeTokensRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/dishes/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteUsersMeTokensTokenID(ctx context.Context, tokenID int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUsersMeTokensTokenIDRequest(c.Server, tokenID)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetGetAllDishesRequest generates requests for GetGetAllDishes
-func NewGetGetAllDishesRequest(server string) (*http.Request, error) {
+// NewGetDishFamiliesRequest generates requests for GetDishFamilies
+func NewGetDishFamiliesRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -454,7 +635,7 @@ func NewGetGetAllDishesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/getAllDishes")
+	operationPath := fmt.Sprintf("/dishFamilies")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -472,19 +653,19 @@ func NewGetGetAllDishesRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewPostMergedDishesRequest calls the generic PostMergedDishes builder with application/json body
-func NewPostMergedDishesRequest(server string, body PostMergedDishesJSONRequestBody) (*http.Request, error) {
+// NewPostDishFamiliesRequest calls the generic PostDishFamilies builder with application/json body
+func NewPostDishFamiliesRequest(server string, body PostDishFamiliesJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostMergedDishesRequestWithBody(server, "application/json", bodyReader)
+	return NewPostDishFamiliesRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPostMergedDishesRequestWithBody generates requests for PostMergedDishes with any type of body
-func NewPostMergedDishesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostDishFamiliesRequestWithBody generates requests for PostDishFamilies with any type of body
+func NewPostDishFamiliesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -492,7 +673,7 @@ func NewPostMergedDishesRequestWithBody(server string, contentType string, body
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/mergedDishes/")
+	operationPath := fmt.Sprintf("/dishFamilies")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -512,13 +693,13 @@ func NewPostMergedDishesRequestWithBody(server string, contentType string, body
 	return req, nil
 }
 
-// NewDeleteMergedDishesMergedDishIDRequest generates requests for DeleteMergedDishesMergedDishID
-func NewDeleteMergedDishesMergedDishIDRequest(server string, mergedDishID int64) (*http.Request, error) {
+// NewDeleteDishFamiliesDishFamilyIDRequest generates requests for DeleteDishFamiliesDishFamilyID
+func NewDeleteDishFamiliesDishFamilyIDRequest(server string, dishFamilyID int64) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "mergedDishID", runtime.ParamLocationPath, mergedDishID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dishFamilyID", runtime.ParamLocationPath, dishFamilyID)
 	if err != nil {
 		return nil, err
 	}
@@ -528,7 +709,7 @@ func NewDeleteMergedDishesMergedDishIDRequest(server string, mergedDishID int64)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/mergedDishes/%s", pathParam0)
+	operationPath := fmt.Sprintf("/dishFamilies/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -546,13 +727,13 @@ func NewDeleteMergedDishesMergedDishIDRequest(server string, mergedDishID int64)
 	return req, nil
 }
 
-// NewGetMergedDishesMergedDishIDRequest generates requests for GetMergedDishesMergedDishID
-func NewGetMergedDishesMergedDishIDRequest(server string, mergedDishID int64) (*http.Request, error) {
+// NewGetDishFamiliesDishFamilyIDRequest generates requests for GetDishFamiliesDishFamilyID
+func NewGetDishFamiliesDishFamilyIDRequest(server string, dishFamilyID int64) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "mergedDishID", runtime.ParamLocationPath, mergedDishID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dishFamilyID", runtime.ParamLocationPath, dishFamilyID)
 	if err != nil {
 		return nil, err
 	}
@@ -562,7 +743,7 @@ func NewGetMergedDishesMergedDishIDRequest(server string, mergedDishID int64) (*
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/mergedDishes/%s", pathParam0)
+	operationPath := fmt.Sprintf("/dishFamilies/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -580,24 +761,24 @@ func NewGetMergedDishesMergedDishIDRequest(server string, mergedDishID int64) (*
 	return req, nil
 }
 
-// NewPatchMergedDishesMergedDishIDRequest calls the generic PatchMergedDishesMergedDishID builder with application/json body
-func NewPatchMergedDishesMergedDishIDRequest(server string, mergedDishID int64, body PatchMergedDishesMergedDishIDJSONRequestBody) (*http.Request, error) {
+// NewPatchDishFamiliesDishFamilyIDRequest calls the generic PatchDishFamiliesDishFamilyID builder with application/json body
+func NewPatchDishFamiliesDishFamilyIDRequest(server string, dishFamilyID int64, body PatchDishFamiliesDishFamilyIDJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPatchMergedDishesMergedDishIDRequestWithBody(server, mergedDishID, "application/json", bodyReader)
+	return NewPatchDishFamiliesDishFamilyIDRequestWithBody(server, dishFamilyID, "application/json", bodyReader)
 }
 
-// NewPatchMergedDishesMergedDishIDRequestWithBody generates requests for PatchMergedDishesMergedDishID with any type of body
-func NewPatchMergedDishesMergedDishIDRequestWithBody(server string, mergedDishID int64, contentType string, body io.Reader) (*http.Request, error) {
+// NewPatchDishFamiliesDishFamilyIDRequestWithBody generates requests for PatchDishFamiliesDishFamilyID with any type of body
+func NewPatchDishFamiliesDishFamilyIDRequestWithBody(server string, dishFamilyID int64, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "mergedDishID", runtime.ParamLocationPath, mergedDishID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dishFamilyID", runtime.ParamLocationPath, dishFamilyID)
 	if err != nil {
 		return nil, err
 	}
@@ -607,7 +788,7 @@ func NewPatchMergedDishesMergedDishIDRequestWithBody(server string, mergedDishID
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/mergedDishes/%s", pathParam0)
+	operationPath := fmt.Sprintf("/dishFamilies/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -627,27 +808,57 @@ func NewPatchMergedDishesMergedDishIDRequestWithBody(server string, mergedDishID
 	return req, nil
 }
 
-// NewPostSearchDishRequest calls the generic PostSearchDish builder with application/json body
-func NewPostSearchDishRequest(server string, body PostSearchDishJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewGetDishesMergeCandidatesDishIDRequest generates requests for GetDishesMergeCandidatesDishID
+func NewGetDishesMergeCandidatesDishIDRequest(server string, dishID int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dishID", runtime.ParamLocationPath, dishID)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostSearchDishRequestWithBody(server, "application/json", bodyReader)
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/dishes/mergeCandidates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// NewPostSearchDishRequestWithBody generates requests for PostSearchDish with any type of body
-func NewPostSearchDishRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetDishesDishIDRequest generates requests for GetDishesDishID
+func NewGetDishesDishIDRequest(server string, dishID int64) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dishID", runtime.ParamLocationPath, dishID)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/searchDish")
+	operationPath := fmt.Sprintf("/dishes/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -657,37 +868,42 @@ func NewPostSearchDishRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewPostSearchDishByDateRequest calls the generic PostSearchDishByDate builder with application/json body
-func NewPostSearchDishByDateRequest(server string, body PostSearchDishByDateJSONRequestBody) (*http.Request, error) {
+// NewPostDishesDishIDRequest calls the generic PostDishesDishID builder with application/json body
+func NewPostDishesDishIDRequest(server string, dishID int64, body PostDishesDishIDJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostSearchDishByDateRequestWithBody(server, "application/json", bodyReader)
+	return NewPostDishesDishIDRequestWithBody(server, dishID, "application/json", bodyReader)
 }
 
-// NewPostSearchDishByDateRequestWithBody generates requests for PostSearchDishByDate with any type of body
-func NewPostSearchDishByDateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostDishesDishIDRequestWithBody generates requests for PostDishesDishID with any type of body
+func NewPostDishesDishIDRequestWithBody(server string, dishID int64, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dishID", runtime.ParamLocationPath, dishID)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/searchDish/byDate")
+	operationPath := fmt.Sprintf("/dishes/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -707,16 +923,23 @@ func NewPostSearchDishByDateRequestWithBody(server string, contentType string, b
 	return req, nil
 }
 
-// NewGetUsersMeRequest generates requests for GetUsersMe
-func NewGetUsersMeRequest(server string) (*http.Request, error) {
+// NewGetDishesDishIDRatingTrendRequest generates requests for GetDishesDishIDRatingTrend
+func NewGetDishesDishIDRatingTrendRequest(server string, dishID int64) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dishID", runtime.ParamLocationPath, dishID)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users/me")
+	operationPath := fmt.Sprintf("/dishes/%s/ratingTrend", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -734,1713 +957,4428 @@ func NewGetUsersMeRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewGetGetAllDishesRequest generates requests for GetGetAllDishes
+func NewGetGetAllDishesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	operationPath := fmt.Sprintf("/getAllDishes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetDishesMergeCandidatesDishID request
-	GetDishesMergeCandidatesDishIDWithResponse(ctx context.Context, dishID int64, reqEditors ...RequestEditorFn) (*GetDishesMergeCandidatesDishIDResponse, error)
+	return req, nil
+}
 
-	// GetDishesDishID request
-	GetDishesDishIDWithResponse(ctx context.Context, dishID int64, reqEditors ...RequestEditorFn) (*GetDishesDishIDResponse, error)
+// NewGetLocationsRequest generates requests for GetLocations
+func NewGetLocationsRequest(server string, params *GetLocationsParams) (*http.Request, error) {
+	var err error
 
-	// PostDishesDishID request with any body
-	PostDishesDishIDWithBodyWithResponse(ctx context.Context, dishID int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDishesDishIDResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	PostDishesDishIDWithResponse(ctx context.Context, dishID int64, body PostDishesDishIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDishesDishIDResponse, error)
+	operationPath := fmt.Sprintf("/locations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetGetAllDishes request
-	GetGetAllDishesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetGetAllDishesResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostMergedDishes request with any body
-	PostMergedDishesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMergedDishesResponse, error)
+	queryValues := queryURL.Query()
 
-	PostMergedDishesWithResponse(ctx context.Context, body PostMergedDishesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMergedDishesResponse, error)
+	if params.IncludeArchived != nil {
 
-	// DeleteMergedDishesMergedDishID request
-	DeleteMergedDishesMergedDishIDWithResponse(ctx context.Context, mergedDishID int64, reqEditors ...RequestEditorFn) (*DeleteMergedDishesMergedDishIDResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeArchived", runtime.ParamLocationQuery, *params.IncludeArchived); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// GetMergedDishesMergedDishID request
-	GetMergedDishesMergedDishIDWithResponse(ctx context.Context, mergedDishID int64, reqEditors ...RequestEditorFn) (*GetMergedDishesMergedDishIDResponse, error)
+	}
 
-	// PatchMergedDishesMergedDishID request with any body
-	PatchMergedDishesMergedDishIDWithBodyWithResponse(ctx context.Context, mergedDishID int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchMergedDishesMergedDishIDResponse, error)
+	queryURL.RawQuery = queryValues.Encode()
 
-	PatchMergedDishesMergedDishIDWithResponse(ctx context.Context, mergedDishID int64, body PatchMergedDishesMergedDishIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchMergedDishesMergedDishIDResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostSearchDish request with any body
-	PostSearchDishWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSearchDishResponse, error)
+	return req, nil
+}
 
-	PostSearchDishWithResponse(ctx context.Context, body PostSearchDishJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSearchDishResponse, error)
+// NewGetLocationsLocationIDRequest generates requests for GetLocationsLocationID
+func NewGetLocationsLocationIDRequest(server string, locationID int64) (*http.Request, error) {
+	var err error
 
-	// PostSearchDishByDate request with any body
-	PostSearchDishByDateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSearchDishByDateResponse, error)
+	var pathParam0 string
 
-	PostSearchDishByDateWithResponse(ctx context.Context, body PostSearchDishByDateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSearchDishByDateResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "locationID", runtime.ParamLocationPath, locationID)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetUsersMe request
-	GetUsersMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeResponse, error)
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-type GetDishesMergeCandidatesDishIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetMergeCandidatesResp
-}
+	operationPath := fmt.Sprintf("/locations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetDishesMergeCandidatesDishIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDishesMergeCandidatesDishIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetDishesDishIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetDishResp
-	JSON400      *BasicError
-	JSON500      *BasicError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetDishesDishIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPatchLocationsLocationIDRequest calls the generic PatchLocationsLocationID builder with application/json body
+func NewPatchLocationsLocationIDRequest(server string, locationID int64, body PatchLocationsLocationIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchLocationsLocationIDRequestWithBody(server, locationID, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDishesDishIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPatchLocationsLocationIDRequestWithBody generates requests for PatchLocationsLocationID with any type of body
+func NewPatchLocationsLocationIDRequestWithBody(server string, locationID int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "locationID", runtime.ParamLocationPath, locationID)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostDishesDishIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BasicError
-	JSON500      *BasicError
-}
-
-// Status returns HTTPResponse.Status
-func (r PostDishesDishIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostDishesDishIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/locations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
-
-type GetGetAllDishesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetAllDishesResponse
-	JSON500      *BasicError
-}
 
-// Status returns HTTPResponse.Status
-func (r GetGetAllDishesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetGetAllDishesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostMergedDishesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CreateMergedDishResp
-	JSON400      *BasicError
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r PostMergedDishesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostMergedDishesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostMergedDishesRequest calls the generic PostMergedDishes builder with application/json body
+func NewPostMergedDishesRequest(server string, body PostMergedDishesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPostMergedDishesRequestWithBody(server, "application/json", bodyReader)
 }
 
-type DeleteMergedDishesMergedDishIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BasicError
-}
+// NewPostMergedDishesRequestWithBody generates requests for PostMergedDishes with any type of body
+func NewPostMergedDishesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r DeleteMergedDishesMergedDishIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteMergedDishesMergedDishIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/mergedDishes/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
-
-type GetMergedDishesMergedDishIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MergedDishManagementData
-}
 
-// Status returns HTTPResponse.Status
-func (r GetMergedDishesMergedDishIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetMergedDishesMergedDishIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PatchMergedDishesMergedDishIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BasicError
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r PatchMergedDishesMergedDishIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchMergedDishesMergedDishIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewDeleteMergedDishesMergedDishIDRequest generates requests for DeleteMergedDishesMergedDishID
+func NewDeleteMergedDishesMergedDishIDRequest(server string, mergedDishID int64) (*http.Request, error) {
+	var err error
 
-type PostSearchDishResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SearchDishResp
-	JSON500      *BasicError
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r PostSearchDishResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "mergedDishID", runtime.ParamLocationPath, mergedDishID)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostSearchDishResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostSearchDishByDateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]int64
-	JSON500      *BasicError
-}
+	operationPath := fmt.Sprintf("/mergedDishes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostSearchDishByDateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostSearchDishByDateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetUsersMeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GetUsersMeResp
-	JSON500      *BasicError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetUsersMeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewGetMergedDishesMergedDishIDRequest generates requests for GetMergedDishesMergedDishID
+func NewGetMergedDishesMergedDishIDRequest(server string, mergedDishID int64) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUsersMeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	var pathParam0 string
 
-// GetDishesMergeCandidatesDishIDWithResponse request returning *GetDishesMergeCandidatesDishIDResponse
-func (c *ClientWithResponses) GetDishesMergeCandidatesDishIDWithResponse(ctx context.Context, dishID int64, reqEditors ...RequestEditorFn) (*GetDishesMergeCandidatesDishIDResponse, error) {
-	rsp, err := c.GetDishesMergeCandidatesDishID(ctx, dishID, reqEditors...)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "mergedDishID", runtime.ParamLocationPath, mergedDishID)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDishesMergeCandidatesDishIDResponse(rsp)
-}
 
-// GetDishesDishIDWithResponse request returning *GetDishesDishIDResponse
-func (c *ClientWithResponses) GetDishesDishIDWithResponse(ctx context.Context, dishID int64, reqEditors ...RequestEditorFn) (*GetDishesDishIDResponse, error) {
-	rsp, err := c.GetDishesDishID(ctx, dishID, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDishesDishIDResponse(rsp)
-}
 
-// PostDishesDishIDWithBodyWithResponse request with arbitrary body returning *PostDishesDishIDResponse
-func (c *ClientWithResponses) PostDishesDishIDWithBodyWithResponse(ctx context.Context, dishID int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDishesDishIDResponse, error) {
-	rsp, err := c.PostDishesDishIDWithBody(ctx, dishID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/mergedDishes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParsePostDishesDishIDResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostDishesDishIDWithResponse(ctx context.Context, dishID int64, body PostDishesDishIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDishesDishIDResponse, error) {
-	rsp, err := c.PostDishesDishID(ctx, dishID, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostDishesDishIDResponse(rsp)
-}
 
-// GetGetAllDishesWithResponse request returning *GetGetAllDishesResponse
-func (c *ClientWithResponses) GetGetAllDishesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetGetAllDishesResponse, error) {
-	rsp, err := c.GetGetAllDishes(ctx, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetGetAllDishesResponse(rsp)
+
+	return req, nil
 }
 
-// PostMergedDishesWithBodyWithResponse request with arbitrary body returning *PostMergedDishesResponse
-func (c *ClientWithResponses) PostMergedDishesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMergedDishesResponse, error) {
-	rsp, err := c.PostMergedDishesWithBody(ctx, contentType, body, reqEditors...)
+// NewPatchMergedDishesMergedDishIDRequest calls the generic PatchMergedDishesMergedDishID builder with application/json body
+func NewPatchMergedDishesMergedDishIDRequest(server string, mergedDishID int64, body PatchMergedDishesMergedDishIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostMergedDishesResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchMergedDishesMergedDishIDRequestWithBody(server, mergedDishID, "application/json", bodyReader)
 }
 
-func (c *ClientWithResponses) PostMergedDishesWithResponse(ctx context.Context, body PostMergedDishesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMergedDishesResponse, error) {
-	rsp, err := c.PostMergedDishes(ctx, body, reqEditors...)
+// NewPatchMergedDishesMergedDishIDRequestWithBody generates requests for PatchMergedDishesMergedDishID with any type of body
+func NewPatchMergedDishesMergedDishIDRequestWithBody(server string, mergedDishID int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "mergedDishID", runtime.ParamLocationPath, mergedDishID)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostMergedDishesResponse(rsp)
-}
 
-// DeleteMergedDishesMergedDishIDWithResponse request returning *DeleteMergedDishesMergedDishIDResponse
-func (c *ClientWithResponses) DeleteMergedDishesMergedDishIDWithResponse(ctx context.Context, mergedDishID int64, reqEditors ...RequestEditorFn) (*DeleteMergedDishesMergedDishIDResponse, error) {
-	rsp, err := c.DeleteMergedDishesMergedDishID(ctx, mergedDishID, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteMergedDishesMergedDishIDResponse(rsp)
-}
 
-// GetMergedDishesMergedDishIDWithResponse request returning *GetMergedDishesMergedDishIDResponse
-func (c *ClientWithResponses) GetMergedDishesMergedDishIDWithResponse(ctx context.Context, mergedDishID int64, reqEditors ...RequestEditorFn) (*GetMergedDishesMergedDishIDResponse, error) {
-	rsp, err := c.GetMergedDishesMergedDishID(ctx, mergedDishID, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/mergedDishes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseGetMergedDishesMergedDishIDResponse(rsp)
-}
 
-// PatchMergedDishesMergedDishIDWithBodyWithResponse request with arbitrary body returning *PatchMergedDishesMergedDishIDResponse
-func (c *ClientWithResponses) PatchMergedDishesMergedDishIDWithBodyWithResponse(ctx context.Context, mergedDishID int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchMergedDishesMergedDishIDResponse, error) {
-	rsp, err := c.PatchMergedDishesMergedDishIDWithBody(ctx, mergedDishID, contentType, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchMergedDishesMergedDishIDResponse(rsp)
-}
 
-func (c *ClientWithResponses) PatchMergedDishesMergedDishIDWithResponse(ctx context.Context, mergedDishID int64, body PatchMergedDishesMergedDishIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchMergedDishesMergedDishIDResponse, error) {
-	rsp, err := c.PatchMergedDishesMergedDishID(ctx, mergedDishID, body, reqEditors...)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchMergedDishesMergedDishIDResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// PostSearchDishWithBodyWithResponse request with arbitrary body returning *PostSearchDishResponse
-func (c *ClientWithResponses) PostSearchDishWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSearchDishResponse, error) {
-	rsp, err := c.PostSearchDishWithBody(ctx, contentType, body, reqEditors...)
+// NewPostSearchDishRequest calls the generic PostSearchDish builder with application/json body
+func NewPostSearchDishRequest(server string, body PostSearchDishJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostSearchDishResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSearchDishRequestWithBody(server, "application/json", bodyReader)
 }
 
-func (c *ClientWithResponses) PostSearchDishWithResponse(ctx context.Context, body PostSearchDishJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSearchDishResponse, error) {
-	rsp, err := c.PostSearchDish(ctx, body, reqEditors...)
+// NewPostSearchDishRequestWithBody generates requests for PostSearchDish with any type of body
+func NewPostSearchDishRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostSearchDishResponse(rsp)
-}
 
-// PostSearchDishByDateWithBodyWithResponse request with arbitrary body returning *PostSearchDishByDateResponse
-func (c *ClientWithResponses) PostSearchDishByDateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSearchDishByDateResponse, error) {
-	rsp, err := c.PostSearchDishByDateWithBody(ctx, contentType, body, reqEditors...)
+	operationPath := fmt.Sprintf("/searchDish")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostSearchDishByDateResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostSearchDishByDateWithResponse(ctx context.Context, body PostSearchDishByDateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSearchDishByDateResponse, error) {
-	rsp, err := c.PostSearchDishByDate(ctx, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostSearchDishByDateResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// GetUsersMeWithResponse request returning *GetUsersMeResponse
-func (c *ClientWithResponses) GetUsersMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeResponse, error) {
-	rsp, err := c.GetUsersMe(ctx, reqEditors...)
+// NewPostSearchDishByDateRequest calls the generic PostSearchDishByDate builder with application/json body
+func NewPostSearchDishByDateRequest(server string, body PostSearchDishByDateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetUsersMeResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSearchDishByDateRequestWithBody(server, "application/json", bodyReader)
 }
 
-// ParseGetDishesMergeCandidatesDishIDResponse parses an HTTP response from a GetDishesMergeCandidatesDishIDWithResponse call
-func ParseGetDishesMergeCandidatesDishIDResponse(rsp *http.Response) (*GetDishesMergeCandidatesDishIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewPostSearchDishByDateRequestWithBody generates requests for PostSearchDishByDate with any type of body
+func NewPostSearchDishByDateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDishesMergeCandidatesDishIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetMergeCandidatesResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
+	operationPath := fmt.Sprintf("/searchDish/byDate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	return response, nil
-}
-
-// ParseGetDishesDishIDResponse parses an HTTP response from a GetDishesDishIDWithResponse call
-func ParseGetDishesDishIDResponse(rsp *http.Response) (*GetDishesDishIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDishesDishIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetDishResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	req.Header.Add("Content-Type", contentType)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BasicError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	return req, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest BasicError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// NewGetUsersMeRequest generates requests for GetUsersMe
+func NewGetUsersMeRequest(server string) (*http.Request, error) {
+	var err error
 
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/users/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParsePostDishesDishIDResponse parses an HTTP response from a PostDishesDishIDWithResponse call
-func ParsePostDishesDishIDResponse(rsp *http.Response) (*PostDishesDishIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostDishesDishIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BasicError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	return req, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest BasicError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// NewDeleteUsersMeSessionsRequest generates requests for DeleteUsersMeSessions
+func NewDeleteUsersMeSessionsRequest(server string) (*http.Request, error) {
+	var err error
 
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/users/me/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParseGetGetAllDishesResponse parses an HTTP response from a GetGetAllDishesWithResponse call
-func ParseGetGetAllDishesResponse(rsp *http.Response) (*GetGetAllDishesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetGetAllDishesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetAllDishesResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return req, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest BasicError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// NewGetUsersMeSessionsRequest generates requests for GetUsersMeSessions
+func NewGetUsersMeSessionsRequest(server string) (*http.Request, error) {
+	var err error
 
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/users/me/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParsePostMergedDishesResponse parses an HTTP response from a PostMergedDishesWithResponse call
-func ParsePostMergedDishesResponse(rsp *http.Response) (*PostMergedDishesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostMergedDishesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CreateMergedDishResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return req, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BasicError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// NewDeleteUsersMeSessionsSessionIDRequest generates requests for DeleteUsersMeSessionsSessionID
+func NewDeleteUsersMeSessionsSessionIDRequest(server string, sessionID string) (*http.Request, error) {
+	var err error
 
-	}
+	var pathParam0 string
 
-	return response, nil
-}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionID", runtime.ParamLocationPath, sessionID)
+	if err != nil {
+		return nil, err
+	}
 
-// ParseDeleteMergedDishesMergedDishIDResponse parses an HTTP response from a DeleteMergedDishesMergedDishIDWithResponse call
-func ParseDeleteMergedDishesMergedDishIDResponse(rsp *http.Response) (*DeleteMergedDishesMergedDishIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteMergedDishesMergedDishIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/users/me/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BasicError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseGetMergedDishesMergedDishIDResponse parses an HTTP response from a GetMergedDishesMergedDishIDWithResponse call
-func ParseGetMergedDishesMergedDishIDResponse(rsp *http.Response) (*GetMergedDishesMergedDishIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetUsersMeStatisticsRequest generates requests for GetUsersMeStatistics
+func NewGetUsersMeStatisticsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetMergedDishesMergedDishIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/users/me/statistics")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MergedDishManagementData
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParsePatchMergedDishesMergedDishIDResponse parses an HTTP response from a PatchMergedDishesMergedDishIDWithResponse call
-func ParsePatchMergedDishesMergedDishIDResponse(rsp *http.Response) (*PatchMergedDishesMergedDishIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetUsersMeTokensRequest generates requests for GetUsersMeTokens
+func NewGetUsersMeTokensRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchMergedDishesMergedDishIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/users/me/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BasicError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParsePostSearchDishResponse parses an HTTP response from a PostSearchDishWithResponse call
-func ParsePostSearchDishResponse(rsp *http.Response) (*PostSearchDishResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewPostUsersMeTokensRequest calls the generic PostUsersMeTokens builder with application/json body
+func NewPostUsersMeTokensRequest(server string, body PostUsersMeTokensJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersMeTokensRequestWithBody(server, "application/json", bodyReader)
+}
 
-	response := &PostSearchDishResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// NewPostUsersMeTokensRequestWithBody generates requests for PostUsersMeTokens with any type of body
+func NewPostUsersMeTokensRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SearchDishResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	operationPath := fmt.Sprintf("/users/me/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest BasicError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// ParsePostSearchDishByDateResponse parses an HTTP response from a PostSearchDishByDateWithResponse call
-func ParsePostSearchDishByDateResponse(rsp *http.Response) (*PostSearchDishByDateResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewDeleteUsersMeTokensTokenIDRequest generates requests for DeleteUsersMeTokensTokenID
+func NewDeleteUsersMeTokensTokenIDRequest(server string, tokenID int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tokenID", runtime.ParamLocationPath, tokenID)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostSearchDishByDateResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []int64
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	operationPath := fmt.Sprintf("/users/me/tokens/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest BasicError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseGetUsersMeResponse parses an HTTP response from a GetUsersMeWithResponse call
-func ParseGetUsersMeResponse(rsp *http.Response) (*GetUsersMeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
 	if err != nil {
 		return nil, err
 	}
+	return &ClientWithResponses{client}, nil
+}
 
-	response := &GetUsersMeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
 	}
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetUsersMeResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetDishFamilies request
+	GetDishFamiliesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDishFamiliesResponse, error)
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest BasicError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// PostDishFamilies request with any body
+	PostDishFamiliesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDishFamiliesResponse, error)
+
+	PostDishFamiliesWithResponse(ctx context.Context, body PostDishFamiliesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDishFamiliesResponse, error)
+
+	// DeleteDishFamiliesDishFamilyID request
+	DeleteDishFamiliesDishFamilyIDWithResponse(ctx context.Context, dishFamilyID int64, reqEditors ...RequestEditorFn) (*DeleteDishFamiliesDishFamilyIDResponse, error)
+
+	// GetDishFamiliesDishFamilyID request
+	GetDishFamiliesDishFamilyIDWithResponse(ctx context.Context, dishFamilyID int64, reqEditors ...RequestEditorFn) (*GetDishFamiliesDishFamilyIDResponse, error)
+
+	// PatchDishFamiliesDishFamilyID request with any body
+	PatchDishFamiliesDishFamilyIDWithBodyWithResponse(ctx context.Context, dishFamilyID int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchDishFamiliesDishFamilyIDResponse, error)
+
+	PatchDishFamiliesDishFamilyIDWithResponse(ctx context.Context, dishFamilyID int64, body PatchDishFamiliesDishFamilyIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchDishFamiliesDishFamilyIDResponse, error)
+
+	// GetDishesMergeCandidatesDishID request
+	GetDishesMergeCandidatesDishIDWithResponse(ctx context.Context, dishID int64, reqEditors ...RequestEditorFn) (*GetDishesMergeCandidatesDishIDResponse, error)
+
+	// GetDishesDishID request
+	GetDishesDishIDWithResponse(ctx context.Context, dishID int64, reqEditors ...RequestEditorFn) (*GetDishesDishIDResponse, error)
+
+	// PostDishesDishID request with any body
+	PostDishesDishIDWithBodyWithResponse(ctx context.Context, dishID int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDishesDishIDResponse, error)
+
+	PostDishesDishIDWithResponse(ctx context.Context, dishID int64, body PostDishesDishIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDishesDishIDResponse, error)
+
+	// GetDishesDishIDRatingTrend request
+	GetDishesDishIDRatingTrendWithResponse(ctx context.Context, dishID int64, reqEditors ...RequestEditorFn) (*GetDishesDishIDRatingTrendResponse, error)
+
+	// GetGetAllDishes request
+	GetGetAllDishesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetGetAllDishesResponse, error)
+
+	// GetLocations request
+	GetLocationsWithResponse(ctx context.Context, params *GetLocationsParams, reqEditors ...RequestEditorFn) (*GetLocationsResponse, error)
+
+	// GetLocationsLocationID request
+	GetLocationsLocationIDWithResponse(ctx context.Context, locationID int64, reqEditors ...RequestEditorFn) (*GetLocationsLocationIDResponse, error)
+
+	// PatchLocationsLocationID request with any body
+	PatchLocationsLocationIDWithBodyWithResponse(ctx context.Context, locationID int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchLocationsLocationIDResponse, error)
+
+	PatchLocationsLocationIDWithResponse(ctx context.Context, locationID int64, body PatchLocationsLocationIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchLocationsLocationIDResponse, error)
+
+	// PostMergedDishes request with any body
+	PostMergedDishesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMergedDishesResponse, error)
+
+	PostMergedDishesWithResponse(ctx context.Context, body PostMergedDishesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMergedDishesResponse, error)
+
+	// DeleteMergedDishesMergedDishID request
+	DeleteMergedDishesMergedDishIDWithResponse(ctx context.Context, mergedDishID int64, reqEditors ...RequestEditorFn) (*DeleteMergedDishesMergedDishIDResponse, error)
+
+	// GetMergedDishesMergedDishID request
+	GetMergedDishesMergedDishIDWithResponse(ctx context.Context, mergedDishID int64, reqEditors ...RequestEditorFn) (*GetMergedDishesMergedDishIDResponse, error)
+
+	// PatchMergedDishesMergedDishID request with any body
+	PatchMergedDishesMergedDishIDWithBodyWithResponse(ctx context.Context, mergedDishID int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchMergedDishesMergedDishIDResponse, error)
+
+	PatchMergedDishesMergedDishIDWithResponse(ctx context.Context, mergedDishID int64, body PatchMergedDishesMergedDishIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchMergedDishesMergedDishIDResponse, error)
+
+	// PostSearchDish request with any body
+	PostSearchDishWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSearchDishResponse, error)
+
+	PostSearchDishWithResponse(ctx context.Context, body PostSearchDishJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSearchDishResponse, error)
+
+	// PostSearchDishByDate request with any body
+	PostSearchDishByDateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSearchDishByDateResponse, error)
+
+	PostSearchDishByDateWithResponse(ctx context.Context, body PostSearchDishByDateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSearchDishByDateResponse, error)
+
+	// GetUsersMe request
+	GetUsersMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeResponse, error)
+
+	// DeleteUsersMeSessions request
+	DeleteUsersMeSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteUsersMeSessionsResponse, error)
+
+	// GetUsersMeSessions request
+	GetUsersMeSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeSessionsResponse, error)
+
+	// DeleteUsersMeSessionsSessionID request
+	DeleteUsersMeSessionsSessionIDWithResponse(ctx context.Context, sessionID string, reqEditors ...RequestEditorFn) (*DeleteUsersMeSessionsSessionIDResponse, error)
+
+	// GetUsersMeStatistics request
+	GetUsersMeStatisticsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeStatisticsResponse, error)
+
+	// GetUsersMeTokens request
+	GetUsersMeTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeTokensResponse, error)
+
+	// PostUsersMeTokens request with any body
+	PostUsersMeTokensWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersMeTokensResponse, error)
+
+	PostUsersMeTokensWithResponse(ctx context.Context, body PostUsersMeTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersMeTokensResponse, error)
+
+	// DeleteUsersMeTokensTokenID request
+	DeleteUsersMeTokensTokenIDWithResponse(ctx context.Context, tokenID int64, reqEditors ...RequestEditorFn) (*DeleteUsersMeTokensTokenIDResponse, error)
+}
+
+type GetDishFamiliesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetDishFamiliesResp
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDishFamiliesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDishFamiliesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostDishFamiliesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CreateDishFamilyResp
+	JSON400      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostDishFamiliesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostDishFamiliesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDishFamiliesDishFamilyIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDishFamiliesDishFamilyIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDishFamiliesDishFamilyIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDishFamiliesDishFamilyIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetDishFamilyResp
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDishFamiliesDishFamilyIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDishFamiliesDishFamilyIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchDishFamiliesDishFamilyIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchDishFamiliesDishFamilyIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchDishFamiliesDishFamilyIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDishesMergeCandidatesDishIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetMergeCandidatesResp
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDishesMergeCandidatesDishIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDishesMergeCandidatesDishIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDishesDishIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetDishResp
+	JSON400      *BasicError
+	JSON500      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDishesDishIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDishesDishIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostDishesDishIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BasicError
+	JSON500      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostDishesDishIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostDishesDishIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDishesDishIDRatingTrendResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetDishRatingTrendResp
+	JSON500      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDishesDishIDRatingTrendResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDishesDishIDRatingTrendResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetGetAllDishesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetAllDishesResponse
+	JSON500      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetGetAllDishesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetGetAllDishesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLocationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetLocationsResp
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLocationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLocationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLocationsLocationIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Location
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLocationsLocationIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLocationsLocationIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchLocationsLocationIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Location
+	JSON400      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchLocationsLocationIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchLocationsLocationIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostMergedDishesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CreateMergedDishResp
+	JSON400      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostMergedDishesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostMergedDishesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMergedDishesMergedDishIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMergedDishesMergedDishIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMergedDishesMergedDishIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMergedDishesMergedDishIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MergedDishManagementData
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMergedDishesMergedDishIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMergedDishesMergedDishIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchMergedDishesMergedDishIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchMergedDishesMergedDishIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchMergedDishesMergedDishIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSearchDishResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SearchDishResp
+	JSON500      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSearchDishResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSearchDishResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSearchDishByDateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]int64
+	JSON500      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSearchDishByDateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSearchDishByDateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersMeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetUsersMeResp
+	JSON500      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUsersMeSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RevokeSessionsResp
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUsersMeSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUsersMeSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersMeSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetSessionsResp
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersMeSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersMeSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUsersMeSessionsSessionIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUsersMeSessionsSessionIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUsersMeSessionsSessionIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersMeStatisticsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetUsersMeStatisticsResp
+	JSON500      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersMeStatisticsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersMeStatisticsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersMeTokensResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetAccessTokensResp
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersMeTokensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersMeTokensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersMeTokensResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CreateAccessTokenResp
+	JSON400      *BasicError
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersMeTokensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersMeTokensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUsersMeTokensTokenIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUsersMeTokensTokenIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUsersMeTokensTokenIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetDishFamiliesWithResponse request returning *GetDishFamiliesResponse
+func (c *ClientWithResponses) GetDishFamiliesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDishFamiliesResponse, error) {
+	rsp, err := c.GetDishFamilies(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDishFamiliesResponse(rsp)
+}
+
+// PostDishFamiliesWithBodyWithResponse request with arbitrary body returning *PostDishFamiliesResponse
+func (c *ClientWithResponses) PostDishFamiliesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDishFamiliesResponse, error) {
+	rsp, err := c.PostDishFamiliesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDishFamiliesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostDishFamiliesWithResponse(ctx context.Context, body PostDishFamiliesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDishFamiliesResponse, error) {
+	rsp, err := c.PostDishFamilies(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDishFamiliesResponse(rsp)
+}
+
+// DeleteDishFamiliesDishFamilyIDWithResponse request returning *DeleteDishFamiliesDishFamilyIDResponse
+func (c *ClientWithResponses) DeleteDishFamiliesDishFamilyIDWithResponse(ctx context.Context, dishFamilyID int64, reqEditors ...RequestEditorFn) (*DeleteDishFamiliesDishFamilyIDResponse, error) {
+	rsp, err := c.DeleteDishFamiliesDishFamilyID(ctx, dishFamilyID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDishFamiliesDishFamilyIDResponse(rsp)
+}
+
+// GetDishFamiliesDishFamilyIDWithResponse request returning *GetDishFamiliesDishFamilyIDResponse
+func (c *ClientWithResponses) GetDishFamiliesDishFamilyIDWithResponse(ctx context.Context, dishFamilyID int64, reqEditors ...RequestEditorFn) (*GetDishFamiliesDishFamilyIDResponse, error) {
+	rsp, err := c.GetDishFamiliesDishFamilyID(ctx, dishFamilyID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDishFamiliesDishFamilyIDResponse(rsp)
+}
+
+// PatchDishFamiliesDishFamilyIDWithBodyWithResponse request with arbitrary body returning *PatchDishFamiliesDishFamilyIDResponse
+func (c *ClientWithResponses) PatchDishFamiliesDishFamilyIDWithBodyWithResponse(ctx context.Context, dishFamilyID int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchDishFamiliesDishFamilyIDResponse, error) {
+	rsp, err := c.PatchDishFamiliesDishFamilyIDWithBody(ctx, dishFamilyID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchDishFamiliesDishFamilyIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchDishFamiliesDishFamilyIDWithResponse(ctx context.Context, dishFamilyID int64, body PatchDishFamiliesDishFamilyIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchDishFamiliesDishFamilyIDResponse, error) {
+	rsp, err := c.PatchDishFamiliesDishFamilyID(ctx, dishFamilyID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchDishFamiliesDishFamilyIDResponse(rsp)
+}
+
+// GetDishesMergeCandidatesDishIDWithResponse request returning *GetDishesMergeCandidatesDishIDResponse
+func (c *ClientWithResponses) GetDishesMergeCandidatesDishIDWithResponse(ctx context.Context, dishID int64, reqEditors ...RequestEditorFn) (*GetDishesMergeCandidatesDishIDResponse, error) {
+	rsp, err := c.GetDishesMergeCandidatesDishID(ctx, dishID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDishesMergeCandidatesDishIDResponse(rsp)
+}
+
+// GetDishesDishIDWithResponse request returning *GetDishesDishIDResponse
+func (c *ClientWithResponses) GetDishesDishIDWithResponse(ctx context.Context, dishID int64, reqEditors ...RequestEditorFn) (*GetDishesDishIDResponse, error) {
+	rsp, err := c.GetDishesDishID(ctx, dishID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDishesDishIDResponse(rsp)
+}
+
+// PostDishesDishIDWithBodyWithResponse request with arbitrary body returning *PostDishesDishIDResponse
+func (c *ClientWithResponses) PostDishesDishIDWithBodyWithResponse(ctx context.Context, dishID int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDishesDishIDResponse, error) {
+	rsp, err := c.PostDishesDishIDWithBody(ctx, dishID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDishesDishIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostDishesDishIDWithResponse(ctx context.Context, dishID int64, body PostDishesDishIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDishesDishIDResponse, error) {
+	rsp, err := c.PostDishesDishID(ctx, dishID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDishesDishIDResponse(rsp)
+}
+
+// GetDishesDishIDRatingTrendWithResponse request returning *GetDishesDishIDRatingTrendResponse
+func (c *ClientWithResponses) GetDishesDishIDRatingTrendWithResponse(ctx context.Context, dishID int64, reqEditors ...RequestEditorFn) (*GetDishesDishIDRatingTrendResponse, error) {
+	rsp, err := c.GetDishesDishIDRatingTrend(ctx, dishID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDishesDishIDRatingTrendResponse(rsp)
+}
+
+// GetGetAllDishesWithResponse request returning *GetGetAllDishesResponse
+func (c *ClientWithResponses) GetGetAllDishesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetGetAllDishesResponse, error) {
+	rsp, err := c.GetGetAllDishes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetGetAllDishesResponse(rsp)
+}
+
+// GetLocationsWithResponse request returning *GetLocationsResponse
+func (c *ClientWithResponses) GetLocationsWithResponse(ctx context.Context, params *GetLocationsParams, reqEditors ...RequestEditorFn) (*GetLocationsResponse, error) {
+	rsp, err := c.GetLocations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLocationsResponse(rsp)
+}
+
+// GetLocationsLocationIDWithResponse request returning *GetLocationsLocationIDResponse
+func (c *ClientWithResponses) GetLocationsLocationIDWithResponse(ctx context.Context, locationID int64, reqEditors ...RequestEditorFn) (*GetLocationsLocationIDResponse, error) {
+	rsp, err := c.GetLocationsLocationID(ctx, locationID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLocationsLocationIDResponse(rsp)
+}
+
+// PatchLocationsLocationIDWithBodyWithResponse request with arbitrary body returning *PatchLocationsLocationIDResponse
+func (c *ClientWithResponses) PatchLocationsLocationIDWithBodyWithResponse(ctx context.Context, locationID int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchLocationsLocationIDResponse, error) {
+	rsp, err := c.PatchLocationsLocationIDWithBody(ctx, locationID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchLocationsLocationIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchLocationsLocationIDWithResponse(ctx context.Context, locationID int64, body PatchLocationsLocationIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchLocationsLocationIDResponse, error) {
+	rsp, err := c.PatchLocationsLocationID(ctx, locationID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchLocationsLocationIDResponse(rsp)
+}
+
+// PostMergedDishesWithBodyWithResponse request with arbitrary body returning *PostMergedDishesResponse
+func (c *ClientWithResponses) PostMergedDishesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMergedDishesResponse, error) {
+	rsp, err := c.PostMergedDishesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMergedDishesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostMergedDishesWithResponse(ctx context.Context, body PostMergedDishesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMergedDishesResponse, error) {
+	rsp, err := c.PostMergedDishes(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMergedDishesResponse(rsp)
+}
+
+// DeleteMergedDishesMergedDishIDWithResponse request returning *DeleteMergedDishesMergedDishIDResponse
+func (c *ClientWithResponses) DeleteMergedDishesMergedDishIDWithResponse(ctx context.Context, mergedDishID int64, reqEditors ...RequestEditorFn) (*DeleteMergedDishesMergedDishIDResponse, error) {
+	rsp, err := c.DeleteMergedDishesMergedDishID(ctx, mergedDishID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMergedDishesMergedDishIDResponse(rsp)
+}
+
+// GetMergedDishesMergedDishIDWithResponse request returning *GetMergedDishesMergedDishIDResponse
+func (c *ClientWithResponses) GetMergedDishesMergedDishIDWithResponse(ctx context.Context, mergedDishID int64, reqEditors ...RequestEditorFn) (*GetMergedDishesMergedDishIDResponse, error) {
+	rsp, err := c.GetMergedDishesMergedDishID(ctx, mergedDishID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMergedDishesMergedDishIDResponse(rsp)
+}
+
+// PatchMergedDishesMergedDishIDWithBodyWithResponse request with arbitrary body returning *PatchMergedDishesMergedDishIDResponse
+func (c *ClientWithResponses) PatchMergedDishesMergedDishIDWithBodyWithResponse(ctx context.Context, mergedDishID int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchMergedDishesMergedDishIDResponse, error) {
+	rsp, err := c.PatchMergedDishesMergedDishIDWithBody(ctx, mergedDishID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchMergedDishesMergedDishIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchMergedDishesMergedDishIDWithResponse(ctx context.Context, mergedDishID int64, body PatchMergedDishesMergedDishIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchMergedDishesMergedDishIDResponse, error) {
+	rsp, err := c.PatchMergedDishesMergedDishID(ctx, mergedDishID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchMergedDishesMergedDishIDResponse(rsp)
+}
+
+// PostSearchDishWithBodyWithResponse request with arbitrary body returning *PostSearchDishResponse
+func (c *ClientWithResponses) PostSearchDishWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSearchDishResponse, error) {
+	rsp, err := c.PostSearchDishWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSearchDishResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSearchDishWithResponse(ctx context.Context, body PostSearchDishJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSearchDishResponse, error) {
+	rsp, err := c.PostSearchDish(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSearchDishResponse(rsp)
+}
+
+// PostSearchDishByDateWithBodyWithResponse request with arbitrary body returning *PostSearchDishByDateResponse
+func (c *ClientWithResponses) PostSearchDishByDateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSearchDishByDateResponse, error) {
+	rsp, err := c.PostSearchDishByDateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSearchDishByDateResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSearchDishByDateWithResponse(ctx context.Context, body PostSearchDishByDateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSearchDishByDateResponse, error) {
+	rsp, err := c.PostSearchDishByDate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSearchDishByDateResponse(rsp)
+}
+
+// GetUsersMeWithResponse request returning *GetUsersMeResponse
+func (c *ClientWithResponses) GetUsersMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeResponse, error) {
+	rsp, err := c.GetUsersMe(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersMeResponse(rsp)
+}
+
+// DeleteUsersMeSessionsWithResponse request returning *DeleteUsersMeSessionsResponse
+func (c *ClientWithResponses) DeleteUsersMeSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteUsersMeSessionsResponse, error) {
+	rsp, err := c.DeleteUsersMeSessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUsersMeSessionsResponse(rsp)
+}
+
+// GetUsersMeSessionsWithResponse request returning *GetUsersMeSessionsResponse
+func (c *ClientWithResponses) GetUsersMeSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeSessionsResponse, error) {
+	rsp, err := c.GetUsersMeSessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersMeSessionsResponse(rsp)
+}
+
+// DeleteUsersMeSessionsSessionIDWithResponse request returning *DeleteUsersMeSessionsSessionIDResponse
+func (c *ClientWithResponses) DeleteUsersMeSessionsSessionIDWithResponse(ctx context.Context, sessionID string, reqEditors ...RequestEditorFn) (*DeleteUsersMeSessionsSessionIDResponse, error) {
+	rsp, err := c.DeleteUsersMeSessionsSessionID(ctx, sessionID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUsersMeSessionsSessionIDResponse(rsp)
+}
+
+// GetUsersMeStatisticsWithResponse request returning *GetUsersMeStatisticsResponse
+func (c *ClientWithResponses) GetUsersMeStatisticsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeStatisticsResponse, error) {
+	rsp, err := c.GetUsersMeStatistics(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersMeStatisticsResponse(rsp)
+}
+
+// GetUsersMeTokensWithResponse request returning *GetUsersMeTokensResponse
+func (c *ClientWithResponses) GetUsersMeTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeTokensResponse, error) {
+	rsp, err := c.GetUsersMeTokens(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersMeTokensResponse(rsp)
+}
+
+// PostUsersMeTokensWithBodyWithResponse request with arbitrary body returning *PostUsersMeTokensResponse
+func (c *ClientWithResponses) PostUsersMeTokensWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersMeTokensResponse, error) {
+	rsp, err := c.PostUsersMeTokensWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersMeTokensResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersMeTokensWithResponse(ctx context.Context, body PostUsersMeTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersMeTokensResponse, error) {
+	rsp, err := c.PostUsersMeTokens(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersMeTokensResponse(rsp)
+}
+
+// DeleteUsersMeTokensTokenIDWithResponse request returning *DeleteUsersMeTokensTokenIDResponse
+func (c *ClientWithResponses) DeleteUsersMeTokensTokenIDWithResponse(ctx context.Context, tokenID int64, reqEditors ...RequestEditorFn) (*DeleteUsersMeTokensTokenIDResponse, error) {
+	rsp, err := c.DeleteUsersMeTokensTokenID(ctx, tokenID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUsersMeTokensTokenIDResponse(rsp)
+}
+
+// ParseGetDishFamiliesResponse parses an HTTP response from a GetDishFamiliesWithResponse call
+func ParseGetDishFamiliesResponse(rsp *http.Response) (*GetDishFamiliesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDishFamiliesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetDishFamiliesResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostDishFamiliesResponse parses an HTTP response from a PostDishFamiliesWithResponse call
+func ParsePostDishFamiliesResponse(rsp *http.Response) (*PostDishFamiliesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostDishFamiliesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreateDishFamilyResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteDishFamiliesDishFamilyIDResponse parses an HTTP response from a DeleteDishFamiliesDishFamilyIDWithResponse call
+func ParseDeleteDishFamiliesDishFamilyIDResponse(rsp *http.Response) (*DeleteDishFamiliesDishFamilyIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteDishFamiliesDishFamilyIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetDishFamiliesDishFamilyIDResponse parses an HTTP response from a GetDishFamiliesDishFamilyIDWithResponse call
+func ParseGetDishFamiliesDishFamilyIDResponse(rsp *http.Response) (*GetDishFamiliesDishFamilyIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDishFamiliesDishFamilyIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetDishFamilyResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchDishFamiliesDishFamilyIDResponse parses an HTTP response from a PatchDishFamiliesDishFamilyIDWithResponse call
+func ParsePatchDishFamiliesDishFamilyIDResponse(rsp *http.Response) (*PatchDishFamiliesDishFamilyIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchDishFamiliesDishFamilyIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDishesMergeCandidatesDishIDResponse parses an HTTP response from a GetDishesMergeCandidatesDishIDWithResponse call
+func ParseGetDishesMergeCandidatesDishIDResponse(rsp *http.Response) (*GetDishesMergeCandidatesDishIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDishesMergeCandidatesDishIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetMergeCandidatesResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDishesDishIDResponse parses an HTTP response from a GetDishesDishIDWithResponse call
+func ParseGetDishesDishIDResponse(rsp *http.Response) (*GetDishesDishIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDishesDishIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetDishResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostDishesDishIDResponse parses an HTTP response from a PostDishesDishIDWithResponse call
+func ParsePostDishesDishIDResponse(rsp *http.Response) (*PostDishesDishIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostDishesDishIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDishesDishIDRatingTrendResponse parses an HTTP response from a GetDishesDishIDRatingTrendWithResponse call
+func ParseGetDishesDishIDRatingTrendResponse(rsp *http.Response) (*GetDishesDishIDRatingTrendResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDishesDishIDRatingTrendResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetDishRatingTrendResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetGetAllDishesResponse parses an HTTP response from a GetGetAllDishesWithResponse call
+func ParseGetGetAllDishesResponse(rsp *http.Response) (*GetGetAllDishesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetGetAllDishesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetAllDishesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLocationsResponse parses an HTTP response from a GetLocationsWithResponse call
+func ParseGetLocationsResponse(rsp *http.Response) (*GetLocationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLocationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetLocationsResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLocationsLocationIDResponse parses an HTTP response from a GetLocationsLocationIDWithResponse call
+func ParseGetLocationsLocationIDResponse(rsp *http.Response) (*GetLocationsLocationIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLocationsLocationIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Location
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchLocationsLocationIDResponse parses an HTTP response from a PatchLocationsLocationIDWithResponse call
+func ParsePatchLocationsLocationIDResponse(rsp *http.Response) (*PatchLocationsLocationIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchLocationsLocationIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Location
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostMergedDishesResponse parses an HTTP response from a PostMergedDishesWithResponse call
+func ParsePostMergedDishesResponse(rsp *http.Response) (*PostMergedDishesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostMergedDishesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreateMergedDishResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteMergedDishesMergedDishIDResponse parses an HTTP response from a DeleteMergedDishesMergedDishIDWithResponse call
+func ParseDeleteMergedDishesMergedDishIDResponse(rsp *http.Response) (*DeleteMergedDishesMergedDishIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteMergedDishesMergedDishIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMergedDishesMergedDishIDResponse parses an HTTP response from a GetMergedDishesMergedDishIDWithResponse call
+func ParseGetMergedDishesMergedDishIDResponse(rsp *http.Response) (*GetMergedDishesMergedDishIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMergedDishesMergedDishIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MergedDishManagementData
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchMergedDishesMergedDishIDResponse parses an HTTP response from a PatchMergedDishesMergedDishIDWithResponse call
+func ParsePatchMergedDishesMergedDishIDResponse(rsp *http.Response) (*PatchMergedDishesMergedDishIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchMergedDishesMergedDishIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSearchDishResponse parses an HTTP response from a PostSearchDishWithResponse call
+func ParsePostSearchDishResponse(rsp *http.Response) (*PostSearchDishResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSearchDishResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SearchDishResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSearchDishByDateResponse parses an HTTP response from a PostSearchDishByDateWithResponse call
+func ParsePostSearchDishByDateResponse(rsp *http.Response) (*PostSearchDishByDateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSearchDishByDateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []int64
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersMeResponse parses an HTTP response from a GetUsersMeWithResponse call
+func ParseGetUsersMeResponse(rsp *http.Response) (*GetUsersMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetUsersMeResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUsersMeSessionsResponse parses an HTTP response from a DeleteUsersMeSessionsWithResponse call
+func ParseDeleteUsersMeSessionsResponse(rsp *http.Response) (*DeleteUsersMeSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUsersMeSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RevokeSessionsResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersMeSessionsResponse parses an HTTP response from a GetUsersMeSessionsWithResponse call
+func ParseGetUsersMeSessionsResponse(rsp *http.Response) (*GetUsersMeSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersMeSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetSessionsResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUsersMeSessionsSessionIDResponse parses an HTTP response from a DeleteUsersMeSessionsSessionIDWithResponse call
+func ParseDeleteUsersMeSessionsSessionIDResponse(rsp *http.Response) (*DeleteUsersMeSessionsSessionIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUsersMeSessionsSessionIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersMeStatisticsResponse parses an HTTP response from a GetUsersMeStatisticsWithResponse call
+func ParseGetUsersMeStatisticsResponse(rsp *http.Response) (*GetUsersMeStatisticsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersMeStatisticsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetUsersMeStatisticsResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersMeTokensResponse parses an HTTP response from a GetUsersMeTokensWithResponse call
+func ParseGetUsersMeTokensResponse(rsp *http.Response) (*GetUsersMeTokensResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersMeTokensResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetAccessTokensResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersMeTokensResponse parses an HTTP response from a PostUsersMeTokensWithResponse call
+func ParsePostUsersMeTokensResponse(rsp *http.Response) (*PostUsersMeTokensResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersMeTokensResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreateAccessTokenResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BasicError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUsersMeTokensTokenIDResponse parses an HTTP response from a DeleteUsersMeTokensTokenIDWithResponse call
+func ParseDeleteUsersMeTokensTokenIDResponse(rsp *http.Response) (*DeleteUsersMeTokensTokenIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUsersMeTokensTokenIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /dishFamilies)
+	GetDishFamilies(w http.ResponseWriter, r *http.Request)
+
+	// (POST /dishFamilies)
+	PostDishFamilies(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /dishFamilies/{dishFamilyID})
+	DeleteDishFamiliesDishFamilyID(w http.ResponseWriter, r *http.Request, dishFamilyID int64)
+
+	// (GET /dishFamilies/{dishFamilyID})
+	GetDishFamiliesDishFamilyID(w http.ResponseWriter, r *http.Request, dishFamilyID int64)
+
+	// (PATCH /dishFamilies/{dishFamilyID})
+	PatchDishFamiliesDishFamilyID(w http.ResponseWriter, r *http.Request, dishFamilyID int64)
+
+	// (GET /dishes/mergeCandidates/{dishID})
+	GetDishesMergeCandidatesDishID(w http.ResponseWriter, r *http.Request, dishID int64)
+
+	// (GET /dishes/{dishID})
+	GetDishesDishID(w http.ResponseWriter, r *http.Request, dishID int64)
+
+	// (POST /dishes/{dishID})
+	PostDishesDishID(w http.ResponseWriter, r *http.Request, dishID int64)
+
+	// (GET /dishes/{dishID}/ratingTrend)
+	GetDishesDishIDRatingTrend(w http.ResponseWriter, r *http.Request, dishID int64)
+
+	// (GET /getAllDishes)
+	GetGetAllDishes(w http.ResponseWriter, r *http.Request)
+
+	// (GET /locations)
+	GetLocations(w http.ResponseWriter, r *http.Request, params GetLocationsParams)
+
+	// (GET /locations/{locationID})
+	GetLocationsLocationID(w http.ResponseWriter, r *http.Request, locationID int64)
+
+	// (PATCH /locations/{locationID})
+	PatchLocationsLocationID(w http.ResponseWriter, r *http.Request, locationID int64)
+
+	// (POST /mergedDishes/)
+	PostMergedDishes(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /mergedDishes/{mergedDishID})
+	DeleteMergedDishesMergedDishID(w http.ResponseWriter, r *http.Request, mergedDishID int64)
+
+	// (GET /mergedDishes/{mergedDishID})
+	GetMergedDishesMergedDishID(w http.ResponseWriter, r *http.Request, mergedDishID int64)
+
+	// (PATCH /mergedDishes/{mergedDishID})
+	PatchMergedDishesMergedDishID(w http.ResponseWriter, r *http.Request, mergedDishID int64)
+
+	// (POST /searchDish)
+	PostSearchDish(w http.ResponseWriter, r *http.Request)
+
+	// (POST /searchDish/byDate)
+	PostSearchDishByDate(w http.ResponseWriter, r *http.Request)
+
+	// (GET /users/me)
+	GetUsersMe(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /users/me/sessions)
+	DeleteUsersMeSessions(w http.ResponseWriter, r *http.Request)
+
+	// (GET /users/me/sessions)
+	GetUsersMeSessions(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /users/me/sessions/{sessionID})
+	DeleteUsersMeSessionsSessionID(w http.ResponseWriter, r *http.Request, sessionID string)
+
+	// (GET /users/me/statistics)
+	GetUsersMeStatistics(w http.ResponseWriter, r *http.Request)
+
+	// (GET /users/me/tokens)
+	GetUsersMeTokens(w http.ResponseWriter, r *http.Request)
+
+	// (POST /users/me/tokens)
+	PostUsersMeTokens(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /users/me/tokens/{tokenID})
+	DeleteUsersMeTokensTokenID(w http.ResponseWriter, r *http.Request, tokenID int64)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetDishFamilies operation middleware
+func (siw *ServerInterfaceWrapper) GetDishFamilies(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDishFamilies(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostDishFamilies operation middleware
+func (siw *ServerInterfaceWrapper) PostDishFamilies(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostDishFamilies(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteDishFamiliesDishFamilyID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDishFamiliesDishFamilyID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "dishFamilyID" -------------
+	var dishFamilyID int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "dishFamilyID", runtime.ParamLocationPath, chi.URLParam(r, "dishFamilyID"), &dishFamilyID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dishFamilyID", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteDishFamiliesDishFamilyID(w, r, dishFamilyID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetDishFamiliesDishFamilyID operation middleware
+func (siw *ServerInterfaceWrapper) GetDishFamiliesDishFamilyID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "dishFamilyID" -------------
+	var dishFamilyID int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "dishFamilyID", runtime.ParamLocationPath, chi.URLParam(r, "dishFamilyID"), &dishFamilyID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dishFamilyID", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDishFamiliesDishFamilyID(w, r, dishFamilyID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PatchDishFamiliesDishFamilyID operation middleware
+func (siw *ServerInterfaceWrapper) PatchDishFamiliesDishFamilyID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "dishFamilyID" -------------
+	var dishFamilyID int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "dishFamilyID", runtime.ParamLocationPath, chi.URLParam(r, "dishFamilyID"), &dishFamilyID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dishFamilyID", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchDishFamiliesDishFamilyID(w, r, dishFamilyID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetDishesMergeCandidatesDishID operation middleware
+func (siw *ServerInterfaceWrapper) GetDishesMergeCandidatesDishID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "dishID" -------------
+	var dishID int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "dishID", runtime.ParamLocationPath, chi.URLParam(r, "dishID"), &dishID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dishID", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDishesMergeCandidatesDishID(w, r, dishID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetDishesDishID operation middleware
+func (siw *ServerInterfaceWrapper) GetDishesDishID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "dishID" -------------
+	var dishID int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "dishID", runtime.ParamLocationPath, chi.URLParam(r, "dishID"), &dishID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dishID", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDishesDishID(w, r, dishID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostDishesDishID operation middleware
+func (siw *ServerInterfaceWrapper) PostDishesDishID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "dishID" -------------
+	var dishID int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "dishID", runtime.ParamLocationPath, chi.URLParam(r, "dishID"), &dishID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dishID", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostDishesDishID(w, r, dishID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetDishesDishIDRatingTrend operation middleware
+func (siw *ServerInterfaceWrapper) GetDishesDishIDRatingTrend(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "dishID" -------------
+	var dishID int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "dishID", runtime.ParamLocationPath, chi.URLParam(r, "dishID"), &dishID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dishID", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDishesDishIDRatingTrend(w, r, dishID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetGetAllDishes operation middleware
+func (siw *ServerInterfaceWrapper) GetGetAllDishes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGetAllDishes(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetLocations operation middleware
+func (siw *ServerInterfaceWrapper) GetLocations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLocationsParams
+
+	// ------------- Optional query parameter "includeArchived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeArchived", r.URL.Query(), &params.IncludeArchived)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeArchived", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLocations(w, r, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetLocationsLocationID operation middleware
+func (siw *ServerInterfaceWrapper) GetLocationsLocationID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "locationID" -------------
+	var locationID int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "locationID", runtime.ParamLocationPath, chi.URLParam(r, "locationID"), &locationID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locationID", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLocationsLocationID(w, r, locationID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PatchLocationsLocationID operation middleware
+func (siw *ServerInterfaceWrapper) PatchLocationsLocationID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "locationID" -------------
+	var locationID int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "locationID", runtime.ParamLocationPath, chi.URLParam(r, "locationID"), &locationID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locationID", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchLocationsLocationID(w, r, locationID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostMergedDishes operation middleware
+func (siw *ServerInterfaceWrapper) PostMergedDishes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostMergedDishes(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteMergedDishesMergedDishID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMergedDishesMergedDishID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "mergedDishID" -------------
+	var mergedDishID int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "mergedDishID", runtime.ParamLocationPath, chi.URLParam(r, "mergedDishID"), &mergedDishID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mergedDishID", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteMergedDishesMergedDishID(w, r, mergedDishID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetMergedDishesMergedDishID operation middleware
+func (siw *ServerInterfaceWrapper) GetMergedDishesMergedDishID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "mergedDishID" -------------
+	var mergedDishID int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "mergedDishID", runtime.ParamLocationPath, chi.URLParam(r, "mergedDishID"), &mergedDishID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mergedDishID", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMergedDishesMergedDishID(w, r, mergedDishID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PatchMergedDishesMergedDishID operation middleware
+func (siw *ServerInterfaceWrapper) PatchMergedDishesMergedDishID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "mergedDishID" -------------
+	var mergedDishID int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "mergedDishID", runtime.ParamLocationPath, chi.URLParam(r, "mergedDishID"), &mergedDishID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mergedDishID", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchMergedDishesMergedDishID(w, r, mergedDishID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostSearchDish operation middleware
+func (siw *ServerInterfaceWrapper) PostSearchDish(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostSearchDish(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostSearchDishByDate operation middleware
+func (siw *ServerInterfaceWrapper) PostSearchDishByDate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostSearchDishByDate(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetUsersMe operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersMe(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersMe(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteUsersMeSessions operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUsersMeSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUsersMeSessions(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetUsersMeSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersMeSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersMeSessions(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteUsersMeSessionsSessionID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUsersMeSessionsSessionID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "sessionID" -------------
+	var sessionID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "sessionID", runtime.ParamLocationPath, chi.URLParam(r, "sessionID"), &sessionID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionID", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUsersMeSessionsSessionID(w, r, sessionID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetUsersMeStatistics operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersMeStatistics(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersMeStatistics(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetUsersMeTokens operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersMeTokens(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersMeTokens(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostUsersMeTokens operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersMeTokens(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersMeTokens(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteUsersMeTokensTokenID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUsersMeTokensTokenID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "tokenID" -------------
+	var tokenID int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tokenID", runtime.ParamLocationPath, chi.URLParam(r, "tokenID"), &tokenID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tokenID", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUsersMeTokensTokenID(w, r, tokenID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshallingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshallingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshalling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshallingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/dishFamilies", wrapper.GetDishFamilies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/dishFamilies", wrapper.PostDishFamilies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/dishFamilies/{dishFamilyID}", wrapper.DeleteDishFamiliesDishFamilyID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/dishFamilies/{dishFamilyID}", wrapper.GetDishFamiliesDishFamilyID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/dishFamilies/{dishFamilyID}", wrapper.PatchDishFamiliesDishFamilyID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/dishes/mergeCandidates/{dishID}", wrapper.GetDishesMergeCandidatesDishID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/dishes/{dishID}", wrapper.GetDishesDishID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/dishes/{dishID}", wrapper.PostDishesDishID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/dishes/{dishID}/ratingTrend", wrapper.GetDishesDishIDRatingTrend)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/getAllDishes", wrapper.GetGetAllDishes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/locations", wrapper.GetLocations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/locations/{locationID}", wrapper.GetLocationsLocationID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/locations/{locationID}", wrapper.PatchLocationsLocationID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/mergedDishes/", wrapper.PostMergedDishes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/mergedDishes/{mergedDishID}", wrapper.DeleteMergedDishesMergedDishID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/mergedDishes/{mergedDishID}", wrapper.GetMergedDishesMergedDishID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/mergedDishes/{mergedDishID}", wrapper.PatchMergedDishesMergedDishID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/searchDish", wrapper.PostSearchDish)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/searchDish/byDate", wrapper.PostSearchDishByDate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/me", wrapper.GetUsersMe)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/users/me/sessions", wrapper.DeleteUsersMeSessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/me/sessions", wrapper.GetUsersMeSessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/users/me/sessions/{sessionID}", wrapper.DeleteUsersMeSessionsSessionID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/me/statistics", wrapper.GetUsersMeStatistics)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/me/tokens", wrapper.GetUsersMeTokens)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/me/tokens", wrapper.PostUsersMeTokens)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/users/me/tokens/{tokenID}", wrapper.DeleteUsersMeTokensTokenID)
+	})
+
+	return r
+}
+
+type GetDishFamiliesRequestObject struct {
+}
+
+type GetDishFamiliesResponseObject interface {
+	VisitGetDishFamiliesResponse(w http.ResponseWriter) error
+}
+
+type GetDishFamilies200JSONResponse GetDishFamiliesResp
+
+func (response GetDishFamilies200JSONResponse) VisitGetDishFamiliesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDishFamilies401Response struct {
+}
+
+func (response GetDishFamilies401Response) VisitGetDishFamiliesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetDishFamilies500Response struct {
+}
 
-	}
+func (response GetDishFamilies500Response) VisitGetDishFamiliesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
 
-	return response, nil
+type PostDishFamiliesRequestObject struct {
+	Body *PostDishFamiliesJSONRequestBody
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
+type PostDishFamiliesResponseObject interface {
+	VisitPostDishFamiliesResponse(w http.ResponseWriter) error
+}
 
-	// (GET /dishes/mergeCandidates/{dishID})
-	GetDishesMergeCandidatesDishID(w http.ResponseWriter, r *http.Request, dishID int64)
+type PostDishFamilies200JSONResponse CreateDishFamilyResp
 
-	// (GET /dishes/{dishID})
-	GetDishesDishID(w http.ResponseWriter, r *http.Request, dishID int64)
+func (response PostDishFamilies200JSONResponse) VisitPostDishFamiliesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	// (POST /dishes/{dishID})
-	PostDishesDishID(w http.ResponseWriter, r *http.Request, dishID int64)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// (GET /getAllDishes)
-	GetGetAllDishes(w http.ResponseWriter, r *http.Request)
+type PostDishFamilies400JSONResponse BasicError
 
-	// (POST /mergedDishes/)
-	PostMergedDishes(w http.ResponseWriter, r *http.Request)
+func (response PostDishFamilies400JSONResponse) VisitPostDishFamiliesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	// (DELETE /mergedDishes/{mergedDishID})
-	DeleteMergedDishesMergedDishID(w http.ResponseWriter, r *http.Request, mergedDishID int64)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// (GET /mergedDishes/{mergedDishID})
-	GetMergedDishesMergedDishID(w http.ResponseWriter, r *http.Request, mergedDishID int64)
+type PostDishFamilies401Response struct {
+}
 
-	// (PATCH /mergedDishes/{mergedDishID})
-	PatchMergedDishesMergedDishID(w http.ResponseWriter, r *http.Request, mergedDishID int64)
+func (response PostDishFamilies401Response) VisitPostDishFamiliesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
 
-	// (POST /searchDish)
-	PostSearchDish(w http.ResponseWriter, r *http.Request)
+type PostDishFamilies500Response struct {
+}
 
-	// (POST /searchDish/byDate)
-	PostSearchDishByDate(w http.ResponseWriter, r *http.Request)
+func (response PostDishFamilies500Response) VisitPostDishFamiliesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
 
-	// (GET /users/me)
-	GetUsersMe(w http.ResponseWriter, r *http.Request)
+type DeleteDishFamiliesDishFamilyIDRequestObject struct {
+	DishFamilyID int64 `json:"dishFamilyID"`
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+type DeleteDishFamiliesDishFamilyIDResponseObject interface {
+	VisitDeleteDishFamiliesDishFamilyIDResponse(w http.ResponseWriter) error
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+type DeleteDishFamiliesDishFamilyID200Response struct {
+}
 
-// GetDishesMergeCandidatesDishID operation middleware
-func (siw *ServerInterfaceWrapper) GetDishesMergeCandidatesDishID(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+func (response DeleteDishFamiliesDishFamilyID200Response) VisitDeleteDishFamiliesDishFamilyIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
 
-	var err error
+type DeleteDishFamiliesDishFamilyID401Response struct {
+}
 
-	// ------------- Path parameter "dishID" -------------
-	var dishID int64
+func (response DeleteDishFamiliesDishFamilyID401Response) VisitDeleteDishFamiliesDishFamilyIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "dishID", runtime.ParamLocationPath, chi.URLParam(r, "dishID"), &dishID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dishID", Err: err})
-		return
-	}
+type DeleteDishFamiliesDishFamilyID404Response struct {
+}
 
-	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDishesMergeCandidatesDishID(w, r, dishID)
-	})
+func (response DeleteDishFamiliesDishFamilyID404Response) VisitDeleteDishFamiliesDishFamilyIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type DeleteDishFamiliesDishFamilyID500Response struct {
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+func (response DeleteDishFamiliesDishFamilyID500Response) VisitDeleteDishFamiliesDishFamilyIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
 }
 
-// GetDishesDishID operation middleware
-func (siw *ServerInterfaceWrapper) GetDishesDishID(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+type GetDishFamiliesDishFamilyIDRequestObject struct {
+	DishFamilyID int64 `json:"dishFamilyID"`
+}
 
-	var err error
+type GetDishFamiliesDishFamilyIDResponseObject interface {
+	VisitGetDishFamiliesDishFamilyIDResponse(w http.ResponseWriter) error
+}
 
-	// ------------- Path parameter "dishID" -------------
-	var dishID int64
+type GetDishFamiliesDishFamilyID200JSONResponse GetDishFamilyResp
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "dishID", runtime.ParamLocationPath, chi.URLParam(r, "dishID"), &dishID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dishID", Err: err})
-		return
-	}
+func (response GetDishFamiliesDishFamilyID200JSONResponse) VisitGetDishFamiliesDishFamilyIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDishesDishID(w, r, dishID)
-	})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type GetDishFamiliesDishFamilyID401Response struct {
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+func (response GetDishFamiliesDishFamilyID401Response) VisitGetDishFamiliesDishFamilyIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
 }
 
-// PostDishesDishID operation middleware
-func (siw *ServerInterfaceWrapper) PostDishesDishID(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+type GetDishFamiliesDishFamilyID404Response struct {
+}
 
-	var err error
+func (response GetDishFamiliesDishFamilyID404Response) VisitGetDishFamiliesDishFamilyIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
 
-	// ------------- Path parameter "dishID" -------------
-	var dishID int64
+type GetDishFamiliesDishFamilyID500Response struct {
+}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "dishID", runtime.ParamLocationPath, chi.URLParam(r, "dishID"), &dishID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dishID", Err: err})
-		return
-	}
+func (response GetDishFamiliesDishFamilyID500Response) VisitGetDishFamiliesDishFamilyIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
 
-	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostDishesDishID(w, r, dishID)
-	})
+type PatchDishFamiliesDishFamilyIDRequestObject struct {
+	DishFamilyID int64 `json:"dishFamilyID"`
+	Body         *PatchDishFamiliesDishFamilyIDJSONRequestBody
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type PatchDishFamiliesDishFamilyIDResponseObject interface {
+	VisitPatchDishFamiliesDishFamilyIDResponse(w http.ResponseWriter) error
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+type PatchDishFamiliesDishFamilyID200Response struct {
 }
 
-// GetGetAllDishes operation middleware
-func (siw *ServerInterfaceWrapper) GetGetAllDishes(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+func (response PatchDishFamiliesDishFamilyID200Response) VisitPatchDishFamiliesDishFamilyIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
 
-	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetGetAllDishes(w, r)
-	})
+type PatchDishFamiliesDishFamilyID400JSONResponse BasicError
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response PatchDishFamiliesDishFamilyID400JSONResponse) VisitPatchDishFamiliesDishFamilyIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	return json.NewEncoder(w).Encode(response)
 }
 
-// PostMergedDishes operation middleware
-func (siw *ServerInterfaceWrapper) PostMergedDishes(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+type PatchDishFamiliesDishFamilyID401Response struct {
+}
 
-	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostMergedDishes(w, r)
-	})
+func (response PatchDishFamiliesDishFamilyID401Response) VisitPatchDishFamiliesDishFamilyIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type PatchDishFamiliesDishFamilyID404Response struct {
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+func (response PatchDishFamiliesDishFamilyID404Response) VisitPatchDishFamiliesDishFamilyIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
 }
 
-// DeleteMergedDishesMergedDishID operation middleware
-func (siw *ServerInterfaceWrapper) DeleteMergedDishesMergedDishID(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+type PatchDishFamiliesDishFamilyID500Response struct {
+}
 
-	var err error
+func (response PatchDishFamiliesDishFamilyID500Response) VisitPatchDishFamiliesDishFamilyIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
 
-	// ------------- Path parameter "mergedDishID" -------------
-	var mergedDishID int64
+type GetDishesMergeCandidatesDishIDRequestObject struct {
+	DishID int64 `json:"dishID"`
+}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "mergedDishID", runtime.ParamLocationPath, chi.URLParam(r, "mergedDishID"), &mergedDishID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mergedDishID", Err: err})
-		return
-	}
+type GetDishesMergeCandidatesDishIDResponseObject interface {
+	VisitGetDishesMergeCandidatesDishIDResponse(w http.ResponseWriter) error
+}
 
-	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteMergedDishesMergedDishID(w, r, mergedDishID)
-	})
+type GetDishesMergeCandidatesDishID200JSONResponse GetMergeCandidatesResp
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response GetDishesMergeCandidatesDishID200JSONResponse) VisitGetDishesMergeCandidatesDishIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetMergedDishesMergedDishID operation middleware
-func (siw *ServerInterfaceWrapper) GetMergedDishesMergedDishID(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+type GetDishesMergeCandidatesDishID401Response struct {
+}
 
-	var err error
+func (response GetDishesMergeCandidatesDishID401Response) VisitGetDishesMergeCandidatesDishIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
 
-	// ------------- Path parameter "mergedDishID" -------------
-	var mergedDishID int64
+type GetDishesMergeCandidatesDishID404Response struct {
+}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "mergedDishID", runtime.ParamLocationPath, chi.URLParam(r, "mergedDishID"), &mergedDishID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mergedDishID", Err: err})
-		return
-	}
+func (response GetDishesMergeCandidatesDishID404Response) VisitGetDishesMergeCandidatesDishIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
 
-	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetMergedDishesMergedDishID(w, r, mergedDishID)
-	})
+type GetDishesMergeCandidatesDishID500Response struct {
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response GetDishesMergeCandidatesDishID500Response) VisitGetDishesMergeCandidatesDishIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+type GetDishesDishIDRequestObject struct {
+	DishID int64 `json:"dishID"`
 }
 
-// PatchMergedDishesMergedDishID operation middleware
-func (siw *ServerInterfaceWrapper) PatchMergedDishesMergedDishID(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+type GetDishesDishIDResponseObject interface {
+	VisitGetDishesDishIDResponse(w http.ResponseWriter) error
+}
+
+type GetDishesDishID200JSONResponse GetDishResp
+
+func (response GetDishesDishID200JSONResponse) VisitGetDishesDishIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	var err error
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// ------------- Path parameter "mergedDishID" -------------
-	var mergedDishID int64
+type GetDishesDishID400JSONResponse BasicError
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "mergedDishID", runtime.ParamLocationPath, chi.URLParam(r, "mergedDishID"), &mergedDishID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mergedDishID", Err: err})
-		return
-	}
+func (response GetDishesDishID400JSONResponse) VisitGetDishesDishIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchMergedDishesMergedDishID(w, r, mergedDishID)
-	})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type GetDishesDishID401Response struct {
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+func (response GetDishesDishID401Response) VisitGetDishesDishIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
 }
 
-// PostSearchDish operation middleware
-func (siw *ServerInterfaceWrapper) PostSearchDish(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+type GetDishesDishID404Response struct {
+}
 
-	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostSearchDish(w, r)
-	})
+func (response GetDishesDishID404Response) VisitGetDishesDishIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type GetDishesDishID500JSONResponse BasicError
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+func (response GetDishesDishID500JSONResponse) VisitGetDishesDishIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// PostSearchDishByDate operation middleware
-func (siw *ServerInterfaceWrapper) PostSearchDishByDate(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+type PostDishesDishIDRequestObject struct {
+	DishID int64 `json:"dishID"`
+	Body   *PostDishesDishIDJSONRequestBody
+}
 
-	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostSearchDishByDate(w, r)
-	})
+type PostDishesDishIDResponseObject interface {
+	VisitPostDishesDishIDResponse(w http.ResponseWriter) error
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type PostDishesDishID200Response struct {
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+func (response PostDishesDishID200Response) VisitPostDishesDishIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
 }
 
-// GetUsersMe operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersMe(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+type PostDishesDishID400JSONResponse BasicError
 
-	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUsersMe(w, r)
-	})
+func (response PostDishesDishID400JSONResponse) VisitPostDishesDishIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+type PostDishesDishID401Response struct {
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+func (response PostDishesDishID401Response) VisitPostDishesDishIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+type PostDishesDishID404Response struct {
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+func (response PostDishesDishID404Response) VisitPostDishesDishIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
 }
 
-type UnmarshallingParamError struct {
-	ParamName string
-	Err       error
+type PostDishesDishID500JSONResponse BasicError
+
+func (response PostDishesDishID500JSONResponse) VisitPostDishesDishIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *UnmarshallingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshalling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+type GetDishesDishIDRatingTrendRequestObject struct {
+	DishID int64 `json:"dishID"`
 }
 
-func (e *UnmarshallingParamError) Unwrap() error {
-	return e.Err
+type GetDishesDishIDRatingTrendResponseObject interface {
+	VisitGetDishesDishIDRatingTrendResponse(w http.ResponseWriter) error
 }
 
-type RequiredParamError struct {
-	ParamName string
+type GetDishesDishIDRatingTrend200JSONResponse GetDishRatingTrendResp
+
+func (response GetDishesDishIDRatingTrend200JSONResponse) VisitGetDishesDishIDRatingTrendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+type GetDishesDishIDRatingTrend401Response struct {
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
+func (response GetDishesDishIDRatingTrend401Response) VisitGetDishesDishIDRatingTrendResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+type GetDishesDishIDRatingTrend404Response struct {
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+func (response GetDishesDishIDRatingTrend404Response) VisitGetDishesDishIDRatingTrendResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+type GetDishesDishIDRatingTrend500JSONResponse BasicError
+
+func (response GetDishesDishIDRatingTrend500JSONResponse) VisitGetDishesDishIDRatingTrendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+type GetGetAllDishesRequestObject struct {
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+type GetGetAllDishesResponseObject interface {
+	VisitGetGetAllDishesResponse(w http.ResponseWriter) error
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+type GetGetAllDishes200JSONResponse GetAllDishesResponse
+
+func (response GetGetAllDishes200JSONResponse) VisitGetGetAllDishesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+type GetGetAllDishes401Response struct {
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+func (response GetGetAllDishes401Response) VisitGetGetAllDishesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+type GetGetAllDishes500JSONResponse BasicError
+
+func (response GetGetAllDishes500JSONResponse) VisitGetGetAllDishesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+type GetLocationsRequestObject struct {
+	Params GetLocationsParams
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+type GetLocationsResponseObject interface {
+	VisitGetLocationsResponse(w http.ResponseWriter) error
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+type GetLocations200JSONResponse GetLocationsResp
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+func (response GetLocations200JSONResponse) VisitGetLocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/dishes/mergeCandidates/{dishID}", wrapper.GetDishesMergeCandidatesDishID)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/dishes/{dishID}", wrapper.GetDishesDishID)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/dishes/{dishID}", wrapper.PostDishesDishID)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/getAllDishes", wrapper.GetGetAllDishes)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/mergedDishes/", wrapper.PostMergedDishes)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/mergedDishes/{mergedDishID}", wrapper.DeleteMergedDishesMergedDishID)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/mergedDishes/{mergedDishID}", wrapper.GetMergedDishesMergedDishID)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/mergedDishes/{mergedDishID}", wrapper.PatchMergedDishesMergedDishID)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/searchDish", wrapper.PostSearchDish)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/searchDish/byDate", wrapper.PostSearchDishByDate)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/users/me", wrapper.GetUsersMe)
-	})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	return r
+type GetLocations401Response struct {
+}
+
+func (response GetLocations401Response) VisitGetLocationsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetLocations500Response struct {
+}
+
+func (response GetLocations500Response) VisitGetLocationsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
 }
 
-type GetDishesMergeCandidatesDishIDRequestObject struct {
-	DishID int64 `json:"dishID"`
+type GetLocationsLocationIDRequestObject struct {
+	LocationID int64 `json:"locationID"`
 }
 
-type GetDishesMergeCandidatesDishIDResponseObject interface {
-	VisitGetDishesMergeCandidatesDishIDResponse(w http.ResponseWriter) error
+type GetLocationsLocationIDResponseObject interface {
+	VisitGetLocationsLocationIDResponse(w http.ResponseWriter) error
 }
 
-type GetDishesMergeCandidatesDishID200JSONResponse GetMergeCandidatesResp
+type GetLocationsLocationID200JSONResponse Location
 
-func (response GetDishesMergeCandidatesDishID200JSONResponse) VisitGetDishesMergeCandidatesDishIDResponse(w http.ResponseWriter) error {
+func (response GetLocationsLocationID200JSONResponse) VisitGetLocationsLocationIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDishesMergeCandidatesDishID401Response struct {
+type GetLocationsLocationID401Response struct {
 }
 
-func (response GetDishesMergeCandidatesDishID401Response) VisitGetDishesMergeCandidatesDishIDResponse(w http.ResponseWriter) error {
+func (response GetLocationsLocationID401Response) VisitGetLocationsLocationIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetDishesMergeCandidatesDishID404Response struct {
+type GetLocationsLocationID404Response struct {
 }
 
-func (response GetDishesMergeCandidatesDishID404Response) VisitGetDishesMergeCandidatesDishIDResponse(w http.ResponseWriter) error {
+func (response GetLocationsLocationID404Response) VisitGetLocationsLocationIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type GetDishesMergeCandidatesDishID500Response struct {
+type GetLocationsLocationID500Response struct {
 }
 
-func (response GetDishesMergeCandidatesDishID500Response) VisitGetDishesMergeCandidatesDishIDResponse(w http.ResponseWriter) error {
+func (response GetLocationsLocationID500Response) VisitGetLocationsLocationIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type GetDishesDishIDRequestObject struct {
-	DishID int64 `json:"dishID"`
+type PatchLocationsLocationIDRequestObject struct {
+	LocationID int64 `json:"locationID"`
+	Body       *PatchLocationsLocationIDJSONRequestBody
 }
 
-type GetDishesDishIDResponseObject interface {
-	VisitGetDishesDishIDResponse(w http.ResponseWriter) error
+type PatchLocationsLocationIDResponseObject interface {
+	VisitPatchLocationsLocationIDResponse(w http.ResponseWriter) error
 }
 
-type GetDishesDishID200JSONResponse GetDishResp
+type PatchLocationsLocationID200JSONResponse Location
 
-func (response GetDishesDishID200JSONResponse) VisitGetDishesDishIDResponse(w http.ResponseWriter) error {
+func (response PatchLocationsLocationID200JSONResponse) VisitPatchLocationsLocationIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDishesDishID400JSONResponse BasicError
+type PatchLocationsLocationID400JSONResponse BasicError
 
-func (response GetDishesDishID400JSONResponse) VisitGetDishesDishIDResponse(w http.ResponseWriter) error {
+func (response PatchLocationsLocationID400JSONResponse) VisitPatchLocationsLocationIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDishesDishID401Response struct {
+type PatchLocationsLocationID401Response struct {
 }
 
-func (response GetDishesDishID401Response) VisitGetDishesDishIDResponse(w http.ResponseWriter) error {
+func (response PatchLocationsLocationID401Response) VisitPatchLocationsLocationIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetDishesDishID404Response struct {
+type PatchLocationsLocationID404Response struct {
 }
 
-func (response GetDishesDishID404Response) VisitGetDishesDishIDResponse(w http.ResponseWriter) error {
+func (response PatchLocationsLocationID404Response) VisitPatchLocationsLocationIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type GetDishesDishID500JSONResponse BasicError
+type PatchLocationsLocationID500Response struct {
+}
 
-func (response GetDishesDishID500JSONResponse) VisitGetDishesDishIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response PatchLocationsLocationID500Response) VisitPatchLocationsLocationIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
+	return nil
+}
+
+type PostMergedDishesRequestObject struct {
+	Body *PostMergedDishesJSONRequestBody
+}
+
+type PostMergedDishesResponseObject interface {
+	VisitPostMergedDishesResponse(w http.ResponseWriter) error
+}
+
+type PostMergedDishes200JSONResponse CreateMergedDishResp
+
+func (response PostMergedDishes200JSONResponse) VisitPostMergedDishesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostDishesDishIDRequestObject struct {
-	DishID int64 `json:"dishID"`
-	Body   *PostDishesDishIDJSONRequestBody
+type PostMergedDishes400JSONResponse BasicError
+
+func (response PostMergedDishes400JSONResponse) VisitPostMergedDishesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PostDishesDishIDResponseObject interface {
-	VisitPostDishesDishIDResponse(w http.ResponseWriter) error
+type PostMergedDishes401Response struct {
 }
 
-type PostDishesDishID200Response struct {
+func (response PostMergedDishes401Response) VisitPostMergedDishesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
 }
 
-func (response PostDishesDishID200Response) VisitPostDishesDishIDResponse(w http.ResponseWriter) error {
+type PostMergedDishes500Response struct {
+}
+
+func (response PostMergedDishes500Response) VisitPostMergedDishesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type DeleteMergedDishesMergedDishIDRequestObject struct {
+	MergedDishID int64 `json:"mergedDishID"`
+}
+
+type DeleteMergedDishesMergedDishIDResponseObject interface {
+	VisitDeleteMergedDishesMergedDishIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteMergedDishesMergedDishID200Response struct {
+}
+
+func (response DeleteMergedDishesMergedDishID200Response) VisitDeleteMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type PostDishesDishID400JSONResponse BasicError
+type DeleteMergedDishesMergedDishID400JSONResponse BasicError
 
-func (response PostDishesDishID400JSONResponse) VisitPostDishesDishIDResponse(w http.ResponseWriter) error {
+func (response DeleteMergedDishesMergedDishID400JSONResponse) VisitDeleteMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostDishesDishID401Response struct {
+type DeleteMergedDishesMergedDishID401Response struct {
 }
 
-func (response PostDishesDishID401Response) VisitPostDishesDishIDResponse(w http.ResponseWriter) error {
+func (response DeleteMergedDishesMergedDishID401Response) VisitDeleteMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type PostDishesDishID404Response struct {
+type DeleteMergedDishesMergedDishID404Response struct {
 }
 
-func (response PostDishesDishID404Response) VisitPostDishesDishIDResponse(w http.ResponseWriter) error {
+func (response DeleteMergedDishesMergedDishID404Response) VisitDeleteMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type PostDishesDishID500JSONResponse BasicError
+type DeleteMergedDishesMergedDishID500Response struct {
+}
 
-func (response PostDishesDishID500JSONResponse) VisitPostDishesDishIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response DeleteMergedDishesMergedDishID500Response) VisitDeleteMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
+	return nil
 }
 
-type GetGetAllDishesRequestObject struct {
+type GetMergedDishesMergedDishIDRequestObject struct {
+	MergedDishID int64 `json:"mergedDishID"`
 }
 
-type GetGetAllDishesResponseObject interface {
-	VisitGetGetAllDishesResponse(w http.ResponseWriter) error
+type GetMergedDishesMergedDishIDResponseObject interface {
+	VisitGetMergedDishesMergedDishIDResponse(w http.ResponseWriter) error
 }
 
-type GetGetAllDishes200JSONResponse GetAllDishesResponse
+type GetMergedDishesMergedDishID200JSONResponse MergedDishManagementData
 
-func (response GetGetAllDishes200JSONResponse) VisitGetGetAllDishesResponse(w http.ResponseWriter) error {
+func (response GetMergedDishesMergedDishID200JSONResponse) VisitGetMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetGetAllDishes401Response struct {
+type GetMergedDishesMergedDishID401Response struct {
 }
 
-func (response GetGetAllDishes401Response) VisitGetGetAllDishesResponse(w http.ResponseWriter) error {
+func (response GetMergedDishesMergedDishID401Response) VisitGetMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetGetAllDishes500JSONResponse BasicError
+type GetMergedDishesMergedDishID404Response struct {
+}
 
-func (response GetGetAllDishes500JSONResponse) VisitGetGetAllDishesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response GetMergedDishesMergedDishID404Response) VisitGetMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetMergedDishesMergedDishID500Response struct {
+}
+
+func (response GetMergedDishesMergedDishID500Response) VisitGetMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
+	return nil
+}
+
+type PatchMergedDishesMergedDishIDRequestObject struct {
+	MergedDishID int64 `json:"mergedDishID"`
+	Body         *PatchMergedDishesMergedDishIDJSONRequestBody
+}
+
+type PatchMergedDishesMergedDishIDResponseObject interface {
+	VisitPatchMergedDishesMergedDishIDResponse(w http.ResponseWriter) error
+}
+
+type PatchMergedDishesMergedDishID200Response struct {
+}
+
+func (response PatchMergedDishesMergedDishID200Response) VisitPatchMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchMergedDishesMergedDishID400JSONResponse BasicError
+
+func (response PatchMergedDishesMergedDishID400JSONResponse) VisitPatchMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostMergedDishesRequestObject struct {
-	Body *PostMergedDishesJSONRequestBody
+type PatchMergedDishesMergedDishID401Response struct {
 }
 
-type PostMergedDishesResponseObject interface {
-	VisitPostMergedDishesResponse(w http.ResponseWriter) error
+func (response PatchMergedDishesMergedDishID401Response) VisitPatchMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
 }
 
-type PostMergedDishes200JSONResponse CreateMergedDishResp
+type PatchMergedDishesMergedDishID404Response struct {
+}
 
-func (response PostMergedDishes200JSONResponse) VisitPostMergedDishesResponse(w http.ResponseWriter) error {
+func (response PatchMergedDishesMergedDishID404Response) VisitPatchMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PatchMergedDishesMergedDishID500Response struct {
+}
+
+func (response PatchMergedDishesMergedDishID500Response) VisitPatchMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PostSearchDishRequestObject struct {
+	Body *PostSearchDishJSONRequestBody
+}
+
+type PostSearchDishResponseObject interface {
+	VisitPostSearchDishResponse(w http.ResponseWriter) error
+}
+
+type PostSearchDish200JSONResponse SearchDishResp
+
+func (response PostSearchDish200JSONResponse) VisitPostSearchDishResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostMergedDishes400JSONResponse BasicError
+type PostSearchDish401Response struct {
+}
 
-func (response PostMergedDishes400JSONResponse) VisitPostMergedDishesResponse(w http.ResponseWriter) error {
+func (response PostSearchDish401Response) VisitPostSearchDishResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostSearchDish500JSONResponse BasicError
+
+func (response PostSearchDish500JSONResponse) VisitPostSearchDishResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostMergedDishes401Response struct {
+type PostSearchDishByDateRequestObject struct {
+	Body *PostSearchDishByDateJSONRequestBody
 }
 
-func (response PostMergedDishes401Response) VisitPostMergedDishesResponse(w http.ResponseWriter) error {
+type PostSearchDishByDateResponseObject interface {
+	VisitPostSearchDishByDateResponse(w http.ResponseWriter) error
+}
+
+type PostSearchDishByDate200JSONResponse []int64
+
+func (response PostSearchDishByDate200JSONResponse) VisitPostSearchDishByDateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSearchDishByDate401Response struct {
+}
+
+func (response PostSearchDishByDate401Response) VisitPostSearchDishByDateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type PostMergedDishes500Response struct {
-}
+type PostSearchDishByDate500JSONResponse BasicError
 
-func (response PostMergedDishes500Response) VisitPostMergedDishesResponse(w http.ResponseWriter) error {
+func (response PostSearchDishByDate500JSONResponse) VisitPostSearchDishByDateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteMergedDishesMergedDishIDRequestObject struct {
-	MergedDishID int64 `json:"mergedDishID"`
+type GetUsersMeRequestObject struct {
 }
 
-type DeleteMergedDishesMergedDishIDResponseObject interface {
-	VisitDeleteMergedDishesMergedDishIDResponse(w http.ResponseWriter) error
+type GetUsersMeResponseObject interface {
+	VisitGetUsersMeResponse(w http.ResponseWriter) error
 }
 
-type DeleteMergedDishesMergedDishID200Response struct {
+type GetUsersMe200JSONResponse GetUsersMeResp
+
+func (response GetUsersMe200JSONResponse) VisitGetUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersMe401Response struct {
 }
 
-func (response DeleteMergedDishesMergedDishID200Response) VisitDeleteMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+func (response GetUsersMe401Response) VisitGetUsersMeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
 	return nil
 }
 
-type DeleteMergedDishesMergedDishID400JSONResponse BasicError
+type GetUsersMe500JSONResponse BasicError
 
-func (response DeleteMergedDishesMergedDishID400JSONResponse) VisitDeleteMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
+func (response GetUsersMe500JSONResponse) VisitGetUsersMeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteMergedDishesMergedDishID401Response struct {
+type DeleteUsersMeSessionsRequestObject struct {
 }
 
-func (response DeleteMergedDishesMergedDishID401Response) VisitDeleteMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+type DeleteUsersMeSessionsResponseObject interface {
+	VisitDeleteUsersMeSessionsResponse(w http.ResponseWriter) error
 }
 
-type DeleteMergedDishesMergedDishID404Response struct {
+type DeleteUsersMeSessions200JSONResponse RevokeSessionsResp
+
+func (response DeleteUsersMeSessions200JSONResponse) VisitDeleteUsersMeSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response DeleteMergedDishesMergedDishID404Response) VisitDeleteMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
+type DeleteUsersMeSessions401Response struct {
+}
+
+func (response DeleteUsersMeSessions401Response) VisitDeleteUsersMeSessionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
 	return nil
 }
 
-type DeleteMergedDishesMergedDishID500Response struct {
+type DeleteUsersMeSessions500Response struct {
 }
 
-func (response DeleteMergedDishesMergedDishID500Response) VisitDeleteMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
+func (response DeleteUsersMeSessions500Response) VisitDeleteUsersMeSessionsResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type GetMergedDishesMergedDishIDRequestObject struct {
-	MergedDishID int64 `json:"mergedDishID"`
+type GetUsersMeSessionsRequestObject struct {
 }
 
-type GetMergedDishesMergedDishIDResponseObject interface {
-	VisitGetMergedDishesMergedDishIDResponse(w http.ResponseWriter) error
+type GetUsersMeSessionsResponseObject interface {
+	VisitGetUsersMeSessionsResponse(w http.ResponseWriter) error
 }
 
-type GetMergedDishesMergedDishID200JSONResponse MergedDishManagementData
+type GetUsersMeSessions200JSONResponse GetSessionsResp
 
-func (response GetMergedDishesMergedDishID200JSONResponse) VisitGetMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
+func (response GetUsersMeSessions200JSONResponse) VisitGetUsersMeSessionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMergedDishesMergedDishID401Response struct {
+type GetUsersMeSessions401Response struct {
 }
 
-func (response GetMergedDishesMergedDishID401Response) VisitGetMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
+func (response GetUsersMeSessions401Response) VisitGetUsersMeSessionsResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetMergedDishesMergedDishID404Response struct {
-}
-
-func (response GetMergedDishesMergedDishID404Response) VisitGetMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
-
-type GetMergedDishesMergedDishID500Response struct {
+type GetUsersMeSessions500Response struct {
 }
 
-func (response GetMergedDishesMergedDishID500Response) VisitGetMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
+func (response GetUsersMeSessions500Response) VisitGetUsersMeSessionsResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type PatchMergedDishesMergedDishIDRequestObject struct {
-	MergedDishID int64 `json:"mergedDishID"`
-	Body         *PatchMergedDishesMergedDishIDJSONRequestBody
+type DeleteUsersMeSessionsSessionIDRequestObject struct {
+	SessionID string `json:"sessionID"`
 }
 
-type PatchMergedDishesMergedDishIDResponseObject interface {
-	VisitPatchMergedDishesMergedDishIDResponse(w http.ResponseWriter) error
+type DeleteUsersMeSessionsSessionIDResponseObject interface {
+	VisitDeleteUsersMeSessionsSessionIDResponse(w http.ResponseWriter) error
 }
 
-type PatchMergedDishesMergedDishID200Response struct {
+type DeleteUsersMeSessionsSessionID200Response struct {
 }
 
-func (response PatchMergedDishesMergedDishID200Response) VisitPatchMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
+func (response DeleteUsersMeSessionsSessionID200Response) VisitDeleteUsersMeSessionsSessionIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type PatchMergedDishesMergedDishID400JSONResponse BasicError
-
-func (response PatchMergedDishesMergedDishID400JSONResponse) VisitPatchMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type PatchMergedDishesMergedDishID401Response struct {
+type DeleteUsersMeSessionsSessionID401Response struct {
 }
 
-func (response PatchMergedDishesMergedDishID401Response) VisitPatchMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
+func (response DeleteUsersMeSessionsSessionID401Response) VisitDeleteUsersMeSessionsSessionIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type PatchMergedDishesMergedDishID404Response struct {
+type DeleteUsersMeSessionsSessionID404Response struct {
 }
 
-func (response PatchMergedDishesMergedDishID404Response) VisitPatchMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
+func (response DeleteUsersMeSessionsSessionID404Response) VisitDeleteUsersMeSessionsSessionIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type PatchMergedDishesMergedDishID500Response struct {
+type DeleteUsersMeSessionsSessionID500Response struct {
 }
 
-func (response PatchMergedDishesMergedDishID500Response) VisitPatchMergedDishesMergedDishIDResponse(w http.ResponseWriter) error {
+func (response DeleteUsersMeSessionsSessionID500Response) VisitDeleteUsersMeSessionsSessionIDResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type PostSearchDishRequestObject struct {
-	Body *PostSearchDishJSONRequestBody
+type GetUsersMeStatisticsRequestObject struct {
 }
 
-type PostSearchDishResponseObject interface {
-	VisitPostSearchDishResponse(w http.ResponseWriter) error
+type GetUsersMeStatisticsResponseObject interface {
+	VisitGetUsersMeStatisticsResponse(w http.ResponseWriter) error
 }
 
-type PostSearchDish200JSONResponse SearchDishResp
+type GetUsersMeStatistics200JSONResponse GetUsersMeStatisticsResp
 
-func (response PostSearchDish200JSONResponse) VisitPostSearchDishResponse(w http.ResponseWriter) error {
+func (response GetUsersMeStatistics200JSONResponse) VisitGetUsersMeStatisticsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostSearchDish401Response struct {
+type GetUsersMeStatistics401Response struct {
 }
 
-func (response PostSearchDish401Response) VisitPostSearchDishResponse(w http.ResponseWriter) error {
+func (response GetUsersMeStatistics401Response) VisitGetUsersMeStatisticsResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type PostSearchDish500JSONResponse BasicError
+type GetUsersMeStatistics500JSONResponse BasicError
 
-func (response PostSearchDish500JSONResponse) VisitPostSearchDishResponse(w http.ResponseWriter) error {
+func (response GetUsersMeStatistics500JSONResponse) VisitGetUsersMeStatisticsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostSearchDishByDateRequestObject struct {
-	Body *PostSearchDishByDateJSONRequestBody
+type GetUsersMeTokensRequestObject struct {
 }
 
-type PostSearchDishByDateResponseObject interface {
-	VisitPostSearchDishByDateResponse(w http.ResponseWriter) error
+type GetUsersMeTokensResponseObject interface {
+	VisitGetUsersMeTokensResponse(w http.ResponseWriter) error
 }
 
-type PostSearchDishByDate200JSONResponse []int64
+type GetUsersMeTokens200JSONResponse GetAccessTokensResp
 
-func (response PostSearchDishByDate200JSONResponse) VisitPostSearchDishByDateResponse(w http.ResponseWriter) error {
+func (response GetUsersMeTokens200JSONResponse) VisitGetUsersMeTokensResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostSearchDishByDate401Response struct {
+type GetUsersMeTokens401Response struct {
 }
 
-func (response PostSearchDishByDate401Response) VisitPostSearchDishByDateResponse(w http.ResponseWriter) error {
+func (response GetUsersMeTokens401Response) VisitGetUsersMeTokensResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type PostSearchDishByDate500JSONResponse BasicError
+type GetUsersMeTokens500Response struct {
+}
 
-func (response PostSearchDishByDate500JSONResponse) VisitPostSearchDishByDateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response GetUsersMeTokens500Response) VisitGetUsersMeTokensResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
+	return nil
 }
 
-type GetUsersMeRequestObject struct {
+type PostUsersMeTokensRequestObject struct {
+	Body *PostUsersMeTokensJSONRequestBody
 }
 
-type GetUsersMeResponseObject interface {
-	VisitGetUsersMeResponse(w http.ResponseWriter) error
+type PostUsersMeTokensResponseObject interface {
+	VisitPostUsersMeTokensResponse(w http.ResponseWriter) error
 }
 
-type GetUsersMe200JSONResponse GetUsersMeResp
+type PostUsersMeTokens200JSONResponse CreateAccessTokenResp
 
-func (response GetUsersMe200JSONResponse) VisitGetUsersMeResponse(w http.ResponseWriter) error {
+func (response PostUsersMeTokens200JSONResponse) VisitPostUsersMeTokensResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUsersMe401Response struct {
+type PostUsersMeTokens400JSONResponse BasicError
+
+func (response PostUsersMeTokens400JSONResponse) VisitPostUsersMeTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response GetUsersMe401Response) VisitGetUsersMeResponse(w http.ResponseWriter) error {
+type PostUsersMeTokens401Response struct {
+}
+
+func (response PostUsersMeTokens401Response) VisitPostUsersMeTokensResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GetUsersMe500JSONResponse BasicError
+type PostUsersMeTokens500Response struct {
+}
 
-func (response GetUsersMe500JSONResponse) VisitGetUsersMeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response PostUsersMeTokens500Response) VisitPostUsersMeTokensResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
+	return nil
+}
 
-	return json.NewEncoder(w).Encode(response)
+type DeleteUsersMeTokensTokenIDRequestObject struct {
+	TokenID int64 `json:"tokenID"`
+}
+
+type DeleteUsersMeTokensTokenIDResponseObject interface {
+	VisitDeleteUsersMeTokensTokenIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteUsersMeTokensTokenID200Response struct {
+}
+
+func (response DeleteUsersMeTokensTokenID200Response) VisitDeleteUsersMeTokensTokenIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteUsersMeTokensTokenID401Response struct {
+}
+
+func (response DeleteUsersMeTokensTokenID401Response) VisitDeleteUsersMeTokensTokenIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteUsersMeTokensTokenID404Response struct {
+}
+
+func (response DeleteUsersMeTokensTokenID404Response) VisitDeleteUsersMeTokensTokenIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type DeleteUsersMeTokensTokenID500Response struct {
+}
+
+func (response DeleteUsersMeTokensTokenID500Response) VisitDeleteUsersMeTokensTokenIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
 }
 
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 
+	// (GET /dishFamilies)
+	GetDishFamilies(ctx context.Context, request GetDishFamiliesRequestObject) (GetDishFamiliesResponseObject, error)
+
+	// (POST /dishFamilies)
+	PostDishFamilies(ctx context.Context, request PostDishFamiliesRequestObject) (PostDishFamiliesResponseObject, error)
+
+	// (DELETE /dishFamilies/{dishFamilyID})
+	DeleteDishFamiliesDishFamilyID(ctx context.Context, request DeleteDishFamiliesDishFamilyIDRequestObject) (DeleteDishFamiliesDishFamilyIDResponseObject, error)
+
+	// (GET /dishFamilies/{dishFamilyID})
+	GetDishFamiliesDishFamilyID(ctx context.Context, request GetDishFamiliesDishFamilyIDRequestObject) (GetDishFamiliesDishFamilyIDResponseObject, error)
+
+	// (PATCH /dishFamilies/{dishFamilyID})
+	PatchDishFamiliesDishFamilyID(ctx context.Context, request PatchDishFamiliesDishFamilyIDRequestObject) (PatchDishFamiliesDishFamilyIDResponseObject, error)
+
 	// (GET /dishes/mergeCandidates/{dishID})
 	GetDishesMergeCandidatesDishID(ctx context.Context, request GetDishesMergeCandidatesDishIDRequestObject) (GetDishesMergeCandidatesDishIDResponseObject, error)
 
@@ -2450,9 +5388,21 @@ type StrictServerInterface interface {
 	// (POST /dishes/{dishID})
 	PostDishesDishID(ctx context.Context, request PostDishesDishIDRequestObject) (PostDishesDishIDResponseObject, error)
 
+	// (GET /dishes/{dishID}/ratingTrend)
+	GetDishesDishIDRatingTrend(ctx context.Context, request GetDishesDishIDRatingTrendRequestObject) (GetDishesDishIDRatingTrendResponseObject, error)
+
 	// (GET /getAllDishes)
 	GetGetAllDishes(ctx context.Context, request GetGetAllDishesRequestObject) (GetGetAllDishesResponseObject, error)
 
+	// (GET /locations)
+	GetLocations(ctx context.Context, request GetLocationsRequestObject) (GetLocationsResponseObject, error)
+
+	// (GET /locations/{locationID})
+	GetLocationsLocationID(ctx context.Context, request GetLocationsLocationIDRequestObject) (GetLocationsLocationIDResponseObject, error)
+
+	// (PATCH /locations/{locationID})
+	PatchLocationsLocationID(ctx context.Context, request PatchLocationsLocationIDRequestObject) (PatchLocationsLocationIDResponseObject, error)
+
 	// (POST /mergedDishes/)
 	PostMergedDishes(ctx context.Context, request PostMergedDishesRequestObject) (PostMergedDishesResponseObject, error)
 
@@ -2471,38 +5421,199 @@ type StrictServerInterface interface {
 	// (POST /searchDish/byDate)
 	PostSearchDishByDate(ctx context.Context, request PostSearchDishByDateRequestObject) (PostSearchDishByDateResponseObject, error)
 
-	// (GET /users/me)
-	GetUsersMe(ctx context.Context, request GetUsersMeRequestObject) (GetUsersMeResponseObject, error)
+	// (GET /users/me)
+	GetUsersMe(ctx context.Context, request GetUsersMeRequestObject) (GetUsersMeResponseObject, error)
+
+	// (DELETE /users/me/sessions)
+	DeleteUsersMeSessions(ctx context.Context, request DeleteUsersMeSessionsRequestObject) (DeleteUsersMeSessionsResponseObject, error)
+
+	// (GET /users/me/sessions)
+	GetUsersMeSessions(ctx context.Context, request GetUsersMeSessionsRequestObject) (GetUsersMeSessionsResponseObject, error)
+
+	// (DELETE /users/me/sessions/{sessionID})
+	DeleteUsersMeSessionsSessionID(ctx context.Context, request DeleteUsersMeSessionsSessionIDRequestObject) (DeleteUsersMeSessionsSessionIDResponseObject, error)
+
+	// (GET /users/me/statistics)
+	GetUsersMeStatistics(ctx context.Context, request GetUsersMeStatisticsRequestObject) (GetUsersMeStatisticsResponseObject, error)
+
+	// (GET /users/me/tokens)
+	GetUsersMeTokens(ctx context.Context, request GetUsersMeTokensRequestObject) (GetUsersMeTokensResponseObject, error)
+
+	// (POST /users/me/tokens)
+	PostUsersMeTokens(ctx context.Context, request PostUsersMeTokensRequestObject) (PostUsersMeTokensResponseObject, error)
+
+	// (DELETE /users/me/tokens/{tokenID})
+	DeleteUsersMeTokensTokenID(ctx context.Context, request DeleteUsersMeTokensTokenIDRequestObject) (DeleteUsersMeTokensTokenIDResponseObject, error)
+}
+
+type StrictHandlerFunc func(ctx context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error)
+
+type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetDishFamilies operation middleware
+func (sh *strictHandler) GetDishFamilies(w http.ResponseWriter, r *http.Request) {
+	var request GetDishFamiliesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDishFamilies(ctx, request.(GetDishFamiliesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDishFamilies")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDishFamiliesResponseObject); ok {
+		if err := validResponse.VisitGetDishFamiliesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// PostDishFamilies operation middleware
+func (sh *strictHandler) PostDishFamilies(w http.ResponseWriter, r *http.Request) {
+	var request PostDishFamiliesRequestObject
+
+	var body PostDishFamiliesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostDishFamilies(ctx, request.(PostDishFamiliesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostDishFamilies")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostDishFamiliesResponseObject); ok {
+		if err := validResponse.VisitPostDishFamiliesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// DeleteDishFamiliesDishFamilyID operation middleware
+func (sh *strictHandler) DeleteDishFamiliesDishFamilyID(w http.ResponseWriter, r *http.Request, dishFamilyID int64) {
+	var request DeleteDishFamiliesDishFamilyIDRequestObject
+
+	request.DishFamilyID = dishFamilyID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteDishFamiliesDishFamilyID(ctx, request.(DeleteDishFamiliesDishFamilyIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteDishFamiliesDishFamilyID")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteDishFamiliesDishFamilyIDResponseObject); ok {
+		if err := validResponse.VisitDeleteDishFamiliesDishFamilyIDResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetDishFamiliesDishFamilyID operation middleware
+func (sh *strictHandler) GetDishFamiliesDishFamilyID(w http.ResponseWriter, r *http.Request, dishFamilyID int64) {
+	var request GetDishFamiliesDishFamilyIDRequestObject
+
+	request.DishFamilyID = dishFamilyID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDishFamiliesDishFamilyID(ctx, request.(GetDishFamiliesDishFamilyIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDishFamiliesDishFamilyID")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDishFamiliesDishFamilyIDResponseObject); ok {
+		if err := validResponse.VisitGetDishFamiliesDishFamilyIDResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc func(ctx context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error)
+// PatchDishFamiliesDishFamilyID operation middleware
+func (sh *strictHandler) PatchDishFamiliesDishFamilyID(w http.ResponseWriter, r *http.Request, dishFamilyID int64) {
+	var request PatchDishFamiliesDishFamilyIDRequestObject
 
-type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+	request.DishFamilyID = dishFamilyID
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	var body PatchDishFamiliesDishFamilyIDJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchDishFamiliesDishFamilyID(ctx, request.(PatchDishFamiliesDishFamilyIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchDishFamiliesDishFamilyID")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchDishFamiliesDishFamilyIDResponseObject); ok {
+		if err := validResponse.VisitPatchDishFamiliesDishFamilyIDResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
 }
 
 // GetDishesMergeCandidatesDishID operation middleware
@@ -2590,6 +5701,32 @@ func (sh *strictHandler) PostDishesDishID(w http.ResponseWriter, r *http.Request
 	}
 }
 
+// GetDishesDishIDRatingTrend operation middleware
+func (sh *strictHandler) GetDishesDishIDRatingTrend(w http.ResponseWriter, r *http.Request, dishID int64) {
+	var request GetDishesDishIDRatingTrendRequestObject
+
+	request.DishID = dishID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDishesDishIDRatingTrend(ctx, request.(GetDishesDishIDRatingTrendRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDishesDishIDRatingTrend")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDishesDishIDRatingTrendResponseObject); ok {
+		if err := validResponse.VisitGetDishesDishIDRatingTrendResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
 // GetGetAllDishes operation middleware
 func (sh *strictHandler) GetGetAllDishes(w http.ResponseWriter, r *http.Request) {
 	var request GetGetAllDishesRequestObject
@@ -2614,6 +5751,91 @@ func (sh *strictHandler) GetGetAllDishes(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+// GetLocations operation middleware
+func (sh *strictHandler) GetLocations(w http.ResponseWriter, r *http.Request, params GetLocationsParams) {
+	var request GetLocationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLocations(ctx, request.(GetLocationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLocations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLocationsResponseObject); ok {
+		if err := validResponse.VisitGetLocationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetLocationsLocationID operation middleware
+func (sh *strictHandler) GetLocationsLocationID(w http.ResponseWriter, r *http.Request, locationID int64) {
+	var request GetLocationsLocationIDRequestObject
+
+	request.LocationID = locationID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLocationsLocationID(ctx, request.(GetLocationsLocationIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLocationsLocationID")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLocationsLocationIDResponseObject); ok {
+		if err := validResponse.VisitGetLocationsLocationIDResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// PatchLocationsLocationID operation middleware
+func (sh *strictHandler) PatchLocationsLocationID(w http.ResponseWriter, r *http.Request, locationID int64) {
+	var request PatchLocationsLocationIDRequestObject
+
+	request.LocationID = locationID
+
+	var body PatchLocationsLocationIDJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchLocationsLocationID(ctx, request.(PatchLocationsLocationIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchLocationsLocationID")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchLocationsLocationIDResponseObject); ok {
+		if err := validResponse.VisitPatchLocationsLocationIDResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
 // PostMergedDishes operation middleware
 func (sh *strictHandler) PostMergedDishes(w http.ResponseWriter, r *http.Request) {
 	var request PostMergedDishesRequestObject
@@ -2816,51 +6038,272 @@ func (sh *strictHandler) GetUsersMe(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// DeleteUsersMeSessions operation middleware
+func (sh *strictHandler) DeleteUsersMeSessions(w http.ResponseWriter, r *http.Request) {
+	var request DeleteUsersMeSessionsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUsersMeSessions(ctx, request.(DeleteUsersMeSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUsersMeSessions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteUsersMeSessionsResponseObject); ok {
+		if err := validResponse.VisitDeleteUsersMeSessionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetUsersMeSessions operation middleware
+func (sh *strictHandler) GetUsersMeSessions(w http.ResponseWriter, r *http.Request) {
+	var request GetUsersMeSessionsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersMeSessions(ctx, request.(GetUsersMeSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersMeSessions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUsersMeSessionsResponseObject); ok {
+		if err := validResponse.VisitGetUsersMeSessionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// DeleteUsersMeSessionsSessionID operation middleware
+func (sh *strictHandler) DeleteUsersMeSessionsSessionID(w http.ResponseWriter, r *http.Request, sessionID string) {
+	var request DeleteUsersMeSessionsSessionIDRequestObject
+
+	request.SessionID = sessionID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUsersMeSessionsSessionID(ctx, request.(DeleteUsersMeSessionsSessionIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUsersMeSessionsSessionID")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteUsersMeSessionsSessionIDResponseObject); ok {
+		if err := validResponse.VisitDeleteUsersMeSessionsSessionIDResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetUsersMeStatistics operation middleware
+func (sh *strictHandler) GetUsersMeStatistics(w http.ResponseWriter, r *http.Request) {
+	var request GetUsersMeStatisticsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersMeStatistics(ctx, request.(GetUsersMeStatisticsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersMeStatistics")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUsersMeStatisticsResponseObject); ok {
+		if err := validResponse.VisitGetUsersMeStatisticsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetUsersMeTokens operation middleware
+func (sh *strictHandler) GetUsersMeTokens(w http.ResponseWriter, r *http.Request) {
+	var request GetUsersMeTokensRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersMeTokens(ctx, request.(GetUsersMeTokensRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersMeTokens")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUsersMeTokensResponseObject); ok {
+		if err := validResponse.VisitGetUsersMeTokensResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// PostUsersMeTokens operation middleware
+func (sh *strictHandler) PostUsersMeTokens(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersMeTokensRequestObject
+
+	var body PostUsersMeTokensJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersMeTokens(ctx, request.(PostUsersMeTokensRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersMeTokens")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersMeTokensResponseObject); ok {
+		if err := validResponse.VisitPostUsersMeTokensResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// DeleteUsersMeTokensTokenID operation middleware
+func (sh *strictHandler) DeleteUsersMeTokensTokenID(w http.ResponseWriter, r *http.Request, tokenID int64) {
+	var request DeleteUsersMeTokensTokenIDRequestObject
+
+	request.TokenID = tokenID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUsersMeTokensTokenID(ctx, request.(DeleteUsersMeTokensTokenIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUsersMeTokensTokenID")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteUsersMeTokensTokenIDResponseObject); ok {
+		if err := validResponse.VisitDeleteUsersMeTokensTokenIDResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xaW5PbthX+K2fYPiQzCrWJnU5Gb+uVm6jNxh570xmP7QdIOJIQkwANgFJZj/575wC8",
-	"iQRXlL1ynLRPu+IFOJfvfOcCfohWKs2URGlNNPsQmdUWU+b+fcKMWD3VWmn6lWmVobYC3b39lln6a4sM",
-	"o1lkrBZyEx0Ok+qKWv6GKxsdJtGNkpYJiXwuzPaptLqgFzmalRaZFUpGs2gh10qnjH4BW6rcAhdmC6vq",
-	"VRASUtQb5HQ9mnSkEby/pFtgMY8mkV86mkVC2r89jmoRhbS4QU0ySpZiWB2N73OhkUez1/6pCe32NqSn",
-	"Rmbx1klJqr7A932pXuD7HI0Fq2DlngcGEvfQvNZTLq1v+d/HC15rzQpQa28wwQ3YLbNgtipPOCyxNFsM",
-	"10ninkEDaW4s3TKod8iBWbBbBMNShEStvBfevAEmOayYlMo9nDFtaR8mC1B2i7pcuVw0Bri2kCAj5faq",
-	"u1Wm1U5w5GQ+i6kZ8JfgZpzDyiuMtG878HjNX0gltXbqtaSN4ZYVJBW+z1lCvsB/C2OF3FRiL3MLa6WB",
-	"wUbs0FmjtgzpjpCyAkrLMNmzB+yF3TZWJeniRo/74XXk73FAM1lf95f5aoXGgEaTKWnQ6dO85OFHjw7j",
-	"bTEPROq8MqjEfVKUKOZt5cd4sKP60Z4hnX9Ee50k3iik7wCPuMtEFiSgRpMnFhxGQKPNNTHJsoDuYmSe",
-	"0ZwyllLuN+Mz9w9LYC0w4RMQZFNhyig2TbSda9jToVDu0ofjJPKEcG37r/9coX/v0H8ka00jJxEuKP5L",
-	"mNd7jXG381AvCXFmmXNVxSh/1biOZtFfpk1Om5YJbRpGUI9KOhK7LQYkHI69OVomEvJac9l7M5i+2G7z",
-	"ghH9BOh9h5ptELS772K4tn0Mz1JhKfgcesgvTCNIBTtl0UCBLY/IPF2OAeZiDQbtxAVQF4xu5wfHIw7C",
-	"Ua1WudYoV3ijchlA5XVK190yIkXTgmX5Kg9K5I35bP2rQd1f9FYZCxpXKG3Q7JXU2qdyup8b1F1vuIuw",
-	"ZcalCe1IskBLaQBlnkaz199Ovps8mjyefP92WEiPD86Fp4vnR7jpv9QpNvwSXdQs5CrJORrIM8p9SqID",
-	"DGSovdD0D4WnkJsY/okFOZ3J0hgT2LEkx6Nrpsp3zAKrfWIs0yaGxRpS5TiDSfgP6gqehNVMoyE71xHg",
-	"+bAq/IwzJDuKgVYebaLRu+tZjRdzv1sbYJk2BmO4FZutdQ4rJXD39luVIGyFsUoXwxWMjwUoiqKI0zTm",
-	"vB0fnFkMYbxbyHwKBY+tMLpxFbJfA8DTVO2qihsmuSAtTcWJxyS3qu+fQ9mBpccRd2u78VLXVUUnzwxw",
-	"5VktBj38S5AK3TKla3r4GMvWLTCwRCPjxcfXEN0cWFU9tQoDFiU+NbcYzon9Fi9Aor3UiCkTyenOzD8W",
-	"EqupeG+ZZBtMUdp5WTd0CKK+D/SAY81jw3UA3e5rQ4zT17jpZ32f0eaS+wIh0EJ/ag/0keVfZ5Xzyr96",
-	"/boI7Nrwfg/+mlGkDvTVZSphTa11n/MYL2PKBIOqXMyVQQZhMffpinGOnHJmt6E80daO5YhxHu3I1+k7",
-	"k4R6Uo30bimrMCFva0zVDj/CCv5FDmut0n5r3ZsC+BmAphCVMXXSdwo4JuhmH+5V99iECADmT39+evcU",
-	"hDQWGSc/Pr++u/npIvYNDateMItjhjeuYPITgiC+dF3Pjyj2OmFSvhuKhZfI9GpLAj4p5sOxUIuZKPWO",
-	"yjzWDH/KcCVOAgonUGUrmhSwFolF7ZtkVk88esq5Wqbf9LjFqDwRq+3R/GfLdghLRFluHsPffan06tWr",
-	"V9/c3n4zn48plWp5hulpWYzY/iRPOQHuN/8Yw5PdwSdPEqzuqEru69cY4fLATWusAuM2J9Cdx93tBJB8",
-	"eg1ZC3qiLmzbKlQP3LRL/NJKrYpgiLj5ybmUt1OdoNYgLOwZdUG55E2X5mZ2e+EmP59UvrXtW+9Noh9o",
-	"4Vz6qW7vtTudY9UqeuquZGz2XyqVIJP3+aDZoe8Eek3ItQpsTo6/fr4gYlB742ovQyDTbiQtOewE7p1s",
-	"llF5hsZ1SirXsMTE0X+K0rCmkLHCJrT54u4lfPWTynCdJ0nxNdwxYwug0pA2jCbRDrXxUlzF38VXrtHP",
-	"ULJMRLPoUXwVPyKnM7t1/p76DabpcbU+/eCBcKBnNmhDwWhzLU3FBK43dUzAwIhUJEy7QHTKltPyTKsl",
-	"WyZFMzav+tpmZkZYdCG04NGsmgKh6TQT86pkzphmKVrUJpq9/hAJkox0q0qg1kyxcbDVOU7KkxhS7HTB",
-	"/pZe92MyZ7Xvrq6qEhX94IRlWSJ88E9/M55Emx3O78I8uoIjZ/Lo46tv+y5xIJCI3HiK3Ajpn308NGp1",
-	"rbgPisMk+t5r1a2yLWrJEkCtlXaTeyGzvIx5IZEkPUxqIJ0Ezo9ogbsBnoFEvMN6xkFIaU8OjqdCwk1V",
-	"qJGphj8G1L6akLgxSPPwmzeDQ94J7LGcVntuqHqRXund1Eh0S0gudoLnLDkB1T8ZNusMEwBkPYcVweaT",
-	"lyz9+AElah2aBgR6wjgsHDrdYPnCgfIZFBoVfZMoUyZE0ZRs6uFbD7HPlfk9IevKkCeKFw9mynZzcTjO",
-	"6STjIRwo93Ds54ft/H8ItpQ0Nq1To5OlBkGZmmSi9CSBd5L4vy6QenTcPpGKLkuS/cO0gFG8Gh3RSaFz",
-	"Hf4F+rF9qD51E94gI920v8k4nh/1uem2fVB/GcYIfVMynjkuJMK9xV9cfmPQ9DTlFwK/J2PF8BKxxEiK",
-	"xrANfiSmB+DnOmF9Hgo/tEf7B794gsHJip+W9Se4x5j0j7VReds+PBiTPdPjFy5Q9o0EjTfFHx00wczX",
-	"VvWM9mYExCbDDU2KlvHAqUYM/8iN/9YpZZJtqv6l3Wj4cUJMPTxUXRSg5EpIa6iVsQo2aOs+Ce0qDuW7",
-	"LxyZDwKwweOmC7fLlwRVxuwqMMDypzEOLeUXAcFTpk7epLW+KCA8fNIOHVh9YrnfJ8jcLf1/gjw7B5t6",
-	"Lj1cBr6sR+3lBB+WRTW279eBzaT7QlXg8bHDZ67/OnP8h+OxL7BLaLAxXbrztTMhMq+m59WZGl1sHaPd",
-	"hx1/oHdxBDXnhhfAUX1Ge/5Z7HA/4b9wd4P4lLJH9Zm2+9j9q7T8kjvNbPH1nwCCbnA89edMg9VceJzp",
-	"vt2jqmzjJ80lkIKzh/JTnQuPHdofBAWM8i+WCA4GjRFKxlDOH0i5Uiv3SdAf3KfV2WxZ0fQPrhOw5RdQ",
-	"/rloEuU6iWbR1tpsNp0SfyRbZezsh6sfrhxArp8vprtvo8Pbw38DAAD///45rQS/MwAA",
+	"H4sIAAAAAAAC/+xdW3PbOJb+KyjuPsxUqSX3Zbam/OaO0x3vtpNU7GxVVzsPEHkkYUICDADKq07lv28d",
+	"XEiQBCnKlhxPZp4SSyAuB9+5n0N9TlJRlIID1yo5/5yodAMFNf+9SFNQ6lZ8BP6Sa7nDzzJQqWSlZoIn",
+	"58k15XQNBXBNMqopEStCSQlSCU5zQs3zROMEc3K7AftfwrSCfEWYIoLnOyJBV5JDRgQnqQRq5p4lpRQl",
+	"SM3A7MV8AdmFxj9WQhZUJ+dJRjV8p1kBySzRuxKS80Rpyfg6+TJLWNYay7j+r5+acYxrWIPEgTlV+r3y",
+	"k7dP+KZgWkNG2Iro+gAbqgiHLUiyBOCkUpDNyfsSd5MRqkkhlCaCp4C0IAXjlcYdTts2pwXgPnpfSKDZ",
+	"G57vgi+XQuRAefLFfP2pYhKy5PwPPLqbKHhsFhDxQ72wWP4DUo3z/0wVS19KKSSu0Cb//YbqyKa+RKZ5",
+	"IbimjEN2ydRmADdX3NKCCU7oUlSaZExtSOofJYyTAuQaMvy8BwaW9ac0E1xdJrMpNz5A4w4VHQFZFiXX",
+	"C0PMgEfewaf+tt7BpwqUJlpYbMMQg/QO6ffYnu81LYDoDdVkA3mJzxIJqVhz9ic0CJ0RmK/n7m+R2wcq",
+	"BYowHcNcCK3OTa2IlhXMmrlJSrll3CUY6JOVkOTXl7dE2rOqZLYPn+ZsE4mqyv6ubipLOQmqFFyB2YLb",
+	"3ZAEAQ/F/5SwSs6T/1g0gm/hpN6iJ/IQ4fhXfw+1PJuTG+AZYZpQRe6Si0pvhGR/WnT/DFSCJHfV2dmP",
+	"qRlu/gt3CdkAzUDOyZVGknKhkaAStGSwRTmypoz3L6tDSY8de7phkiIz/kILlu8mw5TDvWXLlXmuR1D8",
+	"7upS9SfDtUBZ0FFpYakQtxJSVsKcXGiSA8Xl7oVZAhQpKmUIUEqxZRkK1Jc03dgN+O/u7ogCubVClpKM",
+	"rVYggWuSi9QJE54FxCyp1EYncaE3IMPTzMmtIGspqtJvQKyajd7d1VPOEOOEOnFkp2BcaaAoY5mGQj1S",
+	"GLlPqJR0FwqnCOO7PQbnCDidByMspfeix0k4f5PT4DOZIYNtDrNlVs98dRkRP5f+RBzu/TSQhVNPIXLn",
+	"2K01hw99ba4cj34QzzSP9Q5b1F9BBDUXiAA8rwVZ5lhIbUSVZ4hn+/icXOR5l20avqhBXDPF3d0gX+yI",
+	"5YwA3aDmZBKH7oE/y9Sp8B/sdk6uqdFE8KmiOd4F/B9TmvG13/ay0gaNlKzZFnjI2ziZBFLQHXGU8ZIi",
+	"5PZ7pjcNVXF386mM1brvaUCbzF3NQ8PM1ax/CHMFh38Ac7XWjJ25kSWHeBWP0UR9sUkYJ1SlwDNEipAZ",
+	"yBNJ88leyDSbNLTsx8R2Q+TfHNjfUeSKyPHcFz0yWyWrGF/njTDp0Z5u13aCiDzbgqRrINJ8b2UTU/VU",
+	"c9J2riQYa4ELshUaFNlBYKvyqlhaOmUDaD7olvYwxooo0NbmlY46qUB/jzaS14CKqUO5ZbJ+FzIi7gjT",
+	"hCkvwKOmfHPNNMsYzk7zt60r62+pvZX/gR0ei3J3+BnZ0ryC1mfKi0UESSEqbhSK0lSqJAJHq55iDraH",
+	"J7k3CDAktSdVTqntFbYOETVf1Is11BhnEeu671PylRnV5pE5QbeJlBIUcE1WDPJMGRjb0VmfXzIHOxXF",
+	"nZvJYE8Bubq0s9Esgww30ZFjT2qCdrbXkhX3LM+t/4LPuq0yFXc2C7GFBxDBPpiRlRRFdwMjToWEgjI+",
+	"R8V/K0gGOXRv0dr4ly9/e3n70hv3COe3F7cvXp2ExLHAya+gA/dTeVOgDR/j78Vsxzy33mit7SoFkigh",
+	"UcAuGwOcuLhTfaaD3eHuQXruaJzd8Hh5bjUynm1A+ZuPCePOjVFVrolZq4kTLnekO5ngCiaHiY6jJN6U",
+	"VrZapp9ZJRYIr9rIPr6GcKvEmOsxgpZQncwOsD/qtaZct7mhHpzRvsN/J6ExjqB9kDRLDOywVgEMBvgt",
+	"C0bEua6RIwxUwHCOSJNO1jWJ954p3NW+sw247C9EsTSxVvQNS5CNVyQHDMJH2H618eQXUQ+0/qYH9f1C",
+	"wwZveOhZcHOBrXvg7XVt7RHVegrDzR8sRu2jG3VjKQd3lPAWRlBqt30rgWdxqNoBROOIBpVzchWYy0MS",
+	"10WuzaPGhlc9Ix46EvogHzpwFbr7OLV70N/5UdVBbD5Dx4gu5EBMBNrwFD6O9zXuY49xVICIaQKxb/nb",
+	"nY6hLgq1S9CU5UjR5uMGco+QgCZD4Yn8CNd3JFa6D4otc/m9ArII9cjiczj9FxPWFEWJ+2JaofXcEhI2",
+	"Ttbw95Hd7qfkpRjQRZpWUgJP4QXKwcj11vIRDWoVUNg9mkV3ZGn4ZvVegYxEvoTxV1LgOoqbJrxvvFL8",
+	"Hs38LpzMhzZLLcxEkCGk5iZPVBXJ+R/fz36Y/Tj7afa3D8ObPIoq6sD+iqd5lYEiVYkAExwM4o3YMJsO",
+	"5MejdZZREIUw0o1y8idIz18Iau+z1yxsDXmfhFaGkLTFxPNYaMNe15saL2r8WhtgteKSc3LN1httLszt",
+	"wHx3vxE5kA1TWsgRb9/yAtntdrt5UcyzrFtuEBXmHdPkpEEaJ6G7fBWjX2hA7PMx/M4GzPcRK7B+0qqm",
+	"w412P8Fe5bTXCDKh/BeUZwyvauAoaf39IQ5TZOppGjVYbvqua58+Fp9/ZAAFB7+OynMzjbu5HsinqpwA",
+	"0TSXQLPdwz34ofhkfYQBit6AUsNYVu7buBvqvx0I/+RUmQqQmS1OcsJoxaTSU/HudjcNPvVmB46K+k9d",
+	"Q9wI65cHRZRev8CjoCzfn0Gxw8a3daOpZkqzVMU3+NZX8DglrerxAxo6Zjj+iunIfdYjwi/Pa11nU5jL",
+	"XX3FE1V/zJq0AlffaAn042/A13oTkZLmc38qwdcCj1QfGx8ljJOM7mIePcNt1E/Z4VHmXtGtqCSzhQb7",
+	"gIiXhOPeSjD1HylYh5+vQR10HPfItONYytqyvw3NCB07DzLZKyT+w89TgkQeZTlE8YffOYCYmzHmOG7R",
+	"VQ1s6BYcXFZwD7KGkLGF/HliuHAD34K8FlxvxkxfGURSChwc8fnmxExj7TTkZf8QlUCEJfFhfmG9tQFR",
+	"NEs00OJiu34oc+GHhpTDfpp/ZoC3tNA0H8y43uK3gbU6yN37dUtrof7dtWAUk3m1EdPHWE5TcAafi5bg",
+	"2Wtzr5fWkqBUNLJFc0ZVPHyqQXKq2RaIKiHPfdjRlzPNicvgSyitKjOVHJyYKe1+tJCQ9ZLLIaL2Gr5U",
+	"phuGp+rv0H1Tz2sEmpEb1k+BsMwE9/MRSu28HmAysNy7JZHGHChzunu9p9Czqf9RG3HPTSrSgvNlUeod",
+	"QhNpFYwzVZlNkdpAZXR7PX/Ag2toh33rekoaXKBDt0olLc0hsPIaR3hL3/p4vgwKoilEUQJnfP1KVDIC",
+	"q18kmBKZohvEwYXdo2Rjnp0dVm5RX9esBnxnMw3aA1iNMd5BuWcb8dO0KYsJiinGE9G2AN8AxRWC3d0h",
+	"VtIN5etjMXSYu5VQogSx4Vb3xJxceN4Niq6a4sWAt4OyzUfz9F7W2wuwCXXvTT1WU7906dJbg/VNOMBI",
+	"i7EIdBpW1IOaYi/XjzRMNEnBRor3H1uh98CwdK/w5ZAsZRAHdszbpeGH0RscZUqHcNqEhscu7wj1HmG5",
+	"41ct+GhXRX6Fgo+QEv2Cj9FyD/Ooywg9h2qPd66qep/kN+FRW78axZesbdwJod0Om7hnP8S3187ADGZR",
+	"nY8hZFMs6BNAR82b1FHh8dRJd3w0j0J1BO2XqGFNjJOlmyYJcV/bJnPyi7l58vvvv//+3fX1d5eXU8Ks",
+	"9iB70wiyGzHvHeC4EfpTJ4QdOcLTj9fjRZ27fhh0Kh0tLhl3qSPnDsVc9aiT+wuTSmMgoFZpOO5BEOgW",
+	"SLut2JNEKQFb8RHGo4HSjIkY8q8NzA0l7JA6NLjfm/STxjZ1A2hSocj6eXc5wWTNhfiIaZ4g2+4UuOAo",
+	"Fgy3uRqqfEdWDJ1B6yDQ4XLjKawbFv+ZMIjp0nwUD+eDbnJtsCx3E5bfX8uKGxgn/xTCI92JjTt7jyuM",
+	"kvfD83EnFJfDKZVZHEXTYdZc1Bt8XKHv6wm1ZyGt4qVPQYrPUSkI2g6ZctnePgpLp9pkXRGmjf5YiYpn",
+	"jeYybs09U/DYzEdI33pt3DqGVHFNH37sxJ9kBT6qWes4M35/62ZwB80K8UsI0gURtlkz7iWTNRSicfIH",
+	"9H27uPbAsV1opl7a4NBdvKFDQTMwai8as2FZ1F9k5SsaozR+6i+IlcQ51TVLUKX92s6BR+VruFhDmNSh",
+	"JZXRzl2c4gaAx7jvlhUQW8r7FUhwcvH26ni96zjjxdoRf0Jkpbnd1knCiWraNhcbQ1skht6XZuavJSiy",
+	"EfcNBWyShBJVQspWLH2wxepIPRjNbRdDjPe2HCgWHmt0Tt7r9BznLCBZez/9+8PZGF+JWJc1U4hRNCPE",
+	"vfIZDhOAB1O6umVgL1NTpRl37CUqSZaQG/exAK5oEE1kOsfFr25vyF9eiRJWVZ7v/kpuqdI78t4xRTJL",
+	"sFjQ7uJs/sP8zMeFaMmS8+TH+dn8R0QJ1RsDkEW3VHgNEdL/Crq2iOqqYRtElEY9XmV2VFiajPRzJdRm",
+	"5h/OznxcyPEaLcucWf26+IeydoqN6UyoEehVQZv7iLYgIg1+Ovu+f673NjkGmbImyJoZcfm3s7P+2Ctu",
+	"wv65VfuSgJRCmgA642XlNCbjYPdRChWh44uBLnXrq5jGbtU0bZoBTYtsv3G8fwVvherfgRGfP4tsdzTy",
+	"x7rzv7SZS8sKvpwQAdEO72EI2JxM3fdDle8btdg43r6C95FEdvMzzciVgQvGUPEdDOCAVIBSdA1PDtUv",
+	"s2SsoNJOnkPUfzGf95qqcB0utKm/rMVXG6b2yRCol8GiRj5JWoAGqZLzPz4nDJdDmeVDo+ftqtIu8GbB",
+	"Ze2ve/kQh+lEHFnqZIde3E9nPw14Lm5uJKG1bo8jkQYFO95f6tsqgi6K7r1SRe7RvKOqZTCE/QimdpG2",
+	"ij7v7vzz47XunYVtBWEwymaFFGQ9MHV0wTNE0tFV3u7ICu/JwVhSnUYcj/dNwrDdwdPRcvj0s7rz46vY",
+	"WJ/vdBU7UXb5dt9/bh34xOj1GhPUomgXk1rd6bRmVN6+M92gXi8GNU+UKFawnEqbU0YXwVVGlFIs6dK+",
+	"uMrJQxfebtycqEAE1al1vfTezjT+eO7SMFZ/fGKRaMlyCJ4mAmkvcFBRZ6bLR5GcfWz0JSIlrM5vd14w",
+	"07lg2tCci6wIVuO4zgDbi+YH392N6Oh7cK3MVjr7CoBewrvJTOJXjGdsyzJ8yc04VL8xbNZR3Agg62Yt",
+	"Fi0Yzlwk9GsIZLzWUzPKExxoEvcN+ejvQgtkPuhhfyXIHt/QCFP6jzQwvqYd8a8C24jSWMim7GFUgfR7",
+	"w2buX2LSukaZuA8yprRkywofN5IeaLoJayTC/jj3193dvq7mJ9EiQRXIt6VQOh3nX8/YeU7MsA7er7HX",
+	"7tYbW6flaug/cjSGBoJUv4IO391x4nB2/7UjEaLYY3S2jgd6YNjwOd1jq+lxNAsxHP4OWyv7rN8t41fC",
+	"CyPaK5hPZlZSfKpA7hpRYe1p8AX2SSgjeinoE4uEdhPpM8p+tG5z8dn/d597E3tr3/D1/lbPOknG5+Hw",
+	"5yrnm97c00p2v85XCOuFfQB6M3LXJsb3PG77+DZ3v43iibNnE5A2J03//LcdKjwRO6AYDF+lu8DpJuSF",
+	"23X5fe/zOpjzpPnd9pukv0p+t/OO4TG0Xodl/v/O7w6i8HP4boGp+d0xTNphISqvgxUmyeui/cDTJXO7",
+	"oGklc78tKRce9clyy7W673SLzcl/V8p2nBbYV+Yj1MEQYsusgnc+gVoQ4Jlg3L7ZSQuyhqYzG3Q6j5mM",
+	"zxyZRwHYYBvfiS3JU4JqvzHpWj+i3XsRa/JZAeH4SjvWCPjojHFXQH7DZuDpsIw6WNXV/cNm4E3dsOCL",
+	"aJqXO/XtwKZf4ERWYLt544ntv043xNHDG88p9NVgY7E0XUoHQuTSVxX7zqTO+2DHsGPbok6OoKb76gQ4",
+	"qntfD+9xHfYn7O/amFKLArWH/3EW8xM3fync77fguyz++g1A0JQGLGxZ/qA1F09Y46MkE9aEa9pSotFZ",
+	"96aqE8fSw9d0RYjyvzRndX/hnLigOh7OnQpP9A3d6aL9Dra4s2e7N018PfpStv4FzzrVJa7RhQgOAz6i",
+	"f09Z09l5MhREmlHHmN0PJPdgOvi3pqH06dsGRlO3edh9tu92gjM1P6FlfC1fQBb8pCP+1lb7Vx2HOPcp",
+	"7q77TsFnltrosdXis/vfnniKY7Ggj28an9z42Sc5KioYPeyldNvcDgubuB0Z0jyQWaImuJ/3+OZ3c2n1",
+	"Sw9HVV05/K5EX7AwwHhjzNOs/SQKsPNCyBgf9Y/VfQfkN6QHm5+hGZWz0V+63SdvR67d/jLOqesHuj/C",
+	"88/UDheluHuBR6U3wDXSBZTvPRnUefb87XeFdZSe+6lh81vA7qOlFPc445BURo+pf5enSrt0fpz5q+Rd",
+	"ur9lPGY7mVH/TrmMypzFZ/PvdANh6Ne2R8wFC81bu84kU0HXY58u49Kg5ZiGg531yGaDf0lItHwIk8Y5",
+	"0U5T2nHJLKlknpwnG63L84Upgsk3Qunzv5/9/cxg4uLt1WL7ffLlw5f/HwCLCJLcw4AAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
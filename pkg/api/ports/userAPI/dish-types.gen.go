@@ -4,6 +4,8 @@
 package userAPI
 
 import (
+	"time"
+
 	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
 )
 
@@ -25,6 +27,17 @@ const (
 	RateDishReqRatingN5 RateDishReqRating = 5
 )
 
+// AccessTokenEntry Management data of a personal access token. The token itself is only returned on creation
+type AccessTokenEntry struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Id        int64     `json:"id"`
+
+	// LastUsedAt Omitted if the token has never been used. Updated at most once per minute
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	Name       string     `json:"name"`
+	ReadOnly   bool       `json:"readOnly"`
+}
+
 // BasicError defines model for BasicError.
 type BasicError struct {
 	What *string `json:"what,omitempty"`
@@ -37,6 +50,39 @@ type ContainedDishEntry struct {
 	Name string `json:"name"`
 }
 
+// CreateAccessTokenReq Request to create a personal access token
+type CreateAccessTokenReq struct {
+	// Name Name that helps to recognize the token, e.g. the tool that uses it
+	Name string `json:"name"`
+
+	// ReadOnly If true, the token can only be used for GET requests
+	ReadOnly *bool `json:"readOnly,omitempty"`
+}
+
+// CreateAccessTokenResp Success response for token creation
+type CreateAccessTokenResp struct {
+	// Entry Management data of a personal access token. The token itself is only returned on creation
+	Entry AccessTokenEntry `json:"entry"`
+
+	// Token The token. Send it as "Authorization Bearer <token>" header. It cannot be retrieved again
+	Token string `json:"token"`
+}
+
+// CreateDishFamilyReq Request to create a new dish family
+type CreateDishFamilyReq struct {
+	// DishIDs Dishes that are the same recipe. At least two dishes must be provided. Each dish must be \ served at a different location and cannot be part of another dish family. To group dishes of the same \ location, use a merged dish instead
+	DishIDs []int64 `json:"dishIDs"`
+
+	// Name Name of the dish family, e.g. the name of the recipe
+	Name string `json:"name"`
+}
+
+// CreateDishFamilyResp Success response for dish family creation
+type CreateDishFamilyResp struct {
+	// DishFamilyID ID of the newly created dish family
+	DishFamilyID int64 `json:"dishFamilyID"`
+}
+
 // CreateMergedDishReq Request to create a new MergedDish
 type CreateMergedDishReq struct {
 	// MergedDishes Array of dish ids that should be merged. All dishes must be served at the same location \ and cannot be part of any other merged dishes.  At least two dishes must be provided
@@ -52,6 +98,53 @@ type CreateMergedDishResp struct {
 	MergedDishID int64 `json:"mergedDishID"`
 }
 
+// DishFamilyEntry Management data of a dish family
+type DishFamilyEntry struct {
+	// DishIDs Dishes of the dish family in ascending order
+	DishIDs []int64 `json:"dishIDs"`
+	Id      int64   `json:"id"`
+	Name    string  `json:"name"`
+}
+
+// DishFamilyLocationRatings Ratings of a dish family at a single location
+type DishFamilyLocationRatings struct {
+	// AvgRating Average rating at this location. Omitted if there are no votes yet
+	AvgRating *float32 `json:"avgRating,omitempty"`
+
+	// DishID dish ID
+	DishID int64 `json:"dishID"`
+
+	// MergedDishID If set, the ratings cover all dishes of this merged dish
+	MergedDishID *int64 `json:"mergedDishID,omitempty"`
+
+	// Name Name of the dish or of the merged dish it is part of
+	Name string `json:"name"`
+
+	// Ratings Keys mean rating, values mean ratings with that amount of stars
+	Ratings map[string]int `json:"ratings"`
+
+	// ServedAt Location where this dish is served
+	ServedAt string `json:"servedAt"`
+}
+
+// DishFamilyUpdateReq Request to update a dish family. Only present fields are updated
+type DishFamilyUpdateReq struct {
+	// AddDishIDs If present, these IDs are added to the dish family
+	AddDishIDs *[]int64 `json:"addDishIDs,omitempty"`
+
+	// Name If present, the dish family will be renamed to this
+	Name *string `json:"name,omitempty"`
+
+	// RemoveDishIDs If present, these IDs are removed from the dish family. At least two dishes must remain. \ To delete a dish family, use DELETE instead of PATCH
+	RemoveDishIDs *[]int64 `json:"removeDishIDs,omitempty"`
+}
+
+// GetAccessTokensResp defines model for GetAccessTokensResp.
+type GetAccessTokensResp struct {
+	// Tokens All tokens of the user sorted by creation time
+	Tokens []AccessTokenEntry `json:"tokens"`
+}
+
 // GetAllDishesRespEntry Entry in the result array returned by GetAllDishesResponse
 type GetAllDishesRespEntry struct {
 	// Id dishID
@@ -72,11 +165,49 @@ type GetAllDishesResponse struct {
 	Data []GetAllDishesRespEntry `json:"data"`
 }
 
+// GetDishFamiliesResp defines model for GetDishFamiliesResp.
+type GetDishFamiliesResp struct {
+	// DishFamilies All dish families sorted by name
+	DishFamilies []DishFamilyEntry `json:"dishFamilies"`
+}
+
+// GetDishFamilyResp Combined and per location ratings of a dish family
+type GetDishFamilyResp struct {
+	// AvgRating Average rating over all locations. Omitted if there are no votes yet
+	AvgRating *float32 `json:"avgRating,omitempty"`
+	Id        int64    `json:"id"`
+
+	// Locations Ratings per location, sorted by location
+	Locations []DishFamilyLocationRatings `json:"locations"`
+	Name      string                      `json:"name"`
+
+	// Ratings Ratings over all locations. Keys mean rating, values mean ratings with that amount of stars
+	Ratings map[string]int `json:"ratings"`
+}
+
+// GetDishRatingTrendResp Rating trend of a dish. If the dish is part of a merged dish, the trend covers all dishes of the merged dish
+type GetDishRatingTrendResp struct {
+	// MergedDishID If set, the dish is part of this merged dish
+	MergedDishID *int64 `json:"mergedDishID,omitempty"`
+
+	// Name Name of the dish or the merged dish
+	Name string `json:"name"`
+
+	// ServedAt Location where this dish is served
+	ServedAt string `json:"servedAt"`
+
+	// Trend One entry per serving in ascending order
+	Trend []RatingTrendEntry `json:"trend"`
+}
+
 // GetDishResp Detailed description of a dish
 type GetDishResp struct {
 	// AvgRating Average rating for this dish. Omitted if there are no votes yet
 	AvgRating *float32 `json:"avgRating,omitempty"`
 
+	// DishFamilyID If set, the dish is part of this dish family. Use /dishFamilies/{dishFamilyID} to compare its \ ratings with other locations
+	DishFamilyID *int64 `json:"dishFamilyID,omitempty"`
+
 	// MergedDishID If set, the dish is part of this merged dish
 	MergedDishID *int64 `json:"mergedDishID,omitempty"`
 
@@ -102,6 +233,12 @@ type GetDishResp struct {
 // GetDishRespRatingOfUser Most recent rating for this dish of the requesting user. Omitted if the user has not rated yet.
 type GetDishRespRatingOfUser int
 
+// GetLocationsResp defines model for GetLocationsResp.
+type GetLocationsResp struct {
+	// Locations Locations ordered by name
+	Locations []Location `json:"locations"`
+}
+
 // GetMergeCandidatesResp defines model for GetMergeCandidatesResp.
 type GetMergeCandidatesResp struct {
 	Candidates []GetMergeCandidatesRespEntry `json:"candidates"`
@@ -119,11 +256,81 @@ type GetMergeCandidatesRespEntry struct {
 	MergedDishID *int64 `json:"mergedDishID,omitempty"`
 }
 
+// GetSessionsResp defines model for GetSessionsResp.
+type GetSessionsResp struct {
+	// Sessions All sessions of the user sorted by last use, most recent first
+	Sessions []SessionEntry `json:"sessions"`
+}
+
 // GetUsersMeResp Information about the requesting user
 type GetUsersMeResp struct {
 	Email string `json:"email"`
 }
 
+// GetUsersMeStatisticsResp Personal rating statistics of the requesting user
+type GetUsersMeStatisticsResp struct {
+	// AvgGivenRating Average of all ratings given by the user. Omitted if the user has not rated yet
+	AvgGivenRating *float32 `json:"avgGivenRating,omitempty"`
+
+	// CurrentStreakLength Length of the ongoing rating streak in days. Omitted if there is no ongoing streak
+	CurrentStreakLength *int `json:"currentStreakLength,omitempty"`
+
+	// FavouriteDish Describes how the user rated a specific dish
+	FavouriteDish *UserDishPreference `json:"favouriteDish,omitempty"`
+
+	// LongestStreakLength Length of the longest rating streak in days. Omitted if the user never had a streak
+	LongestStreakLength *int `json:"longestStreakLength,omitempty"`
+
+	// MostHatedDish Describes how the user rated a specific dish
+	MostHatedDish *UserDishPreference `json:"mostHatedDish,omitempty"`
+
+	// Percentile Percentage of the other users that have given fewer ratings than the user
+	Percentile float32 `json:"percentile"`
+
+	// RatingsPerMonth Amount of ratings per month in ascending order. Months without ratings are omitted
+	RatingsPerMonth []RatingsPerMonthEntry `json:"ratingsPerMonth"`
+
+	// TeamAvgRating Average of all ratings given by all users. Omitted if there are no ratings yet
+	TeamAvgRating *float32 `json:"teamAvgRating,omitempty"`
+
+	// TotalRatings Total amount of ratings given by the user
+	TotalRatings int `json:"totalRatings"`
+}
+
+// Location Place where dishes are served
+type Location struct {
+	Address string `json:"address"`
+
+	// Aliases Alternative spellings of the name. Dishes reported for an alias are stored at this location
+	Aliases []string `json:"aliases"`
+
+	// Archived Archived locations no longer serve dishes but are kept for their history
+	Archived bool `json:"archived"`
+
+	// DisplayName Name that should be shown to users. Empty if name should be used instead
+	DisplayName string `json:"displayName"`
+
+	// Id location ID
+	Id int64 `json:"id"`
+
+	// Name Name of the location as reported by the scrapers. Used as servedAt value of dishes
+	Name string `json:"name"`
+
+	// OpeningHours Free form description of the opening hours
+	OpeningHours string `json:"openingHours"`
+}
+
+// LocationUpdateReq Request to update the metadata of a location. Only present fields are updated. The name cannot \ be changed
+type LocationUpdateReq struct {
+	Address *string `json:"address,omitempty"`
+
+	// Aliases If present, replaces all aliases. An alias may not be the name or an alias of another location
+	Aliases      *[]string `json:"aliases,omitempty"`
+	Archived     *bool     `json:"archived,omitempty"`
+	DisplayName  *string   `json:"displayName,omitempty"`
+	OpeningHours *string   `json:"openingHours,omitempty"`
+}
+
 // MergedDishManagementData Management Data for merged dish
 type MergedDishManagementData struct {
 	// ContainedDishes Information about contained dishes
@@ -156,6 +363,36 @@ type RateDishReq struct {
 // RateDishReqRating defines model for RateDishReq.Rating.
 type RateDishReqRating int
 
+// RatingTrendEntry Ratings given for a single serving of a dish
+type RatingTrendEntry struct {
+	// AvgRating Average rating for this serving. Omitted if there are no votes for this serving
+	AvgRating *float32 `json:"avgRating,omitempty"`
+
+	// Date Date on which the dish was served. Format YYYY-MM-DD
+	Date openapi_types.Date `json:"date"`
+
+	// RatingCount Amount of ratings for this serving
+	RatingCount int `json:"ratingCount"`
+
+	// Ratings Keys mean rating, values mean ratings with that amount of stars
+	Ratings map[string]int `json:"ratings"`
+}
+
+// RatingsPerMonthEntry defines model for RatingsPerMonthEntry.
+type RatingsPerMonthEntry struct {
+	// Count Amount of ratings given in this month
+	Count int `json:"count"`
+
+	// Month First day of the month. Format YYYY-MM-DD
+	Month openapi_types.Date `json:"month"`
+}
+
+// RevokeSessionsResp defines model for RevokeSessionsResp.
+type RevokeSessionsResp struct {
+	// Revoked Number of revoked sessions
+	Revoked int `json:"revoked"`
+}
+
 // SearchDishByDateReq Request to look up all dishes served on a date optionally filtered by a location
 type SearchDishByDateReq struct {
 	// Date Date on which dishes must have been served. Format YYYY-MM-DD
@@ -186,9 +423,51 @@ type SearchDishResp struct {
 	FoundDish bool `json:"foundDish"`
 }
 
+// SessionEntry Login session of a user
+type SessionEntry struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Current True for the session this request was made with
+	Current bool   `json:"current"`
+	Id      string `json:"id"`
+
+	// IpHash Hash of the ip address of the last request. Only meant to tell sessions apart
+	IpHash string `json:"ipHash"`
+
+	// LastSeenAt Time of the last request to the user API. Updated at most once per minute
+	LastSeenAt time.Time `json:"lastSeenAt"`
+	UserAgent  string    `json:"userAgent"`
+}
+
+// UserDishPreference Describes how the user rated a specific dish
+type UserDishPreference struct {
+	// AvgRating Average of the ratings given by the user for this dish
+	AvgRating float32 `json:"avgRating"`
+	DishID    int64   `json:"dishID"`
+	DishName  string  `json:"dishName"`
+
+	// RatingCount Amount of ratings given by the user for this dish
+	RatingCount int `json:"ratingCount"`
+}
+
+// GetLocationsParams defines parameters for GetLocations.
+type GetLocationsParams struct {
+	// IncludeArchived Also return archived locations
+	IncludeArchived *bool `form:"includeArchived,omitempty" json:"includeArchived,omitempty"`
+}
+
+// PostDishFamiliesJSONRequestBody defines body for PostDishFamilies for application/json ContentType.
+type PostDishFamiliesJSONRequestBody = CreateDishFamilyReq
+
+// PatchDishFamiliesDishFamilyIDJSONRequestBody defines body for PatchDishFamiliesDishFamilyID for application/json ContentType.
+type PatchDishFamiliesDishFamilyIDJSONRequestBody = DishFamilyUpdateReq
+
 // PostDishesDishIDJSONRequestBody defines body for PostDishesDishID for application/json ContentType.
 type PostDishesDishIDJSONRequestBody = RateDishReq
 
+// PatchLocationsLocationIDJSONRequestBody defines body for PatchLocationsLocationID for application/json ContentType.
+type PatchLocationsLocationIDJSONRequestBody = LocationUpdateReq
+
 // PostMergedDishesJSONRequestBody defines body for PostMergedDishes for application/json ContentType.
 type PostMergedDishesJSONRequestBody = CreateMergedDishReq
 
@@ -200,3 +479,6 @@ type PostSearchDishJSONRequestBody = SearchDishReq
 
 // PostSearchDishByDateJSONRequestBody defines body for PostSearchDishByDate for application/json ContentType.
 type PostSearchDishByDateJSONRequestBody = SearchDishByDateReq
+
+// PostUsersMeTokensJSONRequestBody defines body for PostUsersMeTokens for application/json ContentType.
+type PostUsersMeTokensJSONRequestBody = CreateAccessTokenReq
@@ -4,11 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"itsTasty/pkg/api/accessTokenService"
 	"itsTasty/pkg/api/domain"
 	"itsTasty/pkg/api/ports"
-	"log"
+	"itsTasty/pkg/api/sessionService"
+	"itsTasty/pkg/api/statisticsService"
+	"itsTasty/pkg/logging"
+	"itsTasty/pkg/telemetry"
+	"sort"
 	"time"
 
+	"github.com/deepmap/oapi-codegen/pkg/types"
 	"github.com/sourcegraph/conc/iter"
 	"github.com/sourcegraph/conc/pool"
 )
@@ -50,9 +56,23 @@ const defaultDBTimeout = 5 * time.Second
 
 type HttpServer struct {
 	repo       domain.DishRepo
+	locations  domain.LocationRepo
+	families   domain.DishFamilyRepo
+	userStats  statisticsService.UserStatisticsService
+	tokens     accessTokenService.AccessTokenService
+	sessions   sessionService.SessionService
+	events     domain.EventPublisher
 	timeSource TimeSource
 }
 
+// publish hands the event to the event publisher. Errors are only logged, as the change that caused the event
+// has already been persisted
+func (h *HttpServer) publish(ctx context.Context, event domain.Event) {
+	if err := h.events.Publish(ctx, event); err != nil {
+		logging.FromContext(ctx).Error("failed to publish event", "event", event.Type, "err", err)
+	}
+}
+
 func (h *HttpServer) GetDishesMergeCandidatesDishID(ctx context.Context, request GetDishesMergeCandidatesDishIDRequestObject) (GetDishesMergeCandidatesDishIDResponseObject, error) {
 
 	dbCtx, dbCancel := context.WithTimeout(ctx, defaultDBTimeout*2)
@@ -60,7 +80,7 @@ func (h *HttpServer) GetDishesMergeCandidatesDishID(ctx context.Context, request
 
 	mergeCandidates, err := ports.FetchMergeCandidates(dbCtx, request.DishID, h.repo)
 	if err != nil {
-		log.Printf("ports.FetchMergeCandidates failed with : %v", err)
+		logging.FromContext(ctx).Error("ports.FetchMergeCandidates failed", "dishID", request.DishID, "err", err)
 		if errors.Is(err, domain.ErrNotFound) {
 			return GetDishesMergeCandidatesDishID404Response{}, nil
 		}
@@ -84,15 +104,27 @@ func (h *HttpServer) GetDishesMergeCandidatesDishID(ctx context.Context, request
 
 }
 
-func NewHttpServer(repo domain.DishRepo) *HttpServer {
-	return &HttpServer{repo: repo, timeSource: defaultTimeSource{}}
+func NewHttpServer(repo domain.DishRepo, locations domain.LocationRepo, families domain.DishFamilyRepo,
+	userStats statisticsService.UserStatisticsService, tokens accessTokenService.AccessTokenService,
+	sessions sessionService.SessionService, events domain.EventPublisher) *HttpServer {
+	return NewHttpServerCustomTime(repo, locations, families, userStats, tokens, sessions, events, defaultTimeSource{})
 }
 
-type HttpServerFactory func(repo domain.DishRepo) *HttpServer
+type HttpServerFactory func(repo domain.DishRepo, locations domain.LocationRepo, families domain.DishFamilyRepo,
+	userStats statisticsService.UserStatisticsService, tokens accessTokenService.AccessTokenService,
+	sessions sessionService.SessionService, events domain.EventPublisher) *HttpServer
 
-func NewHttpServerCustomTime(repo domain.DishRepo, timeSource TimeSource) *HttpServer {
+func NewHttpServerCustomTime(repo domain.DishRepo, locations domain.LocationRepo, families domain.DishFamilyRepo,
+	userStats statisticsService.UserStatisticsService, tokens accessTokenService.AccessTokenService,
+	sessions sessionService.SessionService, events domain.EventPublisher, timeSource TimeSource) *HttpServer {
 	return &HttpServer{
 		repo:       repo,
+		locations:  locations,
+		families:   families,
+		userStats:  userStats,
+		tokens:     tokens,
+		sessions:   sessions,
+		events:     events,
 		timeSource: timeSource,
 	}
 }
@@ -105,7 +137,7 @@ func (h *HttpServer) GetMergedDishesMergedDishID(ctx context.Context, r GetMerge
 
 	mergedDish, err := h.repo.GetMergedDishByID(dbCtx, r.MergedDishID)
 	if err != nil {
-		log.Printf("failed get merged dish %v : %v", r.MergedDishID, err)
+		logging.FromContext(ctx).Error("failed to get merged dish", "mergedDishID", r.MergedDishID, "err", err)
 		if errors.Is(err, domain.ErrNotFound) {
 			return GetMergedDishesMergedDishID404Response{}, nil
 		}
@@ -126,11 +158,11 @@ func (h *HttpServer) GetMergedDishesMergedDishID(ctx context.Context, r GetMerge
 		return dishID, nil
 	})
 	if err != nil {
-		log.Printf("failed get at least one dish for dishes %v served at %v : %v", dishNames, mergedDish.ServedAt, err)
+		logging.FromContext(ctx).Error("failed to get at least one dish of merged dish", "dishNames", dishNames, "servedAt", mergedDish.ServedAt, "err", err)
 		return GetMergedDishesMergedDishID500Response{}, nil
 	}
 	if len(dishNames) != len(dishIDs) {
-		log.Printf("Got %v dishNames but only %v dishIds", len(dishNames), len(dishIDs))
+		logging.FromContext(ctx).Error("number of dish names and dish ids differs", "dishNames", len(dishNames), "dishIDs", len(dishIDs))
 		return GetMergedDishesMergedDishID500Response{}, nil
 	}
 	containedDishes := make([]ContainedDishEntry, 0)
@@ -178,7 +210,7 @@ func (h *HttpServer) PostMergedDishes(ctx context.Context, request PostMergedDis
 	dishesForMerge, err := mapper.MapErr(request.Body.MergedDishes, func(i *int64) (*domain.Dish, error) {
 		d, err := h.repo.GetDishByID(dbCtx, *i)
 		if err != nil {
-			log.Printf("GetDishByID for id %v failed : %v", *i, err)
+			logging.FromContext(ctx).Warn("GetDishByID failed", "dishID", *i, "err", err)
 			if errors.Is(err, domain.ErrNotFound) {
 
 				return nil, userFacingDishNotExistsErr{*i}
@@ -202,7 +234,7 @@ func (h *HttpServer) PostMergedDishes(ctx context.Context, request PostMergedDis
 
 	mergedDish, err := domain.NewMergedDish(request.Body.Name, dishesForMerge[0], dishesForMerge[1], dishesForMerge[2:])
 	if err != nil {
-		log.Printf("domain.NewMergedDish failed for request %v failed : %v", request.Body, err)
+		logging.FromContext(ctx).Warn("domain.NewMergedDish failed", "request", request.Body, "err", err)
 		if errors.Is(err, domain.ErrNotOnSameLocation) {
 			s := "All dishes must be served at the same location"
 			return PostMergedDishes400JSONResponse{What: &s}, nil
@@ -215,7 +247,7 @@ func (h *HttpServer) PostMergedDishes(ctx context.Context, request PostMergedDis
 	//
 	mergedDishID, err := h.repo.CreateMergedDish(dbCtx, mergedDish)
 	if err != nil {
-		log.Printf("repo.CreateMergedDish failed for  %v failed : %v", mergedDish, err)
+		logging.FromContext(ctx).Error("repo.CreateMergedDish failed", "mergedDish", mergedDish.Name, "err", err)
 		if errors.Is(err, domain.ErrDishAlreadyMerged) {
 			s := "One of the dishes is already part of a merged dish"
 			return PostMergedDishes400JSONResponse{What: &s}, nil
@@ -223,6 +255,8 @@ func (h *HttpServer) PostMergedDishes(ctx context.Context, request PostMergedDis
 		return PostMergedDishes500Response{}, nil
 	}
 
+	h.publish(dbCtx, domain.NewMergedDishChangedEvent(h.timeSource.Now(), mergedDishID, domain.MergedDishCreated, mergedDish))
+
 	return PostMergedDishes200JSONResponse{
 		MergedDishID: mergedDishID,
 	}, nil
@@ -235,13 +269,16 @@ func (h *HttpServer) DeleteMergedDishesMergedDishID(ctx context.Context, r Delet
 
 	err := h.repo.DeleteMergedDishByID(dbCtx, r.MergedDishID)
 	if err != nil {
-		log.Printf("Failed to delte merged dish %v : %v", r.MergedDishID, err)
+		logging.FromContext(ctx).Error("failed to delete merged dish", "mergedDishID", r.MergedDishID, "err", err)
 
 		if errors.Is(err, domain.ErrNotFound) {
 			return DeleteMergedDishesMergedDishID404Response{}, nil
 		}
 		return DeleteMergedDishesMergedDishID500Response{}, nil
 	}
+
+	h.publish(dbCtx, domain.NewMergedDishChangedEvent(h.timeSource.Now(), r.MergedDishID, domain.MergedDishDeleted, nil))
+
 	return DeleteMergedDishesMergedDishID200Response{}, nil
 }
 
@@ -271,7 +308,7 @@ func (h *HttpServer) PatchMergedDishesMergedDishID(ctx context.Context, request
 			return d, nil
 		})
 		if err != nil {
-			log.Printf("failed to fetch dishes %v for adding to merged dish %v : %v", addDishIDs, request.MergedDishID, err)
+			logging.FromContext(ctx).Warn("failed to fetch dishes for adding to merged dish", "dishIDs", addDishIDs, "mergedDishID", request.MergedDishID, "err", err)
 			if errors.Is(err, domain.ErrNotFound) {
 				s := "At least one of the provided dishes that should be added could not be found"
 				return PatchMergedDishesMergedDishID400JSONResponse{What: &s}, nil
@@ -292,7 +329,7 @@ func (h *HttpServer) PatchMergedDishesMergedDishID(ctx context.Context, request
 			return d, nil
 		})
 		if err != nil {
-			log.Printf("failed to fetch dishes %v for removal from merged dish %v : %v", removeDishes, request.MergedDishID, err)
+			logging.FromContext(ctx).Warn("failed to fetch dishes for removal from merged dish", "dishIDs", removeDishes, "mergedDishID", request.MergedDishID, "err", err)
 			if errors.Is(err, domain.ErrNotFound) {
 				s := "At least one of the  dishes that should be removed could not be found"
 				return PatchMergedDishesMergedDishID400JSONResponse{What: &s}, nil
@@ -305,6 +342,7 @@ func (h *HttpServer) PatchMergedDishesMergedDishID(ctx context.Context, request
 	// Update merged dish
 	//
 
+	var updatedMergedDish *domain.MergedDish
 	err := h.repo.UpdateMergedDishByID(dbCtx, request.MergedDishID, func(current *domain.MergedDish) (*domain.MergedDish, error) {
 		for _, v := range addDishes {
 			if err := current.AddDish(v); err != nil {
@@ -322,11 +360,12 @@ func (h *HttpServer) PatchMergedDishesMergedDishID(ctx context.Context, request
 			current.Name = *request.Body.Name
 		}
 
+		updatedMergedDish = current
 		return current, nil
 	})
 	if err != nil {
 
-		log.Printf("UpdateMergedDishByID failed to add dishes %v to merged dish id %v : %v", request.MergedDishID, addDishes, err)
+		logging.FromContext(ctx).Error("UpdateMergedDishByID failed", "mergedDishID", request.MergedDishID, "addDishes", addDishes, "err", err)
 		//common errors
 
 		if errors.Is(err, domain.ErrNotFound) {
@@ -361,6 +400,9 @@ func (h *HttpServer) PatchMergedDishesMergedDishID(ctx context.Context, request
 		return PatchMergedDishesMergedDishID500Response{}, nil
 	}
 
+	h.publish(dbCtx, domain.NewMergedDishChangedEvent(h.timeSource.Now(), request.MergedDishID, domain.MergedDishUpdated,
+		updatedMergedDish))
+
 	return PatchMergedDishesMergedDishID200Response{}, nil
 }
 
@@ -370,7 +412,7 @@ func (h *HttpServer) PostSearchDishByDate(ctx context.Context, request PostSearc
 
 	matchingDishes, err := h.repo.GetDishByDate(dbCtx, request.Body.Date.Time, request.Body.Location)
 	if err != nil {
-		log.Printf("GetDishByDate for date %v and location %v failed : %v", request.Body.Date.Time, request.Body.Location, err)
+		logging.FromContext(ctx).Error("GetDishByDate failed", "date", request.Body.Date.Time, "location", request.Body.Location, "err", err)
 		return PostSearchDishByDate500JSONResponse{}, nil
 	}
 
@@ -380,13 +422,79 @@ func (h *HttpServer) PostSearchDishByDate(ctx context.Context, request PostSearc
 func (h *HttpServer) GetUsersMe(ctx context.Context, _ GetUsersMeRequestObject) (GetUsersMeResponseObject, error) {
 	userEmail, err := GetUserEmailFromCTX(ctx)
 	if err != nil {
-		log.Printf("GetUserEmailFromCTX : %v", err)
+		logging.FromContext(ctx).Error("GetUserEmailFromCTX failed", "err", err)
 		return GetUsersMe500JSONResponse{}, nil
 	}
 
 	return GetUsersMe200JSONResponse{Email: userEmail}, nil
 }
 
+func (h *HttpServer) GetUsersMeStatistics(ctx context.Context, _ GetUsersMeStatisticsRequestObject) (GetUsersMeStatisticsResponseObject, error) {
+	userEmail, err := GetUserEmailFromCTX(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Error("GetUserEmailFromCTX failed", "err", err)
+		return GetUsersMeStatistics500JSONResponse{}, nil
+	}
+
+	stats, err := h.userStats.GetUserStatistics(ctx, userEmail)
+	if err != nil {
+		logging.FromContext(ctx).Error("failed to get user statistics", "err", err)
+		return GetUsersMeStatistics500JSONResponse{}, nil
+	}
+
+	dbCtx, dbCancel := context.WithTimeout(ctx, defaultDBTimeout)
+	defer dbCancel()
+
+	//resolve dish names for favourite and most hated dish
+	toDishPreference := func(pref *domain.DishPreference) (*UserDishPreference, error) {
+		if pref == nil {
+			return nil, nil
+		}
+		dish, err := h.repo.GetDishByID(dbCtx, pref.DishID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get dish %v : %w", pref.DishID, err)
+		}
+		return &UserDishPreference{
+			AvgRating:   pref.AverageRating,
+			DishID:      pref.DishID,
+			DishName:    dish.Name,
+			RatingCount: pref.RatingCount,
+		}, nil
+	}
+
+	response := GetUsersMeStatistics200JSONResponse{
+		AvgGivenRating:  stats.AverageGivenRating,
+		Percentile:      float32(stats.Percentile),
+		RatingsPerMonth: make([]RatingsPerMonthEntry, len(stats.RatingsPerMonth)),
+		TeamAvgRating:   stats.TeamAverageRating,
+		TotalRatings:    stats.TotalRatings,
+	}
+	for i, v := range stats.RatingsPerMonth {
+		response.RatingsPerMonth[i] = RatingsPerMonthEntry{
+			Count: v.Count,
+			Month: types.Date{Time: v.Month},
+		}
+	}
+	if response.FavouriteDish, err = toDishPreference(stats.FavouriteDish); err != nil {
+		logging.FromContext(ctx).Error("failed to resolve favourite dish", "err", err)
+		return GetUsersMeStatistics500JSONResponse{}, nil
+	}
+	if response.MostHatedDish, err = toDishPreference(stats.MostHatedDish); err != nil {
+		logging.FromContext(ctx).Error("failed to resolve most hated dish", "err", err)
+		return GetUsersMeStatistics500JSONResponse{}, nil
+	}
+	if stats.CurrentStreak != nil {
+		l := stats.CurrentStreak.LengthInDays()
+		response.CurrentStreakLength = &l
+	}
+	if stats.LongestStreak != nil {
+		l := stats.LongestStreak.LengthInDays()
+		response.LongestStreakLength = &l
+	}
+
+	return response, nil
+}
+
 func fetchMostRecentUserRating(ctx context.Context, repo domain.DishRepo, userEmail string, dishID int64) (*domain.DishRating, error) {
 	ratings, err := repo.GetRatings(ctx, userEmail, dishID, true)
 	if err != nil {
@@ -402,7 +510,7 @@ func fetchMostRecentUserRating(ctx context.Context, repo domain.DishRepo, userEm
 func (h *HttpServer) GetDishesDishID(ctx context.Context, request GetDishesDishIDRequestObject) (GetDishesDishIDResponseObject, error) {
 	userEmail, err := GetUserEmailFromCTX(ctx)
 	if err != nil {
-		log.Printf("GetUserEmailFromCTX : %v", err)
+		logging.FromContext(ctx).Error("GetUserEmailFromCTX failed", "err", err)
 		return GetDishesDishID500JSONResponse{}, nil
 	}
 
@@ -413,6 +521,7 @@ func (h *HttpServer) GetDishesDishID(ctx context.Context, request GetDishesDishI
 
 	var basicDishData *ports.BasicDishReply
 	var mostRecentUserRating *domain.DishRating
+	var dishFamilyID *int64
 
 	p.Go(func(ctx context.Context) error {
 		var err error
@@ -427,9 +536,20 @@ func (h *HttpServer) GetDishesDishID(ctx context.Context, request GetDishesDishI
 		}
 		return err
 	})
+	p.Go(func(ctx context.Context) error {
+		id, err := h.families.GetDishFamilyOfDish(dbCtx, request.DishID)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return nil
+			}
+			return err
+		}
+		dishFamilyID = &id
+		return nil
+	})
 
 	if err := p.Wait(); err != nil {
-		log.Printf("failed to fetch data for reply : %v", err)
+		logging.FromContext(ctx).Error("failed to fetch data for reply", "dishID", request.DishID, "err", err)
 		return GetDishesDishID500JSONResponse{}, nil
 	}
 
@@ -442,6 +562,7 @@ func (h *HttpServer) GetDishesDishID(ctx context.Context, request GetDishesDishI
 		Ratings:           basicDishData.Ratings,
 		RecentOccurrences: basicDishData.RecentOccurrences,
 		MergedDishID:      basicDishData.MergedDishID,
+		DishFamilyID:      dishFamilyID,
 	}
 
 	if mostRecentUserRating != nil {
@@ -453,17 +574,52 @@ func (h *HttpServer) GetDishesDishID(ctx context.Context, request GetDishesDishI
 
 }
 
+func (h *HttpServer) GetDishesDishIDRatingTrend(ctx context.Context, request GetDishesDishIDRatingTrendRequestObject) (GetDishesDishIDRatingTrendResponseObject, error) {
+	dbCtx, dbCancel := context.WithTimeout(ctx, defaultDBTimeout)
+	defer dbCancel()
+
+	trendData, err := ports.FetchRatingTrend(dbCtx, h.repo, request.DishID)
+	if err != nil {
+		logging.FromContext(ctx).Error("failed to fetch rating trend", "dishID", request.DishID, "err", err)
+		if errors.Is(err, domain.ErrNotFound) {
+			return GetDishesDishIDRatingTrend404Response{}, nil
+		}
+		return GetDishesDishIDRatingTrend500JSONResponse{}, nil
+	}
+
+	trend := make([]RatingTrendEntry, len(trendData.Trend))
+	for i, v := range trendData.Trend {
+		ratings := make(map[string]int)
+		for rating, count := range v.Ratings {
+			ratings[fmt.Sprintf("%v", rating)] = count
+		}
+		trend[i] = RatingTrendEntry{
+			AvgRating:   v.AverageRating,
+			Date:        types.Date{Time: v.Occurrence},
+			RatingCount: v.RatingCount,
+			Ratings:     ratings,
+		}
+	}
+
+	return GetDishesDishIDRatingTrend200JSONResponse{
+		MergedDishID: trendData.MergedDishID,
+		Name:         trendData.Name,
+		ServedAt:     trendData.ServedAt,
+		Trend:        trend,
+	}, nil
+}
+
 func (h *HttpServer) PostDishesDishID(ctx context.Context, request PostDishesDishIDRequestObject) (PostDishesDishIDResponseObject, error) {
 
 	userEmail, err := GetUserEmailFromCTX(ctx)
 	if err != nil {
-		log.Printf("GetUserEmailFromCTX : %v", err)
+		logging.FromContext(ctx).Error("GetUserEmailFromCTX failed", "err", err)
 		return PostDishesDishID500JSONResponse{}, nil
 	}
 
 	rating, err := domain.NewRatingFromInt(int(request.Body.Rating))
 	if err != nil {
-		log.Printf("User %v gave invalid rating : %v", userEmail, err)
+		logging.FromContext(ctx).Warn("invalid rating", "err", err)
 	}
 
 	dishRating := domain.DishRating{
@@ -483,11 +639,13 @@ func (h *HttpServer) PostDishesDishID(ctx context.Context, request PostDishesDis
 		return PostDishesDishID500JSONResponse{}, nil
 	}
 
+	createdNewRating := false
 	err = h.repo.CreateOrUpdateRating(dbCtx, userEmail, request.DishID,
 		func(currentRating *domain.DishRating) (updatedRating *domain.DishRating, createNew bool, err error) {
 
 			updatedRating = &dishRating
 			createNew = dish.CreateNewRatingInsteadOfUpdating(currentRating, *updatedRating)
+			createdNewRating = createNew
 			err = nil
 			return
 		})
@@ -498,6 +656,11 @@ func (h *HttpServer) PostDishesDishID(ctx context.Context, request PostDishesDis
 		return PostDishesDishID500JSONResponse{}, nil
 	}
 
+	if createdNewRating {
+		telemetry.Ratings.Inc()
+		h.publish(dbCtx, domain.NewRatingCreatedEvent(h.timeSource.Now(), request.DishID, rating))
+	}
+
 	return PostDishesDishID200Response{}, nil
 
 }
@@ -508,7 +671,7 @@ func (h *HttpServer) GetGetAllDishes(ctx context.Context, _ GetGetAllDishesReque
 	defer dbCancel()
 	dishesSimple, err := h.repo.GetAllDishesSimple(dbCtx)
 	if err != nil {
-		log.Printf("GetAllDishes : %v", err)
+		logging.FromContext(ctx).Error("GetAllDishes failed", "err", err)
 		return GetGetAllDishes500JSONResponse{}, nil
 	}
 
@@ -569,7 +732,7 @@ func (h *HttpServer) PostSearchDish(ctx context.Context, request PostSearchDishR
 	})
 
 	if err := p.Wait(); err != nil {
-		log.Printf("GetDishByName for %v: %v", request.Body.DishName, err)
+		logging.FromContext(ctx).Error("GetDishByName failed", "dishName", request.Body.DishName, "err", err)
 		return PostSearchDish500JSONResponse{}, nil
 	}
 
@@ -593,3 +756,416 @@ func (h *HttpServer) PostSearchDish(ctx context.Context, request PostSearchDishR
 		FoundDish: true,
 	}, nil
 }
+
+func locationToAPI(id int64, l domain.Location) Location {
+	return Location{
+		Id:           id,
+		Name:         l.Name,
+		DisplayName:  l.DisplayName,
+		Address:      l.Address,
+		OpeningHours: l.OpeningHours,
+		Aliases:      l.Aliases,
+		Archived:     l.Archived,
+	}
+}
+
+func (h *HttpServer) GetLocations(ctx context.Context, request GetLocationsRequestObject) (GetLocationsResponseObject, error) {
+	dbCtx, dbCancel := context.WithTimeout(ctx, defaultDBTimeout)
+	defer dbCancel()
+
+	locations, err := h.locations.GetAllLocations(dbCtx)
+	if err != nil {
+		logging.FromContext(ctx).Error("GetAllLocations failed", "err", err)
+		return GetLocations500Response{}, nil
+	}
+
+	includeArchived := request.Params.IncludeArchived != nil && *request.Params.IncludeArchived
+	resp := GetLocations200JSONResponse{Locations: make([]Location, 0, len(locations))}
+	for id, v := range locations {
+		if v.Archived && !includeArchived {
+			continue
+		}
+		resp.Locations = append(resp.Locations, locationToAPI(id, v))
+	}
+	sort.Slice(resp.Locations, func(i, j int) bool {
+		return resp.Locations[i].Name < resp.Locations[j].Name
+	})
+	return resp, nil
+}
+
+func (h *HttpServer) GetLocationsLocationID(ctx context.Context, request GetLocationsLocationIDRequestObject) (GetLocationsLocationIDResponseObject, error) {
+	dbCtx, dbCancel := context.WithTimeout(ctx, defaultDBTimeout)
+	defer dbCancel()
+
+	location, err := h.locations.GetLocationByID(dbCtx, request.LocationID)
+	if err != nil {
+		logging.FromContext(ctx).Warn("GetLocationByID failed", "locationID", request.LocationID, "err", err)
+		if errors.Is(err, domain.ErrNotFound) {
+			return GetLocationsLocationID404Response{}, nil
+		}
+		return GetLocationsLocationID500Response{}, nil
+	}
+	return GetLocationsLocationID200JSONResponse(locationToAPI(request.LocationID, location)), nil
+}
+
+func (h *HttpServer) PatchLocationsLocationID(ctx context.Context, request PatchLocationsLocationIDRequestObject) (PatchLocationsLocationIDResponseObject, error) {
+	dbCtx, dbCancel := context.WithTimeout(ctx, defaultDBTimeout)
+	defer dbCancel()
+
+	var updated domain.Location
+	err := h.locations.UpdateLocation(dbCtx, request.LocationID, func(current domain.Location) (*domain.Location, error) {
+		if v := request.Body.DisplayName; v != nil {
+			current.DisplayName = *v
+		}
+		if v := request.Body.Address; v != nil {
+			current.Address = *v
+		}
+		if v := request.Body.OpeningHours; v != nil {
+			current.OpeningHours = *v
+		}
+		if v := request.Body.Aliases; v != nil {
+			current.Aliases = *v
+		}
+		if v := request.Body.Archived; v != nil {
+			current.Archived = *v
+		}
+		//validating here gives us the normalized aliases for the response
+		if err := current.Validate(); err != nil {
+			return nil, err
+		}
+		updated = current
+		return &current, nil
+	})
+	if err != nil {
+		logging.FromContext(ctx).Warn("UpdateLocation failed", "locationID", request.LocationID, "err", err)
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return PatchLocationsLocationID404Response{}, nil
+		case errors.Is(err, domain.ErrInvalidLocation), errors.Is(err, domain.ErrLocationAliasConflict):
+			what := err.Error()
+			return PatchLocationsLocationID400JSONResponse{What: &what}, nil
+		}
+		return PatchLocationsLocationID500Response{}, nil
+	}
+
+	return PatchLocationsLocationID200JSONResponse(locationToAPI(request.LocationID, updated)), nil
+}
+
+// fetchDishFamilyMembers looks up the locations of the dishes. Fails with userFacingDishNotExistsErr if a dish
+// does not exist
+func (h *HttpServer) fetchDishFamilyMembers(ctx context.Context, dishIDs []int64) ([]domain.DishFamilyMember, error) {
+	mapper := iter.Mapper[int64, domain.DishFamilyMember]{}
+	return mapper.MapErr(dishIDs, func(i *int64) (domain.DishFamilyMember, error) {
+		d, err := h.repo.GetDishByID(ctx, *i)
+		if err != nil {
+			logging.FromContext(ctx).Warn("GetDishByID failed", "dishID", *i, "err", err)
+			if errors.Is(err, domain.ErrNotFound) {
+				return domain.DishFamilyMember{}, userFacingDishNotExistsErr{*i}
+			}
+			return domain.DishFamilyMember{}, fmt.Errorf("GetDishByID failed : %v", err)
+		}
+		return domain.DishFamilyMember{DishID: *i, ServedAt: d.ServedAt}, nil
+	})
+}
+
+// isUserFacingDishFamilyErr returns true if err is caused by invalid input
+func isUserFacingDishFamilyErr(err error) bool {
+	var notFoundErr userFacingDishNotExistsErr
+	return errors.As(err, &notFoundErr) || errors.Is(err, domain.ErrInvalidDishFamily) ||
+		errors.Is(err, domain.ErrDishAlreadyInFamily) || errors.Is(err, domain.ErrDishNotPartOfDishFamily)
+}
+
+func (h *HttpServer) GetDishFamilies(ctx context.Context, _ GetDishFamiliesRequestObject) (GetDishFamiliesResponseObject, error) {
+	dbCtx, dbCancel := context.WithTimeout(ctx, defaultDBTimeout)
+	defer dbCancel()
+
+	families, err := h.families.GetAllDishFamilies(dbCtx)
+	if err != nil {
+		logging.FromContext(ctx).Error("GetAllDishFamilies failed", "err", err)
+		return GetDishFamilies500Response{}, nil
+	}
+
+	resp := GetDishFamilies200JSONResponse{DishFamilies: make([]DishFamilyEntry, 0, len(families))}
+	for id, v := range families {
+		resp.DishFamilies = append(resp.DishFamilies, DishFamilyEntry{
+			Id:      id,
+			Name:    v.Name,
+			DishIDs: v.DishIDs(),
+		})
+	}
+	sort.Slice(resp.DishFamilies, func(i, j int) bool {
+		a, b := resp.DishFamilies[i], resp.DishFamilies[j]
+		return a.Name < b.Name || a.Name == b.Name && a.Id < b.Id
+	})
+	return resp, nil
+}
+
+func (h *HttpServer) PostDishFamilies(ctx context.Context, request PostDishFamiliesRequestObject) (PostDishFamiliesResponseObject, error) {
+	dbCtx, dbCancel := context.WithTimeout(ctx, defaultDBTimeout)
+	defer dbCancel()
+
+	members, err := h.fetchDishFamilyMembers(dbCtx, request.Body.DishIDs)
+	if err == nil {
+		var family *domain.DishFamily
+		if family, err = domain.NewDishFamily(request.Body.Name, members); err == nil {
+			var id int64
+			if id, err = h.families.CreateDishFamily(dbCtx, *family); err == nil {
+				return PostDishFamilies200JSONResponse{DishFamilyID: id}, nil
+			}
+		}
+	}
+
+	logging.FromContext(ctx).Warn("failed to create dish family", "name", request.Body.Name, "err", err)
+	if isUserFacingDishFamilyErr(err) {
+		what := err.Error()
+		return PostDishFamilies400JSONResponse{What: &what}, nil
+	}
+	return PostDishFamilies500Response{}, nil
+}
+
+func (h *HttpServer) GetDishFamiliesDishFamilyID(ctx context.Context, request GetDishFamiliesDishFamilyIDRequestObject) (GetDishFamiliesDishFamilyIDResponseObject, error) {
+	dbCtx, dbCancel := context.WithTimeout(ctx, defaultDBTimeout)
+	defer dbCancel()
+
+	family, err := h.families.GetDishFamilyByID(dbCtx, request.DishFamilyID)
+	if err != nil {
+		logging.FromContext(ctx).Warn("GetDishFamilyByID failed", "dishFamilyID", request.DishFamilyID, "err", err)
+		if errors.Is(err, domain.ErrNotFound) {
+			return GetDishFamiliesDishFamilyID404Response{}, nil
+		}
+		return GetDishFamiliesDishFamilyID500Response{}, nil
+	}
+
+	data, err := ports.FetchDishFamilyData(dbCtx, h.repo, family)
+	if err != nil {
+		logging.FromContext(ctx).Error("failed to fetch ratings of dish family", "dishFamilyID", request.DishFamilyID, "err", err)
+		return GetDishFamiliesDishFamilyID500Response{}, nil
+	}
+
+	locations := make([]DishFamilyLocationRatings, 0, len(data.Locations))
+	for _, v := range data.Locations {
+		locations = append(locations, DishFamilyLocationRatings{
+			AvgRating:    v.AvgRating,
+			DishID:       v.DishID,
+			MergedDishID: v.MergedDishID,
+			Name:         v.Name,
+			Ratings:      v.Ratings,
+			ServedAt:     v.ServedAt,
+		})
+	}
+	return GetDishFamiliesDishFamilyID200JSONResponse{
+		AvgRating: data.AvgRating,
+		Id:        request.DishFamilyID,
+		Locations: locations,
+		Name:      data.Name,
+		Ratings:   data.Ratings,
+	}, nil
+}
+
+func (h *HttpServer) PatchDishFamiliesDishFamilyID(ctx context.Context, request PatchDishFamiliesDishFamilyIDRequestObject) (PatchDishFamiliesDishFamilyIDResponseObject, error) {
+	dbCtx, dbCancel := context.WithTimeout(ctx, defaultDBTimeout)
+	defer dbCancel()
+
+	addMembers := make([]domain.DishFamilyMember, 0)
+	if v := request.Body.AddDishIDs; v != nil {
+		var err error
+		if addMembers, err = h.fetchDishFamilyMembers(dbCtx, *v); err != nil {
+			logging.FromContext(ctx).Warn("failed to fetch dishes for adding to dish family", "dishIDs", *v, "dishFamilyID", request.DishFamilyID, "err", err)
+			if isUserFacingDishFamilyErr(err) {
+				what := err.Error()
+				return PatchDishFamiliesDishFamilyID400JSONResponse{What: &what}, nil
+			}
+			return PatchDishFamiliesDishFamilyID500Response{}, nil
+		}
+	}
+
+	err := h.families.UpdateDishFamily(dbCtx, request.DishFamilyID, func(current domain.DishFamily) (*domain.DishFamily, error) {
+		for _, v := range addMembers {
+			if err := current.AddDish(v.DishID, v.ServedAt); err != nil {
+				return nil, err
+			}
+		}
+		if v := request.Body.RemoveDishIDs; v != nil {
+			for _, dishID := range *v {
+				if err := current.RemoveDish(dishID); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if v := request.Body.Name; v != nil {
+			current.Name = *v
+		}
+		return &current, nil
+	})
+	if err != nil {
+		logging.FromContext(ctx).Warn("UpdateDishFamily failed", "dishFamilyID", request.DishFamilyID, "err", err)
+		switch {
+		case isUserFacingDishFamilyErr(err):
+			what := err.Error()
+			return PatchDishFamiliesDishFamilyID400JSONResponse{What: &what}, nil
+		case errors.Is(err, domain.ErrNotFound):
+			return PatchDishFamiliesDishFamilyID404Response{}, nil
+		}
+		return PatchDishFamiliesDishFamilyID500Response{}, nil
+	}
+
+	return PatchDishFamiliesDishFamilyID200Response{}, nil
+}
+
+func (h *HttpServer) DeleteDishFamiliesDishFamilyID(ctx context.Context, request DeleteDishFamiliesDishFamilyIDRequestObject) (DeleteDishFamiliesDishFamilyIDResponseObject, error) {
+	dbCtx, dbCancel := context.WithTimeout(ctx, defaultDBTimeout)
+	defer dbCancel()
+
+	if err := h.families.DeleteDishFamily(dbCtx, request.DishFamilyID); err != nil {
+		logging.FromContext(ctx).Warn("DeleteDishFamily failed", "dishFamilyID", request.DishFamilyID, "err", err)
+		if errors.Is(err, domain.ErrNotFound) {
+			return DeleteDishFamiliesDishFamilyID404Response{}, nil
+		}
+		return DeleteDishFamiliesDishFamilyID500Response{}, nil
+	}
+	return DeleteDishFamiliesDishFamilyID200Response{}, nil
+}
+
+func accessTokenToEntry(id int64, token domain.AccessToken) AccessTokenEntry {
+	return AccessTokenEntry{
+		CreatedAt:  token.CreatedAt,
+		Id:         id,
+		LastUsedAt: token.LastUsedAt,
+		Name:       token.Name,
+		ReadOnly:   token.ReadOnly,
+	}
+}
+
+func (h *HttpServer) GetUsersMeTokens(ctx context.Context, _ GetUsersMeTokensRequestObject) (GetUsersMeTokensResponseObject, error) {
+	userEmail, err := GetUserEmailFromCTX(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Error("GetUserEmailFromCTX failed", "err", err)
+		return GetUsersMeTokens500Response{}, nil
+	}
+
+	dbCtx, dbCancel := context.WithTimeout(ctx, defaultDBTimeout)
+	defer dbCancel()
+
+	tokens, err := h.tokens.GetTokens(dbCtx, userEmail)
+	if err != nil {
+		logging.FromContext(ctx).Error("GetTokens failed", "err", err)
+		return GetUsersMeTokens500Response{}, nil
+	}
+
+	resp := GetUsersMeTokens200JSONResponse{Tokens: make([]AccessTokenEntry, 0, len(tokens))}
+	for id, v := range tokens {
+		resp.Tokens = append(resp.Tokens, accessTokenToEntry(id, v))
+	}
+	sort.Slice(resp.Tokens, func(i, j int) bool {
+		a, b := resp.Tokens[i], resp.Tokens[j]
+		return a.CreatedAt.Before(b.CreatedAt) || a.CreatedAt.Equal(b.CreatedAt) && a.Id < b.Id
+	})
+	return resp, nil
+}
+
+func (h *HttpServer) PostUsersMeTokens(ctx context.Context, request PostUsersMeTokensRequestObject) (PostUsersMeTokensResponseObject, error) {
+	userEmail, err := GetUserEmailFromCTX(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Error("GetUserEmailFromCTX failed", "err", err)
+		return PostUsersMeTokens500Response{}, nil
+	}
+
+	dbCtx, dbCancel := context.WithTimeout(ctx, defaultDBTimeout)
+	defer dbCancel()
+
+	readOnly := request.Body.ReadOnly != nil && *request.Body.ReadOnly
+	id, token, secret, err := h.tokens.CreateToken(dbCtx, userEmail, request.Body.Name, readOnly)
+	if err != nil {
+		logging.FromContext(ctx).Warn("failed to create access token", "name", request.Body.Name, "err", err)
+		if errors.Is(err, domain.ErrInvalidAccessToken) {
+			what := err.Error()
+			return PostUsersMeTokens400JSONResponse{What: &what}, nil
+		}
+		return PostUsersMeTokens500Response{}, nil
+	}
+
+	return PostUsersMeTokens200JSONResponse{
+		Entry: accessTokenToEntry(id, token),
+		Token: secret,
+	}, nil
+}
+
+func (h *HttpServer) DeleteUsersMeTokensTokenID(ctx context.Context, request DeleteUsersMeTokensTokenIDRequestObject) (DeleteUsersMeTokensTokenIDResponseObject, error) {
+	userEmail, err := GetUserEmailFromCTX(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Error("GetUserEmailFromCTX failed", "err", err)
+		return DeleteUsersMeTokensTokenID500Response{}, nil
+	}
+
+	dbCtx, dbCancel := context.WithTimeout(ctx, defaultDBTimeout)
+	defer dbCancel()
+
+	if err := h.tokens.RevokeToken(dbCtx, userEmail, request.TokenID); err != nil {
+		logging.FromContext(ctx).Warn("RevokeToken failed", "patID", request.TokenID, "err", err)
+		if errors.Is(err, domain.ErrNotFound) {
+			return DeleteUsersMeTokensTokenID404Response{}, nil
+		}
+		return DeleteUsersMeTokensTokenID500Response{}, nil
+	}
+	return DeleteUsersMeTokensTokenID200Response{}, nil
+}
+
+func (h *HttpServer) GetUsersMeSessions(ctx context.Context, _ GetUsersMeSessionsRequestObject) (GetUsersMeSessionsResponseObject, error) {
+	userEmail, err := GetUserEmailFromCTX(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Error("GetUserEmailFromCTX failed", "err", err)
+		return GetUsersMeSessions500Response{}, nil
+	}
+
+	sessions, err := h.sessions.GetSessions(ctx, userEmail)
+	if err != nil {
+		logging.FromContext(ctx).Error("GetSessions failed", "err", err)
+		return GetUsersMeSessions500Response{}, nil
+	}
+
+	resp := GetUsersMeSessions200JSONResponse{Sessions: make([]SessionEntry, 0, len(sessions))}
+	for _, v := range sessions {
+		resp.Sessions = append(resp.Sessions, SessionEntry{
+			CreatedAt:  v.CreatedAt,
+			Current:    v.Current,
+			Id:         v.ID,
+			IpHash:     v.IPHash,
+			LastSeenAt: v.LastSeenAt,
+			UserAgent:  v.UserAgent,
+		})
+	}
+	return resp, nil
+}
+
+func (h *HttpServer) DeleteUsersMeSessions(ctx context.Context, _ DeleteUsersMeSessionsRequestObject) (DeleteUsersMeSessionsResponseObject, error) {
+	userEmail, err := GetUserEmailFromCTX(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Error("GetUserEmailFromCTX failed", "err", err)
+		return DeleteUsersMeSessions500Response{}, nil
+	}
+
+	revoked, err := h.sessions.RevokeAllSessions(ctx, userEmail)
+	if err != nil {
+		logging.FromContext(ctx).Error("RevokeAllSessions failed", "revoked", revoked, "err", err)
+		return DeleteUsersMeSessions500Response{}, nil
+	}
+	logging.FromContext(ctx).Info("revoked all sessions of user", "revoked", revoked)
+	return DeleteUsersMeSessions200JSONResponse{Revoked: revoked}, nil
+}
+
+func (h *HttpServer) DeleteUsersMeSessionsSessionID(ctx context.Context, request DeleteUsersMeSessionsSessionIDRequestObject) (DeleteUsersMeSessionsSessionIDResponseObject, error) {
+	userEmail, err := GetUserEmailFromCTX(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Error("GetUserEmailFromCTX failed", "err", err)
+		return DeleteUsersMeSessionsSessionID500Response{}, nil
+	}
+
+	if err := h.sessions.RevokeSession(ctx, userEmail, request.SessionID); err != nil {
+		logging.FromContext(ctx).Warn("RevokeSession failed", "err", err)
+		if errors.Is(err, domain.ErrNotFound) {
+			return DeleteUsersMeSessionsSessionID404Response{}, nil
+		}
+		return DeleteUsersMeSessionsSessionID500Response{}, nil
+	}
+	return DeleteUsersMeSessionsSessionID200Response{}, nil
+}
@@ -0,0 +1,151 @@
+package reminderService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsTasty/pkg/api/domain"
+	"itsTasty/pkg/api/statisticsService"
+	"itsTasty/pkg/logging"
+	"sync"
+	"time"
+)
+
+type TimeSource interface {
+	//Now returns the current local time.
+	Now() time.Time
+}
+
+type ReminderService interface {
+	//SendRatingReminders reminds all users with an ongoing streak that did not rate today. Reminders are sent at most
+	//once per day and only after the configured time of day. Returns the amount of sent reminders
+	SendRatingReminders(ctx context.Context) (int, error)
+}
+
+// maxDaysOff limits the search for the previous workday, e.g. if the public holiday data source misbehaves
+const maxDaysOff = 14
+
+type DefaultReminderService struct {
+	statsRepo      domain.StatisticsRepo
+	streakService  statisticsService.StreakService
+	vacationClient domain.VacationDataSource
+	publicHolidays domain.PublicHolidayDataSource
+	notifier       domain.Notifier
+	timeSource     TimeSource
+	//remindAfter is the time of day (offset from midnight) after which reminders are sent
+	remindAfter time.Duration
+
+	//lock protects lastSent
+	lock sync.Mutex
+	//lastSent is the day on which reminders have been sent the last time. As this is only kept in memory,
+	//a restart after remindAfter causes reminders to be sent again
+	lastSent domain.DayPrecisionTime
+}
+
+func NewDefaultReminderService(statsRepo domain.StatisticsRepo, streakService statisticsService.StreakService,
+	vacationClient domain.VacationDataSource, holidayClient domain.PublicHolidayDataSource, notifier domain.Notifier,
+	timeSource TimeSource, remindAfter time.Duration) *DefaultReminderService {
+	return &DefaultReminderService{
+		statsRepo:      statsRepo,
+		streakService:  streakService,
+		vacationClient: vacationClient,
+		publicHolidays: holidayClient,
+		notifier:       notifier,
+		timeSource:     timeSource,
+		remindAfter:    remindAfter,
+	}
+}
+
+func (d *DefaultReminderService) SendRatingReminders(ctx context.Context) (int, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	now := d.timeSource.Now()
+	today := domain.NewDayPrecisionTime(now)
+
+	if now.Sub(today.Time) < d.remindAfter || d.lastSent.Equal(today.Time) {
+		return 0, nil
+	}
+
+	//no dishes are served on weekends and public holidays, and missing them does not break streaks
+	isDayOff, err := d.isHolidayOrWeekend(ctx, today)
+	if err != nil {
+		return 0, err
+	}
+	if isDayOff {
+		return 0, nil
+	}
+	//thus, a streak that ended on the workday before the days off is still ongoing
+	isHolidayOrWeekend := make(map[domain.DayPrecisionTime]bool)
+	for day, i := today.PrevDay(), 0; i < maxDaysOff; day, i = day.PrevDay(), i+1 {
+		isDayOff, err := d.isHolidayOrWeekend(ctx, day)
+		if err != nil {
+			return 0, err
+		}
+		if !isDayOff {
+			break
+		}
+		isHolidayOrWeekend[day] = true
+	}
+
+	//make sure that streaks are up-to-date. Skip this step if it takes to long
+	//(vacation backend queried by statistics service is known to be unreliable)
+	updateCtx, updateCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer updateCancel()
+	if err := d.streakService.UpdateRatingStreaks(updateCtx); err != nil {
+		if !errors.Is(err, context.DeadlineExceeded) {
+			return 0, fmt.Errorf("failed to update rating streaks : %w", err)
+		}
+		logging.FromContext(ctx).Error("failed to update rating streaks before sending reminders", "err", err)
+	}
+
+	userStreaks, err := d.streakService.GetMostRecentUserStreaks(ctx, false)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch user streaks : %w", err)
+	}
+	streaks := make(map[string]domain.RatingStreak, len(userStreaks))
+	for _, v := range userStreaks {
+		streaks[v.User.Email] = v.Streak
+	}
+
+	ratingsToday, err := d.statsRepo.GetAllRatingsForDate(ctx, today)
+	if err != nil {
+		if !errors.Is(err, domain.ErrNotFound) {
+			return 0, fmt.Errorf("failed to fetch today's ratings : %w", err)
+		}
+		ratingsToday = make([]domain.DishRating, 0)
+	}
+
+	vacations, err := d.vacationClient.Vacations(ctx, today)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get today's vacations : %w", err)
+	}
+
+	reminders := domain.NewRatingReminders(today, streaks, ratingsToday, vacations, isHolidayOrWeekend)
+	d.lastSent = today
+
+	//failing to notify a single user should not prevent notifying the others
+	sent := 0
+	var errs []error
+	for _, v := range reminders {
+		if err := d.notifier.SendRatingReminder(ctx, v); err != nil {
+			errs = append(errs, fmt.Errorf("failed to remind %v : %w", v.User.Email, err))
+			continue
+		}
+		sent += 1
+	}
+
+	return sent, errors.Join(errs...)
+}
+
+// isHolidayOrWeekend returns true if day is a Saturday, a Sunday or a public holiday
+func (d *DefaultReminderService) isHolidayOrWeekend(ctx context.Context, day domain.DayPrecisionTime) (bool, error) {
+	if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		return true, nil
+	}
+	isHoliday, err := d.publicHolidays.IsPublicHoliday(ctx, day.Time)
+	if err != nil {
+		return false, fmt.Errorf("failed to check if %v is public holiday : %w", day.Time, err)
+	}
+	return isHoliday, nil
+}
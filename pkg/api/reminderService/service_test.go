@@ -0,0 +1,242 @@
+package reminderService
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"itsTasty/pkg/api/adapters/notifier"
+	"itsTasty/pkg/api/adapters/publicHoliday"
+	"itsTasty/pkg/api/adapters/vacation"
+	"itsTasty/pkg/api/domain"
+	"itsTasty/pkg/api/statisticsService"
+	"testing"
+	"time"
+)
+
+//
+// Mocks
+//
+
+type mockStatsRepo struct {
+	ratingsByDate map[domain.DayPrecisionTime][]domain.DishRating
+}
+
+func (m mockStatsRepo) GetAllUsers(_ context.Context) ([]domain.User, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m mockStatsRepo) GetAllRatingsForDate(_ context.Context, date domain.DayPrecisionTime) ([]domain.DishRating, error) {
+	data, ok := m.ratingsByDate[date]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return data, nil
+}
+
+func (m mockStatsRepo) GetAllRatingsOfUser(_ context.Context, _ string) ([]domain.RatingOfDish, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m mockStatsRepo) GetRatingSummaryPerUser(_ context.Context) ([]domain.UserRatingSummary, error) {
+	return nil, errors.New("not implemented")
+}
+
+type mockStreakService struct {
+	userStreaks   []statisticsService.UserWithStreak
+	updateCounter int
+}
+
+func (m *mockStreakService) UpdateRatingStreaks(_ context.Context) error {
+	m.updateCounter += 1
+	return nil
+}
+
+func (m *mockStreakService) GetMostRecentAllUsersGroupStreak(_ context.Context, _ bool) (*domain.RatingStreak, error) {
+	return nil, domain.ErrNotFound
+}
+
+func (m *mockStreakService) GetMostRecentUserStreaks(_ context.Context, _ bool) ([]statisticsService.UserWithStreak, error) {
+	return m.userStreaks, nil
+}
+
+func (m *mockStreakService) GetLongestStreaks(_ context.Context) ([]statisticsService.UserWithStreak, *domain.RatingStreak, error) {
+	return nil, nil, errors.New("not implemented")
+}
+
+type failingNotifier struct {
+	failFor map[string]interface{}
+	inner   *notifier.FakeNotifier
+}
+
+func (f failingNotifier) SendRatingReminder(ctx context.Context, reminder domain.RatingReminder) error {
+	if _, ok := f.failFor[reminder.User.Email]; ok {
+		return errors.New("mail server unavailable")
+	}
+	return f.inner.SendRatingReminder(ctx, reminder)
+}
+
+type mockTimeSource struct {
+	CurrentTime time.Time
+}
+
+func (m *mockTimeSource) Now() time.Time {
+	return m.CurrentTime
+}
+
+//
+// Test Functions
+//
+
+func TestDefaultReminderService_SendRatingReminders(t *testing.T) {
+	//Wednesday
+	timeSource := &mockTimeSource{CurrentTime: time.Date(2023, time.February, 1, 11, 0, 0, 0, time.Local)}
+	today := domain.NewDayPrecisionTime(timeSource.Now())
+	yesterday := today.PrevDay()
+
+	ongoing := domain.RatingStreak{Begin: yesterday.PrevDay(), End: yesterday}
+	streakService := &mockStreakService{userStreaks: []statisticsService.UserWithStreak{
+		{User: domain.User{Email: "remind@test"}, Streak: ongoing},
+		{User: domain.User{Email: "rated@test"}, Streak: ongoing},
+		{User: domain.User{Email: "vacation@test"}, Streak: ongoing},
+		{User: domain.User{Email: "broken@test"}, Streak: domain.RatingStreak{Begin: yesterday.PrevDay(), End: yesterday.PrevDay()}},
+	}}
+	statsRepo := mockStatsRepo{ratingsByDate: map[domain.DayPrecisionTime][]domain.DishRating{
+		today: {{Who: "rated@test", Value: domain.FourStars, RatingWhen: timeSource.Now()}},
+	}}
+	vacationClient := vacation.NewMockVacationClient(map[domain.DayPrecisionTime]map[string]interface{}{
+		today: {"vacation@test": nil},
+	})
+	holidayClient, err := publicHoliday.NewDefaultRegionHolidayChecker("Schleswig-Holstein")
+	require.NoError(t, err)
+	fakeNotifier := notifier.NewFakeNotifier()
+
+	service := NewDefaultReminderService(statsRepo, streakService, vacationClient, holidayClient, fakeNotifier, timeSource, 10*time.Hour)
+
+	sent, err := service.SendRatingReminders(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, sent)
+	require.Equal(t, 1, streakService.updateCounter)
+	require.Equal(t, []domain.RatingReminder{
+		{User: domain.User{Email: "remind@test"}, Streak: ongoing, Date: today},
+	}, fakeNotifier.Reminders())
+
+	//reminders are only sent once per day
+	timeSource.CurrentTime = timeSource.CurrentTime.Add(time.Hour)
+	sent, err = service.SendRatingReminders(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 0, sent)
+	require.Len(t, fakeNotifier.Reminders(), 1)
+}
+
+func TestDefaultReminderService_SendRatingReminders_NotBeforeRemindAfter(t *testing.T) {
+	timeSource := &mockTimeSource{CurrentTime: time.Date(2023, time.February, 1, 9, 59, 0, 0, time.Local)}
+	yesterday := domain.NewDayPrecisionTime(timeSource.Now()).PrevDay()
+	streakService := &mockStreakService{userStreaks: []statisticsService.UserWithStreak{
+		{User: domain.User{Email: "remind@test"}, Streak: domain.RatingStreak{Begin: yesterday, End: yesterday}},
+	}}
+	holidayClient, err := publicHoliday.NewDefaultRegionHolidayChecker("Schleswig-Holstein")
+	require.NoError(t, err)
+	fakeNotifier := notifier.NewFakeNotifier()
+
+	service := NewDefaultReminderService(mockStatsRepo{}, streakService, vacation.NewEmptyVacationClient(), holidayClient,
+		fakeNotifier, timeSource, 10*time.Hour)
+
+	sent, err := service.SendRatingReminders(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 0, sent)
+
+	timeSource.CurrentTime = timeSource.CurrentTime.Add(time.Minute)
+	sent, err = service.SendRatingReminders(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, sent)
+}
+
+func TestDefaultReminderService_SendRatingReminders_SkipWeekendAndHoliday(t *testing.T) {
+	streakService := &mockStreakService{}
+	statsRepo := mockStatsRepo{}
+	vacationClient := vacation.NewEmptyVacationClient()
+	holidayClient, err := publicHoliday.NewDefaultRegionHolidayChecker("Schleswig-Holstein")
+	require.NoError(t, err)
+	fakeNotifier := notifier.NewFakeNotifier()
+
+	for _, day := range []time.Time{
+		//Saturday
+		time.Date(2023, time.February, 4, 11, 0, 0, 0, time.Local),
+		//Christmas
+		time.Date(2023, time.December, 25, 11, 0, 0, 0, time.Local),
+	} {
+		timeSource := &mockTimeSource{CurrentTime: day}
+		service := NewDefaultReminderService(statsRepo, streakService, vacationClient, holidayClient, fakeNotifier, timeSource, 10*time.Hour)
+		sent, err := service.SendRatingReminders(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 0, sent)
+	}
+	require.Equal(t, 0, streakService.updateCounter)
+	require.Empty(t, fakeNotifier.Reminders())
+}
+
+func TestDefaultReminderService_SendRatingReminders_AfterDaysOff(t *testing.T) {
+	holidayClient, err := publicHoliday.NewDefaultRegionHolidayChecker("Schleswig-Holstein")
+	require.NoError(t, err)
+
+	for name, tt := range map[string]struct {
+		now        time.Time
+		lastRating time.Time
+	}{
+		"Friday to Monday": {
+			now:        time.Date(2023, time.February, 6, 11, 0, 0, 0, time.Local),
+			lastRating: time.Date(2023, time.February, 3, 0, 0, 0, 0, time.Local),
+		},
+		"Thursday before Easter to Tuesday": {
+			now:        time.Date(2023, time.April, 11, 11, 0, 0, 0, time.Local),
+			lastRating: time.Date(2023, time.April, 6, 0, 0, 0, 0, time.Local),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			timeSource := &mockTimeSource{CurrentTime: tt.now}
+			lastRating := domain.NewDayPrecisionTime(tt.lastRating)
+			ongoing := domain.RatingStreak{Begin: lastRating.PrevDay(), End: lastRating}
+			broken := domain.RatingStreak{Begin: lastRating.PrevDay(), End: lastRating.PrevDay()}
+			streakService := &mockStreakService{userStreaks: []statisticsService.UserWithStreak{
+				{User: domain.User{Email: "remind@test"}, Streak: ongoing},
+				{User: domain.User{Email: "broken@test"}, Streak: broken},
+			}}
+			fakeNotifier := notifier.NewFakeNotifier()
+
+			service := NewDefaultReminderService(mockStatsRepo{}, streakService, vacation.NewEmptyVacationClient(),
+				holidayClient, fakeNotifier, timeSource, 10*time.Hour)
+
+			sent, err := service.SendRatingReminders(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, 1, sent)
+			require.Equal(t, []domain.RatingReminder{
+				{User: domain.User{Email: "remind@test"}, Streak: ongoing, Date: domain.NewDayPrecisionTime(tt.now)},
+			}, fakeNotifier.Reminders())
+		})
+	}
+}
+
+func TestDefaultReminderService_SendRatingReminders_NotifierFails(t *testing.T) {
+	timeSource := &mockTimeSource{CurrentTime: time.Date(2023, time.February, 1, 11, 0, 0, 0, time.Local)}
+	yesterday := domain.NewDayPrecisionTime(timeSource.Now()).PrevDay()
+	ongoing := domain.RatingStreak{Begin: yesterday, End: yesterday}
+
+	streakService := &mockStreakService{userStreaks: []statisticsService.UserWithStreak{
+		{User: domain.User{Email: "a@test"}, Streak: ongoing},
+		{User: domain.User{Email: "b@test"}, Streak: ongoing},
+	}}
+	holidayClient, err := publicHoliday.NewDefaultRegionHolidayChecker("Schleswig-Holstein")
+	require.NoError(t, err)
+	fakeNotifier := notifier.NewFakeNotifier()
+	failing := failingNotifier{failFor: map[string]interface{}{"a@test": nil}, inner: fakeNotifier}
+
+	service := NewDefaultReminderService(mockStatsRepo{}, streakService, vacation.NewEmptyVacationClient(), holidayClient,
+		failing, timeSource, 10*time.Hour)
+
+	//the failure for a@test must not prevent the reminder for b@test
+	sent, err := service.SendRatingReminders(context.Background())
+	require.Error(t, err)
+	require.Equal(t, 1, sent)
+	require.Len(t, fakeNotifier.Reminders(), 1)
+	require.Equal(t, "b@test", fakeNotifier.Reminders()[0].User.Email)
+}
@@ -0,0 +1,244 @@
+package sessionService
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/gob"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"itsTasty/pkg/api/domain"
+	"itsTasty/pkg/logging"
+	"itsTasty/pkg/oidcAuth"
+	"net"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/alexedwards/scs/v2"
+)
+
+// keys under which the tracking data is stored in the session
+const (
+	sessionKeyID         = "sessionID"
+	sessionKeyCreatedAt  = "sessionCreatedAt"
+	sessionKeyLastSeenAt = "sessionLastSeenAt"
+	sessionKeyUserAgent  = "sessionUserAgent"
+	sessionKeyIPHash     = "sessionIPHash"
+)
+
+// lastSeenResolution limits how often the tracking data of a session is updated
+const lastSeenResolution = time.Minute
+
+const maxUserAgentLength = 200
+
+type TimeSource interface {
+	//Now returns the current local time.
+	Now() time.Time
+}
+
+type defaultTimeSource struct {
+}
+
+func (d defaultTimeSource) Now() time.Time {
+	return time.Now()
+}
+
+// Session is the tracking data of a login session
+type Session struct {
+	//ID identifies the session. Unlike the session token, it can be shown without allowing to take over the session
+	ID         string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	UserAgent  string
+	IPHash     string
+	//Current is true for the session of the request the data was queried with
+	Current bool
+}
+
+type SessionService interface {
+	//GetSessions returns the sessions of the user. Sessions that have not been tracked yet are omitted.
+	//The context must belong to a request with loaded session
+	GetSessions(ctx context.Context, userEmail string) ([]Session, error)
+	//RevokeSession ends the session of the user. The context must belong to a request with loaded session
+	//Marker errors: domain.ErrNotFound, also if the session belongs to another user
+	RevokeSession(ctx context.Context, userEmail, id string) error
+	//RevokeAllSessions ends all sessions of the user and returns their number. The context must belong to a
+	//request with loaded session
+	RevokeAllSessions(ctx context.Context, userEmail string) (int, error)
+	//RevokeProviderSessions ends the sessions the oidc provider logged out. Empty params match all sessions.
+	//Returns the number of ended sessions. The context must belong to a request with loaded session
+	RevokeProviderSessions(ctx context.Context, subject, providerSessionID string) (int, error)
+}
+
+type DefaultSessionService struct {
+	session *scs.SessionManager
+	//ipHashKey keeps the ip addresses from being recovered by hashing all possible addresses. As it is only kept
+	//in memory, the hashes change with each restart
+	ipHashKey  []byte
+	timeSource TimeSource
+}
+
+func NewDefaultSessionService(session *scs.SessionManager) (*DefaultSessionService, error) {
+	return NewDefaultSessionServiceCustom(session, defaultTimeSource{})
+}
+
+func NewDefaultSessionServiceCustom(session *scs.SessionManager, timeSource TimeSource) (*DefaultSessionService, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate ip hash key : %v", err)
+	}
+	gob.Register(time.Time{})
+	return &DefaultSessionService{
+		session:    session,
+		ipHashKey:  key,
+		timeSource: timeSource,
+	}, nil
+}
+
+// Track records the tracking data of the session before passing the request to next. Only use it for requests
+// with authenticated session
+func (d *DefaultSessionService) Track(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		now := d.timeSource.Now()
+
+		if d.session.GetString(ctx, sessionKeyID) == "" {
+			id := make([]byte, 16)
+			if _, err := rand.Read(id); err != nil {
+				logging.FromContext(ctx).Error("failed to generate session id", "err", err)
+				http.Error(w, "", http.StatusInternalServerError)
+				return
+			}
+			d.session.Put(ctx, sessionKeyID, base64.RawURLEncoding.EncodeToString(id))
+			d.session.Put(ctx, sessionKeyCreatedAt, now)
+		}
+
+		userAgent := r.UserAgent()
+		if len(userAgent) > maxUserAgentLength {
+			userAgent = userAgent[:maxUserAgentLength]
+		}
+		ipHash := d.hashIP(r.RemoteAddr)
+		if now.Sub(d.session.GetTime(ctx, sessionKeyLastSeenAt)) >= lastSeenResolution ||
+			d.session.GetString(ctx, sessionKeyUserAgent) != userAgent || d.session.GetString(ctx, sessionKeyIPHash) != ipHash {
+			d.session.Put(ctx, sessionKeyLastSeenAt, now)
+			d.session.Put(ctx, sessionKeyUserAgent, userAgent)
+			d.session.Put(ctx, sessionKeyIPHash, ipHash)
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (d *DefaultSessionService) hashIP(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	mac := hmac.New(sha256.New, d.ipHashKey)
+	mac.Write([]byte(host))
+	return hex.EncodeToString(mac.Sum(nil))[:16]
+}
+
+// forEachSession calls fn with the context of each stored session and the profile of its user. Sessions without
+// login are skipped. For the session of the request, fn gets ctx instead, as it has the latest data and ending
+// the session with another context would not keep it from being stored again at the end of the request
+func (d *DefaultSessionService) forEachSession(ctx context.Context,
+	fn func(sessionCtx context.Context, profile oidcAuth.UserProfile, current bool) error) error {
+	currentToken := d.session.Token(ctx)
+	return d.session.Iterate(ctx, func(sessionCtx context.Context) error {
+		current := currentToken != "" && d.session.Token(sessionCtx) == currentToken
+		if current {
+			sessionCtx = ctx
+		}
+		raw := d.session.GetString(sessionCtx, oidcAuth.SessionKeyProfile)
+		if raw == "" {
+			return nil
+		}
+		profile := oidcAuth.UserProfile{}
+		if err := json.Unmarshal([]byte(raw), &profile); err != nil {
+			return fmt.Errorf("failed to unmarshal user profile : %v", err)
+		}
+		return fn(sessionCtx, profile, current)
+	})
+}
+
+func (d *DefaultSessionService) GetSessions(ctx context.Context, userEmail string) ([]Session, error) {
+	sessions := make([]Session, 0)
+	err := d.forEachSession(ctx, func(sessionCtx context.Context, profile oidcAuth.UserProfile, current bool) error {
+		id := d.session.GetString(sessionCtx, sessionKeyID)
+		if profile.Email != userEmail || id == "" {
+			return nil
+		}
+		sessions = append(sessions, Session{
+			ID:         id,
+			CreatedAt:  d.session.GetTime(sessionCtx, sessionKeyCreatedAt),
+			LastSeenAt: d.session.GetTime(sessionCtx, sessionKeyLastSeenAt),
+			UserAgent:  d.session.GetString(sessionCtx, sessionKeyUserAgent),
+			IPHash:     d.session.GetString(sessionCtx, sessionKeyIPHash),
+			Current:    current,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate sessions : %v", err)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		a, b := sessions[i], sessions[j]
+		return a.LastSeenAt.After(b.LastSeenAt) || a.LastSeenAt.Equal(b.LastSeenAt) && a.ID < b.ID
+	})
+	return sessions, nil
+}
+
+func (d *DefaultSessionService) RevokeSession(ctx context.Context, userEmail, id string) error {
+	found := false
+	err := d.forEachSession(ctx, func(sessionCtx context.Context, profile oidcAuth.UserProfile, _ bool) error {
+		if found || profile.Email != userEmail || d.session.GetString(sessionCtx, sessionKeyID) != id {
+			return nil
+		}
+		found = true
+		return d.session.Destroy(sessionCtx)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke session : %v", err)
+	}
+	if !found {
+		return fmt.Errorf("no session with id %v : %w", id, domain.ErrNotFound)
+	}
+	return nil
+}
+
+func (d *DefaultSessionService) RevokeAllSessions(ctx context.Context, userEmail string) (int, error) {
+	return d.revokeMatching(ctx, func(profile oidcAuth.UserProfile) bool {
+		return profile.Email == userEmail
+	})
+}
+
+func (d *DefaultSessionService) RevokeProviderSessions(ctx context.Context, subject, providerSessionID string) (int, error) {
+	return d.revokeMatching(ctx, func(profile oidcAuth.UserProfile) bool {
+		return (subject == "" || profile.Subject == subject) &&
+			(providerSessionID == "" || profile.ProviderSessionID == providerSessionID)
+	})
+}
+
+func (d *DefaultSessionService) revokeMatching(ctx context.Context, match func(profile oidcAuth.UserProfile) bool) (int, error) {
+	revoked := 0
+	err := d.forEachSession(ctx, func(sessionCtx context.Context, profile oidcAuth.UserProfile, _ bool) error {
+		if !match(profile) {
+			return nil
+		}
+		if err := d.session.Destroy(sessionCtx); err != nil {
+			return fmt.Errorf("failed to destroy session : %v", err)
+		}
+		revoked += 1
+		return nil
+	})
+	if err != nil {
+		return revoked, fmt.Errorf("failed to revoke sessions : %v", err)
+	}
+	return revoked, nil
+}
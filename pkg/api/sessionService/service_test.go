@@ -0,0 +1,88 @@
+package sessionService
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"itsTasty/pkg/api/domain"
+	"itsTasty/pkg/oidcAuth"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alexedwards/scs/v2"
+	"github.com/stretchr/testify/require"
+)
+
+type mockTimeSource struct {
+	CurrentTime time.Time
+}
+
+func (m *mockTimeSource) Now() time.Time {
+	return m.CurrentTime
+}
+
+// login stores a tracked session for the user and returns its token
+func login(t *testing.T, session *scs.SessionManager, service *DefaultSessionService, profile oidcAuth.UserProfile) string {
+	ctx, err := session.Load(context.Background(), "")
+	require.NoError(t, err)
+	raw, err := json.Marshal(profile)
+	require.NoError(t, err)
+	session.Put(ctx, oidcAuth.SessionKeyProfile, string(raw))
+
+	r := httptest.NewRequest(http.MethodGet, "/users/me", nil).WithContext(ctx)
+	service.Track(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})).ServeHTTP(httptest.NewRecorder(), r)
+
+	token, _, err := session.Commit(ctx)
+	require.NoError(t, err)
+	return token
+}
+
+func TestDefaultSessionService(t *testing.T) {
+	session := scs.New()
+	mockTime := &mockTimeSource{CurrentTime: time.Now()}
+	service, err := NewDefaultSessionServiceCustom(session, mockTime)
+	require.NoError(t, err)
+
+	user1 := oidcAuth.UserProfile{Email: "user1@test.mail", Subject: "user1", ProviderSessionID: "sid1"}
+	laptopToken := login(t, session, service, user1)
+	mockTime.CurrentTime = mockTime.CurrentTime.Add(time.Hour)
+	login(t, session, service, oidcAuth.UserProfile{Email: "user1@test.mail", Subject: "user1", ProviderSessionID: "sid2"})
+	login(t, session, service, oidcAuth.UserProfile{Email: "user2@test.mail", Subject: "user2"})
+
+	laptopCtx, err := session.Load(context.Background(), laptopToken)
+	require.NoError(t, err)
+	//requests without session cookie get a new session
+	anonymousCtx, err := session.Load(context.Background(), "")
+	require.NoError(t, err)
+	sessions, err := service.GetSessions(laptopCtx, "user1@test.mail")
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	//most recently used first
+	require.False(t, sessions[0].Current)
+	require.True(t, sessions[1].Current)
+	require.True(t, mockTime.CurrentTime.Equal(sessions[0].LastSeenAt))
+	require.Len(t, sessions[0].IPHash, 16)
+
+	//users cannot revoke sessions of others
+	require.True(t, errors.Is(service.RevokeSession(laptopCtx, "user2@test.mail", sessions[0].ID), domain.ErrNotFound))
+
+	//the provider ended the session sid2
+	revoked, err := service.RevokeProviderSessions(anonymousCtx, "user1", "sid2")
+	require.NoError(t, err)
+	require.Equal(t, 1, revoked)
+	sessions, err = service.GetSessions(laptopCtx, "user1@test.mail")
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.True(t, sessions[0].Current)
+
+	revoked, err = service.RevokeAllSessions(laptopCtx, "user1@test.mail")
+	require.NoError(t, err)
+	require.Equal(t, 1, revoked)
+	require.Equal(t, scs.Destroyed, session.Status(laptopCtx))
+	sessions, err = service.GetSessions(anonymousCtx, "user2@test.mail")
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.False(t, sessions[0].Current)
+}
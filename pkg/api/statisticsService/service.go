@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"itsTasty/pkg/api/domain"
+	"itsTasty/pkg/logging"
 	"time"
 
 	"github.com/friendsofgo/errors"
@@ -30,16 +31,20 @@ type DefaultStreakService struct {
 	vacationClient     domain.VacationDataSource
 	publicHolidays     domain.PublicHolidayDataSource
 	timeSource         TimeSource
+	//events is notified once a streak reaches a milestone
+	events domain.EventPublisher
 }
 
 func NewDefaultStreakService(statsRepo domain.StatisticsRepo, vacationStreakRepo domain.RatingStreakRepo,
-	vacationClient domain.VacationDataSource, holidayClient domain.PublicHolidayDataSource, timeSource TimeSource) *DefaultStreakService {
+	vacationClient domain.VacationDataSource, holidayClient domain.PublicHolidayDataSource, timeSource TimeSource,
+	events domain.EventPublisher) *DefaultStreakService {
 	return &DefaultStreakService{
 		statsRepo:          statsRepo,
 		vacationStreakRepo: vacationStreakRepo,
 		vacationClient:     vacationClient,
 		publicHolidays:     holidayClient,
 		timeSource:         timeSource,
+		events:             events,
 	}
 }
 
@@ -130,6 +135,8 @@ func (d *DefaultStreakService) updateRatingStreak(ctx context.Context, streakDat
 	}
 
 	createNewStreak := false
+	//extendedStreak is set if the previous streak got extended to today
+	var extendedStreak *domain.RatingStreak
 	err = d.vacationStreakRepo.UpdateMostRecentRatingStreak(ctx, streakName, func(current domain.RatingStreak) (*domain.RatingStreak, error) {
 
 		//if newStreak is equal to current streak do nothing
@@ -147,6 +154,8 @@ func (d *DefaultStreakService) updateRatingStreak(ctx context.Context, streakDat
 
 		if current.End.Equal(newStreak.Begin.PrevDay().Time) {
 			current.End = newStreak.End
+			extended := current
+			extendedStreak = &extended
 		}
 
 		return &current, nil
@@ -164,6 +173,15 @@ func (d *DefaultStreakService) updateRatingStreak(ctx context.Context, streakDat
 		if _, err := d.vacationStreakRepo.CreateRatingStreak(ctx, streakName, newStreak); err != nil {
 			return fmt.Errorf("failed to create new streak for \"%v\" : %w", streakName, err)
 		}
+		extendedStreak = &newStreak
+	}
+
+	if extendedStreak != nil && domain.IsStreakMilestone(extendedStreak.LengthInDays()) {
+		event := domain.NewStreakMilestoneEvent(d.timeSource.Now(), streakName, *extendedStreak)
+		if err := d.events.Publish(ctx, event); err != nil {
+			//streak has already been stored, don't fail because of the notification
+			logging.FromContext(ctx).Error("failed to publish streak milestone", "streak", streakName, "err", err)
+		}
 	}
 
 	return nil
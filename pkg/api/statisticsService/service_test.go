@@ -18,6 +18,7 @@ import (
 type mockStatsRepo struct {
 	users         []domain.User
 	ratingsByDate map[domain.DayPrecisionTime][]domain.DishRating
+	ratingsByUser map[string][]domain.RatingOfDish
 }
 
 func (m mockStatsRepo) GetAllRatingsForDate(_ context.Context, date domain.DayPrecisionTime) ([]domain.DishRating, error) {
@@ -32,6 +33,26 @@ func (m mockStatsRepo) GetAllUsers(ctx context.Context) ([]domain.User, error) {
 	return m.users, nil
 }
 
+func (m mockStatsRepo) GetAllRatingsOfUser(_ context.Context, userEmail string) ([]domain.RatingOfDish, error) {
+	return m.ratingsByUser[userEmail], nil
+}
+
+func (m mockStatsRepo) GetRatingSummaryPerUser(_ context.Context) ([]domain.UserRatingSummary, error) {
+	result := make([]domain.UserRatingSummary, 0)
+	for email, ratings := range m.ratingsByUser {
+		if len(ratings) == 0 {
+			continue
+		}
+		summary := domain.UserRatingSummary{User: domain.User{Email: email}}
+		for _, v := range ratings {
+			summary.RatingCount += 1
+			summary.RatingSum += int(v.Rating.Value)
+		}
+		result = append(result, summary)
+	}
+	return result, nil
+}
+
 type mockRatingStreakRepo struct {
 	streaks map[string][]domain.RatingStreak
 }
@@ -39,8 +60,21 @@ type mockRatingStreakRepo struct {
 func (m mockRatingStreakRepo) GetLongestIndividualStreak(ctx context.Context) ([]string, domain.RatingStreak, error) {
 	panic("implemente me")
 }
+func (m mockRatingStreakRepo) GetAllStreaks(ctx context.Context) (map[string][]domain.RatingStreak, error) {
+	return m.streaks, nil
+}
 func (m mockRatingStreakRepo) GetLongestStreak(ctx context.Context, name string) (domain.RatingStreak, int, error) {
-	panic("implement me")
+	s, ok := m.streaks[name]
+	if !ok || len(s) == 0 {
+		return domain.RatingStreak{}, 0, domain.ErrNotFound
+	}
+	longest := s[0]
+	for _, v := range s[1:] {
+		if v.LengthInDays() > longest.LengthInDays() {
+			longest = v
+		}
+	}
+	return longest, 1, nil
 }
 func (m mockRatingStreakRepo) UpdateMostRecentRatingStreak(ctx context.Context, name string, updateFN domain.StreakUpdateFN) error {
 	current, _, err := m.GetMostRecentStreak(ctx, name)
@@ -94,6 +128,15 @@ func (m *mockTimeSource) AdvanceBy(d time.Duration) {
 }
 
 // MustNewMockTimeSource. date format "DD-MM-YYYY"
+type recordingEventPublisher struct {
+	events []domain.Event
+}
+
+func (r *recordingEventPublisher) Publish(_ context.Context, event domain.Event) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
 func mustNewMockTimeSource(startDate string) *mockTimeSource {
 	t, err := time.ParseInLocation("02-01-2006", startDate, time.Local)
 	if err != nil {
@@ -130,7 +173,7 @@ func TestDefaultStreakService_GetMostRecentUserStreaks_NoUsers(t *testing.T) {
 	//test
 	//
 
-	service := NewDefaultStreakService(statsRepo, streakRepo, vacationClint, holidayClient, timeSource)
+	service := NewDefaultStreakService(statsRepo, streakRepo, vacationClint, holidayClient, timeSource, domain.NoopEventPublisher{})
 
 	ctx := context.Background()
 	err = service.UpdateRatingStreaks(ctx)
@@ -177,7 +220,7 @@ func TestDefaultStreakService_GetMostRecentUserStreaks_NoPrevStreak(t *testing.T
 	//test
 	//
 
-	service := NewDefaultStreakService(statsRepo, streakRepo, vacationClient, holidayClient, timeSource)
+	service := NewDefaultStreakService(statsRepo, streakRepo, vacationClient, holidayClient, timeSource, domain.NoopEventPublisher{})
 
 	ctx := context.Background()
 	err = service.UpdateRatingStreaks(ctx)
@@ -252,7 +295,7 @@ func TestDefaultStreakService_GetMostRecentUserStreaks_PrevStreak_Can_Extend(t *
 	//test
 	//
 
-	service := NewDefaultStreakService(statsRepo, streakRepo, vacationClient, holidayClient, timeSource)
+	service := NewDefaultStreakService(statsRepo, streakRepo, vacationClient, holidayClient, timeSource, domain.NoopEventPublisher{})
 
 	ctx := context.Background()
 	err = service.UpdateRatingStreaks(ctx)
@@ -328,7 +371,7 @@ func TestDefaultStreakService_GetMostRecentUserStreaks_PrevStreak_Cannot_Extend(
 	//test
 	//
 
-	service := NewDefaultStreakService(statsRepo, streakRepo, vacationClient, holidayClient, timeSource)
+	service := NewDefaultStreakService(statsRepo, streakRepo, vacationClient, holidayClient, timeSource, domain.NoopEventPublisher{})
 
 	ctx := context.Background()
 	err = service.UpdateRatingStreaks(ctx)
@@ -374,3 +417,71 @@ func TestDefaultStreakService_GetMostRecentUserStreaks_PrevStreak_Cannot_Extend(
 	require.NoError(t, err)
 	require.Equal(t, wantAllUsersStreak, *allUsersStreak)
 }
+
+func TestDefaultStreakService_UpdateRatingStreaks_PublishesMilestone(t *testing.T) {
+
+	//
+	//setup env
+	//
+	timeSource := mustNewMockTimeSource("01-02-2023")
+
+	user1 := domain.User{Email: "user1@test.user"}
+	today := domain.NewDayPrecisionTime(timeSource.Now())
+	yesterday := today.PrevDay()
+	fourDaysAgo := yesterday.PrevDay().PrevDay().PrevDay()
+
+	user1Rating1 := domain.DishRating{
+		Who:        user1.Email,
+		Value:      domain.ThreeStars,
+		RatingWhen: timeSource.Now(),
+	}
+
+	statsRepo := mockStatsRepo{
+		users:         []domain.User{user1},
+		ratingsByDate: map[domain.DayPrecisionTime][]domain.DishRating{today: {user1Rating1}},
+	}
+
+	//extending this streak to today results in a streak of length 5
+	streakRepo := mockRatingStreakRepo{streaks: map[string][]domain.RatingStreak{
+		user1.Email: {
+			{
+				Begin: fourDaysAgo,
+				End:   yesterday,
+			},
+		},
+	}}
+
+	vacationClient := vacation.NewEmptyVacationClient()
+
+	holidayClient, err := publicHoliday.NewDefaultRegionHolidayChecker("Schleswig-Holstein")
+	require.NoError(t, err)
+
+	events := &recordingEventPublisher{}
+
+	//
+	//test
+	//
+
+	service := NewDefaultStreakService(statsRepo, streakRepo, vacationClient, holidayClient, timeSource, events)
+
+	ctx := context.Background()
+	err = service.UpdateRatingStreaks(ctx)
+	require.NoError(t, err)
+
+	//the new "all users" streak has length 1 and thus is no milestone
+	require.Len(t, events.events, 1)
+	require.Equal(t, domain.EventStreakMilestone, events.events[0].Type)
+	wantData := domain.StreakMilestoneEventData{
+		Name: user1.Email,
+		Streak: domain.RatingStreak{
+			Begin: fourDaysAgo,
+			End:   today,
+		},
+	}
+	require.Equal(t, wantData, events.events[0].Data)
+
+	//running the update again on the same day must not publish the milestone again
+	err = service.UpdateRatingStreaks(ctx)
+	require.NoError(t, err)
+	require.Len(t, events.events, 1)
+}
@@ -0,0 +1,112 @@
+package statisticsService
+
+import (
+	"context"
+	"fmt"
+	"itsTasty/pkg/api/domain"
+	"itsTasty/pkg/logging"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/sourcegraph/conc/pool"
+)
+
+// UserStatistics extends domain.UserStatistics with the rating streaks of the user
+type UserStatistics struct {
+	domain.UserStatistics
+	//CurrentStreak is nil if the user does not have an ongoing streak
+	CurrentStreak *domain.RatingStreak
+	//LongestStreak is nil if the user never had a streak
+	LongestStreak *domain.RatingStreak
+}
+
+type UserStatisticsService interface {
+	GetUserStatistics(ctx context.Context, userEmail string) (UserStatistics, error)
+}
+
+type DefaultUserStatisticsService struct {
+	statsRepo     domain.StatisticsRepo
+	streakRepo    domain.RatingStreakRepo
+	streakService StreakService
+}
+
+func NewDefaultUserStatisticsService(statsRepo domain.StatisticsRepo, streakRepo domain.RatingStreakRepo,
+	streakService StreakService) *DefaultUserStatisticsService {
+	return &DefaultUserStatisticsService{
+		statsRepo:     statsRepo,
+		streakRepo:    streakRepo,
+		streakService: streakService,
+	}
+}
+
+// GetUserStatistics returns the personal rating statistics for the given user
+func (d *DefaultUserStatisticsService) GetUserStatistics(ctx context.Context, userEmail string) (UserStatistics, error) {
+	//try to update rating streaks before processing response. Skip this step if it takes to long
+	//(vacation backend queried by statistics service is known to be unreliable)
+	updateCtx, updateCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer updateCancel()
+	if err := d.streakService.UpdateRatingStreaks(updateCtx); err != nil {
+		logging.FromContext(ctx).Error("failed to update rating streaks", "err", err)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			return UserStatistics{}, fmt.Errorf("failed to update rating streaks : %w", err)
+		}
+	}
+
+	var ratings []domain.RatingOfDish
+	var summaries []domain.UserRatingSummary
+	result := UserStatistics{}
+
+	fetchPool := pool.New().WithContext(ctx).WithCancelOnError()
+
+	fetchPool.Go(func(ctx context.Context) error {
+		var err error
+		ratings, err = d.statsRepo.GetAllRatingsOfUser(ctx, userEmail)
+		if err != nil {
+			return fmt.Errorf("failed to fetch ratings of user : %w", err)
+		}
+		return nil
+	})
+
+	fetchPool.Go(func(ctx context.Context) error {
+		var err error
+		summaries, err = d.statsRepo.GetRatingSummaryPerUser(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to fetch rating summaries : %w", err)
+		}
+		return nil
+	})
+
+	fetchPool.Go(func(ctx context.Context) error {
+		usersWithStreak, err := d.streakService.GetMostRecentUserStreaks(ctx, true)
+		if err != nil {
+			return fmt.Errorf("failed to fetch ongoing user streaks : %w", err)
+		}
+		for _, v := range usersWithStreak {
+			if v.User.Email == userEmail {
+				streak := v.Streak
+				result.CurrentStreak = &streak
+				break
+			}
+		}
+		return nil
+	})
+
+	fetchPool.Go(func(ctx context.Context) error {
+		streak, _, err := d.streakRepo.GetLongestStreak(ctx, userEmail)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return nil
+			}
+			return fmt.Errorf("failed to fetch longest streak : %w", err)
+		}
+		result.LongestStreak = &streak
+		return nil
+	})
+
+	if err := fetchPool.Wait(); err != nil {
+		return UserStatistics{}, fmt.Errorf("failed to fetch data : %w", err)
+	}
+
+	result.UserStatistics = domain.NewUserStatistics(userEmail, ratings, summaries)
+	return result, nil
+}
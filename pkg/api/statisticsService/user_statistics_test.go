@@ -0,0 +1,99 @@
+package statisticsService
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"itsTasty/pkg/api/adapters/publicHoliday"
+	"itsTasty/pkg/api/adapters/vacation"
+	"itsTasty/pkg/api/domain"
+	"testing"
+)
+
+func TestDefaultUserStatisticsService_GetUserStatistics(t *testing.T) {
+
+	//
+	//setup env
+	//
+	timeSource := mustNewMockTimeSource("01-02-2023")
+
+	user1 := domain.User{Email: "user1@test.user"}
+	user2 := domain.User{Email: "user2@test.user"}
+	today := domain.NewDayPrecisionTime(timeSource.Now())
+	yesterday := today.PrevDay()
+
+	oldStreak := domain.RatingStreak{
+		Begin: domain.NewDayPrecisionTime(timeSource.Now().AddDate(0, 0, -20)),
+		End:   domain.NewDayPrecisionTime(timeSource.Now().AddDate(0, 0, -10)),
+	}
+
+	user1RatingToday := domain.NewDishRating(user1.Email, domain.FourStars, timeSource.Now())
+	user1RatingYesterday := domain.NewDishRating(user1.Email, domain.TwoStars, yesterday.Time)
+	user2RatingYesterday := domain.NewDishRating(user2.Email, domain.ThreeStars, yesterday.Time)
+
+	statsRepo := mockStatsRepo{
+		users:         []domain.User{user1, user2},
+		ratingsByDate: map[domain.DayPrecisionTime][]domain.DishRating{today: {user1RatingToday}},
+		ratingsByUser: map[string][]domain.RatingOfDish{
+			user1.Email: {
+				{DishID: 1, Rating: user1RatingToday},
+				{DishID: 2, Rating: user1RatingYesterday},
+			},
+			user2.Email: {
+				{DishID: 2, Rating: user2RatingYesterday},
+			},
+		},
+	}
+
+	streakRepo := mockRatingStreakRepo{streaks: map[string][]domain.RatingStreak{
+		user1.Email: {
+			oldStreak,
+			{
+				Begin: yesterday,
+				End:   yesterday,
+			},
+		},
+	}}
+
+	vacationClient := vacation.NewEmptyVacationClient()
+
+	holidayClient, err := publicHoliday.NewDefaultRegionHolidayChecker("Schleswig-Holstein")
+	require.NoError(t, err)
+
+	streakService := NewDefaultStreakService(statsRepo, streakRepo, vacationClient, holidayClient, timeSource, domain.NoopEventPublisher{})
+
+	//
+	//test
+	//
+
+	service := NewDefaultUserStatisticsService(statsRepo, streakRepo, streakService)
+
+	ctx := context.Background()
+	gotStats, err := service.GetUserStatistics(ctx, user1.Email)
+	require.NoError(t, err)
+
+	//ongoing streak has been extended to today by the rating from today
+	wantCurrentStreak := domain.RatingStreak{Begin: yesterday, End: today}
+	require.NotNil(t, gotStats.CurrentStreak)
+	require.Equal(t, wantCurrentStreak, *gotStats.CurrentStreak)
+	require.NotNil(t, gotStats.LongestStreak)
+	require.Equal(t, oldStreak, *gotStats.LongestStreak)
+
+	require.Equal(t, 2, gotStats.TotalRatings)
+	require.NotNil(t, gotStats.AverageGivenRating)
+	require.Equal(t, float32(3), *gotStats.AverageGivenRating)
+	require.NotNil(t, gotStats.TeamAverageRating)
+	require.Equal(t, float32(3), *gotStats.TeamAverageRating)
+	require.NotNil(t, gotStats.FavouriteDish)
+	require.Equal(t, int64(1), gotStats.FavouriteDish.DishID)
+	require.NotNil(t, gotStats.MostHatedDish)
+	require.Equal(t, int64(2), gotStats.MostHatedDish.DishID)
+	require.Equal(t, float64(100), gotStats.Percentile)
+
+	//user2 has no ongoing and no past streak
+	gotStats, err = service.GetUserStatistics(ctx, user2.Email)
+	require.NoError(t, err)
+	require.Nil(t, gotStats.CurrentStreak)
+	require.Nil(t, gotStats.LongestStreak)
+	require.Equal(t, 1, gotStats.TotalRatings)
+	require.Equal(t, float64(0), gotStats.Percentile)
+}
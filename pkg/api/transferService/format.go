@@ -0,0 +1,246 @@
+package transferService
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// CurrentVersion is the version of the snapshot format written by this package. Increment it on every
+// incompatible change and keep reading older versions if possible
+const CurrentVersion = 1
+
+var ErrUnsupportedVersion = errors.New("unsupported snapshot version")
+var ErrUnknownFormat = errors.New("unknown format")
+
+type Format string
+
+const (
+	//FormatJSON stores the whole Snapshot as a single JSON document
+	FormatJSON Format = "json"
+	//FormatNDJSON stores one record per line. The first line is the header with the version, followed by
+	//one line per entity. Suited for large databases, as it can be processed line by line
+	FormatNDJSON Format = "ndjson"
+)
+
+func ParseFormat(s string) (Format, error) {
+	switch Format(s) {
+	case FormatJSON, FormatNDJSON:
+		return Format(s), nil
+	default:
+		return "", fmt.Errorf("%w : %v", ErrUnknownFormat, s)
+	}
+}
+
+// Snapshot contains all data of a database. IDs are only meaningful within the snapshot and are used
+// to reference entities. They are mapped to the ids of the target database during import
+type Snapshot struct {
+	Version      int          `json:"version"`
+	ExportedAt   time.Time    `json:"exportedAt"`
+	Locations    []Location   `json:"locations"`
+	Dishes       []Dish       `json:"dishes"`
+	MergedDishes []MergedDish `json:"mergedDishes"`
+	Ratings      []Rating     `json:"ratings"`
+	Streaks      []Streak     `json:"streaks"`
+}
+
+type Location struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type Dish struct {
+	ID          int64       `json:"id"`
+	LocationID  int64       `json:"locationId"`
+	Name        string      `json:"name"`
+	Occurrences []time.Time `json:"occurrences"`
+	//Tags was added without a version bump, thus snapshots created before may lack it
+	Tags []string `json:"tags,omitempty"`
+}
+
+type MergedDish struct {
+	ID         int64   `json:"id"`
+	LocationID int64   `json:"locationId"`
+	Name       string  `json:"name"`
+	DishIDs    []int64 `json:"dishIds"`
+}
+
+type Rating struct {
+	DishID int64     `json:"dishId"`
+	User   string    `json:"user"`
+	Value  int       `json:"value"`
+	Date   time.Time `json:"date"`
+}
+
+// Streak is a rating streak of a user or of a user group
+type Streak struct {
+	Name  string    `json:"name"`
+	Begin time.Time `json:"begin"`
+	End   time.Time `json:"end"`
+}
+
+const (
+	recordHeader     = "header"
+	recordLocation   = "location"
+	recordDish       = "dish"
+	recordMergedDish = "mergedDish"
+	recordRating     = "rating"
+	recordStreak     = "streak"
+)
+
+// ndjsonRecord is a single line in FormatNDJSON
+type ndjsonRecord struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+type ndjsonHeader struct {
+	Version    int       `json:"version"`
+	ExportedAt time.Time `json:"exportedAt"`
+}
+
+// Write serializes the snapshot to w using the given format
+func Write(w io.Writer, snapshot *Snapshot, format Format) error {
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(snapshot); err != nil {
+			return fmt.Errorf("failed to encode snapshot : %v", err)
+		}
+		return nil
+	case FormatNDJSON:
+		return writeNDJSON(w, snapshot)
+	default:
+		return fmt.Errorf("%w : %v", ErrUnknownFormat, format)
+	}
+}
+
+func writeNDJSON(w io.Writer, snapshot *Snapshot) error {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	writeRecord := func(recordType string, data interface{}) error {
+		raw, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %v record : %v", recordType, err)
+		}
+		if err := enc.Encode(ndjsonRecord{Type: recordType, Data: raw}); err != nil {
+			return fmt.Errorf("failed to write %v record : %v", recordType, err)
+		}
+		return nil
+	}
+
+	if err := writeRecord(recordHeader, ndjsonHeader{Version: snapshot.Version, ExportedAt: snapshot.ExportedAt}); err != nil {
+		return err
+	}
+	for _, v := range snapshot.Locations {
+		if err := writeRecord(recordLocation, v); err != nil {
+			return err
+		}
+	}
+	for _, v := range snapshot.Dishes {
+		if err := writeRecord(recordDish, v); err != nil {
+			return err
+		}
+	}
+	for _, v := range snapshot.MergedDishes {
+		if err := writeRecord(recordMergedDish, v); err != nil {
+			return err
+		}
+	}
+	for _, v := range snapshot.Ratings {
+		if err := writeRecord(recordRating, v); err != nil {
+			return err
+		}
+	}
+	for _, v := range snapshot.Streaks {
+		if err := writeRecord(recordStreak, v); err != nil {
+			return err
+		}
+	}
+
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("failed to flush output : %v", err)
+	}
+	return nil
+}
+
+// Read parses a snapshot in the given format from r.
+// Marker errors: ErrUnsupportedVersion, ErrUnknownFormat
+func Read(r io.Reader, format Format) (*Snapshot, error) {
+	var snapshot *Snapshot
+	var err error
+	switch format {
+	case FormatJSON:
+		snapshot = &Snapshot{}
+		if err = json.NewDecoder(r).Decode(snapshot); err != nil {
+			return nil, fmt.Errorf("failed to decode snapshot : %v", err)
+		}
+	case FormatNDJSON:
+		if snapshot, err = readNDJSON(r); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%w : %v", ErrUnknownFormat, format)
+	}
+
+	if snapshot.Version < 1 || snapshot.Version > CurrentVersion {
+		return nil, fmt.Errorf("%w : got %v, supported up to %v", ErrUnsupportedVersion, snapshot.Version, CurrentVersion)
+	}
+	return snapshot, nil
+}
+
+func readNDJSON(r io.Reader) (*Snapshot, error) {
+	snapshot := &Snapshot{}
+	dec := json.NewDecoder(r)
+	for line := 1; ; line++ {
+		var record ndjsonRecord
+		if err := dec.Decode(&record); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to decode record %v : %v", line, err)
+		}
+		if line == 1 && record.Type != recordHeader {
+			return nil, fmt.Errorf("first record must be of type %v, got %v", recordHeader, record.Type)
+		}
+
+		var err error
+		switch record.Type {
+		case recordHeader:
+			var header ndjsonHeader
+			err = json.Unmarshal(record.Data, &header)
+			snapshot.Version = header.Version
+			snapshot.ExportedAt = header.ExportedAt
+		case recordLocation:
+			var v Location
+			err = json.Unmarshal(record.Data, &v)
+			snapshot.Locations = append(snapshot.Locations, v)
+		case recordDish:
+			var v Dish
+			err = json.Unmarshal(record.Data, &v)
+			snapshot.Dishes = append(snapshot.Dishes, v)
+		case recordMergedDish:
+			var v MergedDish
+			err = json.Unmarshal(record.Data, &v)
+			snapshot.MergedDishes = append(snapshot.MergedDishes, v)
+		case recordRating:
+			var v Rating
+			err = json.Unmarshal(record.Data, &v)
+			snapshot.Ratings = append(snapshot.Ratings, v)
+		case recordStreak:
+			var v Streak
+			err = json.Unmarshal(record.Data, &v)
+			snapshot.Streaks = append(snapshot.Streaks, v)
+		default:
+			err = fmt.Errorf("unknown record type %v", record.Type)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode record %v : %v", line, err)
+		}
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,384 @@
+package transferService
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"itsTasty/pkg/api/domain"
+	"itsTasty/pkg/api/statisticsService"
+	"sort"
+	"time"
+)
+
+// Repo is the data access required to export and import snapshots
+type Repo interface {
+	domain.DishRepo
+	domain.RatingStreakRepo
+}
+
+// ratingTimePrecision is the precision at which an imported rating is matched with the existing ones. Postgres stores
+// microseconds while SQLite and the memory repo keep nanoseconds, thus snapshots of different backends differ below it
+const ratingTimePrecision = time.Microsecond
+
+type ImportOptions struct {
+	//AnonymizeEmails replaces all user emails with a pseudonym derived from the email, see AnonymizeEmail.
+	//Within one import, the same email is always mapped to the same pseudonym
+	AnonymizeEmails bool
+	//AnonymizationKey is the secret key for AnonymizeEmail. If empty, a random key is used for this import only.
+	//Pass the same key to keep importing the same snapshot twice idempotent
+	AnonymizationKey []byte
+}
+
+// ImportReport counts the imported entities. Created entities did not exist in the target database, skipped
+// entities were already present (e.g. from a previous import)
+type ImportReport struct {
+	CreatedDishes        int
+	SkippedDishes        int
+	CreatedMergedDishes  int
+	SkippedMergedDishes  int
+	CreatedRatings       int
+	SkippedRatings       int
+	CreatedStreaks       int
+	SkippedStreaks       int
+	DishIDMapping        map[int64]int64
+	MergedDishIDMapping  map[int64]int64
+	AnonymizedUserEmails int
+}
+
+type TransferService interface {
+	//Export creates a snapshot of all locations, dishes, occurrences, merged dishes, ratings and streaks
+	Export(ctx context.Context) (*Snapshot, error)
+	//Import adds all data from the snapshot that is not yet present. Importing the same snapshot multiple times
+	//does not create duplicates. Import is not atomic, but a failed import can simply be repeated
+	Import(ctx context.Context, snapshot *Snapshot, opts ImportOptions) (*ImportReport, error)
+}
+
+type DefaultTransferService struct {
+	repo Repo
+}
+
+func NewDefaultTransferService(repo Repo) *DefaultTransferService {
+	return &DefaultTransferService{repo: repo}
+}
+
+const anonymizedEmailDomain = "anonymized.invalid"
+
+// anonymizationKeySize is the size of the random key used if ImportOptions.AnonymizationKey is empty
+const anonymizationKeySize = 32
+
+// AnonymizeEmail returns a pseudonym for the email, derived with HMAC-SHA256 keyed with key. The mapping is
+// deterministic for the same key. Without the key, the pseudonyms cannot be reversed by hashing guessed emails
+func AnonymizeEmail(key []byte, email string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(email))
+	return fmt.Sprintf("user-%s@%s", hex.EncodeToString(mac.Sum(nil)[:8]), anonymizedEmailDomain)
+}
+
+func (d *DefaultTransferService) Export(ctx context.Context) (*Snapshot, error) {
+	snapshot := &Snapshot{
+		Version:      CurrentVersion,
+		ExportedAt:   time.Now(),
+		Locations:    make([]Location, 0),
+		Dishes:       make([]Dish, 0),
+		MergedDishes: make([]MergedDish, 0),
+		Ratings:      make([]Rating, 0),
+		Streaks:      make([]Streak, 0),
+	}
+
+	dishes, err := d.repo.GetAllDishesSimple(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetAllDishesSimple failed : %v", err)
+	}
+	sort.Slice(dishes, func(i, j int) bool {
+		return dishes[i].Id < dishes[j].Id
+	})
+
+	//the domain does not expose location ids, thus we assign them in order of appearance
+	locationIDs := make(map[string]int64)
+	locationID := func(name string) int64 {
+		if id, ok := locationIDs[name]; ok {
+			return id
+		}
+		id := int64(len(locationIDs) + 1)
+		locationIDs[name] = id
+		snapshot.Locations = append(snapshot.Locations, Location{ID: id, Name: name})
+		return id
+	}
+
+	mergedDishIDs := make([]int64, 0)
+	dishIDsOfMergedDish := make(map[int64][]int64)
+	for _, v := range dishes {
+		dish, err := d.repo.GetDishByID(ctx, v.Id)
+		if err != nil {
+			return nil, fmt.Errorf("GetDishByID for dish %v failed : %v", v.Id, err)
+		}
+		tags, err := d.repo.GetDishTags(ctx, v.Id)
+		if err != nil {
+			return nil, fmt.Errorf("GetDishTags for dish %v failed : %v", v.Id, err)
+		}
+		snapshot.Dishes = append(snapshot.Dishes, Dish{
+			ID:          v.Id,
+			LocationID:  locationID(v.ServedAt),
+			Name:        v.Name,
+			Occurrences: dish.Occurrences(),
+			Tags:        tags,
+		})
+
+		ratings, err := d.repo.GetAllRatingsForDish(ctx, v.Id)
+		if err != nil {
+			return nil, fmt.Errorf("GetAllRatingsForDish for dish %v failed : %v", v.Id, err)
+		}
+		for _, r := range ratings {
+			snapshot.Ratings = append(snapshot.Ratings, Rating{
+				DishID: v.Id,
+				User:   r.Who,
+				Value:  int(r.Value),
+				Date:   r.RatingWhen,
+			})
+		}
+
+		if v.MergedDishID != nil {
+			if _, ok := dishIDsOfMergedDish[*v.MergedDishID]; !ok {
+				mergedDishIDs = append(mergedDishIDs, *v.MergedDishID)
+			}
+			dishIDsOfMergedDish[*v.MergedDishID] = append(dishIDsOfMergedDish[*v.MergedDishID], v.Id)
+		}
+	}
+
+	sort.Slice(mergedDishIDs, func(i, j int) bool {
+		return mergedDishIDs[i] < mergedDishIDs[j]
+	})
+	for _, id := range mergedDishIDs {
+		mergedDish, err := d.repo.GetMergedDishByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("GetMergedDishByID for merged dish %v failed : %v", id, err)
+		}
+		snapshot.MergedDishes = append(snapshot.MergedDishes, MergedDish{
+			ID:         id,
+			LocationID: locationID(mergedDish.ServedAt),
+			Name:       mergedDish.Name,
+			DishIDs:    dishIDsOfMergedDish[id],
+		})
+	}
+
+	streaks, err := d.repo.GetAllStreaks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetAllStreaks failed : %v", err)
+	}
+	streakNames := make([]string, 0, len(streaks))
+	for name := range streaks {
+		streakNames = append(streakNames, name)
+	}
+	sort.Strings(streakNames)
+	for _, name := range streakNames {
+		for _, v := range streaks[name] {
+			snapshot.Streaks = append(snapshot.Streaks, Streak{Name: name, Begin: v.Begin.Time, End: v.End.Time})
+		}
+	}
+
+	return snapshot, nil
+}
+
+func (d *DefaultTransferService) Import(ctx context.Context, snapshot *Snapshot, opts ImportOptions) (*ImportReport, error) {
+	if snapshot.Version < 1 || snapshot.Version > CurrentVersion {
+		return nil, fmt.Errorf("%w : got %v, supported up to %v", ErrUnsupportedVersion, snapshot.Version, CurrentVersion)
+	}
+
+	report := &ImportReport{
+		DishIDMapping:       make(map[int64]int64),
+		MergedDishIDMapping: make(map[int64]int64),
+	}
+
+	anonymizationKey := opts.AnonymizationKey
+	if opts.AnonymizeEmails && len(anonymizationKey) == 0 {
+		anonymizationKey = make([]byte, anonymizationKeySize)
+		if _, err := rand.Read(anonymizationKey); err != nil {
+			return nil, fmt.Errorf("failed to generate anonymization key : %v", err)
+		}
+	}
+	anonymizedEmails := make(map[string]interface{})
+	mapUser := func(email string) string {
+		if !opts.AnonymizeEmails {
+			return email
+		}
+		anonymizedEmails[email] = nil
+		report.AnonymizedUserEmails = len(anonymizedEmails)
+		return AnonymizeEmail(anonymizationKey, email)
+	}
+
+	locations := make(map[int64]string)
+	for _, v := range snapshot.Locations {
+		locations[v.ID] = v.Name
+	}
+
+	//
+	// Dishes and Occurrences
+	//
+
+	dishNames := make(map[int64]string)
+	dishLocations := make(map[int64]string)
+	for _, v := range snapshot.Dishes {
+		location, ok := locations[v.LocationID]
+		if !ok {
+			return report, fmt.Errorf("dish %v references unknown location %v", v.ID, v.LocationID)
+		}
+
+		_, _, err := d.repo.GetDishByName(ctx, v.Name, location)
+		if err != nil && !errors.Is(err, domain.ErrNotFound) {
+			return report, fmt.Errorf("GetDishByName for dish %v failed : %v", v.ID, err)
+		}
+		existed := err == nil
+
+		id, err := d.repo.GetOrCreateDishWithOccurrences(ctx, v.Name, location, v.Occurrences)
+		if err != nil {
+			return report, fmt.Errorf("failed to import dish %v : %v", v.ID, err)
+		}
+		if len(v.Tags) > 0 {
+			if err := d.repo.AddDishTags(ctx, id, v.Tags); err != nil {
+				return report, fmt.Errorf("failed to import tags of dish %v : %v", v.ID, err)
+			}
+		}
+		report.DishIDMapping[v.ID] = id
+		dishNames[v.ID] = v.Name
+		dishLocations[v.ID] = location
+		if existed {
+			report.SkippedDishes += 1
+		} else {
+			report.CreatedDishes += 1
+		}
+	}
+
+	//
+	// Merged Dishes
+	//
+
+	for _, v := range snapshot.MergedDishes {
+		location, ok := locations[v.LocationID]
+		if !ok {
+			return report, fmt.Errorf("merged dish %v references unknown location %v", v.ID, v.LocationID)
+		}
+
+		_, id, err := d.repo.GetMergedDish(ctx, v.Name, location)
+		if err == nil {
+			report.MergedDishIDMapping[v.ID] = id
+			report.SkippedMergedDishes += 1
+			continue
+		}
+		if !errors.Is(err, domain.ErrNotFound) {
+			return report, fmt.Errorf("GetMergedDish for merged dish %v failed : %v", v.ID, err)
+		}
+
+		condensedDishNames := make(map[string]interface{})
+		for _, dishID := range v.DishIDs {
+			name, ok := dishNames[dishID]
+			if !ok {
+				return report, fmt.Errorf("merged dish %v references unknown dish %v", v.ID, dishID)
+			}
+			if dishLocations[dishID] != location {
+				return report, fmt.Errorf("merged dish %v : dish %v : %w", v.ID, dishID, domain.ErrNotOnSameLocation)
+			}
+			isMerged, _, err := d.repo.IsDishPartOfMergedDisByID(ctx, report.DishIDMapping[dishID])
+			if err != nil {
+				return report, fmt.Errorf("IsDishPartOfMergedDisByID for dish %v failed : %v", dishID, err)
+			}
+			if isMerged {
+				return report, fmt.Errorf("merged dish %v : dish %v : %w", v.ID, dishID, domain.ErrDishAlreadyMerged)
+			}
+			condensedDishNames[name] = nil
+		}
+		if len(condensedDishNames) < 2 {
+			return report, fmt.Errorf("merged dish %v : %w", v.ID, domain.ErrMergedDishNeedsAtLeastTwoDishes)
+		}
+
+		id, err = d.repo.CreateMergedDish(ctx, domain.NewMergedDishFomDB(v.Name, location, condensedDishNames))
+		if err != nil {
+			return report, fmt.Errorf("failed to import merged dish %v : %v", v.ID, err)
+		}
+		report.MergedDishIDMapping[v.ID] = id
+		report.CreatedMergedDishes += 1
+	}
+
+	//
+	// Ratings
+	//
+
+	for i, v := range snapshot.Ratings {
+		dishID, ok := report.DishIDMapping[v.DishID]
+		if !ok {
+			return report, fmt.Errorf("rating %v references unknown dish %v", i, v.DishID)
+		}
+		value, err := domain.NewRatingFromInt(v.Value)
+		if err != nil {
+			return report, fmt.Errorf("rating %v : %v", i, err)
+		}
+		user := mapUser(v.User)
+
+		existingRatings, err := d.repo.GetRatings(ctx, user, dishID, false)
+		if err != nil && !errors.Is(err, domain.ErrNotFound) {
+			return report, fmt.Errorf("GetRatings for rating %v failed : %v", i, err)
+		}
+		exists := false
+		for _, existing := range existingRatings {
+			if existing.RatingWhen.Sub(v.Date).Abs() < ratingTimePrecision {
+				exists = true
+				break
+			}
+		}
+		if exists {
+			report.SkippedRatings += 1
+			continue
+		}
+
+		newRating := domain.NewDishRating(user, value, v.Date)
+		err = d.repo.CreateOrUpdateRating(ctx, user, dishID, func(_ *domain.DishRating) (*domain.DishRating, bool, error) {
+			return &newRating, true, nil
+		})
+		if err != nil {
+			return report, fmt.Errorf("failed to import rating %v : %v", i, err)
+		}
+		report.CreatedRatings += 1
+	}
+
+	//
+	// Streaks
+	//
+
+	existingStreaks, err := d.repo.GetAllStreaks(ctx)
+	if err != nil {
+		return report, fmt.Errorf("GetAllStreaks failed : %v", err)
+	}
+	for i, v := range snapshot.Streaks {
+		name := v.Name
+		//streaks are either named after a user or after a user group
+		if name != statisticsService.AllUsersStreakName {
+			name = mapUser(name)
+		}
+		//the repos operate on local days, thus we must convert before truncating to day precision
+		streak := domain.NewRatingStreakFromDB(domain.NewDayPrecisionTime(v.Begin.Local()),
+			domain.NewDayPrecisionTime(v.End.Local()))
+
+		exists := false
+		for _, existing := range existingStreaks[name] {
+			if existing.Begin.Equal(streak.Begin.Time) && existing.End.Equal(streak.End.Time) {
+				exists = true
+				break
+			}
+		}
+		if exists {
+			report.SkippedStreaks += 1
+			continue
+		}
+
+		if _, err := d.repo.CreateRatingStreak(ctx, name, streak); err != nil {
+			return report, fmt.Errorf("failed to import streak %v : %v", i, err)
+		}
+		existingStreaks[name] = append(existingStreaks[name], streak)
+		report.CreatedStreaks += 1
+	}
+
+	return report, nil
+}
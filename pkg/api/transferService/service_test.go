@@ -0,0 +1,259 @@
+package transferService
+
+import (
+	"bytes"
+	"context"
+	migrate "github.com/rubenv/sql-migrate"
+	"github.com/stretchr/testify/require"
+	"itsTasty/pkg/api/adapters/dishRepo"
+	"itsTasty/pkg/api/domain"
+	"itsTasty/pkg/api/statisticsService"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fillRepo is a helper that creates two locations, three dishes (two of them merged), ratings and streaks
+func fillRepo(t *testing.T, repo Repo) {
+	ctx := context.Background()
+	day1 := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.Local)
+	day2 := day1.Add(24 * time.Hour)
+
+	dishA, err := repo.GetOrCreateDishWithOccurrences(ctx, "dishA", "locationA", []time.Time{day1, day2})
+	require.NoError(t, err)
+	_, err = repo.GetOrCreateDishWithOccurrences(ctx, "dishA v2", "locationA", []time.Time{day2})
+	require.NoError(t, err)
+	dishB, err := repo.GetOrCreateDishWithOccurrences(ctx, "dishB", "locationB", []time.Time{day1})
+	require.NoError(t, err)
+	require.NoError(t, repo.AddDishTags(ctx, dishB, []string{"vegan"}))
+
+	_, err = repo.CreateMergedDish(ctx, domain.NewMergedDishFomDB("dishA merged", "locationA",
+		map[string]interface{}{"dishA": nil, "dishA v2": nil}))
+	require.NoError(t, err)
+
+	for _, v := range []struct {
+		user   string
+		dishID int64
+		value  domain.Rating
+		when   time.Time
+	}{
+		{"a@test", dishA, domain.FiveStars, day1.Add(12 * time.Hour)},
+		{"a@test", dishA, domain.TwoStars, day2.Add(12 * time.Hour)},
+		{"b@test", dishB, domain.ThreeStars, day1.Add(13 * time.Hour)},
+	} {
+		rating := domain.NewDishRating(v.user, v.value, v.when)
+		err := repo.CreateOrUpdateRating(ctx, v.user, v.dishID, func(_ *domain.DishRating) (*domain.DishRating, bool, error) {
+			return &rating, true, nil
+		})
+		require.NoError(t, err)
+	}
+
+	_, err = repo.CreateRatingStreak(ctx, "a@test", domain.NewRatingStreakFromDB(domain.NewDayPrecisionTime(day1),
+		domain.NewDayPrecisionTime(day2)))
+	require.NoError(t, err)
+	_, err = repo.CreateRatingStreak(ctx, statisticsService.AllUsersStreakName, domain.NewRatingStreakFromDB(
+		domain.NewDayPrecisionTime(day1), domain.NewDayPrecisionTime(day2)))
+	require.NoError(t, err)
+}
+
+// withoutExportTime is a helper that allows comparing snapshots
+func withoutExportTime(s *Snapshot) *Snapshot {
+	c := *s
+	c.ExportedAt = time.Time{}
+	return &c
+}
+
+func TestDefaultTransferService_ExportImport(t *testing.T) {
+	ctx := context.Background()
+	source := dishRepo.NewMemoryRepo()
+	fillRepo(t, source)
+
+	exported, err := NewDefaultTransferService(source).Export(ctx)
+	require.NoError(t, err)
+	require.Equal(t, CurrentVersion, exported.Version)
+	require.Len(t, exported.Locations, 2)
+	require.Len(t, exported.Dishes, 3)
+	require.Len(t, exported.MergedDishes, 1)
+	require.ElementsMatch(t, []int64{1, 2}, exported.MergedDishes[0].DishIDs)
+	require.Len(t, exported.Ratings, 3)
+	require.Len(t, exported.Streaks, 2)
+
+	//target already contains data, thus ids differ between source and target
+	target := dishRepo.NewMemoryRepo()
+	_, err = target.GetOrCreateDishWithOccurrences(ctx, "otherDish", "locationC", nil)
+	require.NoError(t, err)
+	service := NewDefaultTransferService(target)
+
+	report, err := service.Import(ctx, exported, ImportOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 3, report.CreatedDishes)
+	require.Equal(t, 1, report.CreatedMergedDishes)
+	require.Equal(t, 3, report.CreatedRatings)
+	require.Equal(t, 2, report.CreatedStreaks)
+	require.Equal(t, map[int64]int64{1: 2, 2: 3, 3: 4}, report.DishIDMapping)
+
+	dish, err := target.GetDishByID(ctx, report.DishIDMapping[1])
+	require.NoError(t, err)
+	require.Equal(t, "dishA", dish.Name)
+	require.Len(t, dish.Occurrences(), 2)
+	tags, err := target.GetDishTags(ctx, report.DishIDMapping[3])
+	require.NoError(t, err)
+	require.Equal(t, []string{"vegan"}, tags)
+	ratings, err := target.GetRatings(ctx, "a@test", report.DishIDMapping[1], false)
+	require.NoError(t, err)
+	require.Len(t, ratings, 2)
+	mergedDish, err := target.GetMergedDishByID(ctx, report.MergedDishIDMapping[exported.MergedDishes[0].ID])
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"dishA", "dishA v2"}, mergedDish.GetCondensedDishNames())
+
+	//second import must not change anything
+	before, err := service.Export(ctx)
+	require.NoError(t, err)
+	report, err = service.Import(ctx, exported, ImportOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 0, report.CreatedDishes+report.CreatedMergedDishes+report.CreatedRatings+report.CreatedStreaks)
+	require.Equal(t, 3, report.SkippedDishes)
+	require.Equal(t, 1, report.SkippedMergedDishes)
+	require.Equal(t, 3, report.SkippedRatings)
+	require.Equal(t, 2, report.SkippedStreaks)
+	after, err := service.Export(ctx)
+	require.NoError(t, err)
+	require.Equal(t, withoutExportTime(before), withoutExportTime(after))
+}
+
+func TestDefaultTransferService_Import_AcrossBackends(t *testing.T) {
+	ctx := context.Background()
+	source := dishRepo.NewMemoryRepo()
+	fillRepo(t, source)
+	//sub microsecond precision is kept by the memory and the SQLite repo but not by postgres
+	dishID, err := source.GetOrCreateDishWithOccurrences(ctx, "dishA", "locationA", nil)
+	require.NoError(t, err)
+	rating := domain.NewDishRating("c@test", domain.FourStars,
+		time.Date(2023, time.March, 1, 12, 30, 0, 123456789, time.Local))
+	require.NoError(t, source.CreateOrUpdateRating(ctx, "c@test", dishID,
+		func(_ *domain.DishRating) (*domain.DishRating, bool, error) {
+			return &rating, true, nil
+		}))
+	exported, err := NewDefaultTransferService(source).Export(ctx)
+	require.NoError(t, err)
+
+	db, err := dishRepo.OpenSQLiteDB(filepath.Join(t.TempDir(), "itsTasty.db"))
+	require.NoError(t, err)
+	target, err := dishRepo.NewSQLiteRepo(db, &migrate.FileMigrationSource{Dir: "../../../migrations/sqlite"})
+	require.NoError(t, err)
+	defer target.Close()
+	targetService := NewDefaultTransferService(target)
+	report, err := targetService.Import(ctx, exported, ImportOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 4, report.CreatedRatings)
+
+	//the export of the target matches the existing ratings of the source and vice versa
+	reExported, err := targetService.Export(ctx)
+	require.NoError(t, err)
+	report, err = NewDefaultTransferService(source).Import(ctx, reExported, ImportOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 0, report.CreatedRatings)
+	require.Equal(t, 4, report.SkippedRatings)
+
+	//a snapshot of postgres contains the rating times rounded to microseconds
+	postgresSnapshot := *exported
+	postgresSnapshot.Ratings = make([]Rating, 0, len(exported.Ratings))
+	for _, v := range exported.Ratings {
+		v.Date = v.Date.Round(time.Microsecond)
+		postgresSnapshot.Ratings = append(postgresSnapshot.Ratings, v)
+	}
+	report, err = targetService.Import(ctx, &postgresSnapshot, ImportOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 0, report.CreatedRatings)
+	require.Equal(t, 4, report.SkippedRatings)
+}
+
+func TestDefaultTransferService_Import_AnonymizeEmails(t *testing.T) {
+	ctx := context.Background()
+	source := dishRepo.NewMemoryRepo()
+	fillRepo(t, source)
+	exported, err := NewDefaultTransferService(source).Export(ctx)
+	require.NoError(t, err)
+
+	target := dishRepo.NewMemoryRepo()
+	service := NewDefaultTransferService(target)
+	key := []byte("test key")
+	for i := 0; i < 2; i++ {
+		report, err := service.Import(ctx, exported, ImportOptions{AnonymizeEmails: true, AnonymizationKey: key})
+		require.NoError(t, err)
+		require.Equal(t, 2, report.AnonymizedUserEmails)
+		if i > 0 {
+			//importing with the same key is idempotent
+			require.Zero(t, report.CreatedRatings)
+		}
+	}
+
+	got, err := service.Export(ctx)
+	require.NoError(t, err)
+	require.Len(t, got.Ratings, 3)
+	for _, v := range got.Ratings {
+		require.True(t, strings.HasSuffix(v.User, "@"+anonymizedEmailDomain), v.User)
+	}
+	streakNames := make([]string, 0)
+	for _, v := range got.Streaks {
+		streakNames = append(streakNames, v.Name)
+	}
+	require.ElementsMatch(t, []string{AnonymizeEmail(key, "a@test"), statisticsService.AllUsersStreakName}, streakNames)
+	require.NotEqual(t, AnonymizeEmail(key, "a@test"), AnonymizeEmail([]byte("other key"), "a@test"))
+
+	//without a key, a random one is used
+	randomKeyTarget := dishRepo.NewMemoryRepo()
+	_, err = NewDefaultTransferService(randomKeyTarget).Import(ctx, exported, ImportOptions{AnonymizeEmails: true})
+	require.NoError(t, err)
+	got, err = NewDefaultTransferService(randomKeyTarget).Export(ctx)
+	require.NoError(t, err)
+	for _, v := range got.Streaks {
+		require.NotEqual(t, AnonymizeEmail(key, "a@test"), v.Name)
+	}
+}
+
+func TestDefaultTransferService_Import_InvalidReference(t *testing.T) {
+	snapshot := &Snapshot{
+		Version:   CurrentVersion,
+		Locations: []Location{{ID: 1, Name: "locationA"}},
+		Dishes:    []Dish{{ID: 1, LocationID: 1, Name: "dishA"}},
+		Ratings:   []Rating{{DishID: 42, User: "a@test", Value: 3, Date: time.Now()}},
+	}
+	_, err := NewDefaultTransferService(dishRepo.NewMemoryRepo()).Import(context.Background(), snapshot, ImportOptions{})
+	require.Error(t, err)
+}
+
+func TestWriteRead(t *testing.T) {
+	source := dishRepo.NewMemoryRepo()
+	fillRepo(t, source)
+	exported, err := NewDefaultTransferService(source).Export(context.Background())
+	require.NoError(t, err)
+
+	for _, format := range []Format{FormatJSON, FormatNDJSON} {
+		t.Run(string(format), func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			require.NoError(t, Write(buf, exported, format))
+			got, err := Read(buf, format)
+			require.NoError(t, err)
+
+			//compare through serialization, as time values loose their monotonic clock reading
+			want := &bytes.Buffer{}
+			require.NoError(t, Write(want, exported, FormatJSON))
+			gotSerialized := &bytes.Buffer{}
+			require.NoError(t, Write(gotSerialized, got, FormatJSON))
+			require.Equal(t, want.String(), gotSerialized.String())
+		})
+	}
+}
+
+func TestRead_UnsupportedVersion(t *testing.T) {
+	_, err := Read(strings.NewReader(`{"version": 2}`), FormatJSON)
+	require.ErrorIs(t, err, ErrUnsupportedVersion)
+
+	_, err = Read(strings.NewReader(`{"type":"header","data":{"version":0}}`), FormatNDJSON)
+	require.ErrorIs(t, err, ErrUnsupportedVersion)
+
+	_, err = Read(strings.NewReader(`{"type":"dish","data":{}}`), FormatNDJSON)
+	require.Error(t, err)
+}
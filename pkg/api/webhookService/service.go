@@ -0,0 +1,342 @@
+package webhookService
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"itsTasty/pkg/api/domain"
+	"itsTasty/pkg/logging"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	HeaderEvent      = "X-ItsTasty-Event"
+	HeaderDeliveryID = "X-ItsTasty-Delivery"
+	//HeaderTimestamp contains the unix time at which the delivery attempt was signed
+	HeaderTimestamp = "X-ItsTasty-Timestamp"
+	//HeaderSignature contains "t=<unix time>,v1=<hex encoded HMAC-SHA256>". The HMAC covers the unix time, the
+	//delivery id and the request body, joined by "."
+	HeaderSignature = "X-ItsTasty-Signature"
+)
+
+// DefaultSignatureTolerance is the recommended maximum age of a delivery for VerifySignature
+const DefaultSignatureTolerance = 5 * time.Minute
+
+// maxDeliveriesPerRun limits the amount of deliveries processed by a single call to ProcessOutbox
+const maxDeliveriesPerRun = 100
+
+const deliveryTimeout = 10 * time.Second
+
+type TimeSource interface {
+	//Now returns the current local time.
+	Now() time.Time
+}
+
+type defaultTimeSource struct {
+}
+
+func (d defaultTimeSource) Now() time.Time {
+	return time.Now()
+}
+
+type WebhookService interface {
+	domain.EventPublisher
+	//RegisterEndpoint registers a new endpoint for the given event types (all events if empty). Returns the id
+	//of the endpoint and the secret used to sign the deliveries.
+	//Marker errors: domain.ErrInvalidWebhookURL, domain.ErrUnknownEventType
+	RegisterEndpoint(ctx context.Context, url string, eventTypes []domain.EventType) (int64, string, error)
+	GetEndpoints(ctx context.Context) (map[int64]domain.WebhookEndpoint, error)
+	//DeleteEndpoint removes the endpoint and all pending deliveries.
+	//Marker errors: domain.ErrNotFound
+	DeleteEndpoint(ctx context.Context, id int64) error
+	//ProcessOutbox tries to deliver all due deliveries
+	ProcessOutbox(ctx context.Context) error
+}
+
+type DefaultWebhookService struct {
+	repo       domain.WebhookRepo
+	client     *http.Client
+	timeSource TimeSource
+}
+
+func NewDefaultWebhookService(repo domain.WebhookRepo) *DefaultWebhookService {
+	return &DefaultWebhookService{
+		repo:       repo,
+		client:     &http.Client{Timeout: deliveryTimeout},
+		timeSource: defaultTimeSource{},
+	}
+}
+
+func NewDefaultWebhookServiceCustom(repo domain.WebhookRepo, client *http.Client, timeSource TimeSource) *DefaultWebhookService {
+	return &DefaultWebhookService{
+		repo:       repo,
+		client:     client,
+		timeSource: timeSource,
+	}
+}
+
+// Sign returns the value for HeaderSignature of the delivery attempt at signedAt
+func Sign(secret, deliveryID string, signedAt time.Time, body []byte) string {
+	timestamp := strconv.FormatInt(signedAt.Unix(), 10)
+	return "t=" + timestamp + ",v1=" + signatureMAC(secret, timestamp, deliveryID, body)
+}
+
+func signatureMAC(secret, timestamp, deliveryID string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + deliveryID + "."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks that signature is a valid value of HeaderSignature for the delivery with the given id and
+// body and that it was signed at most tolerance before now. Intended for receivers. To reject replays within
+// tolerance as well, receivers have to remember the ids of the deliveries they processed
+func VerifySignature(secret, deliveryID string, body []byte, signature string, now time.Time, tolerance time.Duration) bool {
+	var timestamp, mac string
+	for _, part := range strings.Split(signature, ",") {
+		key, value, _ := strings.Cut(part, "=")
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			mac = value
+		}
+	}
+	signedAt, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || mac == "" {
+		return false
+	}
+	if age := now.Sub(time.Unix(signedAt, 0)); age > tolerance || age < -tolerance {
+		return false
+	}
+	return hmac.Equal([]byte(signatureMAC(secret, timestamp, deliveryID, body)), []byte(mac))
+}
+
+func generateSecret() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("failed to read random bytes : %w", err)
+	}
+	return hex.EncodeToString(raw), nil
+}
+
+func (d *DefaultWebhookService) RegisterEndpoint(ctx context.Context, url string, eventTypes []domain.EventType) (int64, string, error) {
+	secret, err := generateSecret()
+	if err != nil {
+		return 0, "", err
+	}
+
+	endpoint, err := domain.NewWebhookEndpoint(url, secret, eventTypes, d.timeSource.Now())
+	if err != nil {
+		return 0, "", err
+	}
+
+	id, err := d.repo.CreateWebhookEndpoint(ctx, endpoint)
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to store endpoint : %w", err)
+	}
+
+	return id, secret, nil
+}
+
+func (d *DefaultWebhookService) GetEndpoints(ctx context.Context) (map[int64]domain.WebhookEndpoint, error) {
+	endpoints, err := d.repo.GetAllWebhookEndpoints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch endpoints : %w", err)
+	}
+	return endpoints, nil
+}
+
+func (d *DefaultWebhookService) DeleteEndpoint(ctx context.Context, id int64) error {
+	if err := d.repo.DeleteWebhookEndpoint(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete endpoint %v : %w", id, err)
+	}
+	return nil
+}
+
+// Publish adds a delivery for each endpoint subscribed to the event to the outbox. The actual delivery happens in
+// ProcessOutbox
+func (d *DefaultWebhookService) Publish(ctx context.Context, event domain.Event) error {
+	payload, err := marshalEvent(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event : %w", err)
+	}
+
+	endpoints, err := d.repo.GetAllWebhookEndpoints(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch endpoints : %w", err)
+	}
+
+	now := d.timeSource.Now()
+	deliveries := make([]domain.WebhookDelivery, 0, len(endpoints))
+	for id, endpoint := range endpoints {
+		if !endpoint.IsSubscribedTo(event.Type) {
+			continue
+		}
+		deliveries = append(deliveries, domain.NewWebhookDelivery(id, event.Type, payload, now))
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	if err := d.repo.CreateWebhookDeliveries(ctx, deliveries); err != nil {
+		return fmt.Errorf("failed to store deliveries : %w", err)
+	}
+	return nil
+}
+
+func (d *DefaultWebhookService) ProcessOutbox(ctx context.Context) error {
+	dueDeliveries, err := d.repo.GetDueWebhookDeliveries(ctx, d.timeSource.Now(), maxDeliveriesPerRun)
+	if err != nil {
+		return fmt.Errorf("failed to fetch due deliveries : %w", err)
+	}
+	if len(dueDeliveries) == 0 {
+		return nil
+	}
+
+	endpoints, err := d.repo.GetAllWebhookEndpoints(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch endpoints : %w", err)
+	}
+
+	var errs []error
+	for id, delivery := range dueDeliveries {
+		endpoint, ok := endpoints[delivery.EndpointID]
+		if !ok {
+			//endpoint got deleted in the meantime
+			continue
+		}
+
+		deliveryErr := d.deliver(ctx, id, delivery, endpoint)
+		if deliveryErr != nil {
+			logging.FromContext(ctx).Warn("webhook delivery failed", "deliveryID", id, "endpointID", delivery.EndpointID, "attempt", delivery.Attempts+1, "err", deliveryErr)
+		}
+
+		err := d.repo.UpdateWebhookDelivery(ctx, id, func(current domain.WebhookDelivery) (*domain.WebhookDelivery, error) {
+			if deliveryErr != nil {
+				current.RecordFailure(d.timeSource.Now(), deliveryErr)
+			} else {
+				current.RecordSuccess(d.timeSource.Now())
+			}
+			return &current, nil
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to update delivery %v : %w", id, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// deliver sends the payload to the endpoint. Any non 2xx status code is considered an error
+func (d *DefaultWebhookService) deliver(ctx context.Context, id int64, delivery domain.WebhookDelivery, endpoint domain.WebhookEndpoint) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return fmt.Errorf("failed to build request : %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, string(delivery.EventType))
+	//each attempt is signed with the current time, so that retries are not rejected as replays
+	signedAt := d.timeSource.Now()
+	deliveryID := strconv.FormatInt(id, 10)
+	req.Header.Set(HeaderDeliveryID, deliveryID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(signedAt.Unix(), 10))
+	req.Header.Set(HeaderSignature, Sign(endpoint.Secret, deliveryID, signedAt, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed : %w", err)
+	}
+	defer resp.Body.Close()
+	//drain body to allow connection reuse
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status code %v", resp.StatusCode)
+	}
+	return nil
+}
+
+//
+// Wire format
+//
+
+type eventEnvelope struct {
+	Type       domain.EventType `json:"type"`
+	OccurredAt time.Time        `json:"occurredAt"`
+	Data       interface{}      `json:"data"`
+}
+
+type dishCreatedData struct {
+	DishID   int64  `json:"dishID"`
+	Name     string `json:"name"`
+	ServedAt string `json:"servedAt"`
+}
+
+type ratingCreatedData struct {
+	DishID int64 `json:"dishID"`
+	Rating int   `json:"rating"`
+}
+
+type mergedDishChangedData struct {
+	MergedDishID int64  `json:"mergedDishID"`
+	Change       string `json:"change"`
+	Name         string `json:"name,omitempty"`
+	ServedAt     string `json:"servedAt,omitempty"`
+}
+
+type streakMilestoneData struct {
+	Name         string `json:"name"`
+	LengthInDays int    `json:"lengthInDays"`
+	StartDate    string `json:"startDate"`
+	EndDate      string `json:"endDate"`
+}
+
+func marshalEvent(event domain.Event) ([]byte, error) {
+	envelope := eventEnvelope{
+		Type:       event.Type,
+		OccurredAt: event.OccurredAt,
+	}
+
+	switch data := event.Data.(type) {
+	case domain.DishCreatedEventData:
+		envelope.Data = dishCreatedData{
+			DishID:   data.DishID,
+			Name:     data.Name,
+			ServedAt: data.ServedAt,
+		}
+	case domain.RatingCreatedEventData:
+		envelope.Data = ratingCreatedData{
+			DishID: data.DishID,
+			Rating: int(data.Rating),
+		}
+	case domain.MergedDishChangedEventData:
+		envelope.Data = mergedDishChangedData{
+			MergedDishID: data.MergedDishID,
+			Change:       string(data.Change),
+			Name:         data.Name,
+			ServedAt:     data.ServedAt,
+		}
+	case domain.StreakMilestoneEventData:
+		envelope.Data = streakMilestoneData{
+			Name:         data.Name,
+			LengthInDays: data.Streak.LengthInDays(),
+			StartDate:    data.Streak.Begin.Format("2006-01-02"),
+			EndDate:      data.Streak.End.Format("2006-01-02"),
+		}
+	default:
+		return nil, fmt.Errorf("unsupported event data %T for event type %v", event.Data, event.Type)
+	}
+
+	return json.Marshal(envelope)
+}
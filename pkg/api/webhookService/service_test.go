@@ -0,0 +1,262 @@
+package webhookService
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"itsTasty/pkg/api/domain"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+//
+// Mocks
+//
+
+type mockWebhookRepo struct {
+	sync.Mutex
+	nextID     int64
+	endpoints  map[int64]domain.WebhookEndpoint
+	deliveries map[int64]domain.WebhookDelivery
+}
+
+func newMockWebhookRepo() *mockWebhookRepo {
+	return &mockWebhookRepo{
+		nextID:     1,
+		endpoints:  make(map[int64]domain.WebhookEndpoint),
+		deliveries: make(map[int64]domain.WebhookDelivery),
+	}
+}
+
+func (m *mockWebhookRepo) CreateWebhookEndpoint(_ context.Context, endpoint domain.WebhookEndpoint) (int64, error) {
+	m.Lock()
+	defer m.Unlock()
+	id := m.nextID
+	m.nextID += 1
+	m.endpoints[id] = endpoint
+	return id, nil
+}
+
+func (m *mockWebhookRepo) GetAllWebhookEndpoints(_ context.Context) (map[int64]domain.WebhookEndpoint, error) {
+	m.Lock()
+	defer m.Unlock()
+	result := make(map[int64]domain.WebhookEndpoint, len(m.endpoints))
+	for k, v := range m.endpoints {
+		result[k] = v
+	}
+	return result, nil
+}
+
+func (m *mockWebhookRepo) DeleteWebhookEndpoint(_ context.Context, id int64) error {
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.endpoints[id]; !ok {
+		return domain.ErrNotFound
+	}
+	delete(m.endpoints, id)
+	return nil
+}
+
+func (m *mockWebhookRepo) CreateWebhookDeliveries(_ context.Context, deliveries []domain.WebhookDelivery) error {
+	m.Lock()
+	defer m.Unlock()
+	for _, v := range deliveries {
+		m.deliveries[m.nextID] = v
+		m.nextID += 1
+	}
+	return nil
+}
+
+func (m *mockWebhookRepo) GetDueWebhookDeliveries(_ context.Context, now time.Time, limit int) (map[int64]domain.WebhookDelivery, error) {
+	m.Lock()
+	defer m.Unlock()
+	result := make(map[int64]domain.WebhookDelivery)
+	for k, v := range m.deliveries {
+		if len(result) >= limit {
+			break
+		}
+		if v.State == domain.WebhookDeliveryPending && !v.NextAttemptAt.After(now) {
+			result[k] = v
+		}
+	}
+	return result, nil
+}
+
+func (m *mockWebhookRepo) UpdateWebhookDelivery(_ context.Context, id int64, updateFN domain.WebhookDeliveryUpdateFN) error {
+	m.Lock()
+	defer m.Unlock()
+	current, ok := m.deliveries[id]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	updated, err := updateFN(current)
+	if err != nil {
+		return err
+	}
+	if updated != nil {
+		m.deliveries[id] = *updated
+	}
+	return nil
+}
+
+type mockTimeSource struct {
+	CurrentTime time.Time
+}
+
+func (m *mockTimeSource) Now() time.Time {
+	return m.CurrentTime
+}
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// newReceiver returns a test server that records all requests and answers with the status codes in statusCodes
+// (repeating the last one)
+func newReceiver(t *testing.T, statusCodes ...int) (*httptest.Server, *[]receivedRequest) {
+	var lock sync.Mutex
+	received := make([]receivedRequest, 0)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		lock.Lock()
+		defer lock.Unlock()
+		received = append(received, receivedRequest{header: r.Header.Clone(), body: body})
+		idx := len(received) - 1
+		if idx >= len(statusCodes) {
+			idx = len(statusCodes) - 1
+		}
+		w.WriteHeader(statusCodes[idx])
+	}))
+	return ts, &received
+}
+
+//
+// Test Functions
+//
+
+func TestDefaultWebhookService_PublishAndDeliver(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockWebhookRepo()
+	timeSource := &mockTimeSource{CurrentTime: time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC)}
+
+	allEventsReceiver, allEventsRequests := newReceiver(t, http.StatusOK)
+	defer allEventsReceiver.Close()
+	ratingReceiver, ratingRequests := newReceiver(t, http.StatusNoContent)
+	defer ratingReceiver.Close()
+
+	service := NewDefaultWebhookServiceCustom(repo, http.DefaultClient, timeSource)
+
+	allEventsID, allEventsSecret, err := service.RegisterEndpoint(ctx, allEventsReceiver.URL, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, allEventsSecret)
+	_, ratingSecret, err := service.RegisterEndpoint(ctx, ratingReceiver.URL, []domain.EventType{domain.EventRatingCreated})
+	require.NoError(t, err)
+
+	_, _, err = service.RegisterEndpoint(ctx, "not a url", nil)
+	require.ErrorIs(t, err, domain.ErrInvalidWebhookURL)
+
+	//publish events
+	require.NoError(t, service.Publish(ctx, domain.NewDishCreatedEvent(timeSource.Now(), 42, "Pasta", "Mensa")))
+	require.NoError(t, service.Publish(ctx, domain.NewRatingCreatedEvent(timeSource.Now(), 42, domain.FourStars)))
+
+	//nothing is delivered before the outbox is processed
+	require.Empty(t, *allEventsRequests)
+	require.Empty(t, *ratingRequests)
+
+	require.NoError(t, service.ProcessOutbox(ctx))
+
+	require.Len(t, *allEventsRequests, 2)
+	require.Len(t, *ratingRequests, 1)
+
+	now := timeSource.Now()
+	for _, v := range *allEventsRequests {
+		require.NotEmpty(t, v.header.Get(HeaderDeliveryID))
+		require.Equal(t, strconv.FormatInt(now.Unix(), 10), v.header.Get(HeaderTimestamp))
+		require.True(t, VerifySignature(allEventsSecret, v.header.Get(HeaderDeliveryID), v.body,
+			v.header.Get(HeaderSignature), now, DefaultSignatureTolerance))
+	}
+
+	ratingRequest := (*ratingRequests)[0]
+	ratingID := ratingRequest.header.Get(HeaderDeliveryID)
+	ratingSignature := ratingRequest.header.Get(HeaderSignature)
+	require.True(t, VerifySignature(ratingSecret, ratingID, ratingRequest.body, ratingSignature, now, DefaultSignatureTolerance))
+	require.False(t, VerifySignature(allEventsSecret, ratingID, ratingRequest.body, ratingSignature, now, DefaultSignatureTolerance))
+	//the signature is bound to the delivery id and expires
+	require.False(t, VerifySignature(ratingSecret, ratingID+"1", ratingRequest.body, ratingSignature, now, DefaultSignatureTolerance))
+	require.False(t, VerifySignature(ratingSecret, ratingID, ratingRequest.body, ratingSignature,
+		now.Add(DefaultSignatureTolerance+time.Second), DefaultSignatureTolerance))
+	require.False(t, VerifySignature(ratingSecret, ratingID, ratingRequest.body, "sha256=abc", now, DefaultSignatureTolerance))
+	require.Equal(t, string(domain.EventRatingCreated), ratingRequest.header.Get(HeaderEvent))
+
+	var payload map[string]interface{}
+	require.NoError(t, json.Unmarshal(ratingRequest.body, &payload))
+	require.Equal(t, string(domain.EventRatingCreated), payload["type"])
+	require.Equal(t, map[string]interface{}{"dishID": float64(42), "rating": float64(4)}, payload["data"])
+
+	//all deliveries are done, processing again must not send anything
+	require.NoError(t, service.ProcessOutbox(ctx))
+	require.Len(t, *allEventsRequests, 2)
+	require.Len(t, *ratingRequests, 1)
+
+	//deleting the endpoint stops deliveries
+	require.NoError(t, service.DeleteEndpoint(ctx, allEventsID))
+	require.NoError(t, service.Publish(ctx, domain.NewDishCreatedEvent(timeSource.Now(), 43, "Soup", "Mensa")))
+	require.NoError(t, service.ProcessOutbox(ctx))
+	require.Len(t, *allEventsRequests, 2)
+	require.ErrorIs(t, service.DeleteEndpoint(ctx, allEventsID), domain.ErrNotFound)
+}
+
+func TestDefaultWebhookService_RetryWithBackoff(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockWebhookRepo()
+	timeSource := &mockTimeSource{CurrentTime: time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC)}
+
+	//fail twice, then succeed
+	receiver, requests := newReceiver(t, http.StatusInternalServerError, http.StatusBadGateway, http.StatusOK)
+	defer receiver.Close()
+
+	service := NewDefaultWebhookServiceCustom(repo, http.DefaultClient, timeSource)
+	_, _, err := service.RegisterEndpoint(ctx, receiver.URL, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, service.Publish(ctx, domain.NewMergedDishChangedEvent(timeSource.Now(), 1, domain.MergedDishDeleted, nil)))
+
+	//first attempt fails
+	require.NoError(t, service.ProcessOutbox(ctx))
+	require.Len(t, *requests, 1)
+
+	//retry is not due yet
+	timeSource.CurrentTime = timeSource.CurrentTime.Add(domain.WebhookRetryBackoff(1) - time.Second)
+	require.NoError(t, service.ProcessOutbox(ctx))
+	require.Len(t, *requests, 1)
+
+	//second attempt fails
+	timeSource.CurrentTime = timeSource.CurrentTime.Add(time.Second)
+	require.NoError(t, service.ProcessOutbox(ctx))
+	require.Len(t, *requests, 2)
+
+	//third attempt succeeds
+	timeSource.CurrentTime = timeSource.CurrentTime.Add(domain.WebhookRetryBackoff(2))
+	require.NoError(t, service.ProcessOutbox(ctx))
+	require.Len(t, *requests, 3)
+
+	require.Len(t, repo.deliveries, 1)
+	for _, v := range repo.deliveries {
+		require.Equal(t, domain.WebhookDeliveryDelivered, v.State)
+		require.Equal(t, 3, v.Attempts)
+		require.NotNil(t, v.DeliveredAt)
+	}
+
+	//delivered, no more requests
+	timeSource.CurrentTime = timeSource.CurrentTime.Add(24 * time.Hour)
+	require.NoError(t, service.ProcessOutbox(ctx))
+	require.Len(t, *requests, 3)
+}
@@ -0,0 +1,132 @@
+// Package csrf protects cookie authenticated endpoints against cross-site request forgery. State-changing requests
+// must carry the token of their session in the HeaderToken header and must not originate from a foreign origin
+package csrf
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"itsTasty/pkg/logging"
+	"net/http"
+	"net/url"
+)
+
+// HeaderToken carries the token of the session. The SPA gets it from TokenHandler
+const HeaderToken = "X-CSRF-Token"
+
+// sessionKeyToken stores the token in the session
+const sessionKeyToken = "csrfToken"
+
+// SessionStore is the part of scs.SessionManager used to keep the token in the session
+type SessionStore interface {
+	GetString(ctx context.Context, key string) string
+	Put(ctx context.Context, key string, val interface{})
+}
+
+// Protector checks state-changing requests
+type Protector struct {
+	session SessionStore
+	//trustedOrigins are allowed in addition to the origin of the server itself
+	trustedOrigins map[string]bool
+}
+
+// NewProtector creates a Protector. trustedOrigins are origins like "https://localhost:3000" that may send
+// state-changing requests besides the server itself, e.g. the dev frontend
+func NewProtector(session SessionStore, trustedOrigins ...string) *Protector {
+	trusted := make(map[string]bool)
+	for _, v := range trustedOrigins {
+		if v != "" {
+			trusted[v] = true
+		}
+	}
+	return &Protector{session: session, trustedOrigins: trusted}
+}
+
+func isSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return true
+	}
+	return false
+}
+
+// checkOrigin returns an empty string if the request may come from its origin and the reason for rejecting it
+// otherwise. Browsers send Origin on all state-changing requests and Sec-Fetch-Site on all requests, other clients
+// usually send neither
+func (p *Protector) checkOrigin(r *http.Request) string {
+	if origin := r.Header.Get("Origin"); origin != "" {
+		if p.trustedOrigins[origin] {
+			return ""
+		}
+		parsed, err := url.Parse(origin)
+		if err != nil || parsed.Host != r.Host {
+			return "foreign origin"
+		}
+		return ""
+	}
+	if r.Header.Get("Sec-Fetch-Site") == "cross-site" {
+		return "cross-site request"
+	}
+	return ""
+}
+
+// Middleware rejects state-changing requests with http.StatusForbidden, unless they come from an allowed origin
+// and carry the token of their session. Must be used after the session has been loaded
+func (p *Protector) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isSafeMethod(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		reason := p.checkOrigin(r)
+		if reason == "" {
+			expected := p.session.GetString(r.Context(), sessionKeyToken)
+			got := r.Header.Get(HeaderToken)
+			switch {
+			case expected == "":
+				reason = "no token in session"
+			case got == "":
+				reason = "missing token"
+			case subtle.ConstantTimeCompare([]byte(expected), []byte(got)) != 1:
+				reason = "token mismatch"
+			}
+		}
+		if reason != "" {
+			logging.FromContext(r.Context()).Warn("blocked request by csrf check", "reason", reason,
+				"origin", r.Header.Get("Origin"))
+			http.Error(w, "CSRF check failed", http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// TokenResponse is returned by TokenHandler
+type TokenResponse struct {
+	Token string `json:"token"`
+}
+
+// TokenHandler returns the token of the session and creates one if the session has none yet. The token stays the
+// same for the lifetime of the session
+func (p *Protector) TokenHandler(w http.ResponseWriter, r *http.Request) {
+	token := p.session.GetString(r.Context(), sessionKeyToken)
+	if token == "" {
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			logging.FromContext(r.Context()).Error("failed to generate csrf token", "err", err)
+			http.Error(w, "", http.StatusInternalServerError)
+			return
+		}
+		token = base64.RawURLEncoding.EncodeToString(b)
+		p.session.Put(r.Context(), sessionKeyToken, token)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if err := json.NewEncoder(w).Encode(TokenResponse{Token: token}); err != nil {
+		logging.FromContext(r.Context()).Error("failed to encode csrf token", "err", err)
+	}
+}
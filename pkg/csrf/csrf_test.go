@@ -0,0 +1,70 @@
+package csrf
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mapSession is a SessionStore that shares its values across all requests
+type mapSession map[string]string
+
+func (m mapSession) GetString(_ context.Context, key string) string {
+	return m[key]
+}
+
+func (m mapSession) Put(_ context.Context, key string, val interface{}) {
+	m[key] = val.(string)
+}
+
+func TestProtector(t *testing.T) {
+	p := NewProtector(mapSession{}, "https://localhost:3000")
+	handler := p.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	do := func(method string, header http.Header) int {
+		req := httptest.NewRequest(method, "https://example.test/rate", nil)
+		for k := range header {
+			req.Header.Set(k, header[k][0])
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	//no token has been issued yet
+	require.Equal(t, http.StatusForbidden, do(http.MethodPost, http.Header{HeaderToken: {"guess"}}))
+	require.Equal(t, http.StatusOK, do(http.MethodGet, nil))
+
+	rec := httptest.NewRecorder()
+	p.TokenHandler(rec, httptest.NewRequest(http.MethodGet, "/csrfToken", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	token := TokenResponse{}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&token))
+	require.NotEmpty(t, token.Token)
+
+	//the token is stable
+	rec = httptest.NewRecorder()
+	p.TokenHandler(rec, httptest.NewRequest(http.MethodGet, "/csrfToken", nil))
+	again := TokenResponse{}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&again))
+	require.Equal(t, token.Token, again.Token)
+
+	require.Equal(t, http.StatusOK, do(http.MethodPost, http.Header{HeaderToken: {token.Token}}))
+	require.Equal(t, http.StatusOK, do(http.MethodDelete, http.Header{HeaderToken: {token.Token},
+		"Origin": {"https://example.test"}}))
+	require.Equal(t, http.StatusOK, do(http.MethodPatch, http.Header{HeaderToken: {token.Token},
+		"Origin": {"https://localhost:3000"}}))
+
+	require.Equal(t, http.StatusForbidden, do(http.MethodPost, nil))
+	require.Equal(t, http.StatusForbidden, do(http.MethodPost, http.Header{HeaderToken: {token.Token + "x"}}))
+	require.Equal(t, http.StatusForbidden, do(http.MethodPost, http.Header{HeaderToken: {token.Token},
+		"Origin": {"https://evil.test"}}))
+	require.Equal(t, http.StatusForbidden, do(http.MethodPost, http.Header{HeaderToken: {token.Token},
+		"Origin": {"null"}}))
+	require.Equal(t, http.StatusForbidden, do(http.MethodPut, http.Header{HeaderToken: {token.Token},
+		"Sec-Fetch-Site": {"cross-site"}}))
+}
@@ -0,0 +1,135 @@
+// Package health implements the liveness, readiness and status endpoints used by orchestrators and operators
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"itsTasty/pkg/logging"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	StatusOK    = "ok"
+	StatusError = "error"
+)
+
+// Check returns an error if the dependency it checks is not usable
+type Check func(ctx context.Context) error
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// CheckResult is the outcome of a single Check
+type CheckResult struct {
+	Name     string        `json:"name"`
+	Status   string        `json:"status"`
+	Error    string        `json:"error,omitempty"`
+	Duration time.Duration `json:"durationNs,omitempty"`
+}
+
+// Report is the outcome of all checks of a Checker. Status is StatusOK if all checks succeeded
+type Report struct {
+	Status string        `json:"status"`
+	Checks []CheckResult `json:"checks"`
+}
+
+// public returns a copy of r with only the name and status of each check. Errors may contain internals like host
+// names or connection strings and are only shown on the status page
+func (r Report) public() Report {
+	result := Report{Status: r.Status, Checks: make([]CheckResult, 0, len(r.Checks))}
+	for _, v := range r.Checks {
+		result.Checks = append(result.Checks, CheckResult{Name: v.Name, Status: v.Status})
+	}
+	return result
+}
+
+// Checker runs the readiness checks of the app
+type Checker struct {
+	timeout time.Duration
+
+	lock   sync.Mutex
+	checks []namedCheck
+}
+
+// NewChecker creates a Checker that cancels each check after timeout
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{timeout: timeout}
+}
+
+// Add registers check under name. The name shows up in the Report
+func (c *Checker) Add(name string, check Check) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+}
+
+// Run executes all checks concurrently. The results are sorted by name
+func (c *Checker) Run(ctx context.Context) Report {
+	c.lock.Lock()
+	checks := append([]namedCheck(nil), c.checks...)
+	c.lock.Unlock()
+
+	results := make([]CheckResult, len(checks))
+	wg := sync.WaitGroup{}
+	for i := range checks {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := checks[i].check(checkCtx)
+			results[i] = CheckResult{Name: checks[i].name, Status: StatusOK, Duration: time.Since(start)}
+			if err != nil {
+				results[i].Status = StatusError
+				results[i].Error = err.Error()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Name < results[j].Name })
+	report := Report{Status: StatusOK, Checks: results}
+	for _, v := range results {
+		if v.Status != StatusOK {
+			report.Status = StatusError
+		}
+	}
+	return report
+}
+
+// LivenessHandler always succeeds as long as the process is able to serve requests
+func LivenessHandler(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, r, http.StatusOK, Report{Status: StatusOK, Checks: []CheckResult{}})
+}
+
+// ReadinessHandler serves the name and status of all checks. Responds with http.StatusServiceUnavailable if any check
+// failed. The errors of failed checks are logged
+func (c *Checker) ReadinessHandler(w http.ResponseWriter, r *http.Request) {
+	report := c.Run(r.Context())
+	status := http.StatusOK
+	if report.Status != StatusOK {
+		status = http.StatusServiceUnavailable
+		for _, v := range report.Checks {
+			if v.Status != StatusOK {
+				logging.FromContext(r.Context()).Warn("readiness check failed", "check", v.Name, "err", v.Error)
+			}
+		}
+	}
+	writeJSON(w, r, status, report.public())
+}
+
+func writeJSON(w http.ResponseWriter, r *http.Request, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		logging.FromContext(r.Context()).Error("failed to encode health report", "err", err)
+	}
+}
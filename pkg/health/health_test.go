@@ -0,0 +1,58 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecker(t *testing.T) {
+	checker := NewChecker(50 * time.Millisecond)
+	checker.Add("db", func(ctx context.Context) error { return nil })
+
+	rec := httptest.NewRecorder()
+	checker.ReadinessHandler(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	//slow checks are cancelled after the timeout
+	checker.Add("oidc", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	checker.Add("vacation", func(ctx context.Context) error { return errors.New("stale") })
+
+	rec = httptest.NewRecorder()
+	checker.ReadinessHandler(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	report := Report{}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&report))
+	require.Equal(t, StatusError, report.Status)
+	require.Len(t, report.Checks, 3)
+	require.Equal(t, "db", report.Checks[0].Name)
+	require.Equal(t, StatusOK, report.Checks[0].Status)
+	require.Equal(t, "oidc", report.Checks[1].Name)
+	require.Equal(t, StatusError, report.Checks[1].Status)
+	require.Equal(t, "vacation", report.Checks[2].Name)
+	require.Equal(t, StatusError, report.Checks[2].Status)
+	//the errors are only available to the status page
+	require.NotContains(t, rec.Body.String(), "stale")
+	require.NotContains(t, rec.Body.String(), "durationNs")
+
+	report = checker.Run(context.Background())
+	require.Equal(t, context.DeadlineExceeded.Error(), report.Checks[1].Error)
+	require.Equal(t, "stale", report.Checks[2].Error)
+}
+
+func TestLivenessHandler(t *testing.T) {
+	rec := httptest.NewRecorder()
+	LivenessHandler(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"status":"ok","checks":[]}`, rec.Body.String())
+}
@@ -0,0 +1,84 @@
+package health
+
+import (
+	"html/template"
+	"itsTasty/pkg/logging"
+	"itsTasty/pkg/telemetry"
+	"net/http"
+	"time"
+
+	"github.com/go-co-op/gocron"
+)
+
+// Job describes a job scheduled with gocron, whose runs are recorded by telemetry.InstrumentJob
+type Job struct {
+	Name      string
+	Interval  time.Duration
+	Scheduled *gocron.Job
+}
+
+// jobStatus is the view of a Job on the status page
+type jobStatus struct {
+	Name         string
+	Interval     time.Duration
+	NextRun      time.Time
+	LastRun      time.Time
+	LastDuration time.Duration
+	LastError    string
+	Runs         int
+}
+
+var statusTemplate = template.Must(template.New("status").Parse(`<!DOCTYPE html>
+<html>
+<head><title>itsTasty status</title></head>
+<body>
+<h1>Readiness: {{.Readiness.Status}}</h1>
+<table>
+<tr><th>Check</th><th>Status</th><th>Duration</th><th>Error</th></tr>
+{{range .Readiness.Checks}}<tr><td>{{.Name}}</td><td>{{.Status}}</td><td>{{.Duration}}</td><td>{{.Error}}</td></tr>
+{{end}}</table>
+<h1>Jobs</h1>
+<table>
+<tr><th>Job</th><th>Interval</th><th>Next run</th><th>Last run</th><th>Last duration</th><th>Runs</th><th>Last error</th></tr>
+{{range .Jobs}}<tr><td>{{.Name}}</td><td>{{.Interval}}</td><td>{{if not .NextRun.IsZero}}{{.NextRun.Format "2006-01-02 15:04:05"}}{{end}}</td><td>{{if .LastRun.IsZero}}never{{else}}{{.LastRun.Format "2006-01-02 15:04:05"}}{{end}}</td><td>{{.LastDuration}}</td><td>{{.Runs}}</td><td>{{.LastError}}</td></tr>
+{{end}}</table>
+</body>
+</html>
+`))
+
+// StatusHandler serves a html page with the readiness report of checker and the schedule and last run of jobs.
+// The page is meant for operators and must be protected by the caller
+func StatusHandler(checker *Checker, jobs []Job) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		statuses := make([]jobStatus, 0, len(jobs))
+		for _, job := range jobs {
+			status := jobStatus{
+				Name:     job.Name,
+				Interval: job.Interval,
+				NextRun:  job.Scheduled.NextRun(),
+				Runs:     job.Scheduled.RunCount(),
+			}
+			if run, ok := telemetry.LastJobRun(job.Name); ok {
+				status.LastRun = run.Start
+				status.LastDuration = run.Duration.Round(time.Millisecond)
+				if run.Err != nil {
+					status.LastError = run.Err.Error()
+				}
+			}
+			statuses = append(statuses, status)
+		}
+
+		data := struct {
+			Readiness Report
+			Jobs      []jobStatus
+		}{
+			Readiness: checker.Run(r.Context()),
+			Jobs:      statuses,
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Cache-Control", "no-store")
+		if err := statusTemplate.Execute(w, data); err != nil {
+			logging.FromContext(r.Context()).Error("failed to render status page", "err", err)
+		}
+	}
+}
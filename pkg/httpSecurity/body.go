@@ -0,0 +1,37 @@
+package httpSecurity
+
+import (
+	"errors"
+	"net/http"
+)
+
+// LimitBody rejects requests whose body is larger than maxBytes with http.StatusRequestEntityTooLarge. If the client
+// does not announce the size, reading beyond maxBytes fails with an error for which IsBodyTooLarge returns true
+func LimitBody(maxBytes int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.ContentLength > maxBytes {
+				http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// IsBodyTooLarge returns true if err was caused by reading beyond the limit set by LimitBody
+func IsBodyTooLarge(err error) bool {
+	var maxBytesErr *http.MaxBytesError
+	return errors.As(err, &maxBytesErr)
+}
+
+// RequestErrorHandler is meant for the strict handlers generated by oapi-codegen. It responds with
+// http.StatusRequestEntityTooLarge if IsBodyTooLarge and with http.StatusBadRequest otherwise
+func RequestErrorHandler(w http.ResponseWriter, _ *http.Request, err error) {
+	if IsBodyTooLarge(err) {
+		http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	http.Error(w, err.Error(), http.StatusBadRequest)
+}
@@ -0,0 +1,93 @@
+package httpSecurity
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// CertReloader serves a TLS certificate that is reloaded once its files change, e.g. after a renewal by certbot
+// or cert-manager. Use GetCertificate as tls.Config.GetCertificate
+type CertReloader struct {
+	certFile string
+	keyFile  string
+
+	lock    sync.RWMutex
+	cert    *tls.Certificate
+	certMod time.Time
+	keyMod  time.Time
+}
+
+// NewCertReloader loads the PEM encoded certificate and key. Returns an error if they cannot be loaded
+func NewCertReloader(certFile, keyFile string) (*CertReloader, error) {
+	c := &CertReloader{certFile: certFile, keyFile: keyFile}
+	if _, err := c.reloadIfChanged(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// GetCertificate returns the most recently loaded certificate
+func (c *CertReloader) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.cert, nil
+}
+
+// reloadIfChanged loads the files if their modification time differs from the loaded ones. Returns true if the
+// certificate was replaced. On errors, the previous certificate is kept
+func (c *CertReloader) reloadIfChanged() (bool, error) {
+	certInfo, err := os.Stat(c.certFile)
+	if err != nil {
+		return false, fmt.Errorf("failed to stat cert file : %w", err)
+	}
+	keyInfo, err := os.Stat(c.keyFile)
+	if err != nil {
+		return false, fmt.Errorf("failed to stat key file : %w", err)
+	}
+
+	c.lock.RLock()
+	unchanged := c.cert != nil && certInfo.ModTime().Equal(c.certMod) && keyInfo.ModTime().Equal(c.keyMod)
+	c.lock.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.certFile, c.keyFile)
+	if err != nil {
+		return false, fmt.Errorf("failed to load key pair : %w", err)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cert = &cert
+	c.certMod = certInfo.ModTime()
+	c.keyMod = keyInfo.ModTime()
+	return true, nil
+}
+
+// Watch checks the files for changes every interval until ctx is done. Failed reloads are logged, as the cert and
+// key file are usually not replaced atomically, and retried on the next check
+func (c *CertReloader) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloaded, err := c.reloadIfChanged()
+			if err != nil {
+				slog.Warn("failed to reload TLS certificate, keeping the previous one", "certPath", c.certFile, "err", err)
+				continue
+			}
+			if reloaded {
+				slog.Info("reloaded TLS certificate", "certPath", c.certFile)
+			}
+		}
+	}
+}
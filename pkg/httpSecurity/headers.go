@@ -0,0 +1,44 @@
+// Package httpSecurity contains the hardening of the http server: security headers, request body limits and
+// TLS certificates that are reloaded when their files change
+package httpSecurity
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// DefaultContentSecurityPolicy only allows resources from our own origin. Inline styles are allowed as the SPA
+// sets style attributes, inline scripts are not, so the frontend has to be built with INLINE_RUNTIME_CHUNK=false
+const DefaultContentSecurityPolicy = "default-src 'self'; script-src 'self'; style-src 'self' 'unsafe-inline'; " +
+	"img-src 'self' data:; font-src 'self' data:; connect-src 'self'; object-src 'none'; base-uri 'self'; " +
+	"form-action 'self'; frame-ancestors 'none'"
+
+// HeadersConfig configures Headers
+type HeadersConfig struct {
+	//ContentSecurityPolicy is omitted if empty
+	ContentSecurityPolicy string
+	//HSTSMaxAge is the max-age of the Strict-Transport-Security header. The header is omitted if zero
+	HSTSMaxAge time.Duration
+}
+
+// Headers sets security headers on all responses. Strict-Transport-Security is only sent for requests that reached
+// us via https, either directly or through a proxy that sets X-Forwarded-Proto
+func Headers(cfg HeadersConfig) func(http.Handler) http.Handler {
+	hsts := fmt.Sprintf("max-age=%d; includeSubDomains", int64(cfg.HSTSMaxAge.Seconds()))
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			h := w.Header()
+			h.Set("X-Frame-Options", "DENY")
+			h.Set("X-Content-Type-Options", "nosniff")
+			h.Set("Referrer-Policy", "strict-origin-when-cross-origin")
+			if cfg.ContentSecurityPolicy != "" {
+				h.Set("Content-Security-Policy", cfg.ContentSecurityPolicy)
+			}
+			if cfg.HSTSMaxAge > 0 && (r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https") {
+				h.Set("Strict-Transport-Security", hsts)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
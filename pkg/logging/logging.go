@@ -0,0 +1,77 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+type contextKey struct{}
+
+// ParseLevel parses one of "debug", "info", "warn" or "error". An empty string means "info"
+func ParseLevel(level string) (slog.Level, error) {
+	var l slog.Level
+	if level == "" {
+		return slog.LevelInfo, nil
+	}
+	if err := l.UnmarshalText([]byte(level)); err != nil {
+		return 0, fmt.Errorf("invalid log level %v : %v", level, err)
+	}
+	return l, nil
+}
+
+// NewLogger creates a logger that writes records with at least the given level to w. format is either FormatText or
+// FormatJSON. Secrets are redacted, see redactAttr
+func NewLogger(w io.Writer, format string, level slog.Leveler) (*slog.Logger, error) {
+	opts := &slog.HandlerOptions{
+		AddSource:   true,
+		Level:       level,
+		ReplaceAttr: redactAttr,
+	}
+	switch strings.ToLower(format) {
+	case "", FormatText:
+		return slog.New(slog.NewTextHandler(w, opts)), nil
+	case FormatJSON:
+		return slog.New(slog.NewJSONHandler(w, opts)), nil
+	default:
+		return nil, fmt.Errorf("unknown log format %v", format)
+	}
+}
+
+// WithLogger returns a copy of ctx that carries logger
+func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, logger)
+}
+
+// WithAttrs returns a copy of ctx whose logger has the given attributes, see slog.Logger.With
+func WithAttrs(ctx context.Context, args ...any) context.Context {
+	return WithLogger(ctx, loggerFromContext(ctx).With(args...))
+}
+
+// FromContext returns the request scoped logger of ctx or slog.Default if ctx does not carry one.
+// Within a chi router, the logger also carries the route pattern
+func FromContext(ctx context.Context) *slog.Logger {
+	logger := loggerFromContext(ctx)
+	if rctx := chi.RouteContext(ctx); rctx != nil {
+		if route := rctx.RoutePattern(); route != "" {
+			logger = logger.With("route", route)
+		}
+	}
+	return logger
+}
+
+func loggerFromContext(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(contextKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
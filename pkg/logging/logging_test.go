@@ -0,0 +1,108 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+)
+
+// captureLogs replaces the default logger for the duration of the test and returns its output
+func captureLogs(t *testing.T, level slog.Level) *bytes.Buffer {
+	buf := &bytes.Buffer{}
+	logger, err := NewLogger(buf, FormatJSON, level)
+	require.NoError(t, err)
+	previous := slog.Default()
+	slog.SetDefault(logger)
+	t.Cleanup(func() { slog.SetDefault(previous) })
+	return buf
+}
+
+// records parses the json log lines in buf
+func records(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	for _, line := range bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n")) {
+		record := make(map[string]interface{})
+		require.NoError(t, json.Unmarshal(line, &record))
+		result = append(result, record)
+	}
+	return result
+}
+
+func TestParseLevel(t *testing.T) {
+	level, err := ParseLevel("")
+	require.NoError(t, err)
+	require.Equal(t, slog.LevelInfo, level)
+
+	level, err = ParseLevel("DEBUG")
+	require.NoError(t, err)
+	require.Equal(t, slog.LevelDebug, level)
+
+	_, err = ParseLevel("verbose")
+	require.Error(t, err)
+}
+
+func TestRedaction(t *testing.T) {
+	buf := captureLogs(t, slog.LevelInfo)
+
+	header := http.Header{}
+	header.Set("X-API-KEY", "bot-secret")
+	header.Set("Cookie", "its_tasty_session=session-secret")
+	header.Set("Accept", "application/json")
+	slog.With("accessToken", "token-secret").Info("test",
+		"apiKey", "key-secret", "client_secret", "client-secret", "headers", header, "dishID", 42,
+		slog.Group("db", "password", "db-secret"))
+
+	out := buf.String()
+	for _, secret := range []string{"bot-secret", "session-secret", "token-secret", "key-secret", "client-secret", "db-secret"} {
+		require.NotContains(t, out, secret)
+	}
+	record := records(t, buf)[0]
+	require.Equal(t, Redacted, record["accessToken"])
+	require.Equal(t, Redacted, record["apiKey"])
+	require.Equal(t, map[string]interface{}{"password": Redacted}, record["db"])
+	require.Equal(t, float64(42), record["dishID"])
+	require.Equal(t, []interface{}{"application/json"}, record["headers"].(map[string]interface{})["Accept"])
+}
+
+func TestRequestMiddleware(t *testing.T) {
+	buf := captureLogs(t, slog.LevelDebug)
+
+	router := chi.NewRouter()
+	router.Use(RequestMiddleware)
+	router.Get("/dishes/{dishID}", func(w http.ResponseWriter, r *http.Request) {
+		ctx := WithAttrs(r.Context(), "identity", "bot")
+		FromContext(ctx).Debug("handler called")
+	})
+
+	//client supplied request id
+	req := httptest.NewRequest(http.MethodGet, "/dishes/42", nil)
+	req.Header.Set(HeaderRequestID, "test-request-1")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	require.Equal(t, "test-request-1", rec.Header().Get(HeaderRequestID))
+
+	logs := records(t, buf)
+	require.Len(t, logs, 2)
+	require.Equal(t, "handler called", logs[0]["msg"])
+	require.Equal(t, "test-request-1", logs[0]["requestID"])
+	require.Equal(t, "bot", logs[0]["identity"])
+	require.Equal(t, "/dishes/{dishID}", logs[0]["route"])
+	require.Equal(t, "request served", logs[1]["msg"])
+	require.Equal(t, float64(http.StatusOK), logs[1]["status"])
+
+	//invalid request ids are replaced
+	buf.Reset()
+	req = httptest.NewRequest(http.MethodGet, "/dishes/42", nil)
+	req.Header.Set(HeaderRequestID, "bad id\nwith newline")
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	requestID := rec.Header().Get(HeaderRequestID)
+	require.Regexp(t, "^[0-9a-f]{16}$", requestID)
+	require.Equal(t, requestID, records(t, buf)[0]["requestID"])
+}
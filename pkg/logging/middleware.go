@@ -0,0 +1,60 @@
+package logging
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// HeaderRequestID is read from the request and set on the response
+const HeaderRequestID = "X-Request-ID"
+
+// validRequestID restricts request ids passed by clients, as they end up in our logs
+var validRequestID = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,64}$`)
+
+func newRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+// RequestMiddleware adds a request scoped logger with a request id to the context and logs each request once it has
+// been served. If the client sends a valid HeaderRequestID, its value is used as request id. Handlers further down the
+// chain may add the identity of the caller with WithAttrs
+func RequestMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		requestID := r.Header.Get(HeaderRequestID)
+		if !validRequestID.MatchString(requestID) {
+			requestID = newRequestID()
+		}
+		w.Header().Set(HeaderRequestID, requestID)
+
+		logger := slog.Default().With("requestID", requestID, "method", r.Method, "path", r.URL.Path)
+		if span := trace.SpanContextFromContext(r.Context()); span.IsValid() {
+			logger = logger.With("traceID", span.TraceID().String())
+		}
+		ctx := WithLogger(r.Context(), logger)
+
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r.WithContext(ctx))
+
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		level := slog.LevelInfo
+		if status >= http.StatusInternalServerError {
+			level = slog.LevelError
+		}
+		FromContext(ctx).Log(ctx, level, "request served", "status", status, "duration", time.Since(start))
+	})
+}
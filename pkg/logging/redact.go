@@ -0,0 +1,48 @@
+package logging
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+// Redacted replaces the value of sensitive attributes
+const Redacted = "[REDACTED]"
+
+// sensitiveKeyParts are matched against the lower case attribute keys and header names with "-" and "_" removed
+var sensitiveKeyParts = []string{"token", "apikey", "password", "passwd", "secret", "authorization", "cookie"}
+
+// IsSensitiveKey reports whether values stored under key are secrets and must not be logged or printed
+func IsSensitiveKey(key string) bool {
+	key = strings.NewReplacer("-", "", "_", "").Replace(strings.ToLower(key))
+	for _, v := range sensitiveKeyParts {
+		if strings.Contains(key, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactAttr is used as slog.HandlerOptions.ReplaceAttr for all loggers. This way, secrets are redacted no matter
+// which package logs them, as long as they are passed as attribute. Besides attributes with sensitive keys, it
+// redacts sensitive headers of http.Header values
+func redactAttr(_ []string, a slog.Attr) slog.Attr {
+	if IsSensitiveKey(a.Key) {
+		return slog.String(a.Key, Redacted)
+	}
+	if a.Value.Kind() != slog.KindAny {
+		return a
+	}
+	header, ok := a.Value.Any().(http.Header)
+	if !ok {
+		return a
+	}
+	redactedHeader := make(http.Header, len(header))
+	for name, values := range header {
+		if IsSensitiveKey(name) {
+			values = []string{Redacted}
+		}
+		redactedHeader[name] = values
+	}
+	return slog.Any(a.Key, redactedHeader)
+}
@@ -2,12 +2,14 @@ package oidcAuth
 
 import (
 	"context"
+	"crypto/subtle"
 	"fmt"
 	"github.com/coreos/go-oidc"
 	"golang.org/x/oauth2"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 )
 
@@ -20,9 +22,14 @@ type Authenticator interface {
 	// under the given ctx. If successful, the refreshed tokens are again placed in the session. Does not clear
 	// the session on errors
 	Refresh(ctx context.Context) error
+	// CheckProvider returns an error if the oidc provider cannot be reached
+	CheckProvider(ctx context.Context) error
 }
 
 const sessionKeyState = "oidcAuthState"
+const sessionKeyNonce = "oidcAuthNonce"
+const sessionKeyPKCEVerifier = "oidcAuthPKCEVerifier"
+const sessionKeyIDToken = "oidcAuthIDToken"
 const sessionKeyAccessToken = "oidcAuthAccessToken"
 const sessionKeyExpiry = "oidcAuthExpiry"
 const sessionKeyRefreshToken = "oidcAuthRefreshToken"
@@ -42,6 +49,10 @@ type SessionStorage interface {
 
 type UserProfile struct {
 	Email string `json:"email,omitempty"`
+	//Subject identifies the user at the oidc provider
+	Subject string `json:"sub,omitempty"`
+	//ProviderSessionID is the session of the user at the oidc provider, if the provider supports back-channel logout
+	ProviderSessionID string `json:"sid,omitempty"`
 }
 
 type DefaultAuthenticator struct {
@@ -53,6 +64,8 @@ type DefaultAuthenticator struct {
 
 	defaultURLAfterLogin string
 	urlAfterLogout       string
+	//endSessionURL is the end_session_endpoint of the provider for RP-initiated logout. Empty if not supported
+	endSessionURL string
 
 	callbackURL url.URL
 }
@@ -63,7 +76,7 @@ func NewDefaultAuthenticator(providerURL, clientID, clientSecret, callbackURL, d
 
 	provider, err := oidc.NewProvider(ctx, providerURL)
 	if err != nil {
-		log.Printf("failed to get provider %v: %v", providerURL, err)
+		slog.Error("failed to get oidc provider", "providerURL", providerURL, "err", err)
 		return nil, err
 	}
 
@@ -80,6 +93,13 @@ func NewDefaultAuthenticator(providerURL, clientID, clientSecret, callbackURL, d
 		return nil, fmt.Errorf("callbackURL param does not contain valid URL : %v", err)
 	}
 
+	var discovery struct {
+		EndSessionEndpoint string `json:"end_session_endpoint"`
+	}
+	if err := provider.Claims(&discovery); err != nil {
+		return nil, fmt.Errorf("failed to parse discovery document : %v", err)
+	}
+
 	return &DefaultAuthenticator{
 		Provider:             provider,
 		Config:               conf,
@@ -88,20 +108,26 @@ func NewDefaultAuthenticator(providerURL, clientID, clientSecret, callbackURL, d
 		session:              storage,
 		defaultURLAfterLogin: defaultURLAfterLogin,
 		urlAfterLogout:       urlAfterLogout,
+		endSessionURL:        discovery.EndSessionEndpoint,
 		callbackURL:          *cbURL,
 	}, nil
 }
 
-func (da *DefaultAuthenticator) verifyTokenAndStoreInSession(ctx context.Context, token *oauth2.Token) error {
+// verifyTokenAndStoreInSession verifies the id token of token and stores the tokens as well as the profile of the
+// user in the session. If nonce is not empty, the id token must contain it
+func (da *DefaultAuthenticator) verifyTokenAndStoreInSession(ctx context.Context, token *oauth2.Token, nonce string) error {
 	rawIDToken, ok := token.Extra("id_token").(string)
 	if !ok {
 		return fmt.Errorf("no id_token field in oauth2 token")
 	}
 
-	idToken, err := da.Verify(context.TODO(), rawIDToken)
+	idToken, err := da.Verify(ctx, rawIDToken)
 	if err != nil {
 		return fmt.Errorf("failed to verify ID token : %v", err)
 	}
+	if nonce != "" && subtle.ConstantTimeCompare([]byte(idToken.Nonce), []byte(nonce)) == 0 {
+		return fmt.Errorf("nonce of ID token does not match the one of the login request")
+	}
 
 	// Getting  the userInfo
 
@@ -123,7 +149,15 @@ func (da *DefaultAuthenticator) verifyTokenAndStoreInSession(ctx context.Context
 		return fmt.Errorf("rejecting %+v because email is not verified", claims)
 	}
 
-	profile := UserProfile{Email: email}
+	//refreshed id tokens may omit the session of the provider
+	providerSessionID, _ := claims["sid"].(string)
+	if providerSessionID == "" {
+		if previous, err := da.session.GetProfile(ctx, SessionKeyProfile); err == nil {
+			providerSessionID = previous.ProviderSessionID
+		}
+	}
+
+	profile := UserProfile{Email: email, Subject: idToken.Subject, ProviderSessionID: providerSessionID}
 
 	if err := da.session.StoreString(ctx, sessionKeyAccessToken, token.AccessToken); err != nil {
 		return fmt.Errorf("failed to store %v to session : %v", sessionKeyAccessToken, err)
@@ -137,6 +171,11 @@ func (da *DefaultAuthenticator) verifyTokenAndStoreInSession(ctx context.Context
 		return fmt.Errorf("failed to store %v to session : %v", sessionKeyRefreshToken, err)
 	}
 
+	//the id token is sent as hint on logout
+	if err := da.session.StoreString(ctx, sessionKeyIDToken, rawIDToken); err != nil {
+		return fmt.Errorf("failed to store %v to session : %v", sessionKeyIDToken, err)
+	}
+
 	if err := da.session.StoreProfile(ctx, SessionKeyProfile, profile); err != nil {
 		return fmt.Errorf("failed to store %v to session : %v", SessionKeyProfile, err)
 	}
@@ -159,20 +198,61 @@ func (da *DefaultAuthenticator) Refresh(ctx context.Context) error {
 	}
 
 	//Success! Store new data in session
-	if err := da.verifyTokenAndStoreInSession(ctx, token); err != nil {
+	if err := da.verifyTokenAndStoreInSession(ctx, token, ""); err != nil {
 		return fmt.Errorf("verifyTokenAndStoreInSession failed : %v", err)
 	}
 
 	return nil
 }
 
-func (da *DefaultAuthenticator) GetLoginURL(state string) string {
-	return da.Config.AuthCodeURL(state)
+// CheckProvider fetches the discovery document of the oidc provider
+func (da *DefaultAuthenticator) CheckProvider(ctx context.Context) error {
+	discoveryURL := strings.TrimSuffix(da.ProviderURL, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request : %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach oidc provider : %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("oidc provider returned unexpected status code %v", resp.StatusCode)
+	}
+	return nil
+}
 
+// GetLoginURL returns the url of the login page of the provider. The code challenge is derived from pkceVerifier
+func (da *DefaultAuthenticator) GetLoginURL(state, nonce, pkceVerifier string) string {
+	return da.Config.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(pkceVerifier))
 }
-func (da *DefaultAuthenticator) Exchange(ctx context.Context, code string) (*oauth2.Token, error) {
-	return da.Config.Exchange(ctx, code)
+
+// Exchange trades the code of the login callback for the tokens of the user
+func (da *DefaultAuthenticator) Exchange(ctx context.Context, code, pkceVerifier string) (*oauth2.Token, error) {
+	return da.Config.Exchange(ctx, code, oauth2.VerifierOption(pkceVerifier))
 }
+
+// GetLogoutURL returns the url that ends the session at the provider or an empty string if the provider does not
+// support RP-initiated logout
+func (da *DefaultAuthenticator) GetLogoutURL(idTokenHint string) string {
+	if da.endSessionURL == "" {
+		return ""
+	}
+	u, err := url.Parse(da.endSessionURL)
+	if err != nil {
+		return ""
+	}
+	q := u.Query()
+	q.Set("client_id", da.Config.ClientID)
+	q.Set("post_logout_redirect_uri", da.urlAfterLogout)
+	if idTokenHint != "" {
+		q.Set("id_token_hint", idTokenHint)
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
 func (da *DefaultAuthenticator) Verify(ctx context.Context, rawIDToken string) (*oidc.IDToken, error) {
 	oidcConfig := &oidc.Config{
 		ClientID: da.Config.ClientID,
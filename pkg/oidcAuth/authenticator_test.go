@@ -0,0 +1,206 @@
+package oidcAuth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mapSessionStorage is a SessionStorage that holds a single session, like the cookie jar of one browser
+type mapSessionStorage struct {
+	values map[string]any
+}
+
+func newMapSessionStorage() *mapSessionStorage {
+	return &mapSessionStorage{values: make(map[string]any)}
+}
+
+func (m *mapSessionStorage) StoreString(_ context.Context, key, value string) error {
+	m.values[key] = value
+	return nil
+}
+
+func (m *mapSessionStorage) GetString(_ context.Context, key string) (string, error) {
+	v, _ := m.values[key].(string)
+	return v, nil
+}
+
+func (m *mapSessionStorage) StoreTime(_ context.Context, key string, value time.Time) error {
+	m.values[key] = value
+	return nil
+}
+
+func (m *mapSessionStorage) GetTime(_ context.Context, key string) (time.Time, error) {
+	v, _ := m.values[key].(time.Time)
+	return v, nil
+}
+
+func (m *mapSessionStorage) StoreProfile(_ context.Context, key string, profile UserProfile) error {
+	m.values[key] = profile
+	return nil
+}
+
+func (m *mapSessionStorage) GetProfile(_ context.Context, key string) (UserProfile, error) {
+	v, _ := m.values[key].(UserProfile)
+	return v, nil
+}
+
+func (m *mapSessionStorage) ClearEntry(_ context.Context, key string) error {
+	delete(m.values, key)
+	return nil
+}
+
+func (m *mapSessionStorage) Destroy(_ context.Context) error {
+	m.values = make(map[string]any)
+	return nil
+}
+
+type authenticatorTestEnv struct {
+	provider *TestProvider
+	app      *httptest.Server
+	auth     *DefaultAuthenticator
+	storage  *mapSessionStorage
+	//client does not follow redirects, to inspect each step of the flow
+	client *http.Client
+}
+
+func newAuthenticatorTestEnv(t *testing.T) *authenticatorTestEnv {
+	provider, err := NewTestProvider()
+	require.NoError(t, err)
+	t.Cleanup(provider.Close)
+	provider.SetUser("user@test.mail")
+
+	env := &authenticatorTestEnv{
+		provider: provider,
+		storage:  newMapSessionStorage(),
+		client: &http.Client{CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		}},
+	}
+	env.app = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/login":
+			env.auth.LoginHandler(w, r)
+		case "/callback":
+			env.auth.CallbackHandler(w, r)
+		case "/logout":
+			env.auth.LogoutHandler(w, r)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	t.Cleanup(env.app.Close)
+
+	env.auth, err = NewDefaultAuthenticator(provider.URL(), "itsTasty", "secret", env.app.URL+"/callback",
+		env.app.URL+"/home", env.app.URL+"/bye", env.storage)
+	require.NoError(t, err)
+	return env
+}
+
+// get requests rawURL and returns the status code and the redirect location, if any
+func (env *authenticatorTestEnv) get(t *testing.T, rawURL string) (int, *url.URL) {
+	resp, err := env.client.Get(rawURL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	location, err := resp.Location()
+	if err != nil {
+		return resp.StatusCode, nil
+	}
+	return resp.StatusCode, location
+}
+
+// login runs the flow up to the provider redirecting back and returns the callback url
+func (env *authenticatorTestEnv) login(t *testing.T) *url.URL {
+	status, authorizeURL := env.get(t, env.app.URL+"/login?redirectTo="+url.QueryEscape(env.app.URL+"/meals"))
+	require.Equal(t, http.StatusTemporaryRedirect, status)
+	require.Equal(t, env.provider.URL()+"/authorize", authorizeURL.Scheme+"://"+authorizeURL.Host+authorizeURL.Path)
+
+	q := authorizeURL.Query()
+	require.Equal(t, "S256", q.Get("code_challenge_method"))
+	require.NotEmpty(t, q.Get("code_challenge"))
+	require.NotEmpty(t, q.Get("nonce"))
+	require.Equal(t, env.storage.values[sessionKeyNonce], q.Get("nonce"))
+	require.Equal(t, env.storage.values[sessionKeyState], q.Get("state"))
+
+	status, callbackURL := env.get(t, authorizeURL.String())
+	require.Equal(t, http.StatusFound, status)
+	require.NotEmpty(t, callbackURL.Query().Get("code"))
+	return callbackURL
+}
+
+func TestDefaultAuthenticator(t *testing.T) {
+	t.Run("login, refresh and logout", func(t *testing.T) {
+		env := newAuthenticatorTestEnv(t)
+		callbackURL := env.login(t)
+
+		status, location := env.get(t, callbackURL.String())
+		require.Equal(t, http.StatusSeeOther, status)
+		require.Equal(t, env.app.URL+"/meals", location.String())
+		profile, err := env.storage.GetProfile(context.Background(), SessionKeyProfile)
+		require.NoError(t, err)
+		require.Equal(t, "user@test.mail", profile.Email)
+		require.Equal(t, "user@test.mail", profile.Subject)
+		require.NotEmpty(t, profile.ProviderSessionID)
+		for _, key := range []string{sessionKeyState, sessionKeyNonce, sessionKeyPKCEVerifier} {
+			require.NotContains(t, env.storage.values, key)
+		}
+
+		//the callback cannot be replayed
+		status, _ = env.get(t, callbackURL.String())
+		require.Equal(t, http.StatusBadRequest, status)
+
+		require.NoError(t, env.auth.Refresh(context.Background()))
+		refreshed, err := env.storage.GetProfile(context.Background(), SessionKeyProfile)
+		require.NoError(t, err)
+		require.Equal(t, profile, refreshed)
+
+		idToken := env.storage.values[sessionKeyIDToken]
+		status, logoutURL := env.get(t, env.app.URL+"/logout")
+		require.Equal(t, http.StatusTemporaryRedirect, status)
+		require.Equal(t, env.provider.URL()+"/logout", logoutURL.Scheme+"://"+logoutURL.Host+logoutURL.Path)
+		require.Equal(t, "itsTasty", logoutURL.Query().Get("client_id"))
+		require.Equal(t, idToken, logoutURL.Query().Get("id_token_hint"))
+		require.Empty(t, env.storage.values)
+
+		status, location = env.get(t, logoutURL.String())
+		require.Equal(t, http.StatusFound, status)
+		require.Equal(t, env.app.URL+"/bye", location.String())
+	})
+
+	t.Run("invalid state", func(t *testing.T) {
+		env := newAuthenticatorTestEnv(t)
+		callbackURL := env.login(t)
+		q := callbackURL.Query()
+		q.Set("state", "forged")
+		callbackURL.RawQuery = q.Encode()
+
+		status, _ := env.get(t, callbackURL.String())
+		require.Equal(t, http.StatusBadRequest, status)
+		require.NotContains(t, env.storage.values, SessionKeyProfile)
+	})
+
+	t.Run("wrong pkce verifier", func(t *testing.T) {
+		env := newAuthenticatorTestEnv(t)
+		callbackURL := env.login(t)
+		env.storage.values[sessionKeyPKCEVerifier] = "forged-verifier-forged-verifier-forged-verifier"
+
+		status, _ := env.get(t, callbackURL.String())
+		require.Equal(t, http.StatusInternalServerError, status)
+		require.NotContains(t, env.storage.values, SessionKeyProfile)
+	})
+
+	t.Run("wrong nonce", func(t *testing.T) {
+		env := newAuthenticatorTestEnv(t)
+		callbackURL := env.login(t)
+		env.storage.values[sessionKeyNonce] = "forged"
+
+		status, _ := env.get(t, callbackURL.String())
+		require.Equal(t, http.StatusInternalServerError, status)
+		require.NotContains(t, env.storage.values, SessionKeyProfile)
+	})
+}
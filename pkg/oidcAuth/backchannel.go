@@ -0,0 +1,63 @@
+package oidcAuth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/coreos/go-oidc"
+)
+
+// backChannelLogoutEvent must be a member of the events claim of logout tokens,
+// see https://openid.net/specs/openid-connect-backchannel-1_0.html#LogoutToken
+const backChannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+
+// LogoutToken names the sessions that the oidc provider ended. At least one of the fields is set
+type LogoutToken struct {
+	//Subject is the user whose sessions ended, see UserProfile.Subject
+	Subject string
+	//SessionID is the session that ended, see UserProfile.ProviderSessionID
+	SessionID string
+}
+
+// LogoutTokenVerifier checks the logout tokens the oidc provider sends to the back-channel logout endpoint
+type LogoutTokenVerifier struct {
+	verifier *oidc.IDTokenVerifier
+}
+
+// NewLogoutTokenVerifier fetches the discovery document of the provider. Tokens must be issued for clientID
+func NewLogoutTokenVerifier(providerURL, clientID string) (*LogoutTokenVerifier, error) {
+	verifier, err := newProviderVerifier(providerURL, clientID)
+	if err != nil {
+		return nil, err
+	}
+	return &LogoutTokenVerifier{verifier: verifier}, nil
+}
+
+// Verify checks signature, issuer, audience and expiry of the logout token as well as the claims required for
+// logout tokens
+func (v *LogoutTokenVerifier) Verify(ctx context.Context, rawToken string) (LogoutToken, error) {
+	token, err := v.verifier.Verify(ctx, rawToken)
+	if err != nil {
+		return LogoutToken{}, fmt.Errorf("failed to verify token : %v", err)
+	}
+
+	var claims struct {
+		SessionID string                     `json:"sid"`
+		Events    map[string]json.RawMessage `json:"events"`
+	}
+	if err := token.Claims(&claims); err != nil {
+		return LogoutToken{}, fmt.Errorf("failed to parse claims : %v", err)
+	}
+	if _, ok := claims.Events[backChannelLogoutEvent]; !ok {
+		return LogoutToken{}, fmt.Errorf("token has no back-channel logout event")
+	}
+	//the nonce is forbidden to keep id tokens from being used as logout tokens
+	if token.Nonce != "" {
+		return LogoutToken{}, fmt.Errorf("logout token must not have a nonce")
+	}
+	if token.Subject == "" && claims.SessionID == "" {
+		return LogoutToken{}, fmt.Errorf("logout token has neither sub nor sid claim")
+	}
+
+	return LogoutToken{Subject: token.Subject, SessionID: claims.SessionID}, nil
+}
@@ -0,0 +1,66 @@
+package oidcAuth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogoutTokenVerifier(t *testing.T) {
+	provider, err := NewTestProvider()
+	require.NoError(t, err)
+	defer provider.Close()
+
+	verifier, err := NewLogoutTokenVerifier(provider.URL(), "itsTasty")
+	require.NoError(t, err)
+
+	token, err := provider.NewLogoutToken("user@test.mail", "providerSession", "itsTasty")
+	require.NoError(t, err)
+	got, err := verifier.Verify(context.Background(), token)
+	require.NoError(t, err)
+	require.Equal(t, LogoutToken{Subject: "user@test.mail", SessionID: "providerSession"}, got)
+
+	logoutClaims := func(modify func(c map[string]any)) map[string]any {
+		c := map[string]any{
+			"iss":    provider.URL(),
+			"sub":    "user@test.mail",
+			"aud":    "itsTasty",
+			"iat":    time.Now().Unix(),
+			"exp":    time.Now().Add(time.Minute).Unix(),
+			"events": map[string]any{backChannelLogoutEvent: map[string]any{}},
+		}
+		modify(c)
+		return c
+	}
+
+	tests := []struct {
+		name  string
+		token func() (string, error)
+	}{
+		{name: "wrong audience", token: func() (string, error) {
+			return provider.NewLogoutToken("user@test.mail", "", "otherClient")
+		}},
+		{name: "no subject or session", token: func() (string, error) {
+			return provider.NewLogoutToken("", "", "itsTasty")
+		}},
+		{name: "id token", token: func() (string, error) {
+			return provider.NewToken("user@test.mail", "itsTasty", time.Minute)
+		}},
+		{name: "nonce", token: func() (string, error) {
+			return provider.SignToken(logoutClaims(func(c map[string]any) { c["nonce"] = "abc" }))
+		}},
+		{name: "expired", token: func() (string, error) {
+			return provider.SignToken(logoutClaims(func(c map[string]any) { c["exp"] = time.Now().Add(-time.Minute).Unix() }))
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := tt.token()
+			require.NoError(t, err)
+			_, err = verifier.Verify(context.Background(), token)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package oidcAuth
+
+import (
+	"context"
+	"fmt"
+	"github.com/coreos/go-oidc"
+)
+
+// BearerVerifier checks tokens that clients obtained directly from the oidc provider, e.g. the mobile client
+type BearerVerifier struct {
+	verifier *oidc.IDTokenVerifier
+}
+
+// NewBearerVerifier fetches the discovery document of the provider. Tokens are verified against the keys of the
+// provider and must be issued for audience
+func NewBearerVerifier(providerURL, audience string) (*BearerVerifier, error) {
+	verifier, err := newProviderVerifier(providerURL, audience)
+	if err != nil {
+		return nil, err
+	}
+	return &BearerVerifier{verifier: verifier}, nil
+}
+
+// newProviderVerifier returns a verifier for JWTs signed by the provider and issued for audience
+func newProviderVerifier(providerURL, audience string) (*oidc.IDTokenVerifier, error) {
+	//the provider keeps the context to fetch rotated keys later on
+	provider, err := oidc.NewProvider(context.Background(), providerURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get oidc provider : %v", err)
+	}
+	return provider.Verifier(&oidc.Config{ClientID: audience}), nil
+}
+
+// Verify checks signature, issuer, audience and expiry of the JWT as well as the verified email and returns the
+// profile of the user it was issued to
+func (b *BearerVerifier) Verify(ctx context.Context, rawToken string) (UserProfile, error) {
+	token, err := b.verifier.Verify(ctx, rawToken)
+	if err != nil {
+		return UserProfile{}, fmt.Errorf("failed to verify token : %v", err)
+	}
+
+	var claims struct {
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+	}
+	if err := token.Claims(&claims); err != nil {
+		return UserProfile{}, fmt.Errorf("failed to parse claims : %v", err)
+	}
+	if claims.Email == "" {
+		return UserProfile{}, fmt.Errorf("token has no email claim")
+	}
+	//like the login, tokens without the email_verified claim are rejected
+	if !claims.EmailVerified {
+		return UserProfile{}, fmt.Errorf("rejecting %v because email is not verified", claims.Email)
+	}
+
+	return UserProfile{Email: claims.Email}, nil
+}
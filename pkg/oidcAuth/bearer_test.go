@@ -0,0 +1,78 @@
+package oidcAuth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBearerVerifier(t *testing.T) {
+	provider, err := NewTestProvider()
+	require.NoError(t, err)
+	defer provider.Close()
+	otherProvider, err := NewTestProvider()
+	require.NoError(t, err)
+	defer otherProvider.Close()
+
+	verifier, err := NewBearerVerifier(provider.URL(), "itsTasty")
+	require.NoError(t, err)
+
+	valid, err := provider.NewToken("user@test.mail", "itsTasty", time.Minute)
+	require.NoError(t, err)
+	profile, err := verifier.Verify(context.Background(), valid)
+	require.NoError(t, err)
+	require.Equal(t, UserProfile{Email: "user@test.mail"}, profile)
+
+	claims := func(modify func(c map[string]any)) map[string]any {
+		c := map[string]any{
+			"iss":            provider.URL(),
+			"sub":            "user@test.mail",
+			"aud":            "itsTasty",
+			"exp":            time.Now().Add(time.Minute).Unix(),
+			"email":          "user@test.mail",
+			"email_verified": true,
+		}
+		modify(c)
+		return c
+	}
+
+	tests := []struct {
+		name  string
+		token func() (string, error)
+	}{
+		{name: "wrong audience", token: func() (string, error) {
+			return provider.NewToken("user@test.mail", "otherClient", time.Minute)
+		}},
+		{name: "expired", token: func() (string, error) {
+			return provider.NewToken("user@test.mail", "itsTasty", -time.Minute)
+		}},
+		{name: "other signing key", token: func() (string, error) {
+			return otherProvider.SignToken(claims(func(c map[string]any) {}))
+		}},
+		{name: "other issuer", token: func() (string, error) {
+			return provider.SignToken(claims(func(c map[string]any) { c["iss"] = otherProvider.URL() }))
+		}},
+		{name: "no email", token: func() (string, error) {
+			return provider.SignToken(claims(func(c map[string]any) { delete(c, "email") }))
+		}},
+		{name: "unverified email", token: func() (string, error) {
+			return provider.SignToken(claims(func(c map[string]any) { c["email_verified"] = false }))
+		}},
+		{name: "no email_verified", token: func() (string, error) {
+			return provider.SignToken(claims(func(c map[string]any) { delete(c, "email_verified") }))
+		}},
+		{name: "malformed", token: func() (string, error) {
+			return "not.a.jwt", nil
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := tt.token()
+			require.NoError(t, err)
+			_, err = verifier.Verify(context.Background(), token)
+			require.Error(t, err)
+		})
+	}
+}
@@ -1,10 +1,10 @@
 package oidcAuth
 
 import (
-	"context"
 	"crypto/rand"
 	"encoding/base64"
-	"log"
+	"golang.org/x/oauth2"
+	"itsTasty/pkg/logging"
 	"net/http"
 	"net/url"
 )
@@ -12,44 +12,69 @@ import (
 // CallbackHandler handles the oidc callback, establishing a session, and finally redirects back to the calling application
 // The redirect location is expected to be placed into the session key `sessionKeyRedirectTarget` by the login handler
 func (da *DefaultAuthenticator) CallbackHandler(w http.ResponseWriter, r *http.Request) {
-	log.Printf("CallbackHandler was called")
+	logging.FromContext(r.Context()).Debug("CallbackHandler was called")
 
 	stateFromSession, err := da.session.GetString(r.Context(), sessionKeyState)
 	if err != nil {
-		log.Printf("failed to get key %v from session : %v", sessionKeyState, err)
+		logging.FromContext(r.Context()).Error("failed to get state from session", "err", err)
 		http.Error(w, "", http.StatusInternalServerError)
 		return
 	}
-	if r.URL.Query().Get("state") != stateFromSession {
+	if stateFromSession == "" || r.URL.Query().Get("state") != stateFromSession {
 		http.Error(w, "", http.StatusBadRequest)
-		log.Printf("Invalid state parameter")
+		logging.FromContext(r.Context()).Warn("invalid state parameter")
 		return
 	}
 
-	log.Printf("state check passed, doing exchange...")
+	nonce, err := da.session.GetString(r.Context(), sessionKeyNonce)
+	if err != nil {
+		logging.FromContext(r.Context()).Error("failed to get nonce from session", "err", err)
+		http.Error(w, "", http.StatusInternalServerError)
+		return
+	}
+	pkceVerifier, err := da.session.GetString(r.Context(), sessionKeyPKCEVerifier)
+	if err != nil {
+		logging.FromContext(r.Context()).Error("failed to get pkce verifier from session", "err", err)
+		http.Error(w, "", http.StatusInternalServerError)
+		return
+	}
+	if nonce == "" || pkceVerifier == "" {
+		http.Error(w, "", http.StatusBadRequest)
+		logging.FromContext(r.Context()).Warn("no pending login in session")
+		return
+	}
+
+	//the values of a login attempt may only be used once
+	for _, key := range []string{sessionKeyState, sessionKeyNonce, sessionKeyPKCEVerifier} {
+		if err := da.session.ClearEntry(r.Context(), key); err != nil {
+			logging.FromContext(r.Context()).Warn("failed to clear login data from session", "key", key, "err", err)
+		}
+	}
 
-	token, err := da.Exchange(context.TODO(), r.URL.Query().Get("code"))
+	logging.FromContext(r.Context()).Debug("state check passed, doing exchange...")
+
+	token, err := da.Exchange(r.Context(), r.URL.Query().Get("code"), pkceVerifier)
 	if err != nil {
-		log.Printf("Exchange with login server failed: %v", err)
+		logging.FromContext(r.Context()).Error("exchange with login server failed", "err", err)
 		http.Error(w, "", http.StatusInternalServerError)
 		return
 	}
 
-	log.Printf("Done\n extracting token...")
+	logging.FromContext(r.Context()).Debug("exchange done, extracting token...")
 
 	//fetch redirect target that was requested during login. we verified that it is within our page
 	redirectTo, err := da.session.GetString(r.Context(), sessionKeyRedirectTarget)
 	if err != nil {
 		http.Error(w, "", http.StatusInternalServerError)
-		log.Printf("failed to retrieve redirect target from session : %v", err)
+		logging.FromContext(r.Context()).Error("failed to retrieve redirect target from session", "err", err)
 		return
 	}
 	if err := da.session.ClearEntry(r.Context(), sessionKeyRedirectTarget); err != nil {
-		log.Printf("failed to clear %v from session : %v", sessionKeyRedirectTarget, err)
+		logging.FromContext(r.Context()).Warn("failed to clear redirect target from session", "err", err)
 	}
 
-	if err := da.verifyTokenAndStoreInSession(r.Context(), token); err != nil {
-		log.Printf("verifyTokenAndStoreInSession failed : %v", err)
+	if err := da.verifyTokenAndStoreInSession(r.Context(), token, nonce); err != nil {
+		logging.FromContext(r.Context()).Error("verifyTokenAndStoreInSession failed", "err", err)
 		http.Error(w, "", http.StatusInternalServerError)
 		return
 	}
@@ -59,24 +84,33 @@ func (da *DefaultAuthenticator) CallbackHandler(w http.ResponseWriter, r *http.R
 
 // LoginHandler initiates the oidc login workflow which will trigger the call to CallbackHandler
 func (da *DefaultAuthenticator) LoginHandler(w http.ResponseWriter, r *http.Request) {
-	log.Printf("LoginHandler was called")
+	logging.FromContext(r.Context()).Debug("LoginHandler was called")
 	// Generate random state
-	b := make([]byte, 32)
-	_, err := rand.Read(b)
+	state, err := randomString()
 	if err != nil {
-		log.Printf("Failed to generate randomness for random state value : %v", err)
+		logging.FromContext(r.Context()).Error("failed to generate randomness for random state value", "err", err)
 		http.Error(w, "", http.StatusInternalServerError)
 		return
 	}
-	state := base64.StdEncoding.EncodeToString(b)
-
-	// Store random state in session, so that we can retrieve it in the callback handler to check if it is equal
-	//to the provided state
-	if err := da.session.StoreString(r.Context(), sessionKeyState, state); err != nil {
+	// Generate random nonce, which the provider places in the id token to bind it to this login attempt
+	nonce, err := randomString()
+	if err != nil {
+		logging.FromContext(r.Context()).Error("failed to generate randomness for random nonce value", "err", err)
 		http.Error(w, "", http.StatusInternalServerError)
-		log.Printf("Failed to save %v in session : %v", sessionKeyState, err)
 		return
 	}
+	// PKCE keeps an intercepted code from being exchanged by anyone else
+	pkceVerifier := oauth2.GenerateVerifier()
+
+	// Store random state in session, so that we can retrieve it in the callback handler to check if it is equal
+	//to the provided state. Nonce and verifier are needed in the callback handler as well
+	for key, value := range map[string]string{sessionKeyState: state, sessionKeyNonce: nonce, sessionKeyPKCEVerifier: pkceVerifier} {
+		if err := da.session.StoreString(r.Context(), key, value); err != nil {
+			http.Error(w, "", http.StatusInternalServerError)
+			logging.FromContext(r.Context()).Error("failed to save login data in session", "key", key, "err", err)
+			return
+		}
+	}
 
 	// Check if the user requested a specific page to be redirected to after login
 	redirectAfterLogin := da.defaultURLAfterLogin
@@ -85,12 +119,12 @@ func (da *DefaultAuthenticator) LoginHandler(w http.ResponseWriter, r *http.Requ
 		u, err := url.Parse(target)
 		if err != nil {
 			http.Error(w, "Invalid redirectTo URL", http.StatusBadRequest)
-			log.Printf("Failed to parse redirectTo URL : %v", err)
+			logging.FromContext(r.Context()).Warn("failed to parse redirectTo URL", "err", err)
 			return
 		}
 		if u.Hostname() != da.callbackURL.Hostname() {
 			http.Error(w, "Invalid redirectTo URL", http.StatusBadRequest)
-			log.Printf("redirectTo was set to \"%v\" which is outside of our page", u.String())
+			logging.FromContext(r.Context()).Warn("redirectTo is outside of our page", "redirectTo", u.String())
 			return
 		}
 		redirectAfterLogin = u.String()
@@ -99,20 +133,40 @@ func (da *DefaultAuthenticator) LoginHandler(w http.ResponseWriter, r *http.Requ
 	//Store redirect after login location in session, to be able to a access it in the redirect handler
 	if err := da.session.StoreString(r.Context(), sessionKeyRedirectTarget, redirectAfterLogin); err != nil {
 		http.Error(w, "", http.StatusInternalServerError)
-		log.Printf("Failed to save value %v for key %v in session : %v", redirectAfterLogin, sessionKeyRedirectTarget, err)
+		logging.FromContext(r.Context()).Error("failed to save redirect target in session", "redirectTo", redirectAfterLogin, "err", err)
 		return
 	}
 
-	http.Redirect(w, r, da.GetLoginURL(state), http.StatusTemporaryRedirect)
+	http.Redirect(w, r, da.GetLoginURL(state, nonce, pkceVerifier), http.StatusTemporaryRedirect)
 }
 
-// LogoutHandler destroys the session and redirects to da.urlAfterLogout
+// LogoutHandler destroys the session. If the provider supports RP-initiated logout, it redirects to the provider
+// to end the session there as well, which redirects back to da.urlAfterLogout. Otherwise, it redirects to
+// da.urlAfterLogout directly
 func (da *DefaultAuthenticator) LogoutHandler(w http.ResponseWriter, r *http.Request) {
+	idToken, err := da.session.GetString(r.Context(), sessionKeyIDToken)
+	if err != nil {
+		logging.FromContext(r.Context()).Warn("failed to get id token from session", "err", err)
+	}
 
 	if err := da.session.Destroy(r.Context()); err != nil {
-		log.Printf("Failed to destory session: %v", err)
+		logging.FromContext(r.Context()).Error("failed to destroy session", "err", err)
 	} else {
-		log.Printf("LogoutHandler: cleared session")
+		logging.FromContext(r.Context()).Info("logout cleared session")
+	}
+
+	redirectTo := da.urlAfterLogout
+	if logoutURL := da.GetLogoutURL(idToken); logoutURL != "" {
+		redirectTo = logoutURL
+	}
+	http.Redirect(w, r, redirectTo, http.StatusTemporaryRedirect)
+}
+
+// randomString returns 32 random bytes in url safe encoding
+func randomString() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
 	}
-	http.Redirect(w, r, da.urlAfterLogout, http.StatusTemporaryRedirect)
+	return base64.RawURLEncoding.EncodeToString(b), nil
 }
@@ -1,8 +1,7 @@
 package oidcAuth
 
 import (
-	"fmt"
-	"log"
+	"itsTasty/pkg/logging"
 	"net/http"
 	"time"
 )
@@ -12,21 +11,21 @@ import (
 // DOES NOT ABORT ON NO SESSION
 func (da *DefaultAuthenticator) CheckSession(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		log.Printf("CheckSession middleware was called")
+		logging.FromContext(r.Context()).Debug("CheckSession middleware was called")
 		//check if session contains token
 		accessToken, err := da.session.GetString(r.Context(), sessionKeyAccessToken)
 		if err != nil {
 			http.Error(w, "", http.StatusInternalServerError)
-			log.Printf("Failed to get %v from session : %v", sessionKeyAccessToken, err)
+			logging.FromContext(r.Context()).Error("failed to get access token from session", "err", err)
 			return
 		}
 		if accessToken == "" {
-			log.Printf("invalid sessions")
+			logging.FromContext(r.Context()).Debug("session has no access token")
 			if err := da.session.Destroy(r.Context()); err != nil {
-				fmt.Printf("failed to clear session: %v", err)
+				logging.FromContext(r.Context()).Error("failed to clear session", "err", err)
 				return
 			} else {
-				fmt.Printf("cleared session")
+				logging.FromContext(r.Context()).Debug("cleared session")
 			}
 			next.ServeHTTP(w, r)
 			return
@@ -36,30 +35,30 @@ func (da *DefaultAuthenticator) CheckSession(next http.Handler) http.Handler {
 		expiry, err := da.session.GetTime(r.Context(), sessionKeyExpiry)
 		if err != nil {
 			http.Error(w, "", http.StatusInternalServerError)
-			log.Printf("Failed to get %v from session : %v", sessionKeyExpiry, err)
+			logging.FromContext(r.Context()).Error("failed to get token expiry from session", "err", err)
 			return
 		}
 
 		if expiry.Before(time.Now()) {
-			log.Printf("Access token expired, trying to refresh")
+			logging.FromContext(r.Context()).Debug("access token expired, trying to refresh")
 
 			err := da.Refresh(r.Context())
 			if err != nil {
-				log.Printf("refresh failed: %v", err)
+				logging.FromContext(r.Context()).Warn("refresh failed", "err", err)
 				if err := da.session.Destroy(r.Context()); err != nil {
-					fmt.Printf("failed to clear session: %v", err)
+					logging.FromContext(r.Context()).Error("failed to clear session", "err", err)
 					return
 				} else {
-					fmt.Printf("cleared session")
+					logging.FromContext(r.Context()).Debug("cleared session")
 				}
 				next.ServeHTTP(w, r)
 				return
 			}
 
-			log.Printf("sucesfully refreshed session")
+			logging.FromContext(r.Context()).Info("successfully refreshed session")
 		}
 
-		log.Printf("Check session middleware: allowed")
+		logging.FromContext(r.Context()).Debug("CheckSession middleware: allowed")
 		next.ServeHTTP(w, r)
 	})
 }
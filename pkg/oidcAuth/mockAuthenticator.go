@@ -2,7 +2,7 @@ package oidcAuth
 
 import (
 	"context"
-	"log"
+	"itsTasty/pkg/logging"
 	"net/http"
 	"net/url"
 )
@@ -24,7 +24,7 @@ func NewMockAuthenticator(defaultURLAfterLogin, urlAfterLogout string,
 		session:              storage,
 		defaultURLAfterLogin: defaultURLAfterLogin,
 		urlAfterLogout:       urlAfterLogout,
-		defaultUserProfile:   UserProfile{Email: "testUser@some.domain"},
+		defaultUserProfile:   UserProfile{Email: "testUser@some.domain", Subject: "testUser@some.domain"},
 	}
 }
 
@@ -34,7 +34,7 @@ func (m *MockAuthenticator) CheckSession(next http.Handler) http.Handler {
 		_, err := m.session.GetProfile(r.Context(), SessionKeyProfile)
 		if err != nil {
 			http.Error(w, "", http.StatusUnauthorized)
-			log.Printf("CheckSession: unathorized access to %v with method %v and headers %v", r.URL, r.Method, r.Header)
+			logging.FromContext(r.Context()).Warn("unauthorized access", "url", r.URL.String(), "headers", r.Header)
 			return
 		}
 		next.ServeHTTP(w, r)
@@ -48,12 +48,12 @@ func (m *MockAuthenticator) CallbackHandler(w http.ResponseWriter, r *http.Reque
 	user := m.defaultUserProfile
 	//for the mock login handler the caller can optionally supply a username as a request parameter
 	if userEmail := r.URL.Query().Get("userEmail"); userEmail != "" {
-		user = UserProfile{Email: userEmail}
+		user = UserProfile{Email: userEmail, Subject: userEmail}
 	}
 
 	if err := m.session.StoreProfile(r.Context(), SessionKeyProfile, user); err != nil {
 		http.Error(w, "", http.StatusInternalServerError)
-		log.Printf("failed to store %v to session : %v", SessionKeyProfile, err)
+		logging.FromContext(r.Context()).Error("failed to store profile in session", "err", err)
 		return
 	}
 
@@ -61,7 +61,7 @@ func (m *MockAuthenticator) CallbackHandler(w http.ResponseWriter, r *http.Reque
 	redirectTo, err := m.session.GetString(r.Context(), sessionKeyRedirectTarget)
 	if err != nil {
 		http.Error(w, "", http.StatusInternalServerError)
-		log.Printf("failed to retrieve redirect target from session : %v", err)
+		logging.FromContext(r.Context()).Error("failed to retrieve redirect target from session", "err", err)
 		return
 	}
 
@@ -73,12 +73,12 @@ func (m *MockAuthenticator) LoginHandler(w http.ResponseWriter, r *http.Request)
 	user := m.defaultUserProfile
 	//for the mock login handler the caller can optionally supply a username as a request parameter
 	if userEmail := r.URL.Query().Get("userEmail"); userEmail != "" {
-		user = UserProfile{Email: userEmail}
+		user = UserProfile{Email: userEmail, Subject: userEmail}
 	}
 
 	if err := m.session.StoreProfile(r.Context(), SessionKeyProfile, user); err != nil {
 		http.Error(w, "", http.StatusInternalServerError)
-		log.Printf("failed to store %v to session : %v", SessionKeyProfile, err)
+		logging.FromContext(r.Context()).Error("failed to store profile in session", "err", err)
 		return
 	}
 
@@ -89,7 +89,7 @@ func (m *MockAuthenticator) LoginHandler(w http.ResponseWriter, r *http.Request)
 		u, err := url.Parse(target)
 		if err != nil {
 			http.Error(w, "Invalid redirectTo URL", http.StatusBadRequest)
-			log.Printf("Failed to parse redirectTo URL : %v", err)
+			logging.FromContext(r.Context()).Warn("failed to parse redirectTo URL", "err", err)
 			return
 		}
 		redirectAfterLogin = u.String()
@@ -98,7 +98,7 @@ func (m *MockAuthenticator) LoginHandler(w http.ResponseWriter, r *http.Request)
 	//Store redirect after login location in session, to be able to a access it in the redirect handler
 	if err := m.session.StoreString(r.Context(), sessionKeyRedirectTarget, redirectAfterLogin); err != nil {
 		http.Error(w, "", http.StatusInternalServerError)
-		log.Printf("Failed to save value %v for key %v in session : %v", redirectAfterLogin, sessionKeyRedirectTarget, err)
+		logging.FromContext(r.Context()).Error("failed to save redirect target in session", "redirectTo", redirectAfterLogin, "err", err)
 		return
 	}
 
@@ -108,9 +108,9 @@ func (m *MockAuthenticator) LoginHandler(w http.ResponseWriter, r *http.Request)
 
 func (m *MockAuthenticator) LogoutHandler(w http.ResponseWriter, r *http.Request) {
 	if err := m.session.Destroy(r.Context()); err != nil {
-		log.Printf("Failed to destory session: %v", err)
+		logging.FromContext(r.Context()).Error("failed to destroy session", "err", err)
 	} else {
-		log.Printf("LogoutHandler: cleared session")
+		logging.FromContext(r.Context()).Info("logout cleared session")
 	}
 	http.Redirect(w, r, m.urlAfterLogout, http.StatusTemporaryRedirect)
 }
@@ -118,3 +118,7 @@ func (m *MockAuthenticator) LogoutHandler(w http.ResponseWriter, r *http.Request
 func (m *MockAuthenticator) Refresh(_ context.Context) error {
 	return nil
 }
+
+func (m *MockAuthenticator) CheckProvider(_ context.Context) error {
+	return nil
+}
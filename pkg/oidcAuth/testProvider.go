@@ -0,0 +1,298 @@
+package oidcAuth
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// TestProvider is a local stand-in for the oidc provider. It serves the discovery document and its signing key and
+// signs tokens with arbitrary claims. It also implements the authorization code flow with PKCE for the user set via
+// SetUser as well as RP-initiated logout. Only meant for tests
+type TestProvider struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	keyID  string
+	//lastID makes the issued codes and tokens unique
+	lastID atomic.Int64
+
+	//mutex guards the fields below
+	mutex sync.Mutex
+	//user is the email of the user that is logged in at the provider. Empty if nobody is logged in
+	user string
+	//grants maps unused authorization codes and refresh tokens to the login they belong to
+	grants map[string]testProviderGrant
+}
+
+// testProviderGrant is the state of a login at the TestProvider
+type testProviderGrant struct {
+	email       string
+	clientID    string
+	redirectURI string
+	nonce       string
+	challenge   string
+	sessionID   string
+}
+
+// NewTestProvider starts a TestProvider on a local port. Call Close once it is no longer needed
+func NewTestProvider() (*TestProvider, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate signing key : %v", err)
+	}
+	p := &TestProvider{key: key, keyID: "testKey", grants: make(map[string]testProviderGrant)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.discoveryHandler)
+	mux.HandleFunc("/keys", p.keysHandler)
+	mux.HandleFunc("/authorize", p.authorizeHandler)
+	mux.HandleFunc("/token", p.tokenHandler)
+	mux.HandleFunc("/logout", p.logoutHandler)
+	p.server = httptest.NewServer(mux)
+	return p, nil
+}
+
+// URL is the issuer url of the provider
+func (p *TestProvider) URL() string {
+	return p.server.URL
+}
+
+func (p *TestProvider) Close() {
+	p.server.Close()
+}
+
+// SetUser logs in the user with the given email at the provider. The authorization endpoint issues codes for this
+// user without asking for credentials. An empty email logs out the user
+func (p *TestProvider) SetUser(email string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.user = email
+}
+
+// NewToken returns a signed token for the user with verified email that is valid for lifetime
+func (p *TestProvider) NewToken(email, audience string, lifetime time.Duration) (string, error) {
+	now := time.Now()
+	return p.SignToken(map[string]any{
+		"iss":            p.URL(),
+		"sub":            email,
+		"aud":            audience,
+		"iat":            now.Unix(),
+		"exp":            now.Add(lifetime).Unix(),
+		"email":          email,
+		"email_verified": true,
+	})
+}
+
+// NewLogoutToken returns a signed back-channel logout token for the given subject and provider session. Empty
+// params are omitted
+func (p *TestProvider) NewLogoutToken(subject, sessionID, audience string) (string, error) {
+	now := time.Now()
+	claims := map[string]any{
+		"iss":    p.URL(),
+		"aud":    audience,
+		"iat":    now.Unix(),
+		"exp":    now.Add(time.Minute).Unix(),
+		"jti":    fmt.Sprintf("logout-%v", now.UnixNano()),
+		"events": map[string]any{backChannelLogoutEvent: map[string]any{}},
+	}
+	if subject != "" {
+		claims["sub"] = subject
+	}
+	if sessionID != "" {
+		claims["sid"] = sessionID
+	}
+	return p.SignToken(claims)
+}
+
+// SignToken returns a RS256 signed JWT with the given claims
+func (p *TestProvider) SignToken(claims map[string]any) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": p.keyID})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal header : %v", err)
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal claims : %v", err)
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, p.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token : %v", err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func (p *TestProvider) discoveryHandler(w http.ResponseWriter, _ *http.Request) {
+	writeTestProviderJSON(w, map[string]any{
+		"issuer":                                p.URL(),
+		"jwks_uri":                              p.URL() + "/keys",
+		"authorization_endpoint":                p.URL() + "/authorize",
+		"token_endpoint":                        p.URL() + "/token",
+		"end_session_endpoint":                  p.URL() + "/logout",
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+// authorizeHandler issues an authorization code for the current user and redirects back to the client
+func (p *TestProvider) authorizeHandler(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	redirectURI, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil || q.Get("redirect_uri") == "" {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+	if q.Get("response_type") != "code" || q.Get("client_id") == "" || q.Get("code_challenge") == "" ||
+		q.Get("code_challenge_method") != "S256" {
+		http.Error(w, "invalid authorization request", http.StatusBadRequest)
+		return
+	}
+
+	redirectQuery := redirectURI.Query()
+	redirectQuery.Set("state", q.Get("state"))
+
+	p.mutex.Lock()
+	if p.user == "" {
+		redirectQuery.Set("error", "login_required")
+	} else {
+		code := p.newID("code")
+		p.grants[code] = testProviderGrant{
+			email:       p.user,
+			clientID:    q.Get("client_id"),
+			redirectURI: q.Get("redirect_uri"),
+			nonce:       q.Get("nonce"),
+			challenge:   q.Get("code_challenge"),
+			sessionID:   p.newID("session"),
+		}
+		redirectQuery.Set("code", code)
+	}
+	p.mutex.Unlock()
+
+	redirectURI.RawQuery = redirectQuery.Encode()
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+// tokenHandler redeems authorization codes and refresh tokens. Each of them can only be used once
+func (p *TestProvider) tokenHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+	clientID, _, ok := r.BasicAuth()
+	if !ok {
+		clientID = r.PostForm.Get("client_id")
+	}
+
+	var grantKey string
+	switch r.PostForm.Get("grant_type") {
+	case "authorization_code":
+		grantKey = r.PostForm.Get("code")
+	case "refresh_token":
+		grantKey = r.PostForm.Get("refresh_token")
+	default:
+		writeTestProviderTokenError(w, "unsupported_grant_type")
+		return
+	}
+
+	p.mutex.Lock()
+	grant, ok := p.grants[grantKey]
+	delete(p.grants, grantKey)
+	p.mutex.Unlock()
+	if !ok || grant.clientID != clientID {
+		writeTestProviderTokenError(w, "invalid_grant")
+		return
+	}
+
+	idTokenClaims := map[string]any{
+		"iss":            p.URL(),
+		"sub":            grant.email,
+		"aud":            grant.clientID,
+		"iat":            time.Now().Unix(),
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"email":          grant.email,
+		"email_verified": true,
+		"sid":            grant.sessionID,
+	}
+	if r.PostForm.Get("grant_type") == "authorization_code" {
+		challenge := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if r.PostForm.Get("redirect_uri") != grant.redirectURI ||
+			base64.RawURLEncoding.EncodeToString(challenge[:]) != grant.challenge {
+			writeTestProviderTokenError(w, "invalid_grant")
+			return
+		}
+		if grant.nonce != "" {
+			idTokenClaims["nonce"] = grant.nonce
+		}
+	}
+
+	idToken, err := p.SignToken(idTokenClaims)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	refreshToken := p.newID("refresh")
+	p.mutex.Lock()
+	p.grants[refreshToken] = testProviderGrant{email: grant.email, clientID: grant.clientID, sessionID: grant.sessionID}
+	p.mutex.Unlock()
+
+	writeTestProviderJSON(w, map[string]any{
+		"access_token":  p.newID("access"),
+		"token_type":    "Bearer",
+		"expires_in":    3600,
+		"refresh_token": refreshToken,
+		"id_token":      idToken,
+	})
+}
+
+func (p *TestProvider) newID(prefix string) string {
+	return fmt.Sprintf("%v-%v", prefix, p.lastID.Add(1))
+}
+
+// logoutHandler logs out the current user and redirects to post_logout_redirect_uri if present
+func (p *TestProvider) logoutHandler(w http.ResponseWriter, r *http.Request) {
+	p.SetUser("")
+	if target := r.URL.Query().Get("post_logout_redirect_uri"); target != "" {
+		http.Redirect(w, r, target, http.StatusFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (p *TestProvider) keysHandler(w http.ResponseWriter, _ *http.Request) {
+	writeTestProviderJSON(w, map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"alg": "RS256",
+			"use": "sig",
+			"kid": p.keyID,
+			"n":   base64.RawURLEncoding.EncodeToString(p.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.E)).Bytes()),
+		}},
+	})
+}
+
+func writeTestProviderTokenError(w http.ResponseWriter, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": code})
+}
+
+func writeTestProviderJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,128 @@
+// Package rateLimit limits the number of requests a client may send per time window. Limits are defined per route
+// class, e.g. to allow fewer expensive requests than cheap ones. The counters live in a Store, which may be shared
+// by multiple replicas
+package rateLimit
+
+import (
+	"context"
+	"fmt"
+	"itsTasty/pkg/logging"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limit allows Requests per Window. The zero value disables limiting
+type Limit struct {
+	Requests int
+	Window   time.Duration
+}
+
+// Disabled returns true if l does not limit requests
+func (l Limit) Disabled() bool {
+	return l.Requests <= 0
+}
+
+func (l Limit) String() string {
+	if l.Disabled() {
+		return "0"
+	}
+	return fmt.Sprintf("%v/%v", l.Requests, l.Window)
+}
+
+// ParseLimit parses limits in the format "<requests>/<window>", e.g. "100/1m". "0" or an empty string disable
+// limiting
+func ParseLimit(s string) (Limit, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || s == "0" {
+		return Limit{}, nil
+	}
+	rawRequests, rawWindow, found := strings.Cut(s, "/")
+	if !found {
+		return Limit{}, fmt.Errorf("limit \"%v\" is not in the format <requests>/<window>", s)
+	}
+	requests, err := strconv.Atoi(rawRequests)
+	if err != nil || requests < 0 {
+		return Limit{}, fmt.Errorf("requests of limit \"%v\" must be a non-negative number", s)
+	}
+	window, err := time.ParseDuration(rawWindow)
+	if err != nil || window <= 0 {
+		return Limit{}, fmt.Errorf("window of limit \"%v\" must be a positive duration", s)
+	}
+	return Limit{Requests: requests, Window: window}, nil
+}
+
+// Store keeps the request counters
+type Store interface {
+	// Increment adds a request to the counter of key for the window starting at windowStart and returns the number
+	// of requests in this window, including the new one. Counters of previous windows are discarded
+	Increment(ctx context.Context, key string, windowStart time.Time, window time.Duration) (int, error)
+}
+
+type TimeSource interface {
+	//Now returns the current local time.
+	Now() time.Time
+}
+
+type defaultTimeSource struct {
+}
+
+func (d defaultTimeSource) Now() time.Time {
+	return time.Now()
+}
+
+// Limiter enforces the limits of the route classes with fixed time windows
+type Limiter struct {
+	store      Store
+	limits     map[string]Limit
+	timeSource TimeSource
+}
+
+// NewLimiter creates a Limiter that enforces limits, keyed by route class. Classes without limit are not limited
+func NewLimiter(store Store, limits map[string]Limit) *Limiter {
+	return NewLimiterCustom(store, limits, defaultTimeSource{})
+}
+
+// NewLimiterCustom is like NewLimiter but allows to mock the time
+func NewLimiterCustom(store Store, limits map[string]Limit, timeSource TimeSource) *Limiter {
+	return &Limiter{store: store, limits: limits, timeSource: timeSource}
+}
+
+// Middleware counts the requests of each client per route class. classify returns the route class of the request
+// and identify the client, e.g. the authenticated user. Requests over the limit are rejected with
+// http.StatusTooManyRequests and a Retry-After header. If the store fails, requests are allowed
+func (l *Limiter) Middleware(classify func(r *http.Request) string,
+	identify func(r *http.Request) string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			class := classify(r)
+			limit := l.limits[class]
+			client := identify(r)
+			if limit.Disabled() || client == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			now := l.timeSource.Now()
+			windowStart := now.Truncate(limit.Window)
+			count, err := l.store.Increment(r.Context(), class+":"+client, windowStart, limit.Window)
+			if err != nil {
+				logging.FromContext(r.Context()).Error("failed to count request, skipping rate limit",
+					"class", class, "err", err)
+				next.ServeHTTP(w, r)
+				return
+			}
+			if count > limit.Requests {
+				retryAfter := windowStart.Add(limit.Window).Sub(now)
+				logging.FromContext(r.Context()).Warn("rate limit exceeded", "class", class, "limit", limit.String(),
+					"retryAfter", retryAfter)
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+				http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package rateLimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval is the minimum time between two removals of expired counters
+const sweepInterval = time.Minute
+
+type memoryCounter struct {
+	windowStart time.Time
+	expires     time.Time
+	count       int
+}
+
+// MemoryStore keeps the counters in memory. The counters are not shared between replicas
+type MemoryStore struct {
+	lock      sync.Mutex
+	counters  map[string]*memoryCounter
+	lastSweep time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{counters: make(map[string]*memoryCounter), lastSweep: time.Now()}
+}
+
+func (m *MemoryStore) Increment(_ context.Context, key string, windowStart time.Time, window time.Duration) (int, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.sweep()
+
+	counter, ok := m.counters[key]
+	if !ok || !counter.windowStart.Equal(windowStart) {
+		counter = &memoryCounter{windowStart: windowStart, expires: windowStart.Add(window)}
+		m.counters[key] = counter
+	}
+	counter.count++
+	return counter.count, nil
+}
+
+// sweep removes expired counters, so that clients that stopped sending requests do not use memory forever.
+// Caller must hold the lock
+func (m *MemoryStore) sweep() {
+	now := time.Now()
+	if now.Sub(m.lastSweep) < sweepInterval {
+		return
+	}
+	m.lastSweep = now
+	for key, counter := range m.counters {
+		if !counter.expires.After(now) {
+			delete(m.counters, key)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package rateLimit
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// PostgresStore keeps the counters in the rate_limit_counters table, so that all replicas share them.
+// Each key has a single row that is reset once a new window starts
+type PostgresStore struct {
+	db *sql.DB
+}
+
+func NewPostgresStore(db *sql.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+func (p *PostgresStore) Increment(ctx context.Context, key string, windowStart time.Time, _ time.Duration) (int, error) {
+	count := 0
+	err := p.db.QueryRowContext(ctx, `
+insert into rate_limit_counters (key, window_start, hits) values ($1, $2, 1)
+on conflict (key) do update set
+	hits = case when rate_limit_counters.window_start = excluded.window_start
+		then rate_limit_counters.hits + 1 else 1 end,
+	window_start = excluded.window_start
+returning hits`, key, windowStart.UTC()).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment counter : %w", err)
+	}
+	return count, nil
+}
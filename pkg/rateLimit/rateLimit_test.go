@@ -0,0 +1,114 @@
+package rateLimit
+
+import (
+	"context"
+	"itsTasty/pkg/testutils"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	migrate "github.com/rubenv/sql-migrate"
+	"github.com/stretchr/testify/require"
+)
+
+type mockTimeSource struct {
+	CurrentTime time.Time
+}
+
+func (m *mockTimeSource) Now() time.Time {
+	return m.CurrentTime
+}
+
+func TestParseLimit(t *testing.T) {
+	limit, err := ParseLimit("100/1m")
+	require.NoError(t, err)
+	require.Equal(t, Limit{Requests: 100, Window: time.Minute}, limit)
+
+	for _, disabled := range []string{"", "0", "0/1s"} {
+		limit, err = ParseLimit(disabled)
+		require.NoError(t, err)
+		require.True(t, limit.Disabled(), disabled)
+	}
+
+	for _, invalid := range []string{"100", "-1/1m", "ten/1m", "100/0s", "100/minute"} {
+		_, err = ParseLimit(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	mockTime := &mockTimeSource{CurrentTime: time.Date(2026, 10, 19, 12, 0, 15, 0, time.UTC)}
+	limiter := NewLimiterCustom(NewMemoryStore(), map[string]Limit{
+		"cheap":     {Requests: 3, Window: time.Minute},
+		"expensive": {Requests: 1, Window: time.Hour},
+	}, mockTime)
+	handler := limiter.Middleware(
+		func(r *http.Request) string { return r.URL.Query().Get("class") },
+		func(r *http.Request) string { return r.Header.Get("X-Client") },
+	)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	do := func(class, client string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/?class="+class, nil)
+		req.Header.Set("X-Client", client)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	for i := 0; i < 3; i++ {
+		require.Equal(t, http.StatusOK, do("cheap", "a").Code)
+	}
+	rec := do("cheap", "a")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "45", rec.Header().Get("Retry-After"))
+	require.Equal(t, http.StatusOK, do("cheap", "b").Code)
+
+	require.Equal(t, http.StatusOK, do("expensive", "a").Code)
+	rec = do("expensive", "a")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "3585", rec.Header().Get("Retry-After"))
+
+	//classes without limit and unidentified clients are not limited
+	for i := 0; i < 5; i++ {
+		require.Equal(t, http.StatusOK, do("unknown", "a").Code)
+		require.Equal(t, http.StatusOK, do("cheap", "").Code)
+	}
+
+	mockTime.CurrentTime = mockTime.CurrentTime.Add(time.Minute)
+	require.Equal(t, http.StatusOK, do("cheap", "a").Code)
+	require.Equal(t, http.StatusTooManyRequests, do("expensive", "a").Code)
+}
+
+func Test_PostgresStore(t *testing.T) {
+	db, err := testutils.GlobalDockerPool.GetPostgresIntegrationTestDB()
+	if err != nil {
+		t.Fatalf("GetPostgresIntegrationTestDB failed : %v", err)
+	}
+	defer func() {
+		if err := testutils.GlobalDockerPool.Cleanup(); err != nil {
+			t.Fatalf("failed to cleanup docker pool : %v", err)
+		}
+	}()
+	migrationSource := &migrate.FileMigrationSource{Dir: "../../migrations/postgres"}
+	_, err = migrate.Exec(db, "postgres", migrationSource, migrate.Up)
+	require.NoError(t, err)
+
+	store := NewPostgresStore(db)
+	ctx := context.Background()
+	window := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	for i := 1; i <= 3; i++ {
+		count, err := store.Increment(ctx, "read:a", window, time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, i, count)
+	}
+	count, err := store.Increment(ctx, "read:b", window, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	//a new window resets the counter
+	count, err = store.Increment(ctx, "read:a", window.Add(time.Minute), time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+}
@@ -0,0 +1,60 @@
+package telemetry
+
+import (
+	"context"
+	"itsTasty/pkg/logging"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// JobRun describes the most recent run of a job
+type JobRun struct {
+	Start    time.Time
+	Duration time.Duration
+	//Err is nil if the run succeeded
+	Err error
+}
+
+// lastJobRuns maps job names to their last run
+var lastJobRuns = struct {
+	sync.Mutex
+	runs map[string]JobRun
+}{runs: make(map[string]JobRun)}
+
+// LastJobRun returns the last run of the job with the given name. Returns false if the job has not completed a run yet
+func LastJobRun(name string) (JobRun, bool) {
+	lastJobRuns.Lock()
+	defer lastJobRuns.Unlock()
+	run, ok := lastJobRuns.runs[name]
+	return run, ok
+}
+
+// InstrumentJob wraps job for use with gocron. Each run gets its own root span and a context that is cancelled after
+// timeout and carries a logger with the job name, see logging.FromContext. Its duration and outcome are observed with
+// JobDuration and are available via LastJobRun. job is responsible for logging its errors
+func InstrumentJob(name string, timeout time.Duration, job func(ctx context.Context) error) func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		start := time.Now()
+		ctx, span := Tracer().Start(ctx, "job "+name, trace.WithNewRoot(),
+			trace.WithAttributes(attribute.String("job", name)))
+		err := job(logging.WithAttrs(ctx, "job", name))
+		EndSpan(span, err)
+		duration := time.Since(start)
+
+		outcome := "success"
+		if err != nil {
+			outcome = "error"
+		}
+		JobDuration.WithLabelValues(name, outcome).Observe(duration.Seconds())
+
+		lastJobRuns.Lock()
+		lastJobRuns.runs[name] = JobRun{Start: start, Duration: duration, Err: err}
+		lastJobRuns.Unlock()
+	}
+}
@@ -0,0 +1,88 @@
+package telemetry
+
+import (
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "itstasty"
+
+// Registry contains all metrics of the app and is served by MetricsHandler
+var Registry = prometheus.NewRegistry()
+
+var (
+	//HTTPRequestDuration is observed by HTTPMiddleware. route is the chi route pattern to keep the cardinality low
+	HTTPRequestDuration = promauto.With(Registry).NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Latency of HTTP requests",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	//DBErrors counts failed queries. backend is the name of the storage backend, e.g. "postgres"
+	DBErrors = promauto.With(Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "db_errors_total",
+		Help:      "Number of failed database queries",
+	}, []string{"backend"})
+
+	//JobDuration is observed by InstrumentJob. outcome is either "success" or "error"
+	JobDuration = promauto.With(Registry).NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "job_duration_seconds",
+		Help:      "Duration and outcome of background jobs",
+		Buckets:   []float64{0.01, 0.1, 0.5, 1, 5, 10, 30, 60, 300},
+	}, []string{"job", "outcome"})
+
+	//Ratings counts submitted ratings. Use increase(itstasty_ratings_total[1d]) for the ratings per day
+	Ratings = promauto.With(Registry).NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "ratings_total",
+		Help:      "Number of submitted ratings",
+	})
+)
+
+// activeSessions holds the function that counts the sessions for the active sessions gauge
+var activeSessions = struct {
+	sync.Mutex
+	count func() (int, error)
+}{}
+
+func init() {
+	Registry.MustRegister(collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+	promauto.With(Registry).NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "active_sessions",
+		Help:      "Number of active user sessions",
+	}, func() float64 {
+		activeSessions.Lock()
+		defer activeSessions.Unlock()
+		if activeSessions.count == nil {
+			return 0
+		}
+		count, err := activeSessions.count()
+		if err != nil {
+			slog.Error("failed to count active sessions", "err", err)
+			return 0
+		}
+		return float64(count)
+	})
+}
+
+// SetActiveSessionsSource sets the function that is called to count the active sessions on each scrape
+func SetActiveSessionsSource(count func() (int, error)) {
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
+	activeSessions.count = count
+}
+
+// MetricsHandler serves all metrics in the Prometheus text format
+func MetricsHandler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
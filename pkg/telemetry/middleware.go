@@ -0,0 +1,65 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// HTTPMiddleware starts a server span for each request and observes HTTPRequestDuration. It must be used on the root
+// chi router, so that the route pattern is complete once the request has been served
+func HTTPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := Tracer().Start(ctx, r.Method, trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(semconv.HTTPRequestMethodKey.String(r.Method), semconv.URLPath(r.URL.Path)))
+		defer span.End()
+
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r.WithContext(ctx))
+
+		status := ww.Status()
+		//handlers that only write a body do not call WriteHeader
+		if status == 0 {
+			status = http.StatusOK
+		}
+		route := "unmatched"
+		if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+			route = rctx.RoutePattern()
+		}
+		span.SetName(r.Method + " " + route)
+		span.SetAttributes(semconv.HTTPRoute(route), semconv.HTTPResponseStatusCode(status))
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+		HTTPRequestDuration.WithLabelValues(r.Method, route, strconv.Itoa(status)).Observe(time.Since(start).Seconds())
+	})
+}
+
+// StrictHandlerSpan is a middleware for the strict handlers generated by oapi-codegen. It wraps each operation
+// in a span named after the operationID, e.g.
+// userAPI.NewStrictHandler(s, []userAPI.StrictMiddlewareFunc{telemetry.StrictHandlerSpan[userAPI.StrictHandlerFunc]})
+func StrictHandlerSpan[F ~func(ctx context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error)](
+	f F, operationID string) F {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+		ctx, span := Tracer().Start(ctx, operationID, trace.WithAttributes(attribute.String("operation", operationID)))
+		resp, err := f(ctx, w, r.WithContext(ctx), args)
+		if resp != nil {
+			span.SetAttributes(attribute.String("response", fmt.Sprintf("%T", resp)))
+		}
+		EndSpan(span, err)
+		return resp, err
+	}
+}
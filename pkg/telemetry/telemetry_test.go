@@ -0,0 +1,110 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+// recordSpans installs a TracerProvider that records all spans in memory for the duration of the test
+func recordSpans(t *testing.T) *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	previous := otel.GetTracerProvider()
+	SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return exporter
+}
+
+func TestHTTPMiddleware(t *testing.T) {
+	exporter := recordSpans(t)
+
+	dishRouter := chi.NewRouter()
+	dishRouter.Get("/{dishID}", func(w http.ResponseWriter, r *http.Request) {
+		_, span := Tracer().Start(r.Context(), "handler")
+		span.End()
+		w.WriteHeader(http.StatusTeapot)
+	})
+	router := chi.NewRouter()
+	router.Use(HTTPMiddleware)
+	router.Mount("/dishes", dishRouter)
+
+	before := testutil.CollectAndCount(HTTPRequestDuration)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/dishes/42", nil))
+	require.Equal(t, http.StatusTeapot, rec.Code)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	handlerSpan, requestSpan := spans[0], spans[1]
+	require.Equal(t, "GET /dishes/{dishID}", requestSpan.Name)
+	require.Contains(t, requestSpan.Attributes, semconv.HTTPRoute("/dishes/{dishID}"))
+	require.Contains(t, requestSpan.Attributes, semconv.HTTPResponseStatusCode(http.StatusTeapot))
+	require.Equal(t, requestSpan.SpanContext.SpanID(), handlerSpan.Parent.SpanID())
+	require.Equal(t, before+1, testutil.CollectAndCount(HTTPRequestDuration))
+}
+
+func TestInstrumentJob(t *testing.T) {
+	exporter := recordSpans(t)
+
+	var deadline time.Time
+	InstrumentJob("testJobOK", time.Minute, func(ctx context.Context) error {
+		deadline, _ = ctx.Deadline()
+		return nil
+	})()
+	require.WithinDuration(t, time.Now().Add(time.Minute), deadline, 10*time.Second)
+	InstrumentJob("testJobFailing", time.Minute, func(ctx context.Context) error {
+		return errors.New("failed")
+	})()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	require.Equal(t, "job testJobOK", spans[0].Name)
+	require.Equal(t, codes.Unset, spans[0].Status.Code)
+	require.Equal(t, "job testJobFailing", spans[1].Name)
+	require.Equal(t, codes.Error, spans[1].Status.Code)
+
+	require.Equal(t, uint64(1), jobRuns(t, "testJobOK", "success"))
+	require.Equal(t, uint64(1), jobRuns(t, "testJobFailing", "error"))
+
+	run, ok := LastJobRun("testJobOK")
+	require.True(t, ok)
+	require.NoError(t, run.Err)
+	run, ok = LastJobRun("testJobFailing")
+	require.True(t, ok)
+	require.EqualError(t, run.Err, "failed")
+	_, ok = LastJobRun("testJobUnknown")
+	require.False(t, ok)
+}
+
+// jobRuns returns the number of observations of JobDuration with the given labels
+func jobRuns(t *testing.T, job, outcome string) uint64 {
+	families, err := Registry.Gather()
+	require.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() != "itstasty_job_duration_seconds" {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			labels := make(map[string]string)
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			if labels["job"] == job && labels["outcome"] == outcome {
+				return m.GetHistogram().GetSampleCount()
+			}
+		}
+	}
+	return 0
+}
@@ -0,0 +1,77 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "itsTasty"
+
+const (
+	//ExporterNone disables tracing
+	ExporterNone = "none"
+	//ExporterConsole prints all spans to stdout. Intended for local development
+	ExporterConsole = "console"
+	//ExporterOTLP sends spans to an OTLP/HTTP collector, configured via the standard OTEL_EXPORTER_OTLP_* env vars
+	ExporterOTLP = "otlp"
+)
+
+// Tracer returns the tracer for all spans of this app. It uses the global TracerProvider, which is a no-op until
+// SetupTracing or SetTracerProvider is called
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// SetupTracing installs a global TracerProvider that exports spans with the given exporter. The returned function
+// flushes pending spans and must be called before the app terminates
+func SetupTracing(ctx context.Context, exporter string) (shutdown func(context.Context) error, err error) {
+	var spanExporter sdktrace.SpanExporter
+	switch exporter {
+	case "", ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterConsole:
+		spanExporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case ExporterOTLP:
+		spanExporter, err = otlptracehttp.New(ctx)
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %v", exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %v trace exporter : %v", exporter, err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(semconv.ServiceName(instrumentationName)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create otel resource : %v", err)
+	}
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(spanExporter), sdktrace.WithResource(res))
+	SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// SetTracerProvider installs provider as global TracerProvider. Tests use this to record spans with
+// an in-memory exporter
+func SetTracerProvider(provider trace.TracerProvider) {
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+}
+
+// EndSpan marks the span as failed if err is not nil and ends it
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
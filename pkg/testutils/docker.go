@@ -5,7 +5,7 @@ import (
 	"fmt"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/ory/dockertest"
-	"log"
+	"log/slog"
 	"sync"
 )
 
@@ -45,18 +45,18 @@ func (p *dockerPool) GetPostgresIntegrationTestDB() (*sql.DB, error) {
 	}()
 
 	if p.pool == nil {
-		log.Printf("Initializing docker pool...")
+		slog.Info("initializing docker pool...")
 		pool, err := dockertest.NewPool("")
 		if err != nil {
 			return nil, fmt.Errorf("failed to connect to docker : %v", err)
 		}
 		p.pool = pool
 		p.usageCounter = 0
-		log.Printf("Pool initializaiton done")
+		slog.Info("pool initialization done")
 	}
 
 	if p.usageCounter == 0 {
-		log.Printf("Creating container...")
+		slog.Info("creating container...")
 		//resource, err := p.pool.Run("postgres", "latest", []string{"POSTGRES_PASSWORD=" + pgPW, "POSTGRES_DB=" + testDBName, "POSTGRES_USER=" + pgUser})
 		resource, err := p.pool.Run("postgres", "latest", []string{"POSTGRES_PASSWORD=" + pgPW, "POSTGRES_USER=" + pgUser})
 		if err != nil {
@@ -67,10 +67,10 @@ func (p *dockerPool) GetPostgresIntegrationTestDB() (*sql.DB, error) {
 		}
 
 		p.resource = resource
-		log.Printf("Container Initialization done")
+		slog.Info("container initialization done")
 
 	}
-	log.Printf("Waiting for postgres to accept connections...")
+	slog.Info("waiting for postgres to accept connections...")
 	var db *sql.DB
 	err := p.pool.Retry(func() error {
 		var err error
@@ -97,7 +97,7 @@ func (p *dockerPool) GetPostgresIntegrationTestDB() (*sql.DB, error) {
 		return nil
 	})
 	if err != nil {
-		log.Printf("retry for connecting to controlDB timed out : %v", err)
+		slog.Warn("retry for connecting to controlDB timed out", "err", err)
 	}
 
 	p.usageCounter += 1